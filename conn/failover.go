@@ -0,0 +1,55 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conn
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IsRetriable returns whether a request which failed with the error may succeed if sent to
+// another server of the same group: there's no healthy connection to the server, or the server
+// is unavailable, e.g. because it's restarting or hasn't caught up with the membership yet.
+func IsRetriable(err error) bool {
+	return err == ErrNoConnection || err == ErrUnhealthyConnection ||
+		status.Code(err) == codes.Unavailable
+}
+
+// WithFailover calls fn with the pools one after the other, until a call succeeds or fails with
+// an error which isn't retriable, and returns what the last call returned. The pools which
+// became unhealthy are skipped. ErrNoConnection is returned if no pool could be called.
+func WithFailover(ctx context.Context, pools []*Pool,
+	fn func(ctx context.Context, pl *Pool) (interface{}, error)) (interface{}, error) {
+	err := ErrNoConnection
+	for _, pl := range pools {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if !pl.IsHealthy() {
+			continue
+		}
+		var reply interface{}
+		if reply, err = fn(ctx, pl); err == nil || !IsRetriable(err) {
+			return reply, err
+		}
+		glog.Warningf("Request to %s failed, trying the next server: %v", pl.Addr, err)
+	}
+	return nil, err
+}
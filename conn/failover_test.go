@@ -0,0 +1,94 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conn
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func healthyPool(addr string) *Pool {
+	return &Pool{Addr: addr, lastEcho: time.Now()}
+}
+
+func TestWithFailover(t *testing.T) {
+	pools := []*Pool{healthyPool("a"), {Addr: "unhealthy"}, healthyPool("b"), healthyPool("c")}
+	var called []string
+	call := func(errs map[string]error) func(context.Context, *Pool) (interface{}, error) {
+		called = called[:0]
+		return func(ctx context.Context, pl *Pool) (interface{}, error) {
+			called = append(called, pl.Addr)
+			if err := errs[pl.Addr]; err != nil {
+				return nil, err
+			}
+			return pl.Addr, nil
+		}
+	}
+	ctx := context.Background()
+
+	// The first server answering is used.
+	reply, err := WithFailover(ctx, pools, call(nil))
+	require.NoError(t, err)
+	require.Equal(t, "a", reply)
+	require.Equal(t, []string{"a"}, called)
+
+	// The unhealthy servers are skipped, and the servers unavailable are failed over.
+	reply, err = WithFailover(ctx, pools, call(map[string]error{
+		"a": status.Error(codes.Unavailable, "leader restarting"),
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "b", reply)
+	require.Equal(t, []string{"a", "b"}, called)
+
+	// The other errors are returned, as another server would fail the same way.
+	_, err = WithFailover(ctx, pools, call(map[string]error{"a": errors.New("bad query")}))
+	require.EqualError(t, err, "bad query")
+	require.Equal(t, []string{"a"}, called)
+
+	// The error of the last server is returned if none answered.
+	_, err = WithFailover(ctx, pools, call(map[string]error{
+		"a": ErrUnhealthyConnection,
+		"b": ErrUnhealthyConnection,
+		"c": ErrNoConnection,
+	}))
+	require.Equal(t, ErrNoConnection, err)
+	require.Equal(t, []string{"a", "b", "c"}, called)
+
+	_, err = WithFailover(ctx, nil, call(nil))
+	require.Equal(t, ErrNoConnection, err)
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = WithFailover(cctx, pools, call(nil))
+	require.Equal(t, context.Canceled, err)
+	require.Empty(t, called)
+}
+
+func TestIsRetriable(t *testing.T) {
+	require.True(t, IsRetriable(ErrNoConnection))
+	require.True(t, IsRetriable(ErrUnhealthyConnection))
+	require.True(t, IsRetriable(status.Error(codes.Unavailable, "unavailable")))
+	require.False(t, IsRetriable(status.Error(codes.InvalidArgument, "invalid")))
+	require.False(t, IsRetriable(context.DeadlineExceeded))
+	require.False(t, IsRetriable(nil))
+}
@@ -452,13 +452,16 @@ func (n *Node) proposeConfChange(ctx context.Context, pb raftpb.ConfChange) erro
 	}
 }
 
-func (n *Node) AddToCluster(ctx context.Context, pid uint64) error {
+// AddToCluster adds the peer to the Raft group, as a learner if isLearner is set. Learners get
+// the log replicated to them, but don't vote and can't become the leader.
+func (n *Node) AddToCluster(ctx context.Context, pid uint64, isLearner bool) error {
 	addr, ok := n.Peer(pid)
 	x.AssertTruef(ok, "Unable to find conn pool for peer: %d", pid)
 	rc := &pb.RaftContext{
-		Addr:  addr,
-		Group: n.RaftContext.Group,
-		Id:    pid,
+		Addr:      addr,
+		Group:     n.RaftContext.Group,
+		Id:        pid,
+		IsLearner: isLearner,
 	}
 	rcBytes, err := rc.Marshal()
 	x.Check(err)
@@ -468,9 +471,12 @@ func (n *Node) AddToCluster(ctx context.Context, pid uint64) error {
 		NodeID:  pid,
 		Context: rcBytes,
 	}
+	if isLearner {
+		cc.Type = raftpb.ConfChangeAddLearnerNode
+	}
 	err = errInternalRetry
 	for err == errInternalRetry {
-		glog.Infof("Trying to add %d to cluster. Addr: %v\n", pid, addr)
+		glog.Infof("Trying to add %d to cluster. Addr: %v, learner: %v\n", pid, addr, isLearner)
 		glog.Infof("Current confstate at %d: %+v\n", n.Id, n.ConfState())
 		err = n.proposeConfChange(ctx, cc)
 	}
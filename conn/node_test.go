@@ -75,7 +75,7 @@ func TestProposal(t *testing.T) {
 
 	db, err := openBadger(dir)
 	require.NoError(t, err)
-	store := raftwal.Init(db, 0, 0)
+	store := raftwal.Init(db, dir, 0, 0)
 
 	rc := &pb.RaftContext{Id: 1}
 	n := NewNode(rc, store)
@@ -21,7 +21,10 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"os"
+	"os/signal"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/dgraph-io/dgo/protos/api"
@@ -31,6 +34,7 @@ import (
 	"go.opencensus.io/plugin/ocgrpc"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 var (
@@ -61,6 +65,10 @@ type Pools struct {
 
 var pi *Pools
 
+// internalCreds are the credentials of the connections to the other nodes, if they use mutual
+// TLS. See SetupInternalTLS.
+var internalCreds credentials.TransportCredentials
+
 func init() {
 	pi = new(Pools)
 	pi.all = make(map[string]*Pool)
@@ -143,6 +151,39 @@ func (p *Pools) Connect(addr string) *Pool {
 	return pool
 }
 
+// SetupInternalTLS sets up mutual TLS for the connections to the other nodes with the
+// certificates in certDir, and returns the options of the gRPC server of the node accepting them.
+// Nothing is set up for an empty certDir. The certificates are reloaded on SIGHUP.
+func SetupInternalTLS(certDir string) ([]grpc.ServerOption, error) {
+	if certDir == "" {
+		return nil, nil
+	}
+	serverCfg, clientCfg, reload, err := x.GenerateInternalTLSConfig(certDir)
+	if err != nil {
+		return nil, err
+	}
+	internalCreds = credentials.NewTLS(clientCfg)
+
+	go func() {
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, syscall.SIGHUP)
+		for range sigChan {
+			reload()
+			glog.Infoln("Internal TLS certificates and CAs reloaded")
+		}
+	}()
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(serverCfg))}, nil
+}
+
+// InternalDialOption returns the option dialing the other nodes with mutual TLS if it's set up,
+// or without any security.
+func InternalDialOption() grpc.DialOption {
+	if internalCreds == nil {
+		return grpc.WithInsecure()
+	}
+	return grpc.WithTransportCredentials(internalCreds)
+}
+
 // NewPool creates a new "pool" with one gRPC connection, refcount 0.
 func NewPool(addr string) (*Pool, error) {
 	conn, err := grpc.Dial(addr,
@@ -151,7 +192,7 @@ func NewPool(addr string) (*Pool, error) {
 			grpc.MaxCallRecvMsgSize(x.GrpcMaxSize),
 			grpc.MaxCallSendMsgSize(x.GrpcMaxSize)),
 		grpc.WithBackoffMaxDelay(time.Second),
-		grpc.WithInsecure())
+		InternalDialOption())
 	if err != nil {
 		return nil, err
 	}
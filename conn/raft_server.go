@@ -139,9 +139,11 @@ func (w *RaftServer) IsPeer(ctx context.Context, rc *pb.RaftContext) (*pb.PeerRe
 		return &pb.PeerResponse{}, nil
 	}
 
-	for _, raftIdx := range node._confState.Nodes {
-		if rc.Id == raftIdx {
-			return &pb.PeerResponse{Status: true}, nil
+	for _, ids := range [][]uint64{node._confState.Nodes, node._confState.Learners} {
+		for _, raftIdx := range ids {
+			if rc.Id == raftIdx {
+				return &pb.PeerResponse{Status: true}, nil
+			}
 		}
 	}
 	return &pb.PeerResponse{}, nil
@@ -181,7 +183,7 @@ func (w *RaftServer) JoinCluster(ctx context.Context,
 	}
 	node.Connect(rc.Id, rc.Addr)
 
-	err := node.AddToCluster(context.Background(), rc.Id)
+	err := node.AddToCluster(context.Background(), rc.Id, rc.IsLearner)
 	glog.Infof("[%d] Done joining cluster with err: %v", rc.Id, err)
 	return &api.Payload{}, err
 }
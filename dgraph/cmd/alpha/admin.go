@@ -19,14 +19,21 @@ package alpha
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/query"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
 )
@@ -61,7 +68,32 @@ func exportHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	// Export logic can be moved to dgraphzero.
-	if err := worker.ExportOverNetwork(context.Background()); err != nil {
+	destination := r.URL.Query().Get("destination")
+	// All the namespaces are exported unless one is asked for.
+	var ns uint64
+	allNamespaces := true
+	if nsStr := r.URL.Query().Get("namespace"); len(nsStr) > 0 {
+		var err error
+		if ns, err = strconv.ParseUint(nsStr, 0, 64); err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, "Invalid namespace.")
+			return
+		}
+		if !worker.KnownNamespace(ns) {
+			x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf("Namespace %d doesn't exist.", ns))
+			return
+		}
+		allNamespaces = false
+	}
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "rdf"
+	}
+	if format != "rdf" && format != "json" {
+		x.SetStatus(w, x.ErrorInvalidRequest, "Invalid export format. Must be rdf or json.")
+		return
+	}
+	if err := worker.ExportOverNetwork(context.Background(), destination, ns,
+		allNamespaces, format); err != nil {
 		x.SetStatus(w, err.Error(), "Export failed.")
 		return
 	}
@@ -69,6 +101,221 @@ func exportHandler(w http.ResponseWriter, r *http.Request) {
 	x.Check2(w.Write([]byte(`{"code": "Success", "message": "Export completed."}`)))
 }
 
+// rollupHandler rolls up all the posting lists of this server in the background.
+func rollupHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r, http.MethodPost) {
+		return
+	}
+	if err := worker.TriggerRollup(); err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	x.Check2(w.Write([]byte(`{"code": "Success", "message": "Rollup started."}`)))
+}
+
+func canonicalSchemaHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r, http.MethodGet) {
+		return
+	}
+	canonical, hash, err := worker.CanonicalSchema(context.Background())
+	if err != nil {
+		x.SetStatus(w, err.Error(), "Fetching canonical schema failed.")
+		return
+	}
+	// The canonical form is returned as is, so that the exact bytes hashed can be signed.
+	resp := map[string]string{
+		"canonical": string(canonical),
+		"sha256":    hex.EncodeToString(hash[:]),
+	}
+	js, err := json.Marshal(resp)
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	x.Check2(w.Write(js))
+}
+
+// schemaDiffHandler compares the schema of the predicates listed in the a and b parameters,
+// each a comma separated list, or all of them if empty. Each side can be restricted to the
+// schema of a group with the a_group and b_group parameters. The fields compared can be given in
+// the fields parameter, the default ones are compared otherwise. The comparison stops once the
+// client goes away, or once the timeout parameter, or else the schema timeout, has passed.
+func schemaDiffHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r, http.MethodGet) {
+		return
+	}
+	group := func(param string) (uint32, error) {
+		val := r.URL.Query().Get(param)
+		if val == "" {
+			return 0, nil
+		}
+		gid, err := strconv.ParseUint(val, 0, 32)
+		return uint32(gid), err
+	}
+	groupA, err := group("a_group")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, "Invalid a_group: "+err.Error())
+		return
+	}
+	groupB, err := group("b_group")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, "Invalid b_group: "+err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	timeout := worker.Config.SchemaTimeout
+	if t := r.URL.Query().Get("timeout"); len(t) > 0 {
+		if timeout, err = time.ParseDuration(t); err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, "Invalid timeout: "+err.Error())
+			return
+		}
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	fields := paramList(r, "fields")
+	diff, err := worker.SchemaDiff(ctx,
+		&pb.SchemaRequest{GroupId: groupA, Predicates: paramList(r, "a"), Fields: fields},
+		&pb.SchemaRequest{GroupId: groupB, Predicates: paramList(r, "b"), Fields: fields})
+	if err != nil {
+		x.SetStatus(w, err.Error(), "Comparing schema failed.")
+		return
+	}
+	js, err := json.Marshal(diff)
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	x.Check2(w.Write(js))
+}
+
+// schemaDQLHandler returns the schema of the predicates of a namespace, the default one unless
+// the namespace parameter asks for another, in the schema syntax accepted by alter, so that it
+// can be kept and applied again later. Only the predicates listed in the pred parameter, a comma
+// separated list, are returned if it's given.
+func schemaDQLHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r, http.MethodGet) {
+		return
+	}
+	ns := x.DefaultNamespace
+	if nsStr := r.URL.Query().Get("namespace"); len(nsStr) > 0 {
+		var err error
+		if ns, err = strconv.ParseUint(nsStr, 0, 64); err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, "Invalid namespace.")
+			return
+		}
+		if !worker.KnownNamespace(ns) {
+			x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf("Namespace %d doesn't exist.", ns))
+			return
+		}
+	}
+	var preds []string
+	for _, pred := range paramList(r, "pred") {
+		preds = append(preds, x.NamespaceAttr(ns, pred))
+	}
+	nodes, err := worker.GetSchemaOverNetwork(r.Context(), &pb.SchemaRequest{Predicates: preds})
+	if err != nil {
+		x.SetStatus(w, err.Error(), "Fetching schema failed.")
+		return
+	}
+	// The predicates are written the way they're named in the namespace, for the schema to be
+	// applied in it.
+	nsNodes := nodes[:0]
+	for _, node := range nodes {
+		if pns, pred := x.ParseNamespaceAttr(node.Predicate); pns == ns {
+			node.Predicate = pred
+			nsNodes = append(nsNodes, node)
+		}
+	}
+	js, err := json.Marshal(map[string]string{"schema": worker.SchemaNodesToDQL(nsNodes)})
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	x.Check2(w.Write(js))
+}
+
+// schemaBatchHandler returns the schema asked for by each of the requests listed in the body, in
+// the same order. The body is a JSON list of requests, each with the predicates in pred and the
+// fields in fields, e.g. [{"pred": ["name"], "fields": ["type"]}]. The requests are merged so
+// that every group is asked once, however many requests ask for its predicates.
+func schemaBatchHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r, http.MethodPost) {
+		return
+	}
+	defer r.Body.Close()
+	var reqs []struct {
+		Predicates []string `json:"pred"`
+		Fields     []string `json:"fields"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, "Invalid request: "+err.Error())
+		return
+	}
+	schemaReqs := make([]*pb.SchemaRequest, 0, len(reqs))
+	for _, req := range reqs {
+		schemaReqs = append(schemaReqs,
+			&pb.SchemaRequest{Predicates: req.Predicates, Fields: req.Fields})
+	}
+	schemas, err := worker.GetSchemaBatchOverNetwork(r.Context(), schemaReqs)
+	if err != nil {
+		x.SetStatus(w, err.Error(), "Fetching schema failed.")
+		return
+	}
+	js, err := json.Marshal(map[string]interface{}{"schemas": schemas})
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	x.Check2(w.Write(js))
+}
+
+// schemaForQueryHandler returns the schema of just the predicates the query in the body refers
+// to, with the fields needed to plan its execution.
+func schemaForQueryHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r, http.MethodPost) {
+		return
+	}
+	defer r.Body.Close()
+	q, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	nodes, err := query.SchemaForQuery(r.Context(), gql.Request{Str: string(q)})
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	js, err := json.Marshal(map[string]interface{}{"schema": nodes})
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	x.Check2(w.Write(js))
+}
+
+// paramList returns the values of the comma separated list in the URL parameter.
+func paramList(r *http.Request, param string) []string {
+	var vals []string
+	for _, val := range strings.Split(r.URL.Query().Get(param), ",") {
+		if val = strings.TrimSpace(val); val != "" {
+			vals = append(vals, val)
+		}
+	}
+	return vals
+}
+
 func memoryLimitHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
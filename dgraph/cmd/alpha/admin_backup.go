@@ -41,7 +41,8 @@ func backupHandler(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, err.Error(), "Backup failed.")
 		return
 	}
-	if err := worker.BackupOverNetwork(context.Background(), target); err != nil {
+	forceFull := r.FormValue("force_full") == "true"
+	if err := worker.BackupOverNetwork(context.Background(), target, forceFull); err != nil {
 		x.SetStatus(w, err.Error(), "Backup failed.")
 		return
 	}
@@ -51,5 +52,5 @@ func backupHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 func init() {
-	http.HandleFunc("/admin/backup", backupHandler)
+	http.HandleFunc("/admin/backup", x.AuditHandler(backupHandler))
 }
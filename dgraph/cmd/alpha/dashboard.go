@@ -55,6 +55,7 @@ func keywordHandler(w http.ResponseWriter, r *http.Request) {
 		"and",
 		"anyofterms",
 		"anyoftext",
+		"bm25",
 		"contains",
 		"count",
 		"delete",
@@ -71,6 +72,7 @@ func keywordHandler(w http.ResponseWriter, r *http.Request) {
 		"le",
 		"mutation",
 		"near",
+		"nearest",
 		"offset",
 		"or",
 		"orderasc",
@@ -0,0 +1,146 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package alpha
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/graphql"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// graphqlHandler serves standard GraphQL requests, sent as the JSON body of a POST request, as
+// the body of a POST request of type application/graphql, or in the query, operationName and
+// variables parameters of a GET request.
+func graphqlHandler(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	w.Header().Set("Content-Type", "application/json")
+
+	var req graphql.Request
+	switch r.Method {
+	case http.MethodOptions:
+		return
+	case http.MethodGet:
+		params := r.URL.Query()
+		req.Query = params.Get("query")
+		req.OperationName = params.Get("operationName")
+		if vars := params.Get("variables"); len(vars) > 0 {
+			dec := json.NewDecoder(strings.NewReader(vars))
+			dec.UseNumber()
+			if err := dec.Decode(&req.Variables); err != nil {
+				x.SetStatus(w, x.ErrorInvalidRequest, "Invalid variables: "+err.Error())
+				return
+			}
+		}
+	case http.MethodPost:
+		defer r.Body.Close()
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "application/graphql") {
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+				return
+			}
+			req.Query = string(body)
+			break
+		}
+		dec := json.NewDecoder(r.Body)
+		dec.UseNumber()
+		if err := dec.Decode(&req); err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, "Invalid request: "+err.Error())
+			return
+		}
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	resp := graphql.Execute(attachTokens(context.Background(), r), &req)
+	if js, err := json.Marshal(resp); err == nil {
+		x.Check2(w.Write(js))
+	} else {
+		x.SetStatusWithData(w, x.Error, "Unable to marshal response")
+	}
+}
+
+// graphqlSchemaHandler returns the GraphQL schema uploaded, along with the schema of the queries
+// and mutations generated for it, or uploads a new one.
+func graphqlSchemaHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		graphqlSchemaGetHandler(w, r)
+	case http.MethodPost:
+		graphqlSchemaPostHandler(w, r)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+	}
+}
+
+func graphqlSchemaGetHandler(w http.ResponseWriter, r *http.Request) {
+	schema, err := graphql.GetSchema(attachTokens(context.Background(), r))
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	js, err := json.Marshal(map[string]string{
+		"schema": schema.SDL,
+		"api":    schema.API(),
+	})
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	x.Check2(w.Write(js))
+}
+
+func graphqlSchemaPostHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r, http.MethodPost) {
+		return
+	}
+	defer r.Body.Close()
+	sdl, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	if _, err := graphql.UpdateSchema(attachTokens(context.Background(), r),
+		string(sdl)); err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	x.Check2(w.Write([]byte(`{"code": "Success", "message": "GraphQL schema updated."}`)))
+}
+
+// graphqlDgraphSchema returns the Dgraph schema the GraphQL schema uploaded expects, empty if
+// none was uploaded.
+func graphqlDgraphSchema(ctx context.Context) (string, error) {
+	schema, err := graphql.GetSchema(ctx)
+	switch {
+	case err == graphql.ErrNoSchema:
+		return "", nil
+	case err != nil:
+		return "", err
+	}
+	return schema.Dgraph(), nil
+}
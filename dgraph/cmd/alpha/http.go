@@ -17,12 +17,13 @@
 package alpha
 
 import (
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -35,6 +36,7 @@ import (
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 func allowed(method string) bool {
@@ -59,6 +61,85 @@ func extractStartTs(urlPath string) (uint64, error) {
 	}
 }
 
+// attachTokens passes the auth token, the access jwt and the namespace of the request, if
+// present, on to the server in the metadata of the context, and the address of the client as
+// its peer.
+func attachTokens(ctx context.Context, r *http.Request) context.Context {
+	md := metadata.New(nil)
+	md.Append("auth-token", r.Header.Get("X-Dgraph-AuthToken"))
+	md.Append("accessJwt", r.Header.Get("X-Dgraph-AccessToken"))
+	md.Append("namespace", r.Header.Get("X-Dgraph-Namespace"))
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// gzipResponseWriter compresses everything written to the response with gzip.
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	gz *gzip.Writer
+}
+
+func (w *gzipResponseWriter) Write(b []byte) (int, error) {
+	return w.gz.Write(b)
+}
+
+// acceptsGzip returns whether the client accepts a response compressed with gzip, as told by
+// the Accept-Encoding header of the request.
+func acceptsGzip(r *http.Request) bool {
+	for _, enc := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		parts := strings.Split(enc, ";")
+		if strings.TrimSpace(parts[0]) != "gzip" {
+			continue
+		}
+		// A quality of zero means gzip isn't acceptable.
+		for _, param := range parts[1:] {
+			if q := strings.TrimSpace(param); strings.HasPrefix(q, "q=") {
+				v, err := strconv.ParseFloat(q[2:], 64)
+				return err == nil && v > 0
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// withCompression decompresses the body of the requests with a Content-Encoding of gzip before
+// passing them on to h, and compresses the response written by h with gzip if the client accepts
+// it. Large JSON results are many times smaller compressed.
+func withCompression(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch enc := r.Header.Get("Content-Encoding"); enc {
+		case "", "identity":
+		case "gzip":
+			gz, err := gzip.NewReader(r.Body)
+			if err != nil {
+				x.AddCorsHeaders(w)
+				x.SetStatus(w, x.ErrorInvalidRequest, "Unable to read the gzip body: "+err.Error())
+				return
+			}
+			defer gz.Close()
+			r.Body = gz
+		default:
+			x.AddCorsHeaders(w)
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			x.SetStatus(w, x.ErrorInvalidRequest, "Unsupported Content-Encoding: "+enc)
+			return
+		}
+
+		w.Header().Add("Vary", "Accept-Encoding")
+		if !acceptsGzip(r) {
+			h(w, r)
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		defer gz.Close()
+		h(&gzipResponseWriter{ResponseWriter: w, gz: gz}, r)
+	}
+}
+
 // This method should just build the request and proxy it to the Query method of dgraph.Server.
 // It can then encode the response as appropriate before sending it back to the user.
 func queryHandler(w http.ResponseWriter, r *http.Request) {
@@ -102,6 +183,25 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 
 	d := r.URL.Query().Get("debug")
 	ctx := context.WithValue(context.Background(), "debug", d)
+	ctx = attachTokens(ctx, r)
+
+	// If debug is explain, return the plan the query was run with.
+	var plan *worker.QueryPlan
+	if d == "explain" || r.Header.Get("X-Dgraph-Explain") == "true" {
+		ctx, plan = worker.WithQueryPlan(ctx)
+	}
+
+	// If timeout is set, stop the query once it has run for that long.
+	if t := r.URL.Query().Get("timeout"); len(t) > 0 {
+		d, err := time.ParseDuration(t)
+		if err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, "Invalid timeout: "+err.Error())
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d)
+		defer cancel()
+	}
 
 	// If ro is set, run this as a readonly query.
 	if ro := r.URL.Query().Get("ro"); len(ro) > 0 && req.StartTs == 0 {
@@ -109,6 +209,13 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 			req.ReadOnly = true
 		}
 	}
+	// If be is set, run this as a best effort query, which is also readonly.
+	if be := r.URL.Query().Get("be"); len(be) > 0 && req.StartTs == 0 {
+		if be == "true" || be == "1" {
+			req.BestEffort = true
+			req.ReadOnly = true
+		}
+	}
 
 	// Core processing happens here.
 	resp, err := (&edgraph.Server{}).Query(ctx, &req)
@@ -122,25 +229,11 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	e := query.Extensions{
 		Txn:     resp.Txn,
 		Latency: resp.Latency,
+		Plan:    plan,
 	}
 	response["extensions"] = e
 
-	// User can either ask for schema or have a query.
-	if len(resp.Schema) > 0 {
-		sort.Slice(resp.Schema, func(i, j int) bool {
-			return resp.Schema[i].Predicate < resp.Schema[j].Predicate
-		})
-		js, err := json.Marshal(resp.Schema)
-		if err != nil {
-			x.SetStatusWithData(w, x.Error, "Unable to marshal schema")
-			return
-		}
-		mp := map[string]interface{}{}
-		mp["schema"] = json.RawMessage(string(js))
-		response["data"] = mp
-	} else {
-		response["data"] = json.RawMessage(string(resp.Json))
-	}
+	response["data"] = json.RawMessage(string(resp.Json))
 
 	if js, err := json.Marshal(response); err == nil {
 		w.Write(js)
@@ -172,6 +265,7 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	parseStart := time.Now()
 
 	var mu *api.Mutation
+	var up *gql.Upsert
 	if mType := r.Header.Get("X-Dgraph-MutationType"); mType == "json" {
 		// Parse JSON.
 		ms := make(map[string]*skipJSONUnmarshal)
@@ -188,6 +282,15 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 		if delJSON, ok := ms["delete"]; ok && delJSON != nil {
 			mu.DeleteJson = delJSON.bs
 		}
+	} else if strings.HasPrefix(strings.TrimSpace(string(m)), "upsert") {
+		// Parse the query and the conditional mutations of an upsert block.
+		up, err = gql.ParseUpsert(string(m))
+		if err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+			return
+		}
+		// The mutations are run by the upsert, this only holds the commit and start ts.
+		mu = &api.Mutation{}
 	} else {
 		// Parse NQuads.
 		mu, err = gql.ParseMutation(string(m))
@@ -218,7 +321,13 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	mu.StartTs = ts
 
-	resp, err := (&edgraph.Server{}).Mutate(context.Background(), mu)
+	ctx := attachTokens(context.Background(), r)
+	var resp *api.Assigned
+	if up != nil {
+		resp, err = (&edgraph.Server{}).Upsert(ctx, up, mu.StartTs, mu.CommitNow)
+	} else {
+		resp, err = (&edgraph.Server{}).Mutate(ctx, mu)
+	}
 	if err != nil {
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
 		return
@@ -300,6 +409,7 @@ func commitHandler(w http.ResponseWriter, r *http.Request) {
 	tc.Keys = encodedKeys
 
 	cts, err := worker.CommitOverNetwork(context.Background(), tc)
+	edgraph.AuditCommit(attachTokens(context.Background(), r), tc, cts, err)
 	if err != nil {
 		x.SetStatus(w, x.Error, err.Error())
 		return
@@ -358,6 +468,7 @@ func abortHandler(w http.ResponseWriter, r *http.Request) {
 	tc.Aborted = true
 
 	_, aerr := worker.CommitOverNetwork(context.Background(), tc)
+	edgraph.AuditCommit(attachTokens(context.Background(), r), tc, 0, aerr)
 	if aerr != nil {
 		x.SetStatus(w, x.Error, aerr.Error())
 		return
@@ -375,6 +486,27 @@ func abortHandler(w http.ResponseWriter, r *http.Request) {
 	w.Write(js)
 }
 
+// parseOperation parses the body of an alter request, which is either the operation as JSON or
+// the schema. The drop op can be given by name, like {"drop_op": "DATA"}.
+func parseOperation(b []byte) *api.Operation {
+	op := &api.Operation{}
+	if err := json.Unmarshal(b, op); err == nil {
+		return op
+	}
+	var named struct {
+		api.Operation
+		DropOp string `json:"drop_op"`
+	}
+	if err := json.Unmarshal(b, &named); err == nil {
+		if v, ok := api.Operation_DropOp_value[named.DropOp]; ok {
+			op = &named.Operation
+			op.DropOp = api.Operation_DropOp(v)
+			return op
+		}
+	}
+	return &api.Operation{Schema: string(b)}
+}
+
 func alterHandler(w http.ResponseWriter, r *http.Request) {
 	x.AddCorsHeaders(w)
 	w.Header().Set("Content-Type", "application/json")
@@ -389,19 +521,13 @@ func alterHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	op := &api.Operation{}
-
 	defer r.Body.Close()
 	b, err := ioutil.ReadAll(r.Body)
 	if err != nil {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
-
-	err = json.Unmarshal(b, &op)
-	if err != nil {
-		op.Schema = string(b)
-	}
+	op := parseOperation(b)
 
 	glog.Infof("Got alter request via HTTP from %s\n", r.RemoteAddr)
 	fwd := r.Header.Get("X-Forwarded-For")
@@ -409,10 +535,7 @@ func alterHandler(w http.ResponseWriter, r *http.Request) {
 		glog.Infof("The alter request is forwarded by %s\n", fwd)
 	}
 
-	md := metadata.New(nil)
-	// Pass in an auth token, if present.
-	md.Append("auth-token", r.Header.Get("X-Dgraph-AuthToken"))
-	ctx := metadata.NewIncomingContext(context.Background(), md)
+	ctx := attachTokens(context.Background(), r)
 	if _, err = (&edgraph.Server{}).Alter(ctx, op); err != nil {
 		x.SetStatus(w, x.Error, err.Error())
 		return
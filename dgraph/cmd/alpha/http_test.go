@@ -18,6 +18,7 @@ package alpha
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -27,6 +28,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/query"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
@@ -200,3 +202,61 @@ func TestAlterAllFieldsShouldBeSet(t *testing.T) {
 	require.Len(t, qr.Errors, 1)
 	require.Equal(t, qr.Errors[0].Code, "Error")
 }
+
+func TestParseOperation(t *testing.T) {
+	op := parseOperation([]byte(`{"drop_all": true}`))
+	require.True(t, op.DropAll)
+	op = parseOperation([]byte(`{"drop_op": "DATA"}`))
+	require.Equal(t, api.Operation_DATA, op.DropOp)
+	op = parseOperation([]byte(`{"drop_op": "ATTR", "drop_value": "name"}`))
+	require.Equal(t, api.Operation_ATTR, op.DropOp)
+	require.Equal(t, "name", op.DropValue)
+	op = parseOperation([]byte(`{"drop_op": 2}`))
+	require.Equal(t, api.Operation_DATA, op.DropOp)
+
+	op = parseOperation([]byte(`name: string .`))
+	require.Equal(t, "name: string .", op.Schema)
+	op = parseOperation([]byte(`{"drop_op": "NOTHING"}`))
+	require.Equal(t, `{"drop_op": "NOTHING"}`, op.Schema)
+}
+
+func TestCompression(t *testing.T) {
+	echo := withCompression(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		w.Write(body)
+	})
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(`{"set":[]}`))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	req, err := http.NewRequest("POST", "/mutate", &buf)
+	require.NoError(t, err)
+	req.Header.Set("Content-Encoding", "gzip")
+	req.Header.Set("Accept-Encoding", "deflate, gzip")
+	rr := httptest.NewRecorder()
+	echo(rr, req)
+	require.Equal(t, "gzip", rr.Header().Get("Content-Encoding"))
+	gzr, err := gzip.NewReader(rr.Body)
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(gzr)
+	require.NoError(t, err)
+	require.Equal(t, `{"set":[]}`, string(body))
+
+	req, err = http.NewRequest("POST", "/query", bytes.NewBufferString("{}"))
+	require.NoError(t, err)
+	req.Header.Set("Accept-Encoding", "gzip;q=0")
+	rr = httptest.NewRecorder()
+	echo(rr, req)
+	require.Empty(t, rr.Header().Get("Content-Encoding"))
+	require.Equal(t, "{}", rr.Body.String())
+
+	req, err = http.NewRequest("POST", "/query", bytes.NewBufferString("{}"))
+	require.NoError(t, err)
+	req.Header.Set("Content-Encoding", "br")
+	rr = httptest.NewRecorder()
+	echo(rr, req)
+	require.Equal(t, http.StatusUnsupportedMediaType, rr.Code)
+}
@@ -36,16 +36,17 @@ import (
 	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/storage"
 	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/golang/glog"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
-	"go.opencensus.io/exporter/jaeger"
 	"go.opencensus.io/plugin/ocgrpc"
-	otrace "go.opencensus.io/trace"
 	"go.opencensus.io/zpages"
 	"golang.org/x/net/context"
 	"golang.org/x/net/trace"
@@ -99,8 +100,7 @@ they form a Raft group and provide synchronous replication.
 			" mmap consumes more RAM, but provides better performance.")
 
 	// OpenCensus flags.
-	flag.Float64("trace", 1.0, "The ratio of queries to trace.")
-	flag.String("jaeger.collector", "", "Send opencensus traces to Jaeger.")
+	x.RegisterTracingFlags(flag)
 
 	flag.StringP("wal", "w", "w", "Directory to store raft write-ahead logs.")
 	flag.Bool("nomutations", false, "Don't allow mutations on this server.")
@@ -116,25 +116,71 @@ they form a Raft group and provide synchronous replication.
 		"IP_ADDRESS:PORT of a Dgraph Zero.")
 	flag.Uint64("idx", 0,
 		"Optional Raft ID that this Dgraph Alpha will use to join RAFT groups.")
+	flag.Int("learner_of", 0,
+		"Join the group with this id as a learner, a read-only replica which gets the data of the"+
+			" group but never votes nor becomes its leader. Useful to run best-effort queries"+
+			" without loading the members of the group. Use 0 to join as a regular member.")
+	flag.String("cdc", "", "The file the changes made by the transactions committed in the"+
+		" group of this server are published to, one JSON object per line in the order of"+
+		" their commit ts. The last commit ts published is kept next to it in a .checkpoint"+
+		" file. Empty for no change data capture.")
 	flag.Bool("expand_edge", true,
 		"Enables the expand() feature. This is very expensive for large data loads because it"+
 			" doubles the number of mutations going on in the system.")
 	flag.Int("max_retries", -1,
 		"Commits to disk will give up after these number of retries to prevent locking the worker"+
 			" in a failed state. Use -1 to retry infinitely.")
+	flag.Bool("schema_refresh", false,
+		"Allow the schema held in memory to be reloaded from disk through the RefreshSchema RPC."+
+			" Only meant to recover from a schema update that didn't propagate.")
+	flag.Int("schema_fanout", 0,
+		"Number of groups asked for their schema at the same time by a schema query."+
+			" Use 0 to ask all the groups at once.")
+	flag.Duration("schema_timeout", 30*time.Second,
+		"Time to wait for another group to return its schema, for schema queries without"+
+			" a deadline. Use 0 to wait indefinitely.")
+	flag.Int("schema_retries", 0,
+		"Number of times a schema query failing while the cluster membership is syncing is"+
+			" retried.")
+	flag.Duration("schema_retry_backoff", 100*time.Millisecond,
+		"Time to wait before retrying a schema query the first time. It doubles every retry.")
+	flag.Int("rollup_deltas", 100,
+		"Number of deltas a posting list is rolled up at, without waiting for the rollup of"+
+			" all of them. Use 0 to disable.")
+	flag.Duration("rollup_age", 10*time.Minute,
+		"Age of the oldest delta a posting list is rolled up at, without waiting for the"+
+			" rollup of all of them. Use 0 to disable.")
+	flag.Int64("rollup_disk_growth_mb", 4096,
+		"Growth of the store in MB since the last rollup which triggers the rollup of all the"+
+			" posting lists. Use 0 to disable.")
+	flag.Bool("background_indexing", false,
+		"Build the index added to an existing predicate in the background, accepting mutations"+
+			" meanwhile. Functions needing the index fail until it's built.")
 	flag.String("auth_token", "",
 		"If set, all Alter requests to Dgraph would need to have this token."+
 			" The token can be passed as follows: For HTTP requests, in X-Dgraph-AuthToken header."+
 			" For Grpc, in auth-token key in the context.")
+	flag.String("storage_access_key", "", "The access key to the object store exports and"+
+		" backups are sent to. Defaults to the environment variable of the store, e.g."+
+		" AWS_ACCESS_KEY_ID, MINIO_ACCESS_KEY or GS_ACCESS_KEY_ID.")
+	flag.String("storage_secret_key", "", "The secret key to the object store exports and"+
+		" backups are sent to. Defaults to the environment variable of the store, e.g."+
+		" AWS_SECRET_ACCESS_KEY, MINIO_SECRET_KEY or GS_SECRET_ACCESS_KEY.")
 	flag.String("hmac_secret_file", "", "The file storing the HMAC secret"+
 		" that is used for signing the JWT. Enterprise feature.")
 	flag.Duration("access_jwt_ttl", 6*time.Hour, "The TTL for the access jwt. "+
 		"Enterprise feature.")
 	flag.Duration("refresh_jwt_ttl", 30*24*time.Hour, "The TTL for the refresh jwt. "+
 		"Enterprise feature.")
+	flag.Duration("acl_refresh_interval", 30*time.Second, "The interval to reload the acls"+
+		" at, which is how long changes to them can take to reach this alpha. "+
+		"Enterprise feature.")
 	flag.Float64P("lru_mb", "l", -1,
 		"Estimated memory the LRU cache can take. "+
 			"Actual usage by the process would be more than specified here.")
+	flag.Float64("lru_fraction", 0,
+		"Fraction of the memory available to the process, or its cgroup, the LRU cache is "+
+			"limited to. If set, the size of the cache doesn't depend on --lru_mb.")
 	flag.Bool("debugmode", false,
 		"Enable debug mode for more debug information.")
 
@@ -145,10 +191,41 @@ they form a Raft group and provide synchronous replication.
 	flag.Uint64("query_edge_limit", 1e6,
 		"Limit for the maximum number of edges that can be returned in a query."+
 			" This is only useful for shortest path queries.")
+	flag.Duration("query_log_threshold", 0, "Log the queries taking at least this long,"+
+		" with their variables, latency, predicates and timestamps. 0 disables the log.")
+	flag.String("query_log", "", "The file the slow queries are logged to, as one JSON"+
+		" object per line. Defaults to the server log.")
+	flag.Int64("query_log_size_mb", 100, "The size the file of the slow queries is rotated at."+
+		" The last 5 rotated files are kept.")
+	flag.String("audit_log", "", "The file every alter, mutation, commit, login and admin"+
+		" endpoint call is recorded in, appended to it with a chain of hashes. Empty disables it.")
+	flag.Duration("query_timeout", 0, "Stop the queries running for longer than this, unless"+
+		" the request has an earlier deadline. 0 means no limit.")
+	flag.Int64("query_cache_mb", 0, "The size of the cache of the results of read-only queries,"+
+		" kept until a transaction commits to the predicates they read. 0 disables the cache.")
+	flag.Duration("query_cache_ttl", time.Minute, "The longest a result is served from the"+
+		" query cache. Bounds how stale results can get after drops and schema changes made on"+
+		" other groups. 0 means no limit.")
+	flag.Int("max_pending_queries", 0, "The max number of queries run at once. Further"+
+		" queries fail with a retryable RESOURCE_EXHAUSTED error. 0 means no limit.")
+	flag.Int("max_pending_mutations", 0, "The max number of mutations run at once. Further"+
+		" mutations fail with a retryable RESOURCE_EXHAUSTED error. 0 means no limit.")
+	flag.Float64("mutation_edges_per_sec", 0, "The max number of edges mutated per second."+
+		" 0 means no limit.")
+	flag.Duration("graceful_timeout", 30*time.Second, "On shutdown, how long to wait for the"+
+		" queries in flight and the pending transactions to finish, and for the Raft leadership"+
+		" to be transferred, before the server stops.")
+	flag.Float64("client_mutation_edges_per_sec", 0, "The max number of edges mutated per"+
+		" second by a client, told apart by the user of its access jwt or its IP address."+
+		" 0 means no limit.")
+	flag.Bool("ludicrous_mode", false, "Commit the mutations run with CommitNow in a new"+
+		" transaction as soon as they're applied, without conflict detection nor waiting for Zero."+
+		" Trades consistency for write throughput.")
 
 	// TLS configurations
 	x.RegisterTLSFlags(flag)
 	flag.String("tls_client_auth", "VERIFYIFGIVEN", "Enable TLS client authentication")
+	x.RegisterInternalTLSFlags(flag)
 	tlsConf.ConfigType = x.TLSServerConfig
 
 	//Custom plugins.
@@ -218,14 +295,26 @@ func grpcPort() int {
 	return x.Config.PortOffset + x.PortGrpc
 }
 
+// healthCheck returns the health of the server and of the groups of the cluster as JSON, with
+// the status 200 if the server accepts requests, and 503 otherwise.
 func healthCheck(w http.ResponseWriter, r *http.Request) {
 	x.AddCorsHeaders(w)
-	if err := x.HealthCheck(); err == nil {
+	w.Header().Set("Content-Type", "application/json")
+
+	info := worker.Health()
+	// Load balancers stop sending requests to a server shutting down.
+	if edgraph.Draining() {
+		info.Healthy, info.Draining = false, true
+	}
+	if info.Healthy {
 		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("OK"))
 	} else {
 		w.WriteHeader(http.StatusServiceUnavailable)
 	}
+	m := jsonpb.Marshaler{EmitDefaults: true}
+	if err := m.Marshal(w, info); err != nil {
+		glog.Errorf("Unable to marshal the health of the server: %v", err)
+	}
 }
 
 // storeStatsHandler outputs some basic stats for data store.
@@ -255,19 +344,6 @@ func setupListener(addr string, port int, reload func()) (net.Listener, error) {
 func serveGRPC(l net.Listener, tlsCfg *tls.Config, wg *sync.WaitGroup) {
 	defer wg.Done()
 
-	if collector := Alpha.Conf.GetString("jaeger.collector"); len(collector) > 0 {
-		// Port details: https://www.jaegertracing.io/docs/getting-started/
-		// Default collectorEndpointURI := "http://localhost:14268"
-		je, err := jaeger.NewExporter(jaeger.Options{
-			Endpoint:    collector,
-			ServiceName: "dgraph.alpha",
-		})
-		if err != nil {
-			log.Fatalf("Failed to create the Jaeger exporter: %v", err)
-		}
-		// And now finally register it as a Trace Exporter
-		otrace.RegisterExporter(je)
-	}
 	// Exclusively for stats, metrics, etc. Not for tracing.
 	// var views = append(ocgrpc.DefaultServerViews, ocgrpc.DefaultClientViews...)
 	// if err := view.Register(views...); err != nil {
@@ -286,10 +362,22 @@ func serveGRPC(l net.Listener, tlsCfg *tls.Config, wg *sync.WaitGroup) {
 
 	s := grpc.NewServer(opt...)
 	api.RegisterDgraphServer(s, &edgraph.Server{})
+	pb.RegisterDgraphServer(s, &edgraph.Server{})
 	hapi.RegisterHealthServer(s, health.NewServer())
 	err := s.Serve(l)
 	glog.Errorf("GRPC listener canceled: %v\n", err)
-	s.Stop()
+
+	// Let the requests in flight finish, up to the graceful timeout.
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-shutdownContext().Done():
+		s.Stop()
+	}
 }
 
 func serveHTTP(l net.Listener, tlsCfg *tls.Config, wg *sync.WaitGroup) {
@@ -308,9 +396,7 @@ func serveHTTP(l net.Listener, tlsCfg *tls.Config, wg *sync.WaitGroup) {
 		err = srv.Serve(l)
 	}
 	glog.Errorf("Stopped taking more http(s) requests. Err: %v", err)
-	ctx, cancel := context.WithTimeout(context.Background(), 630*time.Second)
-	defer cancel()
-	if err := srv.Shutdown(ctx); err != nil {
+	if err := srv.Shutdown(shutdownContext()); err != nil {
 		log.Printf("Http(s) shutdown err: %v", err.Error())
 	}
 }
@@ -345,22 +431,30 @@ func setupServer() {
 		log.Fatal(err)
 	}
 
-	http.HandleFunc("/query", queryHandler)
-	http.HandleFunc("/query/", queryHandler)
-	http.HandleFunc("/mutate", mutationHandler)
-	http.HandleFunc("/mutate/", mutationHandler)
+	http.HandleFunc("/query", withCompression(queryHandler))
+	http.HandleFunc("/query/", withCompression(queryHandler))
+	http.HandleFunc("/mutate", withCompression(mutationHandler))
+	http.HandleFunc("/mutate/", withCompression(mutationHandler))
 	http.HandleFunc("/commit/", commitHandler)
 	http.HandleFunc("/abort/", abortHandler)
 	http.HandleFunc("/alter", alterHandler)
 	http.HandleFunc("/health", healthCheck)
 	http.HandleFunc("/share", shareHandler)
+	http.HandleFunc("/graphql", graphqlHandler)
 
 	// TODO: Figure out what this is for?
 	http.HandleFunc("/debug/store", storeStatsHandler)
 
-	http.HandleFunc("/admin/shutdown", shutDownHandler)
-	http.HandleFunc("/admin/export", exportHandler)
-	http.HandleFunc("/admin/config/lru_mb", memoryLimitHandler)
+	http.HandleFunc("/admin/shutdown", x.AuditHandler(shutDownHandler))
+	http.HandleFunc("/admin/export", x.AuditHandler(exportHandler))
+	http.HandleFunc("/admin/schema/canonical", x.AuditHandler(canonicalSchemaHandler))
+	http.HandleFunc("/admin/schema/diff", x.AuditHandler(schemaDiffHandler))
+	http.HandleFunc("/admin/schema/dql", x.AuditHandler(schemaDQLHandler))
+	http.HandleFunc("/admin/schema/batch", x.AuditHandler(schemaBatchHandler))
+	http.HandleFunc("/admin/schema/query", x.AuditHandler(schemaForQueryHandler))
+	http.HandleFunc("/admin/schema/graphql", x.AuditHandler(graphqlSchemaHandler))
+	http.HandleFunc("/admin/config/lru_mb", x.AuditHandler(memoryLimitHandler))
+	http.HandleFunc("/admin/rollup", x.AuditHandler(rollupHandler))
 
 	// Add OpenCensus z-pages.
 	zpages.Handle(http.DefaultServeMux, "/z")
@@ -377,6 +471,7 @@ func setupServer() {
 	go func() {
 		defer wg.Done()
 		<-shutdownCh
+		drain()
 		// Stops grpc/http servers; Already accepted connections are not closed.
 		grpcListener.Close()
 		httpListener.Close()
@@ -385,10 +480,42 @@ func setupServer() {
 	glog.Infoln("gRPC server started.  Listening on port", grpcPort())
 	glog.Infoln("HTTP server started.  Listening on port", httpPort())
 	wg.Wait()
+	shutdownContext()
+	shutdownCancel()
 }
 
 var shutdownCh chan struct{}
 
+var (
+	shutdownOnce   sync.Once
+	shutdownCtx    context.Context
+	shutdownCancel context.CancelFunc
+)
+
+// shutdownContext returns the context which is done once the graceful timeout, started with the
+// first call, is over.
+func shutdownContext() context.Context {
+	shutdownOnce.Do(func() {
+		shutdownCtx, shutdownCancel = context.WithTimeout(context.Background(),
+			Alpha.Conf.GetDuration("graceful_timeout"))
+	})
+	return shutdownCtx
+}
+
+// drain stops the server from taking new queries and transactions, and waits for the ones in
+// flight to finish before handing the leadership of the group over to another member.
+func drain() {
+	glog.Infof("Draining the server, up to %v", Alpha.Conf.GetDuration("graceful_timeout"))
+	edgraph.StartDraining()
+	ctx := shutdownContext()
+	if err := edgraph.WaitForDrain(ctx); err != nil {
+		glog.Warningf("Stopping before the requests in flight are done: %v", err)
+	}
+	if err := worker.TransferLeadership(ctx); err != nil {
+		glog.Warningf("Unable to transfer the leadership: %v", err)
+	}
+}
+
 func run() {
 	bindall = Alpha.Conf.GetBool("bindall")
 
@@ -402,6 +529,22 @@ func run() {
 		Nomutations:    Alpha.Conf.GetBool("nomutations"),
 		AuthToken:      Alpha.Conf.GetString("auth_token"),
 		AllottedMemory: Alpha.Conf.GetFloat64("lru_mb"),
+		LruFraction:    Alpha.Conf.GetFloat64("lru_fraction"),
+
+		QueryLogThreshold: Alpha.Conf.GetDuration("query_log_threshold"),
+		QueryLogFile:      Alpha.Conf.GetString("query_log"),
+		QueryLogSizeMB:    Alpha.Conf.GetInt64("query_log_size_mb"),
+		QueryTimeout:      Alpha.Conf.GetDuration("query_timeout"),
+		QueryCacheMB:      Alpha.Conf.GetInt64("query_cache_mb"),
+		QueryCacheTTL:     Alpha.Conf.GetDuration("query_cache_ttl"),
+
+		MaxPendingQueries:         Alpha.Conf.GetInt("max_pending_queries"),
+		MaxPendingMutations:       Alpha.Conf.GetInt("max_pending_mutations"),
+		MutationEdgesPerSec:       Alpha.Conf.GetFloat64("mutation_edges_per_sec"),
+		ClientMutationEdgesPerSec: Alpha.Conf.GetFloat64("client_mutation_edges_per_sec"),
+		LudicrousMode:             Alpha.Conf.GetBool("ludicrous_mode"),
+
+		GraphQLSchema: graphqlDgraphSchema,
 	}
 
 	secretFile := Alpha.Conf.GetString("hmac_secret_file")
@@ -414,15 +557,22 @@ func run() {
 		opts.HmacSecret = hmacSecret
 		opts.AccessJwtTtl = Alpha.Conf.GetDuration("access_jwt_ttl")
 		opts.RefreshJwtTtl = Alpha.Conf.GetDuration("refresh_jwt_ttl")
+		opts.AclRefreshInterval = Alpha.Conf.GetDuration("acl_refresh_interval")
 
 		glog.Info("HMAC secret loaded successfully.")
 	}
 	edgraph.SetConfiguration(opts)
 
+	storage.Config = storage.Options{
+		AccessKey: Alpha.Conf.GetString("storage_access_key"),
+		SecretKey: Alpha.Conf.GetString("storage_secret_key"),
+	}
+
 	ips, err := parseIPsFromString(Alpha.Conf.GetString("whitelist"))
 	x.Check(err)
 	worker.Config = worker.Options{
 		ExportPath:          Alpha.Conf.GetString("export"),
+		WALDir:              Alpha.Conf.GetString("wal"),
 		NumPendingProposals: Alpha.Conf.GetInt("pending_proposals"),
 		Tracing:             Alpha.Conf.GetFloat64("trace"),
 		MyAddr:              Alpha.Conf.GetString("my"),
@@ -431,6 +581,18 @@ func run() {
 		ExpandEdge:          Alpha.Conf.GetBool("expand_edge"),
 		WhiteListedIPRanges: ips,
 		MaxRetries:          Alpha.Conf.GetInt("max_retries"),
+		SchemaRefresh:       Alpha.Conf.GetBool("schema_refresh"),
+		SchemaFanout:        Alpha.Conf.GetInt("schema_fanout"),
+		SchemaTimeout:       Alpha.Conf.GetDuration("schema_timeout"),
+		SchemaRetries:       Alpha.Conf.GetInt("schema_retries"),
+		SchemaRetryBackoff:  Alpha.Conf.GetDuration("schema_retry_backoff"),
+		BackgroundIndexing:  Alpha.Conf.GetBool("background_indexing"),
+		LearnerOf:           uint32(Alpha.Conf.GetInt("learner_of")),
+		CDCFile:             Alpha.Conf.GetString("cdc"),
+		InternalTLSDir:      Alpha.Conf.GetString("tls_internal_dir"),
+		RollupDeltas:        Alpha.Conf.GetInt("rollup_deltas"),
+		RollupAge:           Alpha.Conf.GetDuration("rollup_age"),
+		RollupDiskGrowthMB:  Alpha.Conf.GetInt64("rollup_disk_growth_mb"),
 	}
 
 	x.LoadTLSConfig(&tlsConf, Alpha.Conf, tlsNodeCert, tlsNodeKey)
@@ -455,8 +617,11 @@ func run() {
 			return true, true
 		}
 	}
-	otrace.ApplyConfig(otrace.Config{
-		DefaultSampler: otrace.ProbabilitySampler(worker.Config.Tracing)})
+	if err := x.SetupTracing(Alpha.Conf, "dgraph.alpha"); err != nil {
+		log.Fatalf("Unable to set up tracing: %v", err)
+	}
+	x.Checkf(x.OpenAuditLog(Alpha.Conf.GetString("audit_log"), "alpha"),
+		"Unable to open the audit log")
 
 	// Posting will initialize index which requires schema. Hence, initialize
 	// schema before calling posting.Init().
@@ -501,6 +666,7 @@ func run() {
 
 	// Setup external communication.
 	go worker.StartRaftNodes(edgraph.State.WALstore, bindall)
+	go edgraph.RefreshAcls(shutdownCh)
 	setupServer()
 	glog.Infoln("GRPC and HTTP stopped.")
 	worker.BlockingStop()
@@ -157,6 +157,16 @@ func dropAll() error {
 	return err
 }
 
+func dropData() error {
+	op := `{"drop_op": "DATA"}`
+	req, err := http.NewRequest("PUT", addr+"/alter", bytes.NewBufferString(op))
+	if err != nil {
+		return err
+	}
+	_, _, err = runRequest(req)
+	return err
+}
+
 func deletePredicate(pred string) error {
 	op := `{"drop_attr": "` + pred + `"}`
 	req, err := http.NewRequest("PUT", addr+"/alter", bytes.NewBufferString(op))
@@ -1393,6 +1403,39 @@ func TestDropAll(t *testing.T) {
 	require.JSONEq(t, `{"data": {"q":[]}}`, output)
 }
 
+func TestDropData(t *testing.T) {
+	s := `name: string @index(term) .`
+	require.NoError(t, alterSchemaWithRetry(s))
+	require.NoError(t, runMutation(`
+	{
+		set{
+			_:foo <name> "Foo" .
+		}
+	}`))
+
+	q := `
+	{
+		q(func: allofterms(name, "Foo")) {
+			name
+		}
+	}`
+	output, err := runQuery(q)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"q":[{"name":"Foo"}]}}`, output)
+
+	require.NoError(t, dropData())
+
+	// The data is gone but the schema and its index are kept, so the query still works without
+	// altering the schema again.
+	output, err = runQuery(q)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"q":[]}}`, output)
+	output, err = runQuery(`schema(pred: [name]) {}`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":{"schema":[{"predicate":"name","type":"string",`+
+		`"index":true,"tokenizer":["term"]}]}}`, output)
+}
+
 func TestRecurseExpandAll(t *testing.T) {
 	var q1 = `
 	{
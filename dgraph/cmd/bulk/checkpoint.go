@@ -0,0 +1,132 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bulk
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const manifestFile = "manifest.json"
+
+// manifest is written to the tmp dir once the map phase is done, and updated as the reduce shards
+// are completed, so that the loader can resume at the reduce phase with --resume.
+type manifest struct {
+	sync.Mutex `json:"-"`
+	path       string
+
+	WriteTs uint64 `json:"write_ts"`
+	// The schema, including the predicates only found in the data.
+	Schema map[string]*pb.SchemaUpdate `json:"schema"`
+	// The map files of every reduce shard, relative to the tmp dir.
+	MapFiles [][]string `json:"map_files"`
+	// The reduce shards completely written to their output dir.
+	DoneShards []int `json:"done_shards"`
+}
+
+func manifestPath(tmpDir string) string {
+	return filepath.Join(tmpDir, manifestFile)
+}
+
+// writeManifest records the output of the map phase, once the map shards have been merged into
+// the reduce shards.
+func writeManifest(st *state) *manifest {
+	m := &manifest{
+		path:    manifestPath(st.opt.TmpDir),
+		WriteTs: st.writeTs,
+		Schema:  st.schema.m,
+	}
+	for _, dir := range shardDirs(st.opt.TmpDir) {
+		var files []string
+		for _, file := range filenamesInTree(dir) {
+			rel, err := filepath.Rel(st.opt.TmpDir, file)
+			x.Check(err)
+			files = append(files, rel)
+		}
+		m.MapFiles = append(m.MapFiles, files)
+	}
+	x.Check(m.save())
+	return m
+}
+
+// readManifest reads the manifest left in the tmp dir by a previous run, checking that all the
+// map files it lists are still there.
+func readManifest(opt options) (*manifest, error) {
+	path := manifestPath(opt.TmpDir)
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("No map phase to resume from: %s not found", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	m := &manifest{path: path}
+	if err := json.Unmarshal(b, m); err != nil {
+		return nil, x.Wrapf(err, "While reading %s", path)
+	}
+	if len(m.MapFiles) != opt.ReduceShards {
+		return nil, fmt.Errorf("The map phase was run with %d reduce shards, not %d",
+			len(m.MapFiles), opt.ReduceShards)
+	}
+	for _, files := range m.MapFiles {
+		for _, file := range files {
+			if _, err := os.Stat(filepath.Join(opt.TmpDir, file)); err != nil {
+				return nil, x.Wrapf(err, "Map file of the map phase missing")
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m *manifest) isDone(shard int) bool {
+	m.Lock()
+	defer m.Unlock()
+	for _, done := range m.DoneShards {
+		if done == shard {
+			return true
+		}
+	}
+	return false
+}
+
+// markDone records that the reduce shard was completely written.
+func (m *manifest) markDone(shard int) {
+	m.Lock()
+	defer m.Unlock()
+	m.DoneShards = append(m.DoneShards, shard)
+	x.Check(m.save())
+}
+
+// save replaces the manifest at once, so that it's never found half written after a crash.
+func (m *manifest) save() error {
+	b, err := json.MarshalIndent(m, "", "\t")
+	if err != nil {
+		return err
+	}
+	tmp := m.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.path)
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bulk
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func TestManifestResume(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "bulk")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	mapFile := filepath.Join("shards", "shard_0", "000", "000.map")
+	require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(tmpDir, mapFile)), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, mapFile), nil, 0644))
+
+	m := &manifest{
+		path:     manifestPath(tmpDir),
+		WriteTs:  10,
+		Schema:   map[string]*pb.SchemaUpdate{"name": {ValueType: pb.Posting_STRING}},
+		MapFiles: [][]string{{mapFile}, {}},
+	}
+	require.NoError(t, m.save())
+	m.markDone(1)
+
+	opt := options{TmpDir: tmpDir, ReduceShards: 2}
+	resumed, err := readManifest(opt)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), resumed.WriteTs)
+	require.Equal(t, pb.Posting_STRING, resumed.Schema["name"].ValueType)
+	require.False(t, resumed.isDone(0))
+	require.True(t, resumed.isDone(1))
+
+	// The map phase has to be resumed with the same number of reduce shards.
+	opt.ReduceShards = 3
+	_, err = readManifest(opt)
+	require.Error(t, err)
+
+	// And all of its output.
+	opt.ReduceShards = 2
+	require.NoError(t, os.Remove(filepath.Join(tmpDir, mapFile)))
+	_, err = readManifest(opt)
+	require.Error(t, err)
+}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/dgraph-io/badger"
 	bo "github.com/dgraph-io/badger/options"
+	"github.com/dgraph-io/dgraph/conn"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/x"
@@ -48,16 +49,20 @@ type options struct {
 	TmpDir           string
 	NumGoroutines    int
 	MapBufSize       int64
+	ReduceMemory     int64
 	ExpandEdges      bool
 	SkipMapPhase     bool
 	CleanupTmp       bool
 	NumShufflers     int
 	Version          bool
 	StoreXids        bool
+	XidDir           string
 	ZeroAddr         string
 	HttpAddr         string
 	IgnoreErrors     bool
 	CustomTokenizers string
+	Resume           bool
+	InternalTLSDir   string
 
 	MapShards    int
 	ReduceShards int
@@ -73,8 +78,9 @@ type state struct {
 	shards        *shardMap
 	readerChunkCh chan *bytes.Buffer
 	mapFileId     uint32 // Used atomically to name the output files of the mappers.
-	dbs           []*badger.DB
 	writeTs       uint64 // All badger writes use this timestamp
+	reduceBudget  *memBudget
+	manifest      *manifest
 }
 
 type loader struct {
@@ -86,9 +92,12 @@ type loader struct {
 
 func newLoader(opt options) *loader {
 	fmt.Printf("Connecting to zero at %s\n", opt.ZeroAddr)
+	_, err := conn.SetupInternalTLS(opt.InternalTLSDir)
+	x.Checkf(err, "While setting up internal TLS with the certificates in %s",
+		opt.InternalTLSDir)
 	zero, err := grpc.Dial(opt.ZeroAddr,
 		grpc.WithBlock(),
-		grpc.WithInsecure(),
+		conn.InternalDialOption(),
 		grpc.WithTimeout(time.Minute))
 	x.Checkf(err, "Unable to connect to zero, Is it running at %s?", opt.ZeroAddr)
 	st := &state{
@@ -98,6 +107,7 @@ func newLoader(opt options) *loader {
 		// Lots of gz readers, so not much channel buffer needed.
 		readerChunkCh: make(chan *bytes.Buffer, opt.NumGoroutines),
 		writeTs:       getWriteTimestamp(zero),
+		reduceBudget:  newMemBudget(opt.ReduceMemory),
 	}
 	st.schema = newSchemaStore(readSchema(opt.SchemaFile), opt, st)
 	ld := &loader{
@@ -126,7 +136,7 @@ func getWriteTimestamp(zero *grpc.ClientConn) uint64 {
 	}
 }
 
-func readSchema(filename string) []*pb.SchemaUpdate {
+func readSchema(filename string) *schema.ParsedSchema {
 	f, err := os.Open(filename)
 	x.Check(err)
 	defer f.Close()
@@ -139,7 +149,7 @@ func readSchema(filename string) []*pb.SchemaUpdate {
 	buf, err := ioutil.ReadAll(r)
 	x.Check(err)
 
-	initialSchema, err := schema.Parse(string(buf))
+	initialSchema, err := schema.ParseWithTypes(string(buf))
 	x.Check(err)
 	return initialSchema
 }
@@ -166,8 +176,14 @@ type uidRangeResponse struct {
 func (ld *loader) mapStage() {
 	ld.prog.setPhase(mapPhase)
 
-	xidDir := filepath.Join(ld.opt.TmpDir, "xids")
-	x.Check(os.Mkdir(xidDir, 0755))
+	// The xid map is kept in the tmp dir, unless it's kept for the next loads.
+	xidDir := ld.opt.XidDir
+	if xidDir == "" {
+		xidDir = filepath.Join(ld.opt.TmpDir, "xids")
+		x.Check(os.Mkdir(xidDir, 0755))
+	} else {
+		x.Check(os.MkdirAll(xidDir, 0700))
+	}
 	opt := badger.DefaultOptions
 	opt.SyncWrites = false
 	opt.TableLoadingMode = bo.MemoryMap
@@ -267,6 +283,33 @@ func (ld *loader) mapStage() {
 type shuffleOutput struct {
 	db         *badger.DB
 	mapEntries []*pb.MapEntry
+	// Done once the entries are written, to tell when the reduce shard is complete.
+	written *sync.WaitGroup
+	// The size of the entries, held in the reduce budget until they're written.
+	size int64
+}
+
+// writeManifest records the output of the map phase, so that the loader can resume from it.
+func (ld *loader) writeManifest() {
+	ld.manifest = writeManifest(ld.state)
+}
+
+// resume continues from the map phase of a previous run, recorded in its manifest. The reduce
+// shards completed by it are kept, the others are written again from scratch.
+func (ld *loader) resume() {
+	m, err := readManifest(ld.opt)
+	x.Check(err)
+	ld.manifest = m
+	ld.writeTs = m.WriteTs
+	ld.schema.m = m.Schema
+	for i, dir := range ld.opt.shardOutputDirs {
+		if m.isDone(i) {
+			fmt.Printf("Reduce shard %d already done, keeping %s\n", i, dir)
+			continue
+		}
+		x.Check(os.RemoveAll(dir))
+		x.Check(os.MkdirAll(dir, 0700))
+	}
 }
 
 func (ld *loader) reduceStage() {
@@ -286,15 +329,6 @@ func (ld *loader) reduceStage() {
 	redu.run()
 }
 
-func (ld *loader) writeSchema() {
-	for _, db := range ld.dbs {
-		ld.schema.write(db)
-	}
-}
-
 func (ld *loader) cleanup() {
-	for _, db := range ld.dbs {
-		x.Check(db.Close())
-	}
 	ld.prog.endSummary()
 }
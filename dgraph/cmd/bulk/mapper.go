@@ -308,6 +308,16 @@ func (m *mapper) addIndexMapEntries(nq gql.NQuad, de *pb.DirectedEdge) {
 		toks, err := tok.BuildTokens(schemaVal.Value, tok.GetLangTokenizer(toker, nq.Lang))
 		x.Check(err)
 
+		// The full-text postings carry the facets bm25 ranks the matches with.
+		var fcs map[string][]*api.Facet
+		if tokerName == "fulltext" {
+			fcs, err = posting.FullTextFacets(schemaVal.Value.(string), nq.Lang)
+			x.Check(err)
+			if len(fcs) > 0 {
+				toks = append(toks, tok.FullTextDocsToken)
+			}
+		}
+
 		// Store index posting.
 		for _, t := range toks {
 			m.addMapEntry(
@@ -315,6 +325,7 @@ func (m *mapper) addIndexMapEntries(nq gql.NQuad, de *pb.DirectedEdge) {
 				&pb.Posting{
 					Uid:         de.GetEntity(),
 					PostingType: pb.Posting_REF,
+					Facets:      fcs[t],
 				},
 				m.state.shards.shardFor(nq.Predicate),
 			)
@@ -18,6 +18,7 @@ package bulk
 
 import (
 	"bytes"
+	"sync"
 	"sync/atomic"
 
 	"github.com/dgraph-io/dgraph/codec"
@@ -99,6 +100,50 @@ func (r *reducer) reduce(job shuffleOutput) {
 	x.Check(txn.CommitAt(r.state.writeTs, func(err error) {
 		x.Check(err)
 		NumBadgerWrites.Add(-1)
+		r.reduceBudget.release(job.size)
+		job.written.Done()
 		r.writesThr.Done()
 	}))
 }
+
+// memBudget bounds the memory used by the entries held between the shufflers and the writes of
+// the reducers. A nil budget has no limit.
+type memBudget struct {
+	sync.Mutex
+	cond *sync.Cond
+	max  int64
+	used int64
+}
+
+func newMemBudget(max int64) *memBudget {
+	if max <= 0 {
+		return nil
+	}
+	b := &memBudget{max: max}
+	b.cond = sync.NewCond(&b.Mutex)
+	return b
+}
+
+// acquire waits until n bytes fit in the budget. When nothing else is held, n is let through
+// even if it's larger than the budget, so that it doesn't wait forever.
+func (b *memBudget) acquire(n int64) {
+	if b == nil {
+		return
+	}
+	b.Lock()
+	defer b.Unlock()
+	for b.used > 0 && b.used+n > b.max {
+		b.cond.Wait()
+	}
+	b.used += n
+}
+
+func (b *memBudget) release(n int64) {
+	if b == nil {
+		return
+	}
+	b.Lock()
+	b.used -= n
+	b.Unlock()
+	b.cond.Broadcast()
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bulk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemBudget(t *testing.T) {
+	// No budget, no limit.
+	var unlimited *memBudget = newMemBudget(0)
+	unlimited.acquire(1 << 40)
+	unlimited.release(1 << 40)
+
+	b := newMemBudget(100)
+	// Larger than the budget, but let through since nothing else is held.
+	b.acquire(150)
+
+	acquired := make(chan struct{})
+	go func() {
+		b.acquire(10)
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("Acquired more than the budget")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	b.release(150)
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("Budget not acquired after release")
+	}
+	require.Equal(t, int64(10), b.used)
+}
@@ -67,16 +67,28 @@ func init() {
 		"Generate edges that allow nodes to be expanded using _predicate_ or expand(...). "+
 			"Disable to increase loading speed.")
 	flag.Bool("skip_map_phase", false,
-		"Skip the map phase (assumes that map output files already exist).")
+		"Skip the map phase (assumes that map output files already exist). Same as --resume.")
+	flag.Bool("resume", false,
+		"Resume a load which crashed or was stopped after its map phase, from the map output "+
+			"and the reduce shards completed in the tmp directory.")
 	flag.Bool("cleanup_tmp", true,
 		"Clean up the tmp directory after the loader finishes. Setting this to false allows the"+
 			" bulk loader can be re-run while skipping the map phase.")
+	flag.Int64("reduce_memory_mb", 0,
+		"The memory budget of the postings being reduced and written in the reduce phase. The "+
+			"shufflers wait for the budget to be available before reading more. 0 means no limit.")
 	flag.Int("shufflers", 1,
 		"Number of shufflers to run concurrently. Increasing this can improve performance, and "+
 			"must be less than or equal to the number of reduce shards.")
 	flag.Bool("version", false, "Prints the version of Dgraph Bulk Loader.")
 	flag.BoolP("store_xids", "x", false, "Generate an xid edge for each node.")
+	flag.String("xidmap", "",
+		"Directory to store the xid to uid mapping, which is kept after the load so that the "+
+			"live loader can reuse it with its --xidmap flag. The mapping in it is reused.")
 	flag.StringP("zero", "z", "localhost:5080", "gRPC address for Dgraph zero")
+	flag.String("tls_internal_dir", "", "Path to directory with the CA certificate ca.crt, and "+
+		"the certificate node.crt and key node.key to connect to Dgraph zero with, if it "+
+		"uses mutual TLS.")
 	// TODO: Potentially move http server to main.
 	flag.String("http", "localhost:8080",
 		"Address to serve http (pprof).")
@@ -102,18 +114,22 @@ func run() {
 		TmpDir:           Bulk.Conf.GetString("tmp"),
 		NumGoroutines:    Bulk.Conf.GetInt("num_go_routines"),
 		MapBufSize:       int64(Bulk.Conf.GetInt("mapoutput_mb")),
+		ReduceMemory:     int64(Bulk.Conf.GetInt("reduce_memory_mb")),
 		ExpandEdges:      Bulk.Conf.GetBool("expand_edges"),
 		SkipMapPhase:     Bulk.Conf.GetBool("skip_map_phase"),
 		CleanupTmp:       Bulk.Conf.GetBool("cleanup_tmp"),
 		NumShufflers:     Bulk.Conf.GetInt("shufflers"),
 		Version:          Bulk.Conf.GetBool("version"),
 		StoreXids:        Bulk.Conf.GetBool("store_xids"),
+		XidDir:           Bulk.Conf.GetString("xidmap"),
 		ZeroAddr:         Bulk.Conf.GetString("zero"),
 		HttpAddr:         Bulk.Conf.GetString("http"),
 		IgnoreErrors:     Bulk.Conf.GetBool("ignore_errors"),
 		MapShards:        Bulk.Conf.GetInt("map_shards"),
 		ReduceShards:     Bulk.Conf.GetInt("reduce_shards"),
 		CustomTokenizers: Bulk.Conf.GetString("custom_tokenizers"),
+		Resume:           Bulk.Conf.GetBool("resume") || Bulk.Conf.GetBool("skip_map_phase"),
+		InternalTLSDir:   Bulk.Conf.GetString("tls_internal_dir"),
 	}
 
 	x.PrintVersion()
@@ -150,6 +166,7 @@ func run() {
 	}
 
 	opt.MapBufSize <<= 20 // Convert from MB to B.
+	opt.ReduceMemory <<= 20
 
 	optBuf, err := json.MarshalIndent(&opt, "", "\t")
 	x.Check(err)
@@ -161,8 +178,11 @@ func run() {
 		log.Fatal(http.ListenAndServe(opt.HttpAddr, nil))
 	}()
 
-	// Delete and recreate the output dirs to ensure they are empty.
-	x.Check(os.RemoveAll(opt.DgraphsDir))
+	// Delete and recreate the output dirs to ensure they are empty. When resuming, only the
+	// ones of the reduce shards which weren't completed are.
+	if !opt.Resume {
+		x.Check(os.RemoveAll(opt.DgraphsDir))
+	}
 	for i := 0; i < opt.ReduceShards; i++ {
 		dir := filepath.Join(opt.DgraphsDir, strconv.Itoa(i), "p")
 		x.Check(os.MkdirAll(dir, 0700))
@@ -170,7 +190,7 @@ func run() {
 	}
 
 	// Create a directory just for bulk loader's usage.
-	if !opt.SkipMapPhase {
+	if !opt.Resume {
 		x.Check(os.RemoveAll(opt.TmpDir))
 		x.Check(os.MkdirAll(opt.TmpDir, 0700))
 	}
@@ -179,12 +199,14 @@ func run() {
 	}
 
 	loader := newLoader(opt)
-	if !opt.SkipMapPhase {
+	if opt.Resume {
+		loader.resume()
+	} else {
 		loader.mapStage()
 		mergeMapShardsIntoReduceShards(opt)
+		loader.writeManifest()
 	}
 	loader.reduceStage()
-	loader.writeSchema()
 	loader.cleanup()
 }
 
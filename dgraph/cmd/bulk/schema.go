@@ -25,17 +25,19 @@ import (
 	"github.com/dgraph-io/badger"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
 	wk "github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
 )
 
 type schemaStore struct {
 	sync.RWMutex
-	m map[string]*pb.SchemaUpdate
+	m     map[string]*pb.SchemaUpdate
+	types []*pb.TypeUpdate
 	*state
 }
 
-func newSchemaStore(initial []*pb.SchemaUpdate, opt options, state *state) *schemaStore {
+func newSchemaStore(initial *schema.ParsedSchema, opt options, state *state) *schemaStore {
 	s := &schemaStore{
 		m: map[string]*pb.SchemaUpdate{
 			"_predicate_": &pb.SchemaUpdate{
@@ -43,6 +45,7 @@ func newSchemaStore(initial []*pb.SchemaUpdate, opt options, state *state) *sche
 				List:      true,
 			},
 		},
+		types: initial.Types,
 		state: state,
 	}
 	if opt.StoreXids {
@@ -51,7 +54,7 @@ func newSchemaStore(initial []*pb.SchemaUpdate, opt options, state *state) *sche
 			Tokenizer: []string{"hash"},
 		}
 	}
-	for _, sch := range initial {
+	for _, sch := range initial.Schemas {
 		p := sch.Predicate
 		sch.Predicate = "" // Predicate is stored in the (badger) key, so not needed in the value.
 		if _, ok := s.m[p]; ok {
@@ -148,5 +151,11 @@ func (s *schemaStore) write(db *badger.DB) {
 		x.Check(err)
 		x.Check(txn.SetWithMeta(k, v, posting.BitCompletePosting))
 	}
+	// Every group holds all the types.
+	for _, typ := range s.types {
+		v, err := typ.Marshal()
+		x.Check(err)
+		x.Check(txn.SetWithMeta(x.TypeDefKey(typ.TypeName), v, posting.BitCompletePosting))
+	}
 	x.Check(txn.CommitAt(1, nil))
 }
@@ -24,6 +24,8 @@ import (
 	"io"
 	"log"
 	"os"
+	"path/filepath"
+	"sync"
 
 	"github.com/dgraph-io/badger"
 	bo "github.com/dgraph-io/badger/options"
@@ -38,24 +40,34 @@ type shuffler struct {
 }
 
 func (s *shuffler) run() {
-	shardDirs := shardDirs(s.opt.TmpDir)
-	x.AssertTrue(len(shardDirs) == s.opt.ReduceShards)
+	x.AssertTrue(len(s.manifest.MapFiles) == s.opt.ReduceShards)
 	x.AssertTrue(len(s.opt.shardOutputDirs) == s.opt.ReduceShards)
 
 	thr := x.NewThrottle(s.opt.NumShufflers)
 	for i := 0; i < s.opt.ReduceShards; i++ {
+		if s.manifest.isDone(i) {
+			continue
+		}
 		thr.Start()
 		go func(shardId int, db *badger.DB) {
-			mapFiles := filenamesInTree(shardDirs[shardId])
+			mapFiles := s.manifest.MapFiles[shardId]
 			shuffleInputChs := make([]chan *pb.MapEntry, len(mapFiles))
 			for i, mapFile := range mapFiles {
 				shuffleInputChs[i] = make(chan *pb.MapEntry, 1000)
-				go readMapOutput(mapFile, shuffleInputChs[i])
+				go readMapOutput(filepath.Join(s.opt.TmpDir, mapFile), shuffleInputChs[i])
 			}
 
+			var written sync.WaitGroup
 			ci := &countIndexer{state: s.state, db: db}
-			s.shufflePostings(shuffleInputChs, ci)
+			s.shufflePostings(shuffleInputChs, ci, &written)
 			ci.wait()
+			written.Wait()
+
+			// Once the shard is complete and on disk, it doesn't need to be written again if
+			// the loader is resumed.
+			s.schema.write(db)
+			x.Check(db.Close())
+			s.manifest.markDone(shardId)
 			thr.Done()
 		}(i, s.createBadger(i))
 	}
@@ -71,7 +83,6 @@ func (s *shuffler) createBadger(i int) *badger.DB {
 	opt.ValueDir = opt.Dir
 	db, err := badger.OpenManaged(opt)
 	x.Check(err)
-	s.dbs = append(s.dbs, db)
 	return db
 }
 
@@ -106,7 +117,8 @@ func readMapOutput(filename string, mapEntryCh chan<- *pb.MapEntry) {
 	close(mapEntryCh)
 }
 
-func (s *shuffler) shufflePostings(mapEntryChs []chan *pb.MapEntry, ci *countIndexer) {
+func (s *shuffler) shufflePostings(mapEntryChs []chan *pb.MapEntry, ci *countIndexer,
+	written *sync.WaitGroup) {
 	var ph postingHeap
 	for _, ch := range mapEntryChs {
 		heap.Push(&ph, heapNode{mapEntry: <-ch, ch: ch})
@@ -115,6 +127,7 @@ func (s *shuffler) shufflePostings(mapEntryChs []chan *pb.MapEntry, ci *countInd
 	const batchSize = 1000
 	const batchAlloc = batchSize * 11 / 10
 	batch := make([]*pb.MapEntry, 0, batchAlloc)
+	var batchBytes int64
 	var prevKey []byte
 	var plistLen int
 	for len(ph.nodes) > 0 {
@@ -134,23 +147,33 @@ func (s *shuffler) shufflePostings(mapEntryChs []chan *pb.MapEntry, ci *countInd
 		}
 
 		if len(batch) >= batchSize && bytes.Compare(prevKey, me.Key) != 0 {
-			s.output <- shuffleOutput{mapEntries: batch, db: ci.db}
-			NumQueuedReduceJobs.Add(1)
+			s.sendBatch(batch, batchBytes, ci.db, written)
 			batch = make([]*pb.MapEntry, 0, batchAlloc)
+			batchBytes = 0
 		}
 		prevKey = me.Key
 		batch = append(batch, me)
+		batchBytes += int64(me.Size())
 		plistLen++
 	}
 	if len(batch) > 0 {
-		s.output <- shuffleOutput{mapEntries: batch, db: ci.db}
-		NumQueuedReduceJobs.Add(1)
+		s.sendBatch(batch, batchBytes, ci.db, written)
 	}
 	if plistLen > 0 {
 		ci.addUid(prevKey, plistLen)
 	}
 }
 
+// sendBatch sends the entries to the reducers, once they fit in the reduce budget. They're held
+// in it until they're written.
+func (s *shuffler) sendBatch(batch []*pb.MapEntry, size int64, db *badger.DB,
+	written *sync.WaitGroup) {
+	s.reduceBudget.acquire(size)
+	written.Add(1)
+	s.output <- shuffleOutput{mapEntries: batch, db: db, written: written, size: size}
+	NumQueuedReduceJobs.Add(1)
+}
+
 type heapNode struct {
 	mapEntry *pb.MapEntry
 	ch       <-chan *pb.MapEntry
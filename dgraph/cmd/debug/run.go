@@ -23,6 +23,7 @@ import (
 	"io"
 	"log"
 	"math"
+	"os"
 	"strconv"
 	"strings"
 
@@ -50,6 +51,7 @@ type flagOptions struct {
 	itemMeta   bool
 	jepsen     bool
 	jepsenAt   uint64
+	auditLog   string
 }
 
 func init() {
@@ -71,6 +73,7 @@ func init() {
 	flag.StringVarP(&opt.keyLookup, "lookup", "l", "", "Hex of key to lookup.")
 	flag.BoolVarP(&opt.keyHistory, "history", "y", false, "Show all versions of a key.")
 	flag.StringVarP(&opt.pdir, "postings", "p", "", "Directory where posting lists are stored.")
+	flag.StringVar(&opt.auditLog, "audit", "", "Verify the chain of hashes of this audit log.")
 }
 
 func toInt(o *pb.Posting) int {
@@ -461,6 +464,9 @@ func printKeys(db *badger.DB) {
 		if pk.IsSchema() {
 			buf.WriteString("{s}")
 		}
+		if pk.IsTypeDef() {
+			buf.WriteString("{t}")
+		}
 		if pk.IsReverse() {
 			buf.WriteString("{r}")
 		}
@@ -482,7 +488,24 @@ func printKeys(db *badger.DB) {
 	fmt.Printf("Found %d keys\n", loop)
 }
 
+func verifyAuditLog() {
+	f, err := os.Open(opt.auditLog)
+	x.Check(err)
+	defer f.Close()
+
+	n, err := x.VerifyAuditLog(f)
+	if err != nil {
+		log.Fatalf("Audit log %s is not valid: %v", opt.auditLog, err)
+	}
+	fmt.Printf("Audit log %s is valid, with %d entries.\n", opt.auditLog, n)
+}
+
 func run() {
+	if len(opt.auditLog) > 0 {
+		verifyAuditLog()
+		return
+	}
+
 	bopts := badger.DefaultOptions
 	bopts.Dir = opt.pdir
 	bopts.ValueDir = opt.pdir
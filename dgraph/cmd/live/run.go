@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -40,6 +41,7 @@ import (
 	bopt "github.com/dgraph-io/badger/options"
 	"github.com/dgraph-io/dgo"
 	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/rdf"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/dgraph-io/dgraph/xidmap"
@@ -56,6 +58,7 @@ type options struct {
 	clientDir           string
 	ignoreIndexConflict bool
 	authToken           string
+	upsertPredicate     string
 }
 
 var opt options
@@ -77,15 +80,20 @@ func init() {
 	Live.EnvPrefix = "DGRAPH_LIVE"
 
 	flag := Live.Cmd.Flags()
-	flag.StringP("rdfs", "r", "", "Location of rdf files to load")
+	flag.StringP("rdfs", "r", "",
+		"Location of RDF or JSON files to load. Files ending in .json or .json.gz are JSON.")
 	flag.StringP("schema", "s", "", "Location of schema file")
 	flag.StringP("dgraph", "d", "127.0.0.1:9080", "Dgraph gRPC server address")
 	flag.StringP("zero", "z", "127.0.0.1:5080", "Dgraphzero gRPC server address")
 	flag.IntP("conc", "c", 10,
 		"Number of concurrent requests to make to Dgraph")
 	flag.IntP("batch", "b", 1000,
-		"Number of RDF N-Quads to send as part of a mutation.")
+		"Number of RDF N-Quads, or N-Quads read from JSON, to send as part of a mutation.")
 	flag.StringP("xidmap", "x", "", "Directory to store xid to uid mapping")
+	flag.String("upsert_predicate", "",
+		"Predicate holding the xids of the nodes, such as xid. The nodes are looked up by it "+
+			"before being created, and the new ones get it, so loading the same data again "+
+			"doesn't create new nodes. It must have an exact or hash index.")
 	flag.BoolP("ignore_index_conflict", "i", true,
 		"Ignores conflicts on index keys during transaction")
 	flag.StringP("auth_token", "a", "",
@@ -147,7 +155,9 @@ func processSchemaFile(ctx context.Context, file string, dgraphClient *dgo.Dgrap
 	return dgraphClient.Alter(ctx, op)
 }
 
-func (l *loader) uid(val string) string {
+// uid returns the uid of the node with the xid val. The xid is added to mu for the new nodes, if
+// the nodes are upserted by their xid.
+func (l *loader) uid(val string, mu *api.Mutation) string {
 	// Attempt to parse as a UID (in the same format that dgraph outputs - a
 	// hex number prefixed by "0x"). If parsing succeeds, then this is assumed
 	// to be an existing node in the graph. There is limited protection against
@@ -159,10 +169,56 @@ func (l *loader) uid(val string) string {
 		}
 	}
 
-	uid, _ := l.alloc.AssignUid(val)
+	uid, isNew := l.alloc.AssignUid(val)
+	if isNew && upsertXid(val) {
+		mu.Set = append(mu.Set, &api.NQuad{
+			Subject:     fmt.Sprintf("%#x", uid),
+			Predicate:   opt.upsertPredicate,
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: val}},
+		})
+	}
 	return fmt.Sprintf("%#x", uint64(uid))
 }
 
+// upsertXid returns whether the node with the xid is upserted by it. Blank nodes aren't, like the
+// bulk loader doesn't store their xids.
+func upsertXid(xid string) bool {
+	return opt.upsertPredicate != "" && !strings.HasPrefix(xid, "_:")
+}
+
+// lookupXid returns the uid of the node with the xid in the upsert predicate, or 0 if there's
+// none. Failed queries are retried.
+func (l *loader) lookupXid(xid string) uint64 {
+	if !upsertXid(xid) {
+		return 0
+	}
+	q := fmt.Sprintf(`query q($xid: string) { q(func: eq(<%s>, $xid), first: 1) { uid } }`,
+		opt.upsertPredicate)
+	for i := time.Millisecond; ; i *= 2 {
+		resp, err := l.dc.NewReadOnlyTxn().QueryWithVars(l.opts.Ctx, q,
+			map[string]string{"$xid": xid})
+		if err == nil {
+			var result struct {
+				Q []struct {
+					Uid string `json:"uid"`
+				} `json:"q"`
+			}
+			x.Check(json.Unmarshal(resp.Json, &result))
+			if len(result.Q) == 0 {
+				return 0
+			}
+			uid, err := strconv.ParseUint(result.Q[0].Uid, 0, 64)
+			x.Check(err)
+			return uid
+		}
+		handleError(err)
+		if i >= 10*time.Second {
+			i = 10 * time.Second
+		}
+		time.Sleep(i)
+	}
+}
+
 func fileReader(file string) (io.Reader, *os.File) {
 	f, err := os.Open(file)
 	x.Check(err)
@@ -177,9 +233,17 @@ func fileReader(file string) (io.Reader, *os.File) {
 	return r, f
 }
 
+// isJSONFile returns whether the file holds JSON instead of RDF, going by its extension.
+func isJSONFile(file string) bool {
+	return strings.HasSuffix(strings.TrimSuffix(strings.ToLower(file), ".gz"), ".json")
+}
+
 // processFile sends mutations for a given gz file.
 func (l *loader) processFile(ctx context.Context, file string) error {
 	fmt.Printf("\nProcessing %s\n", file)
+	if isJSONFile(file) {
+		return l.processJSONFile(ctx, file)
+	}
 	gr, f := fileReader(file)
 	var buf bytes.Buffer
 	bufReader := bufio.NewReader(gr)
@@ -213,9 +277,9 @@ func (l *loader) processFile(ctx context.Context, file string) error {
 		batchSize++
 		buf.Reset()
 
-		nq.Subject = l.uid(nq.Subject)
+		nq.Subject = l.uid(nq.Subject, &mu)
 		if len(nq.ObjectId) > 0 {
-			nq.ObjectId = l.uid(nq.ObjectId)
+			nq.ObjectId = l.uid(nq.ObjectId, &mu)
 		}
 		mu.Set = append(mu.Set, &nq)
 
@@ -232,6 +296,109 @@ func (l *loader) processFile(ctx context.Context, file string) error {
 	return nil
 }
 
+// processJSONFile sends mutations for a JSON file, holding either an array of objects or a
+// stream of objects such as one per line. The objects are in the format of the JSON mutations,
+// and are read one at a time so that the file isn't loaded in memory.
+func (l *loader) processJSONFile(ctx context.Context, file string) error {
+	gr, f := fileReader(file)
+	defer f.Close()
+	r := bufio.NewReader(gr)
+	isArray, err := startsJSONArray(r)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(r)
+	if isArray {
+		// Skip the opening bracket, to decode the objects of the array one by one.
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+	}
+
+	var obj uint64
+	mu := api.Mutation{}
+	var batchSize int
+	for dec.More() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		obj++
+
+		var b json.RawMessage
+		if err := dec.Decode(&b); err != nil {
+			return fmt.Errorf("Error while reading JSON: %v, on object:%v", err, obj)
+		}
+		nqs, err := edgraph.NquadsFromJson(b)
+		if err != nil {
+			return fmt.Errorf("Error while parsing JSON: %v, on object:%v", err, obj)
+		}
+
+		// The nodes without a uid are named _:blank-0, _:blank-1... in every object, so they
+		// get a new uid per object instead of going through the xid map.
+		blanks := make(map[string]string)
+		for _, nq := range nqs {
+			nq.Subject = l.jsonUid(nq.Subject, blanks, &mu)
+			if len(nq.ObjectId) > 0 {
+				nq.ObjectId = l.jsonUid(nq.ObjectId, blanks, &mu)
+			}
+			mu.Set = append(mu.Set, nq)
+			batchSize++
+
+			if batchSize >= opt.numRdf {
+				l.reqs <- mu
+				batchSize = 0
+				mu = api.Mutation{}
+			}
+		}
+	}
+	if batchSize > 0 {
+		l.reqs <- mu
+		mu = api.Mutation{}
+	}
+	return nil
+}
+
+// startsJSONArray returns whether the JSON read from r is an array, leaving r at its start.
+func startsJSONArray(r *bufio.Reader) (bool, error) {
+	for {
+		ch, _, err := r.ReadRune()
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		switch ch {
+		case ' ', '\t', '\r', '\n', '\ufeff':
+			continue
+		}
+		return ch == '[', r.UnreadRune()
+	}
+}
+
+// jsonUid returns the uid of a node of the N-Quads read from a JSON object. The uids given in the
+// object are kept, the blank nodes named in it are mapped through the xid map like the ones in
+// RDF, and the nodes without a uid get a new one.
+func (l *loader) jsonUid(val string, blanks map[string]string, mu *api.Mutation) string {
+	if !strings.HasPrefix(val, "_:") {
+		if uid, err := strconv.ParseUint(val, 0, 64); err == nil {
+			return fmt.Sprintf("%#x", uid)
+		}
+		return l.uid(val, mu)
+	}
+	if !strings.HasPrefix(val, "_:blank-") {
+		return l.uid(val, mu)
+	}
+	uid, ok := blanks[val]
+	if !ok {
+		uid = fmt.Sprintf("%#x", l.alloc.AllocateUid())
+		blanks[val] = uid
+	}
+	return uid
+}
+
 func fileList(files string) []string {
 	if len(files) == 0 {
 		return []string{}
@@ -253,24 +420,22 @@ func setup(opts batchMutationOptions, dc *dgo.Dgraph) *loader {
 	connzero, err := x.SetupConnection(opt.zero, &tlsConf)
 	x.Checkf(err, "Unable to connect to zero, Is it running at %s?", opt.zero)
 
-	alloc := xidmap.New(
-		kv,
-		connzero,
-		xidmap.Options{
-			NumShards: 100,
-			LRUSize:   1e5,
-		},
-	)
-
 	l := &loader{
 		opts:     opts,
 		dc:       dc,
 		start:    time.Now(),
 		reqs:     make(chan api.Mutation, opts.Pending*2),
-		alloc:    alloc,
 		kv:       kv,
 		zeroconn: connzero,
 	}
+	xopt := xidmap.Options{
+		NumShards: 100,
+		LRUSize:   1e5,
+	}
+	if opt.upsertPredicate != "" {
+		xopt.Lookup = l.lookupXid
+	}
+	l.alloc = xidmap.New(kv, connzero, xopt)
 
 	l.requestsWg.Add(opts.Pending)
 	for i := 0; i < opts.Pending; i++ {
@@ -293,6 +458,7 @@ func run() error {
 		clientDir:           Live.Conf.GetString("xidmap"),
 		ignoreIndexConflict: Live.Conf.GetBool("ignore_index_conflict"),
 		authToken:           Live.Conf.GetString("auth_token"),
+		upsertPredicate:     Live.Conf.GetString("upsert_predicate"),
 	}
 	x.LoadTLSConfig(&tlsConf, Live.Conf, x.TlsClientCert, x.TlsClientKey)
 	tlsConf.ServerName = Live.Conf.GetString("tls_server_name")
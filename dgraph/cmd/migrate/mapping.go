@@ -0,0 +1,350 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"unicode"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// mapping controls how the tables are migrated to Dgraph. It's generated from the tables found,
+// written to a file users can edit, to rename the types and the predicates, skip tables or
+// columns and choose which foreign keys become edges, and read back on the next migration.
+type mapping struct {
+	Tables []*tableMapping `json:"tables"`
+}
+
+// tableMapping maps the rows of a table to nodes of a type.
+type tableMapping struct {
+	Table string `json:"table"`
+	Type  string `json:"type"`
+	Skip  bool   `json:"skip,omitempty"`
+	// Key holds the columns the nodes are named after, the primary key of the table. The rows of
+	// a table without one are named after their position, and aren't the target of any edge.
+	Key     []string         `json:"key,omitempty"`
+	Columns []*columnMapping `json:"columns"`
+	Edges   []*edgeMapping   `json:"edges,omitempty"`
+}
+
+// columnMapping maps a column to a predicate of a scalar type.
+type columnMapping struct {
+	Column    string `json:"column"`
+	Predicate string `json:"predicate"`
+	// DgraphType is the type of the predicate, and Index the tokenizer it's indexed with, if any.
+	DgraphType string `json:"dgraph_type"`
+	Index      string `json:"index,omitempty"`
+	Skip       bool   `json:"skip,omitempty"`
+}
+
+// edgeMapping maps a foreign key to a uid predicate, from the node of the row to the node of the
+// row referenced. The columns of the foreign key are only migrated as values if their column
+// mapping isn't skipped.
+type edgeMapping struct {
+	ForeignKey string   `json:"foreign_key"`
+	Columns    []string `json:"columns"`
+	RefTable   string   `json:"ref_table"`
+	RefColumns []string `json:"ref_columns"`
+	Predicate  string   `json:"predicate"`
+	Reverse    bool     `json:"reverse,omitempty"`
+	Skip       bool     `json:"skip,omitempty"`
+}
+
+// newMapping returns the default mapping of the tables: a type per table named after it, with a
+// predicate per column, and an edge with a reverse index per foreign key referencing a primary
+// key. The columns of these foreign keys are skipped, as the edges replace them.
+func newMapping(tables []*tableInfo) *mapping {
+	types := make(map[string]string, len(tables))
+	keys := make(map[string][]string, len(tables))
+	for _, t := range tables {
+		types[t.name] = typeName(t.name)
+		keys[t.name] = t.primaryKey
+	}
+
+	m := &mapping{}
+	for _, t := range tables {
+		tm := &tableMapping{Table: t.name, Type: types[t.name], Key: t.primaryKey}
+		edgeColumns := make(map[string]bool)
+		for _, fk := range t.foreignKeys {
+			em := &edgeMapping{
+				ForeignKey: fk.name,
+				Columns:    fk.columns,
+				RefTable:   fk.refTable,
+				RefColumns: fk.refColumns,
+				Predicate:  tm.Type + "." + edgeName(fk),
+				Reverse:    true,
+			}
+			refKey, ok := keys[fk.refTable]
+			if !ok || !sameColumns(fk.refColumns, refKey) {
+				// The nodes are only named after the primary key, the other foreign keys can't
+				// be followed.
+				em.Skip = true
+			}
+			if !em.Skip {
+				for _, c := range fk.columns {
+					edgeColumns[c] = true
+				}
+			}
+			tm.Edges = append(tm.Edges, em)
+		}
+		kept := make(map[string]bool)
+		for _, c := range t.columns {
+			cm := &columnMapping{
+				Column:     c.name,
+				Predicate:  tm.Type + "." + predicateName(c.name),
+				DgraphType: dgraphType(c.dataType),
+				Skip:       edgeColumns[c.name] && !contains(t.primaryKey, c.name),
+			}
+			// The primary key is indexed to find the nodes by it.
+			if contains(t.primaryKey, c.name) {
+				cm.Index = defaultIndex(cm.DgraphType)
+			}
+			if !cm.Skip {
+				kept[cm.Predicate] = true
+			}
+			tm.Columns = append(tm.Columns, cm)
+		}
+		// An edge named like a column kept, as its foreign key is part of the primary key, is
+		// renamed to keep the predicates of different types apart.
+		for _, em := range tm.Edges {
+			if kept[em.Predicate] {
+				em.Predicate += "_ref"
+			}
+		}
+		m.Tables = append(m.Tables, tm)
+	}
+	return m
+}
+
+// validate checks that the mapping only names the tables and columns found, and that the edges
+// point to tables named after their primary key.
+func (m *mapping) validate(tables []*tableInfo) error {
+	found := make(map[string]*tableInfo, len(tables))
+	for _, t := range tables {
+		found[t.name] = t
+	}
+	mapped := make(map[string]*tableMapping, len(m.Tables))
+	for _, tm := range m.Tables {
+		if _, ok := found[tm.Table]; !ok {
+			return x.Errorf("Table %s of the mapping not found", tm.Table)
+		}
+		if len(tm.Type) == 0 {
+			return x.Errorf("Missing type of table %s in the mapping", tm.Table)
+		}
+		mapped[tm.Table] = tm
+	}
+
+	// A predicate can't hold values of different types.
+	predTypes := make(map[string]string)
+	checkType := func(pred, typ string) error {
+		if prev, ok := predTypes[pred]; ok && prev != typ {
+			return x.Errorf("Predicate %s mapped to both %s and %s", pred, prev, typ)
+		}
+		predTypes[pred] = typ
+		return nil
+	}
+
+	for _, tm := range m.Tables {
+		t := found[tm.Table]
+		if tm.Skip {
+			continue
+		}
+		for _, c := range tm.Key {
+			if !t.hasColumn(c) {
+				return x.Errorf("Key column %s of table %s not found", c, tm.Table)
+			}
+		}
+		for _, cm := range tm.Columns {
+			if !t.hasColumn(cm.Column) {
+				return x.Errorf("Column %s of table %s not found", cm.Column, tm.Table)
+			}
+			if cm.Skip {
+				continue
+			}
+			if len(cm.Predicate) == 0 {
+				return x.Errorf("Missing predicate of column %s of table %s", cm.Column,
+					tm.Table)
+			}
+			if err := checkType(cm.Predicate, cm.DgraphType); err != nil {
+				return err
+			}
+		}
+		for _, em := range tm.Edges {
+			if em.Skip {
+				continue
+			}
+			ref, ok := mapped[em.RefTable]
+			if !ok || ref.Skip {
+				return x.Errorf("Edge %s of table %s points to table %s, which isn't migrated",
+					em.Predicate, tm.Table, em.RefTable)
+			}
+			if len(ref.Key) == 0 || !sameColumns(em.RefColumns, ref.Key) {
+				return x.Errorf("Edge %s of table %s doesn't point to the key of table %s",
+					em.Predicate, tm.Table, em.RefTable)
+			}
+			if len(em.Columns) != len(em.RefColumns) || len(em.Predicate) == 0 {
+				return x.Errorf("Invalid edge %s of table %s", em.Predicate, tm.Table)
+			}
+			for _, c := range em.Columns {
+				if !t.hasColumn(c) {
+					return x.Errorf("Column %s of edge %s of table %s not found", c,
+						em.Predicate, tm.Table)
+				}
+			}
+			if err := checkType(em.Predicate, "uid"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (t *tableInfo) hasColumn(name string) bool {
+	for _, c := range t.columns {
+		if c.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func readMapping(file string) (*mapping, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, x.Wrapf(err, "while reading the mapping")
+	}
+	m := &mapping{}
+	if err := json.Unmarshal(b, m); err != nil {
+		return nil, x.Wrapf(err, "while parsing the mapping %s", file)
+	}
+	return m, nil
+}
+
+func writeMapping(file string, m *mapping) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, append(b, '\n'), 0644)
+}
+
+// dgraphType returns the Dgraph type of the values of an SQL data type.
+func dgraphType(dataType string) string {
+	switch t := strings.ToLower(dataType); {
+	case strings.Contains(t, "int") || strings.Contains(t, "serial"):
+		return "int"
+	case t == "decimal" || t == "numeric" || t == "float" || t == "double" ||
+		t == "real" || t == "double precision":
+		return "float"
+	case t == "bool" || t == "boolean":
+		return "bool"
+	case t == "date" || t == "datetime" || strings.HasPrefix(t, "timestamp"):
+		return "datetime"
+	default:
+		return "string"
+	}
+}
+
+// defaultIndex returns the tokenizer for looking up the values of a Dgraph type by equality.
+func defaultIndex(typ string) string {
+	switch typ {
+	case "int", "float", "bool":
+		return typ
+	case "datetime":
+		return "hour"
+	default:
+		return "exact"
+	}
+}
+
+// typeName turns the name of a table into the name of a type, such as order_items into
+// OrderItems.
+func typeName(table string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range table {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() == 0 {
+		return "Table"
+	}
+	return b.String()
+}
+
+// predicateName turns the name of a column into the part of a predicate following the type,
+// keeping letters, digits and underscores.
+func predicateName(column string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return r
+		}
+		return '_'
+	}, column)
+}
+
+// edgeName names the edge of a foreign key after its column without the id suffix, like
+// company for company_id, or after the table referenced if it has more than one column.
+func edgeName(fk *foreignKey) string {
+	if len(fk.columns) == 1 {
+		c := fk.columns[0]
+		for _, suffix := range []string{"_id", "Id", "ID"} {
+			if trimmed := strings.TrimSuffix(c, suffix); trimmed != c && len(trimmed) > 0 {
+				return predicateName(trimmed)
+			}
+		}
+		return predicateName(c)
+	}
+	return predicateName(fk.refTable)
+}
+
+func sameColumns(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, c := range a {
+		if !contains(b, c) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (em *edgeMapping) String() string {
+	return fmt.Sprintf("%s(%s) -> %s(%s)", em.ForeignKey, strings.Join(em.Columns, ", "),
+		em.RefTable, strings.Join(em.RefColumns, ", "))
+}
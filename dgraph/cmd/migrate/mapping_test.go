@@ -0,0 +1,194 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testTables() []*tableInfo {
+	return []*tableInfo{
+		{
+			name: "companies",
+			columns: []*columnInfo{
+				{name: "id", dataType: "int"},
+				{name: "name", dataType: "varchar"},
+			},
+			primaryKey: []string{"id"},
+		},
+		{
+			name: "employees",
+			columns: []*columnInfo{
+				{name: "id", dataType: "bigint"},
+				{name: "full name", dataType: "text"},
+				{name: "salary", dataType: "decimal"},
+				{name: "hired", dataType: "datetime"},
+				{name: "company_id", dataType: "int"},
+			},
+			primaryKey: []string{"id"},
+			foreignKeys: []*foreignKey{{
+				name:       "fk_company",
+				columns:    []string{"company_id"},
+				refTable:   "companies",
+				refColumns: []string{"id"},
+			}},
+		},
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestNewMapping(t *testing.T) {
+	tables := testTables()
+	m := newMapping(tables)
+	require.NoError(t, m.validate(tables))
+	require.Len(t, m.Tables, 2)
+
+	emp := m.Tables[1]
+	require.Equal(t, "Employees", emp.Type)
+	require.Equal(t, []string{"id"}, emp.Key)
+	require.Equal(t, "Employees.id", emp.Columns[0].Predicate)
+	require.Equal(t, "int", emp.Columns[0].Index)
+	require.Equal(t, "Employees.full_name", emp.Columns[1].Predicate)
+	require.Equal(t, "string", emp.Columns[1].DgraphType)
+	require.Equal(t, "float", emp.Columns[2].DgraphType)
+	require.Equal(t, "datetime", emp.Columns[3].DgraphType)
+	// The column of the foreign key is replaced by the edge.
+	require.True(t, emp.Columns[4].Skip)
+	require.Len(t, emp.Edges, 1)
+	require.Equal(t, "Employees.company", emp.Edges[0].Predicate)
+	require.True(t, emp.Edges[0].Reverse)
+	require.False(t, emp.Edges[0].Skip)
+}
+
+func TestMappingEdgeNotToKey(t *testing.T) {
+	tables := testTables()
+	tables[1].foreignKeys[0].refColumns = []string{"name"}
+	m := newMapping(tables)
+	require.True(t, m.Tables[1].Edges[0].Skip)
+	require.False(t, m.Tables[1].Columns[4].Skip)
+	require.NoError(t, m.validate(tables))
+
+	m.Tables[1].Edges[0].Skip = false
+	require.Error(t, m.validate(tables))
+}
+
+func TestMappingValidate(t *testing.T) {
+	tables := testTables()
+	m := newMapping(tables)
+	m.Tables[0].Columns[1].Column = "missing"
+	require.Error(t, m.validate(tables))
+
+	m = newMapping(tables)
+	m.Tables[0].Skip = true
+	require.Error(t, m.validate(tables))
+
+	m = newMapping(tables)
+	m.Tables[0].Columns[1].Predicate = "Employees.company"
+	require.Error(t, m.validate(tables))
+}
+
+func TestWriteSchema(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeSchema(&buf, newMapping(testTables())))
+	require.Equal(t, `<Companies.id>: int @index(int) .
+<Companies.name>: string .
+<Employees.company>: uid @reverse .
+<Employees.full_name>: string .
+<Employees.hired>: datetime .
+<Employees.id>: int @index(int) .
+<Employees.salary>: float .
+
+type Companies {
+  Companies.id
+  Companies.name
+}
+
+type Employees {
+  Employees.id
+  Employees.full_name
+  Employees.salary
+  Employees.hired
+  Employees.company
+}
+`, buf.String())
+}
+
+func TestRowNode(t *testing.T) {
+	m := newMapping(testTables())
+	byName := map[string]*tableMapping{"companies": m.Tables[0], "employees": m.Tables[1]}
+	row := map[string]*string{
+		"id":         strPtr("7"),
+		"full name":  strPtr("Alice \"Al\" Smith"),
+		"salary":     nil,
+		"hired":      strPtr("2018-05-03 10:11:12"),
+		"company_id": strPtr("3"),
+	}
+	n := rowNode(m.Tables[1], byName, row, 1)
+	require.Equal(t, "Employees-7", n.label)
+
+	var buf bytes.Buffer
+	rw := newRDFWriter(&buf)
+	require.NoError(t, rw.write(n))
+	require.NoError(t, rw.close())
+	require.Equal(t, `_:Employees-7 <dgraph.type> "Employees" .
+_:Employees-7 <Employees.id> "7" .
+_:Employees-7 <Employees.full_name> "Alice \"Al\" Smith" .
+_:Employees-7 <Employees.hired> "2018-05-03T10:11:12" .
+_:Employees-7 <Employees.company> _:Companies-3 .
+`, buf.String())
+
+	buf.Reset()
+	jw := newJSONWriter(&buf)
+	require.NoError(t, jw.write(n))
+	require.NoError(t, jw.close())
+	var objs []map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &objs))
+	require.Equal(t, []map[string]interface{}{{
+		"uid":                 "_:Employees-7",
+		"dgraph.type":         "Employees",
+		"Employees.id":        "7",
+		"Employees.full_name": "Alice \"Al\" Smith",
+		"Employees.hired":     "2018-05-03T10:11:12",
+		"Employees.company":   map[string]interface{}{"uid": "_:Companies-3"},
+	}}, objs)
+
+	// There's no edge without the foreign key.
+	row["company_id"] = nil
+	n = rowNode(m.Tables[1], byName, row, 1)
+	require.Empty(t, n.edges)
+}
+
+func TestNodeLabel(t *testing.T) {
+	row := map[string]*string{"a": strPtr("x y"), "b": strPtr("1_2")}
+	require.Equal(t, "T-x_20y-1_5f2", nodeLabel("T", []string{"a", "b"}, row))
+	require.Equal(t, "", nodeLabel("T", []string{"a", "c"}, row))
+}
+
+func TestConvertValue(t *testing.T) {
+	require.Equal(t, "2018-05-03T10:11:12Z", convertValue("datetime", "2018-05-03 10:11:12Z"))
+	require.Equal(t, "2018-05-03T10:11:12.5+02:00",
+		convertValue("datetime", "2018-05-03 10:11:12.5+02"))
+	require.Equal(t, "2018-05-03", convertValue("datetime", "2018-05-03"))
+	require.Equal(t, "2018-05-03 10:11:12", convertValue("string", "2018-05-03 10:11:12"))
+}
@@ -0,0 +1,275 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// writeSchema writes the schema of the predicates and the types of the mapping.
+func writeSchema(w io.Writer, m *mapping) error {
+	preds := make(map[string]string)
+	var types []string
+	for _, tm := range m.Tables {
+		if tm.Skip {
+			continue
+		}
+		var fields []string
+		for _, cm := range tm.Columns {
+			if cm.Skip {
+				continue
+			}
+			schema := cm.DgraphType
+			if len(cm.Index) > 0 {
+				schema += fmt.Sprintf(" @index(%s)", cm.Index)
+			}
+			preds[cm.Predicate] = schema
+			fields = append(fields, cm.Predicate)
+		}
+		for _, em := range tm.Edges {
+			if em.Skip {
+				continue
+			}
+			schema := "uid"
+			if em.Reverse {
+				schema += " @reverse"
+			}
+			preds[em.Predicate] = schema
+			fields = append(fields, em.Predicate)
+		}
+		if len(fields) > 0 {
+			types = append(types, fmt.Sprintf("type %s {\n  %s\n}\n", tm.Type,
+				strings.Join(fields, "\n  ")))
+		}
+	}
+
+	names := make([]string, 0, len(preds))
+	for name := range preds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	bw := bufio.NewWriter(w)
+	for _, name := range names {
+		fmt.Fprintf(bw, "<%s>: %s .\n", name, preds[name])
+	}
+	for _, typ := range types {
+		fmt.Fprintf(bw, "\n%s", typ)
+	}
+	return bw.Flush()
+}
+
+// node is what a row is migrated to.
+type node struct {
+	label string
+	typ   string
+	// The predicates with values, and the edges with the labels of the nodes they point to, in
+	// the order of the mapping.
+	preds  []string
+	values []string
+	edges  []string
+	labels []string
+}
+
+// rowNode returns the node of a row of the table, given the values of the columns by name, and
+// the position of the row in the table. tables holds the mapping of all the tables by name.
+func rowNode(tm *tableMapping, tables map[string]*tableMapping, row map[string]*string,
+	pos int) *node {
+	n := &node{typ: tm.Type}
+	if len(tm.Key) == 0 {
+		n.label = escapeLabel(tm.Type) + fmt.Sprintf("-row%d", pos)
+	} else {
+		n.label = nodeLabel(tm.Type, tm.Key, row)
+	}
+
+	for _, cm := range tm.Columns {
+		if v := row[cm.Column]; !cm.Skip && v != nil {
+			n.preds = append(n.preds, cm.Predicate)
+			n.values = append(n.values, convertValue(cm.DgraphType, *v))
+		}
+	}
+	for _, em := range tm.Edges {
+		if em.Skip {
+			continue
+		}
+		ref := tables[em.RefTable]
+		refRow := make(map[string]*string, len(em.Columns))
+		for i, c := range em.Columns {
+			refRow[em.RefColumns[i]] = row[c]
+		}
+		// There's no edge if a column of the foreign key is NULL.
+		if label := nodeLabel(ref.Type, ref.Key, refRow); len(label) > 0 {
+			n.edges = append(n.edges, em.Predicate)
+			n.labels = append(n.labels, label)
+		}
+	}
+	return n
+}
+
+// nodeLabel returns the label of the blank node of the row of type typ, made of the type and
+// the values of the key, or an empty string if a value is missing.
+func nodeLabel(typ string, key []string, row map[string]*string) string {
+	parts := []string{escapeLabel(typ)}
+	for _, c := range key {
+		v := row[c]
+		if v == nil {
+			return ""
+		}
+		parts = append(parts, escapeLabel(*v))
+	}
+	return strings.Join(parts, "-")
+}
+
+// escapeLabel escapes the characters other than ASCII letters and digits, which can't all be
+// part of a blank node label, as _ followed by their hex code.
+func escapeLabel(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "_%02x", c)
+		}
+	}
+	return b.String()
+}
+
+// The layouts of the dates and times returned by the databases, with and without a time zone.
+var (
+	zonedLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999Z07"}
+	localLayouts = []string{"2006-01-02 15:04:05.999999999"}
+)
+
+// convertValue converts the value of a column to the format Dgraph parses for its type. Only
+// the dates and times need it, as Dgraph expects a T between them.
+func convertValue(typ, val string) string {
+	if typ != "datetime" {
+		return val
+	}
+	for _, layout := range zonedLayouts {
+		if t, err := time.Parse(layout, val); err == nil {
+			return t.Format(time.RFC3339Nano)
+		}
+	}
+	for _, layout := range localLayouts {
+		if t, err := time.Parse(layout, val); err == nil {
+			return t.Format("2006-01-02T15:04:05.999999999")
+		}
+	}
+	return val
+}
+
+// nodeWriter writes the nodes in a format the bulk and live loaders read.
+type nodeWriter interface {
+	write(n *node) error
+	// close writes what follows the last node. It doesn't close the underlying writer.
+	close() error
+}
+
+type rdfWriter struct {
+	w *bufio.Writer
+}
+
+func newRDFWriter(w io.Writer) *rdfWriter {
+	return &rdfWriter{w: bufio.NewWriter(w)}
+}
+
+func (rw *rdfWriter) write(n *node) error {
+	fmt.Fprintf(rw.w, "_:%s <dgraph.type> \"%s\" .\n", n.label, escapeLiteral(n.typ))
+	for i, pred := range n.preds {
+		fmt.Fprintf(rw.w, "_:%s <%s> \"%s\" .\n", n.label, pred, escapeLiteral(n.values[i]))
+	}
+	for i, pred := range n.edges {
+		_, err := fmt.Fprintf(rw.w, "_:%s <%s> _:%s .\n", n.label, pred, n.labels[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rw *rdfWriter) close() error {
+	return rw.w.Flush()
+}
+
+// escapeLiteral escapes the backslashes, the quotes and the line breaks of an RDF literal.
+func escapeLiteral(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`).
+		Replace(s)
+}
+
+// jsonWriter writes the nodes as the objects of a JSON array.
+type jsonWriter struct {
+	w     *bufio.Writer
+	count int
+}
+
+func newJSONWriter(w io.Writer) *jsonWriter {
+	return &jsonWriter{w: bufio.NewWriter(w)}
+}
+
+func (jw *jsonWriter) write(n *node) error {
+	obj := map[string]interface{}{
+		"uid":         "_:" + n.label,
+		"dgraph.type": n.typ,
+	}
+	add := func(pred string, val interface{}) {
+		// The edges of different foreign keys can have the same predicate.
+		switch prev := obj[pred].(type) {
+		case nil:
+			obj[pred] = val
+		case []interface{}:
+			obj[pred] = append(prev, val)
+		default:
+			obj[pred] = []interface{}{prev, val}
+		}
+	}
+	for i, pred := range n.preds {
+		add(pred, n.values[i])
+	}
+	for i, pred := range n.edges {
+		add(pred, map[string]string{"uid": "_:" + n.labels[i]})
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	sep := ",\n"
+	if jw.count == 0 {
+		sep = "[\n"
+	}
+	jw.count++
+	jw.w.WriteString(sep)
+	_, err = jw.w.Write(b)
+	return err
+}
+
+func (jw *jsonWriter) close() error {
+	if jw.count == 0 {
+		jw.w.WriteString("[")
+	}
+	jw.w.WriteString("\n]\n")
+	return jw.w.Flush()
+}
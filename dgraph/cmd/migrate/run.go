@@ -0,0 +1,220 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"compress/gzip"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/spf13/cobra"
+
+	// The drivers of the databases migrated from.
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+)
+
+var Migrate x.SubCommand
+
+func init() {
+	Migrate.Cmd = &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the tables of a MySQL or Postgres database to Dgraph",
+		Long: `
+Migrate reads the tables of a MySQL or Postgres database and writes a Dgraph schema and the
+RDF or JSON data to load with the bulk or live loader. Every table becomes a type and every
+row a node, with a predicate per column and an edge per foreign key. The mapping of the tables
+is written to a file which can be edited, to rename the types and the predicates, skip tables
+and columns or choose which foreign keys become edges, and passed back with --mapping.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			defer x.StartProfile(Migrate.Conf).Stop()
+			if err := run(); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+	Migrate.EnvPrefix = "DGRAPH_MIGRATE"
+
+	flag := Migrate.Cmd.Flags()
+	flag.String("driver", "mysql", "Database to migrate from: mysql or postgres.")
+	flag.String("host", "localhost", "Host of the database.")
+	flag.Int("port", 0, "Port of the database. Defaults to 3306 for mysql and 5432 for postgres.")
+	flag.String("user", "", "User to connect to the database as.")
+	flag.String("password", "", "Password of the user. Can be set with DGRAPH_MIGRATE_PASSWORD.")
+	flag.String("db", "", "Database to migrate.")
+	flag.String("pg_schema", "public", "Postgres schema of the tables to migrate.")
+	flag.String("tables", "", "Comma separated tables to migrate. All of them by default.")
+	flag.String("mapping", "", "Mapping of the tables to read instead of generating it.")
+	flag.String("mapping_out", "mapping.json",
+		"File to write the mapping of the tables to, to edit it and pass it to --mapping.")
+	flag.StringP("schema_out", "s", "schema.txt", "File to write the Dgraph schema to.")
+	flag.StringP("out", "o", "data.rdf.gz",
+		"File to write the data to, as JSON if it ends in .json or .json.gz, or as RDF "+
+			"otherwise. It's compressed if it ends in .gz.")
+}
+
+func run() error {
+	conf := Migrate.Conf
+	d, ok := dialects[conf.GetString("driver")]
+	if !ok {
+		return x.Errorf("Unknown driver %q, must be mysql or postgres",
+			conf.GetString("driver"))
+	}
+	port := conf.GetInt("port")
+	if port == 0 {
+		port = d.defaultPort
+	}
+	dbName := conf.GetString("db")
+	if len(dbName) == 0 {
+		return x.Errorf("The database to migrate (--db) must be specified")
+	}
+	db, err := sql.Open(d.driver, d.dsn(conf.GetString("host"), port, conf.GetString("user"),
+		conf.GetString("password"), dbName))
+	if err != nil {
+		return x.Wrapf(err, "while connecting to the database")
+	}
+	defer db.Close()
+	if err := db.Ping(); err != nil {
+		return x.Wrapf(err, "while connecting to the database")
+	}
+
+	// The tables of a MySQL database are in the schema named after it.
+	schema := dbName
+	if d.driver == "postgres" {
+		schema = conf.GetString("pg_schema")
+	}
+	var names []string
+	if tables := conf.GetString("tables"); len(tables) > 0 {
+		for _, name := range strings.Split(tables, ",") {
+			names = append(names, strings.TrimSpace(name))
+		}
+	}
+	tables, err := introspect(db, d, schema, names)
+	if err != nil {
+		return err
+	}
+
+	var m *mapping
+	if file := conf.GetString("mapping"); len(file) > 0 {
+		if m, err = readMapping(file); err != nil {
+			return err
+		}
+	} else {
+		m = newMapping(tables)
+	}
+	if err := m.validate(tables); err != nil {
+		return err
+	}
+	for _, tm := range m.Tables {
+		if len(tm.Key) == 0 && !tm.Skip {
+			fmt.Printf("Table %s has no primary key, no edge can point to its rows.\n",
+				tm.Table)
+		}
+		for _, em := range tm.Edges {
+			if em.Skip {
+				fmt.Printf("Foreign key %s of table %s isn't migrated as an edge.\n", em,
+					tm.Table)
+			}
+		}
+	}
+	if file := conf.GetString("mapping_out"); len(file) > 0 {
+		if err := writeMapping(file, m); err != nil {
+			return x.Wrapf(err, "while writing the mapping")
+		}
+	}
+
+	if err := writeFile(conf.GetString("schema_out"), func(w io.Writer) error {
+		return writeSchema(w, m)
+	}); err != nil {
+		return x.Wrapf(err, "while writing the schema")
+	}
+	out := conf.GetString("out")
+	return writeFile(out, func(w io.Writer) error {
+		var nw nodeWriter = newRDFWriter(w)
+		if strings.HasSuffix(strings.TrimSuffix(out, ".gz"), ".json") {
+			nw = newJSONWriter(w)
+		}
+		if err := migrateRows(db, d, schema, tables, m, nw); err != nil {
+			return err
+		}
+		return nw.close()
+	})
+}
+
+// migrateRows writes the nodes of the rows of all the tables of the mapping.
+func migrateRows(db *sql.DB, d *dialect, schema string, tables []*tableInfo, m *mapping,
+	nw nodeWriter) error {
+	byName := make(map[string]*tableMapping, len(m.Tables))
+	for _, tm := range m.Tables {
+		byName[tm.Table] = tm
+	}
+	for _, t := range tables {
+		tm, ok := byName[t.name]
+		if !ok || tm.Skip {
+			continue
+		}
+		columns := make([]string, 0, len(t.columns))
+		for _, c := range t.columns {
+			columns = append(columns, c.name)
+		}
+
+		var count int
+		row := make(map[string]*string, len(columns))
+		err := readRows(db, d, schema, t.name, columns, func(values []*string) error {
+			for i, c := range columns {
+				row[c] = values[i]
+			}
+			count++
+			return nw.write(rowNode(tm, byName, row, count))
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Migrated %d rows of table %s.\n", count, t.name)
+	}
+	return nil
+}
+
+// writeFile writes a file with fn, compressing it if its name ends in .gz.
+func writeFile(file string, fn func(w io.Writer) error) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if !strings.HasSuffix(file, ".gz") {
+		if err := fn(f); err != nil {
+			return err
+		}
+		return f.Close()
+	}
+	gw := gzip.NewWriter(f)
+	if err := fn(gw); err != nil {
+		return err
+	}
+	if err := gw.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,258 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// dialect holds what differs between the databases migrated from.
+type dialect struct {
+	driver      string
+	defaultPort int
+	// dsn returns the data source name of the database to connect to.
+	dsn func(host string, port int, user, password, db string) string
+	// quote quotes an identifier, such as the name of a table or a column.
+	quote func(ident string) string
+	// placeholder returns the placeholder of the i-th argument of a query, starting at 1.
+	placeholder func(i int) string
+	// foreignKeys lists the columns of the foreign keys of a table, given the schema and the
+	// table, ordered by constraint and position, along with the table and columns referenced.
+	foreignKeys string
+}
+
+var dialects = map[string]*dialect{
+	"mysql": {
+		driver:      "mysql",
+		defaultPort: 3306,
+		dsn: func(host string, port int, user, password, db string) string {
+			return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s", user, password, host, port, db)
+		},
+		quote: func(ident string) string {
+			return "`" + strings.Replace(ident, "`", "``", -1) + "`"
+		},
+		placeholder: func(int) string { return "?" },
+		foreignKeys: `SELECT constraint_name, column_name, referenced_table_name,
+			referenced_column_name
+			FROM information_schema.key_column_usage
+			WHERE table_schema = ? AND table_name = ? AND referenced_table_name IS NOT NULL
+			ORDER BY constraint_name, ordinal_position`,
+	},
+	"postgres": {
+		driver:      "postgres",
+		defaultPort: 5432,
+		dsn: func(host string, port int, user, password, db string) string {
+			// The values are quoted, in case they're empty or have spaces.
+			q := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace
+			return fmt.Sprintf("host='%s' port=%d user='%s' password='%s' dbname='%s'",
+				q(host), port, q(user), q(password), q(db))
+		},
+		quote: func(ident string) string {
+			return `"` + strings.Replace(ident, `"`, `""`, -1) + `"`
+		},
+		placeholder: func(i int) string { return fmt.Sprintf("$%d", i) },
+		// Postgres doesn't have the referenced columns in key_column_usage, they're found through
+		// the unique constraint the foreign key refers to.
+		foreignKeys: `SELECT kcu.constraint_name, kcu.column_name, ref.table_name,
+			ref.column_name
+			FROM information_schema.referential_constraints rc
+			JOIN information_schema.key_column_usage kcu
+				ON kcu.constraint_schema = rc.constraint_schema
+				AND kcu.constraint_name = rc.constraint_name
+			JOIN information_schema.key_column_usage ref
+				ON ref.constraint_schema = rc.unique_constraint_schema
+				AND ref.constraint_name = rc.unique_constraint_name
+				AND ref.ordinal_position = kcu.position_in_unique_constraint
+			WHERE kcu.table_schema = $1 AND kcu.table_name = $2
+			ORDER BY kcu.constraint_name, kcu.ordinal_position`,
+	},
+}
+
+type columnInfo struct {
+	name     string
+	dataType string
+}
+
+type foreignKey struct {
+	name       string
+	columns    []string
+	refTable   string
+	refColumns []string
+}
+
+type tableInfo struct {
+	name        string
+	columns     []*columnInfo
+	primaryKey  []string
+	foreignKeys []*foreignKey
+}
+
+// introspect returns the tables of the schema, or only the ones named if any, with their columns
+// and keys.
+func introspect(db *sql.DB, d *dialect, schema string, names []string) ([]*tableInfo, error) {
+	if len(names) == 0 {
+		var err error
+		if names, err = tableNames(db, d, schema); err != nil {
+			return nil, err
+		}
+	}
+
+	var tables []*tableInfo
+	for _, name := range names {
+		t := &tableInfo{name: name}
+		if err := t.readColumns(db, d, schema); err != nil {
+			return nil, x.Wrapf(err, "while reading the columns of table %s", name)
+		}
+		if len(t.columns) == 0 {
+			return nil, x.Errorf("Table %s not found in schema %s", name, schema)
+		}
+		if err := t.readPrimaryKey(db, d, schema); err != nil {
+			return nil, x.Wrapf(err, "while reading the primary key of table %s", name)
+		}
+		if err := t.readForeignKeys(db, d, schema); err != nil {
+			return nil, x.Wrapf(err, "while reading the foreign keys of table %s", name)
+		}
+		tables = append(tables, t)
+	}
+	return tables, nil
+}
+
+func tableNames(db *sql.DB, d *dialect, schema string) ([]string, error) {
+	rows, err := db.Query(fmt.Sprintf(`SELECT table_name FROM information_schema.tables
+		WHERE table_schema = %s AND table_type = 'BASE TABLE' ORDER BY table_name`,
+		d.placeholder(1)), schema)
+	if err != nil {
+		return nil, x.Wrapf(err, "while listing the tables")
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+func (t *tableInfo) readColumns(db *sql.DB, d *dialect, schema string) error {
+	rows, err := db.Query(fmt.Sprintf(`SELECT column_name, data_type
+		FROM information_schema.columns WHERE table_schema = %s AND table_name = %s
+		ORDER BY ordinal_position`, d.placeholder(1), d.placeholder(2)), schema, t.name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		c := &columnInfo{}
+		if err := rows.Scan(&c.name, &c.dataType); err != nil {
+			return err
+		}
+		t.columns = append(t.columns, c)
+	}
+	return rows.Err()
+}
+
+func (t *tableInfo) readPrimaryKey(db *sql.DB, d *dialect, schema string) error {
+	rows, err := db.Query(fmt.Sprintf(`SELECT kcu.column_name
+		FROM information_schema.table_constraints tc
+		JOIN information_schema.key_column_usage kcu
+			ON kcu.constraint_schema = tc.constraint_schema
+			AND kcu.constraint_name = tc.constraint_name
+			AND kcu.table_name = tc.table_name
+		WHERE tc.constraint_type = 'PRIMARY KEY' AND tc.table_schema = %s
+			AND tc.table_name = %s
+		ORDER BY kcu.ordinal_position`, d.placeholder(1), d.placeholder(2)), schema, t.name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return err
+		}
+		t.primaryKey = append(t.primaryKey, column)
+	}
+	return rows.Err()
+}
+
+func (t *tableInfo) readForeignKeys(db *sql.DB, d *dialect, schema string) error {
+	rows, err := db.Query(d.foreignKeys, schema, t.name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	var fk *foreignKey
+	for rows.Next() {
+		var name, column, refTable, refColumn string
+		if err := rows.Scan(&name, &column, &refTable, &refColumn); err != nil {
+			return err
+		}
+		if fk == nil || fk.name != name {
+			fk = &foreignKey{name: name, refTable: refTable}
+			t.foreignKeys = append(t.foreignKeys, fk)
+		}
+		fk.columns = append(fk.columns, column)
+		fk.refColumns = append(fk.refColumns, refColumn)
+	}
+	return rows.Err()
+}
+
+// readRows calls fn with the values of the columns of every row of the table, in the order of
+// the columns. The values of the columns which are NULL are nil.
+func readRows(db *sql.DB, d *dialect, schema, table string, columns []string,
+	fn func(values []*string) error) error {
+	quoted := make([]string, 0, len(columns))
+	for _, c := range columns {
+		quoted = append(quoted, d.quote(c))
+	}
+	rows, err := db.Query(fmt.Sprintf("SELECT %s FROM %s.%s", strings.Join(quoted, ", "),
+		d.quote(schema), d.quote(table)))
+	if err != nil {
+		return x.Wrapf(err, "while reading the rows of table %s", table)
+	}
+	defer rows.Close()
+
+	raw := make([]sql.RawBytes, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range raw {
+		dest[i] = &raw[i]
+	}
+	values := make([]*string, len(columns))
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return x.Wrapf(err, "while reading a row of table %s", table)
+		}
+		for i, r := range raw {
+			values[i] = nil
+			if r != nil {
+				s := string(r)
+				values[i] = &s
+			}
+		}
+		if err := fn(values); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package upgrade
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/spf13/cobra"
+)
+
+var Upgrade x.SubCommand
+
+func init() {
+	Upgrade.Cmd = &cobra.Command{
+		Use:   "upgrade",
+		Short: "Rewrite the directories of a server written in an older on-disk format",
+		Long: `
+Upgrade rewrites the postings and WAL directories of an alpha, or the WAL directory of a zero,
+written by an older version of Dgraph in the on-disk format of this version. The server must be
+stopped. The directories are left as they are, and the upgraded ones are written to the output
+directory under the same names. Run it on the directories of every server of a group, or on the
+ones of a single server and copy them to the others, one group after the other.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			defer x.StartProfile(Upgrade.Conf).Stop()
+			if err := run(); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+	Upgrade.EnvPrefix = "DGRAPH_UPGRADE"
+
+	flag := Upgrade.Cmd.Flags()
+	flag.StringP("postings", "p", "", "Postings directory of an alpha to upgrade.")
+	flag.StringP("wal", "w", "", "WAL directory of an alpha (w) or a zero (zw) to upgrade.")
+	flag.StringP("out", "o", "", "Directory to write the upgraded directories to.")
+}
+
+func run() error {
+	conf := Upgrade.Conf
+	pdir, wdir, out := conf.GetString("postings"), conf.GetString("wal"), conf.GetString("out")
+	if len(pdir) == 0 && len(wdir) == 0 {
+		return x.Errorf("The directories to upgrade (--postings or --wal) must be specified")
+	}
+	if len(out) == 0 {
+		return x.Errorf("The output directory (--out) must be specified")
+	}
+	if len(pdir) > 0 {
+		if err := upgradeDir(pdir, out, true); err != nil {
+			return x.Wrapf(err, "while upgrading %s", pdir)
+		}
+	}
+	if len(wdir) > 0 {
+		if err := upgradeDir(wdir, out, false); err != nil {
+			return x.Wrapf(err, "while upgrading %s", wdir)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/raftwal"
+	"github.com/dgraph-io/dgraph/stream"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// upgrade rewrites the directories written in a format version in the next one.
+type upgrade struct {
+	// posting returns what a key of a postings directory is written as, see
+	// stream.Lists.ItemToKVFunc.
+	posting func(key []byte, itr *badger.Iterator) (*pb.KV, error)
+	// wal upgrades the copy of a WAL directory in place.
+	wal func(db *badger.DB, dir string) error
+}
+
+// upgrades[v] upgrades the directories of format v to format v+1.
+var upgrades = []upgrade{
+	// Format 1 keeps the Raft entries in a log in the WAL directory instead of Badger, and records
+	// the version in the directories. The posting lists are rolled up on the way.
+	{posting: rollupPosting, wal: raftwal.Upgrade},
+}
+
+// upgradeDir writes the directory, upgraded to the current format, to a directory of the same
+// name in the output directory. It's a postings directory if postings is set, and a WAL
+// directory otherwise.
+func upgradeDir(dir, out string, postings bool) error {
+	version, err := x.ReadFormatVersion(dir)
+	if err != nil {
+		return err
+	}
+	switch {
+	case version == x.FormatVersion:
+		fmt.Printf("%s is already in format %d.\n", dir, version)
+		return nil
+	case version > x.FormatVersion:
+		return x.Errorf("%s is in format %d, newer than the format %d of this version of Dgraph",
+			dir, version, x.FormatVersion)
+	}
+	dst := filepath.Join(out, filepath.Base(filepath.Clean(dir)))
+	if err := makeEmptyDir(dst); err != nil {
+		return err
+	}
+
+	if postings {
+		err = upgradePostings(dir, dst, version)
+	} else {
+		err = upgradeWAL(dir, dst, version)
+	}
+	if err != nil {
+		return err
+	}
+	return x.WriteFormatVersion(dst, x.FormatVersion)
+}
+
+// upgradePostings rewrites the postings directory src of the format version into dst, once per
+// format up to the current one.
+func upgradePostings(src, dst string, version int) error {
+	for v := version; v < x.FormatVersion; v++ {
+		next := dst
+		if v+1 < x.FormatVersion {
+			// The intermediate formats are written next to the output directory.
+			next = fmt.Sprintf("%s.%d", dst, v+1)
+			if err := makeEmptyDir(next); err != nil {
+				return err
+			}
+			defer os.RemoveAll(next)
+		}
+		fmt.Printf("Upgrading %s from format %d to %d.\n", src, v, v+1)
+		if err := rewritePostings(src, next, upgrades[v].posting); err != nil {
+			return err
+		}
+		src = next
+	}
+	return nil
+}
+
+// upgradeWAL copies the WAL directory src of the format version into dst, and upgrades the copy
+// to the current format.
+func upgradeWAL(src, dst string, version int) error {
+	if err := copyWAL(src, dst); err != nil {
+		return err
+	}
+	opt := badger.LSMOnlyOptions
+	opt.Dir = dst
+	opt.ValueDir = dst
+	db, err := badger.Open(opt)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	for v := version; v < x.FormatVersion; v++ {
+		fmt.Printf("Upgrading %s from format %d to %d.\n", src, v, v+1)
+		if err := upgrades[v].wal(db, dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// makeEmptyDir creates the directory, failing if it already exists with files in it.
+func makeEmptyDir(dir string) error {
+	if files, err := ioutil.ReadDir(dir); err == nil && len(files) > 0 {
+		return x.Errorf("Output directory %s isn't empty", dir)
+	}
+	return os.MkdirAll(dir, 0700)
+}
+
+func openPostings(dir string, readOnly bool) (*badger.DB, error) {
+	opt := badger.DefaultOptions
+	opt.Dir = dir
+	opt.ValueDir = dir
+	opt.ValueThreshold = x.PostingValueThreshold
+	opt.NumVersionsToKeep = math.MaxInt32
+	opt.ReadOnly = readOnly
+	return badger.OpenManaged(opt)
+}
+
+// rewritePostings writes the keys of the postings directory src to the postings directory dst,
+// as returned by fn.
+func rewritePostings(src, dst string,
+	fn func(key []byte, itr *badger.Iterator) (*pb.KV, error)) error {
+	in, err := openPostings(src, true)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := openPostings(dst, false)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	writer := x.NewTxnWriter(out)
+	sl := stream.Lists{Stream: writer, DB: in, ItemToKVFunc: fn}
+	if err := sl.Orchestrate(context.Background(), "Upgrading", math.MaxUint64); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+// rollupPosting returns the posting list of the key as a complete posting list at the version of
+// its latest delta. The other keys are copied at their latest version.
+func rollupPosting(key []byte, itr *badger.Iterator) (*pb.KV, error) {
+	item := itr.Item()
+	if item.IsDeletedOrExpired() {
+		return nil, nil
+	}
+	if pk := x.Parse(key); pk == nil || pk.IsSchema() || pk.IsTypeDef() {
+		val, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.KV{
+			Key:      key,
+			Val:      val,
+			UserMeta: []byte{item.UserMeta()},
+			Version:  item.Version(),
+		}, nil
+	}
+	l, err := posting.ReadPostingList(key, itr)
+	if err != nil {
+		return nil, err
+	}
+	return l.MarshalToKv()
+}
+
+// copyWAL copies all the versions of the keys of the WAL directory src, and the log of the Raft
+// entries if there's one, to the WAL directory dst.
+func copyWAL(src, dst string) error {
+	opt := badger.LSMOnlyOptions
+	opt.Dir = src
+	opt.ValueDir = src
+	opt.ReadOnly = true
+	in, err := badger.Open(opt)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	opt = badger.LSMOnlyOptions
+	opt.Dir = dst
+	opt.ValueDir = dst
+	out, err := badger.Open(opt)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	pr, pw := io.Pipe()
+	go func() {
+		_, err := in.Backup(pw, 0)
+		pw.CloseWithError(err)
+	}()
+	err = out.Load(pr)
+	// Unblocks the backup if the load stopped early.
+	pr.CloseWithError(err)
+	if err != nil {
+		return err
+	}
+	return copyFiles(filepath.Join(src, raftwal.LogDir), filepath.Join(dst, raftwal.LogDir))
+}
+
+// copyFiles copies the files of the directory src to the directory dst, if src exists.
+func copyFiles(src, dst string) error {
+	files, err := ioutil.ReadDir(src)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dst, 0700); err != nil {
+		return err
+	}
+	for _, fi := range files {
+		if fi.IsDir() {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(src, fi.Name()))
+		if err != nil {
+			return err
+		}
+		if err := x.WriteFileSync(filepath.Join(dst, fi.Name()), data, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
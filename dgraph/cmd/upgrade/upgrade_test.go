@@ -0,0 +1,147 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package upgrade
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgraph-io/badger"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/raftwal"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestUpgrades(t *testing.T) {
+	require.Len(t, upgrades, x.FormatVersion)
+	for _, u := range upgrades {
+		require.NotNil(t, u.posting)
+		require.NotNil(t, u.wal)
+	}
+}
+
+func writeDelta(t *testing.T, w *x.TxnWriter, key []byte, value string, ts uint64) {
+	pl := &pb.PostingList{Postings: []*pb.Posting{{
+		Uid:         math.MaxUint64,
+		Value:       []byte(value),
+		ValType:     pb.Posting_STRING,
+		PostingType: pb.Posting_VALUE,
+		Op:          posting.Set,
+	}}}
+	data, err := pl.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, w.SetAt(key, data, posting.BitDeltaPosting, ts))
+}
+
+func TestUpgradePostings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "upgrade")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pdir := filepath.Join(dir, "p")
+	require.NoError(t, os.Mkdir(pdir, 0700))
+	db, err := openPostings(pdir, false)
+	require.NoError(t, err)
+	w := x.NewTxnWriter(db)
+	writeDelta(t, w, x.DataKey("name", 1), "alice", 2)
+	writeDelta(t, w, x.DataKey("name", 1), "bob", 3)
+	require.NoError(t, w.SetAt(x.SchemaKey("name"), []byte("schema"), posting.BitSchemaPosting, 1))
+	require.NoError(t, w.Flush())
+	require.NoError(t, db.Close())
+
+	out := filepath.Join(dir, "out")
+	require.NoError(t, upgradeDir(pdir, out, true))
+	version, err := x.ReadFormatVersion(filepath.Join(out, "p"))
+	require.NoError(t, err)
+	require.Equal(t, x.FormatVersion, version)
+
+	db, err = openPostings(filepath.Join(out, "p"), true)
+	require.NoError(t, err)
+	defer db.Close()
+	txn := db.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+
+	// The deltas are rolled up at the version of the latest one.
+	item, err := txn.Get(x.DataKey("name", 1))
+	require.NoError(t, err)
+	require.Equal(t, posting.BitCompletePosting, item.UserMeta())
+	require.Equal(t, uint64(3), item.Version())
+	val, err := item.ValueCopy(nil)
+	require.NoError(t, err)
+	var pl pb.PostingList
+	require.NoError(t, pl.Unmarshal(val))
+	require.Len(t, pl.Postings, 1)
+	require.Equal(t, "bob", string(pl.Postings[0].Value))
+
+	item, err = txn.Get(x.SchemaKey("name"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), item.Version())
+	val, err = item.ValueCopy(nil)
+	require.NoError(t, err)
+	require.Equal(t, "schema", string(val))
+
+	// The directories already upgraded are left as they are, and aren't overwritten.
+	require.NoError(t, upgradeDir(filepath.Join(out, "p"), out, true))
+	require.Error(t, upgradeDir(pdir, out, true))
+}
+
+func TestUpgradeWAL(t *testing.T) {
+	dir, err := ioutil.TempDir("", "upgrade")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	wdir := filepath.Join(dir, "zw")
+	opt := badger.LSMOnlyOptions
+	opt.Dir = wdir
+	opt.ValueDir = wdir
+	db, err := badger.Open(opt)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte("key"), []byte("value"))
+	}))
+	require.NoError(t, db.Close())
+
+	out := filepath.Join(dir, "out")
+	require.NoError(t, upgradeDir(wdir, out, false))
+	version, err := x.ReadFormatVersion(filepath.Join(out, "zw"))
+	require.NoError(t, err)
+	require.Equal(t, x.FormatVersion, version)
+	// The Raft entries are kept in a log since format 1.
+	_, err = os.Stat(filepath.Join(out, "zw", raftwal.LogDir))
+	require.NoError(t, err)
+
+	opt.Dir = filepath.Join(out, "zw")
+	opt.ValueDir = opt.Dir
+	db, err = badger.Open(opt)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte("key"))
+		if err != nil {
+			return err
+		}
+		val, err := item.ValueCopy(nil)
+		require.Equal(t, "value", string(val))
+		return err
+	}))
+}
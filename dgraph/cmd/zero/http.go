@@ -27,6 +27,7 @@ import (
 
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/x"
+	humanize "github.com/dustin/go-humanize"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/golang/glog"
 )
@@ -127,8 +128,65 @@ func (st *state) removeNode(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(fmt.Sprintf("Removed node with group: %v, idx: %v", groupId, nodeId)))
 }
 
-// moveTablet can be used to move a tablet to a specific group. It takes in tablet and group as
+// createNamespace adds a namespace to the catalog. It takes in the name of the namespace as
+// argument, and returns the namespace created along with its id.
+func (st *state) createNamespace(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	name := r.URL.Query().Get("name")
+	if len(name) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, "name is a mandatory query parameter")
+		return
+	}
+	ns, err := st.zero.createNamespace(context.Background(), name)
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{}
+	if err := m.Marshal(w, ns); err != nil {
+		x.SetStatus(w, x.ErrorNoData, err.Error())
+		return
+	}
+}
+
+// removeNamespace removes a namespace from the catalog. It takes in the id of the namespace as
 // argument.
+func (st *state) removeNamespace(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	id, ok := intFromQueryParam(w, r, "id")
+	if !ok {
+		return
+	}
+	if err := st.zero.removeNamespace(context.Background(), id); err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Write([]byte(fmt.Sprintf("Removed namespace with id: %v", id)))
+}
+
+// moveTablet can be used to move a tablet to a specific group. It takes in tablet and group as
+// argument. With dryrun=true, it only reports the size of the tablet and how long moving it is
+// expected to take.
 func (st *state) moveTablet(w http.ResponseWriter, r *http.Request) {
 	x.AddCorsHeaders(w)
 	if r.Method == "OPTIONS" {
@@ -182,6 +240,25 @@ func (st *state) moveTablet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if pin := st.zero.pin(tablet); pin != nil && pin.GroupId != 0 && pin.GroupId != dstGroup {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			fmt.Sprintf("Tablet: [%s] is pinned to group: [%d]", tablet, pin.GroupId))
+		return
+	}
+
+	if dryrun := r.URL.Query().Get("dryrun"); dryrun == "true" || dryrun == "1" {
+		eta := "no rate limit set"
+		if d := moveDuration(tab.Space); d > 0 {
+			eta = fmt.Sprintf("about %s at %s/s", d.Round(time.Second),
+				humanize.IBytes(opts.moveRate))
+		}
+		w.Write([]byte(fmt.Sprintf("Dry run: predicate: [%s] of size: [%s] would be moved "+
+			"from group: [%d] to [%d], taking %s", tablet, humanize.Bytes(uint64(tab.Space)),
+			srcGroup, dstGroup, eta)))
+		return
+	}
+
 	if err := st.zero.movePredicate(tablet, srcGroup, dstGroup); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		x.SetStatus(w, x.Error, err.Error())
@@ -192,6 +269,71 @@ func (st *state) moveTablet(w http.ResponseWriter, r *http.Request) {
 		tablet, srcGroup, dstGroup)))
 }
 
+// pinTablet keeps a tablet out of the automatic rebalancing. It takes in tablet, and optionally
+// group as argument, in which case the tablet is also placed in, and moved to, that group.
+func (st *state) pinTablet(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	tablet := r.URL.Query().Get("tablet")
+	if len(tablet) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, "tablet is a mandatory query parameter")
+		return
+	}
+	var groupId uint64
+	if len(r.URL.Query().Get("group")) > 0 {
+		var ok bool
+		if groupId, ok = intFromQueryParam(w, r, "group"); !ok {
+			return
+		}
+	}
+
+	if err := st.zero.pinTablet(context.Background(), tablet, uint32(groupId)); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	if groupId == 0 {
+		w.Write([]byte(fmt.Sprintf("Tablet: [%s] excluded from rebalancing", tablet)))
+		return
+	}
+	w.Write([]byte(fmt.Sprintf("Tablet: [%s] pinned to group: [%d]", tablet, groupId)))
+}
+
+// unpinTablet lets a pinned tablet be rebalanced again. It takes in tablet as argument.
+func (st *state) unpinTablet(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	tablet := r.URL.Query().Get("tablet")
+	if len(tablet) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, "tablet is a mandatory query parameter")
+		return
+	}
+	if err := st.zero.unpinTablet(context.Background(), tablet); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Write([]byte(fmt.Sprintf("Tablet: [%s] unpinned", tablet)))
+}
+
 func (st *state) getState(w http.ResponseWriter, r *http.Request) {
 	x.AddCorsHeaders(w)
 	w.Header().Set("Content-Type", "application/json")
@@ -235,10 +235,15 @@ func (o *Oracle) updateCommitStatus(index uint64, src *api.TxnContext) {
 	// TODO: We should check if the tablet is in read-only status here.
 	if o.updateCommitStatusHelper(index, src) {
 		delta := new(pb.OracleDelta)
-		delta.Txns = append(delta.Txns, &pb.TxnStatus{
+		status := &pb.TxnStatus{
 			StartTs:  src.StartTs,
 			CommitTs: o.commitTs(src.StartTs),
-		})
+		}
+		if status.CommitTs > 0 {
+			// Lets the Alphas tell which cached query results the commit makes stale.
+			status.Preds = src.Preds
+		}
+		delta.Txns = append(delta.Txns, status)
 		o.updates <- delta
 	}
 }
@@ -278,6 +283,9 @@ func (s *Server) proposeTxn(ctx context.Context, src *api.TxnContext) error {
 		CommitTs: src.CommitTs,
 		Aborted:  src.Aborted,
 	}
+	if !src.Aborted {
+		zp.Txn.Preds = src.Preds
+	}
 
 	// NOTE: It is important that we continue retrying proposeTxn until we succeed. This should
 	// happen, irrespective of what the user context timeout might be. We check for it before
@@ -317,6 +325,12 @@ func (s *Server) commit(ctx context.Context, src *api.TxnContext) error {
 	if src.Aborted {
 		return s.proposeTxn(ctx, src)
 	}
+	if len(src.Keys) == 0 && len(src.Preds) == 0 {
+		// A read-only transaction has nothing to commit. Not tracking it in the commits keeps
+		// their memory from growing with the reads.
+		span.Annotate(nil, "Read-only transaction")
+		return nil
+	}
 
 	// Use the start timestamp to check if we have a conflict, before we need to assign a commit ts.
 	s.orc.RLock()
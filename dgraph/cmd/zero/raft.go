@@ -155,6 +155,18 @@ func newGroup() *pb.Group {
 	}
 }
 
+// numReplicas returns the number of members of the group which are replicas of it, i.e. all
+// of them but the learners.
+func numReplicas(group *pb.Group) int {
+	var num int
+	for _, m := range group.Members {
+		if !m.Learner {
+			num++
+		}
+	}
+	return num
+}
+
 func (n *node) handleMemberProposal(member *pb.Member) error {
 	n.server.AssertLock()
 	state := n.server.state
@@ -191,7 +203,11 @@ func (n *node) handleMemberProposal(member *pb.Member) error {
 		// else already removed.
 		return nil
 	}
-	if !has && len(group.Members) >= n.server.NumReplicas {
+	if member.Learner {
+		if !has && numReplicas(group) == 0 {
+			return x.Errorf("Learner can only join a group with members: %+v", member)
+		}
+	} else if !has && numReplicas(group) >= n.server.NumReplicas {
 		// We shouldn't allow more members than the number of replicas.
 		return x.Errorf("Group reached replication level. Can't add another member: %+v", member)
 	}
@@ -202,7 +218,7 @@ func (n *node) handleMemberProposal(member *pb.Member) error {
 	group.Members[member.Id] = member
 	// Increment nextGroup when we have enough replicas
 	if member.GroupId == n.server.nextGroup &&
-		len(group.Members) >= n.server.NumReplicas {
+		numReplicas(group) >= n.server.NumReplicas {
 		n.server.nextGroup++
 	}
 	if member.Leader {
@@ -264,6 +280,50 @@ func (n *node) handleTabletProposal(tablet *pb.Tablet) error {
 	return nil
 }
 
+func (n *node) handleNamespaceProposal(ns *pb.Namespace) error {
+	n.server.AssertLock()
+	state := n.server.state
+
+	if ns.Id == x.DefaultNamespace {
+		return x.Errorf("The default namespace can't be changed")
+	}
+	if ns.Remove {
+		glog.Infof("Removing namespace: %d\n", ns.Id)
+		delete(state.Namespaces, ns.Id)
+		return nil
+	}
+	for _, existing := range state.Namespaces {
+		if existing.Id == ns.Id || existing.Name == ns.Name {
+			return x.Errorf("Namespace %q already exists with id %d", existing.Name, existing.Id)
+		}
+	}
+	if state.Namespaces == nil {
+		state.Namespaces = make(map[uint64]*pb.Namespace)
+	}
+	state.Namespaces[ns.Id] = ns
+	return nil
+}
+
+func (n *node) handlePinProposal(pin *pb.TabletPin) error {
+	n.server.AssertLock()
+	state := n.server.state
+
+	if len(pin.Predicate) == 0 {
+		return x.Errorf("Tablet pin has no predicate: %+v", pin)
+	}
+	if pin.Remove {
+		glog.Infof("Unpinning tablet: [%v]\n", pin.Predicate)
+		delete(state.Pins, pin.Predicate)
+		return nil
+	}
+	glog.Infof("Pinning tablet: [%v] to group: [%v]\n", pin.Predicate, pin.GroupId)
+	if state.Pins == nil {
+		state.Pins = make(map[string]*pb.TabletPin)
+	}
+	state.Pins[pin.Predicate] = pin
+	return nil
+}
+
 func (n *node) applyProposal(e raftpb.Entry) (string, error) {
 	var p pb.ZeroProposal
 	// Raft commits empty entry on becoming a leader.
@@ -323,6 +383,20 @@ func (n *node) applyProposal(e raftpb.Entry) (string, error) {
 			return p.Key, err
 		}
 	}
+	if p.Namespace != nil {
+		if err := n.handleNamespaceProposal(p.Namespace); err != nil {
+			span.Annotatef(nil, "While applying namespace proposal: %+v", err)
+			glog.Errorf("While applying namespace proposal: %+v", err)
+			return p.Key, err
+		}
+	}
+	if p.Pin != nil {
+		if err := n.handlePinProposal(p.Pin); err != nil {
+			span.Annotatef(nil, "While applying pin proposal: %+v", err)
+			glog.Errorf("While applying pin proposal: %+v", err)
+			return p.Key, err
+		}
+	}
 
 	if p.MaxLeaseId > state.MaxLeaseId {
 		state.MaxLeaseId = p.MaxLeaseId
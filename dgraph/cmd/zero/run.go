@@ -27,9 +27,7 @@ import (
 	"syscall"
 	"time"
 
-	"go.opencensus.io/exporter/jaeger"
 	"go.opencensus.io/plugin/ocgrpc"
-	otrace "go.opencensus.io/trace"
 	"go.opencensus.io/zpages"
 	"golang.org/x/net/context"
 	"golang.org/x/net/trace"
@@ -53,6 +51,10 @@ type options struct {
 	peer              string
 	w                 string
 	rebalanceInterval time.Duration
+	moveRate          uint64 // bytes per second
+	maxMoves          int
+	deadMemberTimeout time.Duration
+	internalTLSDir    string
 }
 
 var opts options
@@ -86,11 +88,18 @@ instances to achieve high-availability.
 	flag.String("peer", "", "Address of another dgraphzero server.")
 	flag.StringP("wal", "w", "zw", "Directory storing WAL.")
 	flag.Duration("rebalance_interval", 8*time.Minute, "Interval for trying a predicate move.")
+	flag.Int("move_rate_mb", 0,
+		"Rate in MB per second at which predicates are moved between groups. 0 for no limit.")
+	flag.Int("max_concurrent_moves", 1, "Maximum number of predicates moved at the same time.")
+	flag.Duration("dead_member_timeout", 0, "Remove Alphas which haven't been seen for this"+
+		" long from their group, so that new Alphas can replace them. 0 to never remove them.")
 	flag.Bool("telemetry", true, "Send anonymous telemetry data to Dgraph devs.")
+	flag.String("audit_log", "", "The file every admin endpoint call is recorded in, appended"+
+		" to it with a chain of hashes. Empty disables it.")
+	x.RegisterInternalTLSFlags(flag)
 
 	// OpenCensus flags.
-	flag.Float64("trace", 1.0, "The ratio of queries to trace.")
-	flag.String("jaeger.collector", "", "Send opencensus traces to Jaeger.")
+	x.RegisterTracingFlags(flag)
 }
 
 func setupListener(addr string, port int, kind string) (listener net.Listener, err error) {
@@ -106,30 +115,23 @@ type state struct {
 }
 
 func (st *state) serveGRPC(l net.Listener, wg *sync.WaitGroup, store *raftwal.DiskStorage) {
-	if collector := Zero.Conf.GetString("jaeger.collector"); len(collector) > 0 {
-		// Port details: https://www.jaegertracing.io/docs/getting-started/
-		// Default collectorEndpointURI := "http://localhost:14268"
-		je, err := jaeger.NewExporter(jaeger.Options{
-			Endpoint:    collector,
-			ServiceName: "dgraph.zero",
-		})
-		if err != nil {
-			log.Fatalf("Failed to create the Jaeger exporter: %v", err)
-		}
-		// And now finally register it as a Trace Exporter
-		otrace.RegisterExporter(je)
-	}
 	// Exclusively for stats, metrics, etc. Not for tracing.
 	// var views = append(ocgrpc.DefaultServerViews, ocgrpc.DefaultClientViews...)
 	// if err := view.Register(views...); err != nil {
 	// 	glog.Fatalf("Unable to register OpenCensus stats: %v", err)
 	// }
 
-	s := grpc.NewServer(
+	// The connections to the other Zeros and the Alphas use the same certificates.
+	tlsOpts, err := conn.SetupInternalTLS(opts.internalTLSDir)
+	x.Checkf(err, "While setting up internal TLS with the certificates in %s",
+		opts.internalTLSDir)
+	grpcOpts := append([]grpc.ServerOption{
 		grpc.MaxRecvMsgSize(x.GrpcMaxSize),
 		grpc.MaxSendMsgSize(x.GrpcMaxSize),
 		grpc.MaxConcurrentStreams(1000),
-		grpc.StatsHandler(&ocgrpc.ServerHandler{}))
+		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
+	}, tlsOpts...)
+	s := grpc.NewServer(grpcOpts...)
 
 	rc := pb.RaftContext{Id: opts.nodeId, Addr: opts.myAddr, Group: 0}
 	m := conn.NewNode(&rc, store)
@@ -182,12 +184,27 @@ func run() {
 		peer:              Zero.Conf.GetString("peer"),
 		w:                 Zero.Conf.GetString("wal"),
 		rebalanceInterval: Zero.Conf.GetDuration("rebalance_interval"),
+		moveRate:          uint64(Zero.Conf.GetInt("move_rate_mb")) << 20,
+		maxMoves:          Zero.Conf.GetInt("max_concurrent_moves"),
+		deadMemberTimeout: Zero.Conf.GetDuration("dead_member_timeout"),
+		internalTLSDir:    Zero.Conf.GetString("tls_internal_dir"),
 	}
 
 	if opts.numReplicas < 0 || opts.numReplicas%2 == 0 {
 		log.Fatalf("ERROR: Number of replicas must be odd for consensus. Found: %d",
 			opts.numReplicas)
 	}
+	if Zero.Conf.GetInt("move_rate_mb") < 0 {
+		log.Fatalf("ERROR: move_rate_mb can't be negative. Found: %d",
+			Zero.Conf.GetInt("move_rate_mb"))
+	}
+	if opts.deadMemberTimeout < 0 {
+		log.Fatalf("ERROR: dead_member_timeout can't be negative. Found: %v",
+			opts.deadMemberTimeout)
+	}
+	if opts.maxMoves < 1 {
+		log.Fatalf("ERROR: max_concurrent_moves must be at least 1. Found: %d", opts.maxMoves)
+	}
 
 	if Zero.Conf.GetBool("expose_trace") {
 		// TODO: Remove this once we get rid of event logs.
@@ -196,8 +213,11 @@ func run() {
 		}
 	}
 	grpc.EnableTracing = false
-	otrace.ApplyConfig(otrace.Config{
-		DefaultSampler: otrace.ProbabilitySampler(Zero.Conf.GetFloat64("trace"))})
+	if err := x.SetupTracing(Zero.Conf, "dgraph.zero"); err != nil {
+		log.Fatalf("Unable to set up tracing: %v", err)
+	}
+	x.Checkf(x.OpenAuditLog(Zero.Conf.GetString("audit_log"), "zero"),
+		"Unable to open the audit log")
 
 	addr := "localhost"
 	if opts.bindall {
@@ -217,6 +237,7 @@ func run() {
 
 	// Open raft write-ahead log and initialize raft node.
 	x.Checkf(os.MkdirAll(opts.w, 0700), "Error while creating WAL dir.")
+	x.Check(x.CheckFormatVersion(opts.w))
 	kvOpt := badger.LSMOnlyOptions
 	kvOpt.SyncWrites = true
 	kvOpt.Truncate = true
@@ -226,7 +247,8 @@ func run() {
 	kv, err := badger.Open(kvOpt)
 	x.Checkf(err, "Error while opening WAL store")
 	defer kv.Close()
-	store := raftwal.Init(kv, opts.nodeId, 0)
+	store := raftwal.Init(kv, opts.w, opts.nodeId, 0)
+	defer store.Close()
 
 	var wg sync.WaitGroup
 	wg.Add(3)
@@ -236,9 +258,13 @@ func run() {
 	st.serveHTTP(httpListener, &wg)
 
 	http.HandleFunc("/state", st.getState)
-	http.HandleFunc("/removeNode", st.removeNode)
-	http.HandleFunc("/moveTablet", st.moveTablet)
-	http.HandleFunc("/assign", st.assign)
+	http.HandleFunc("/removeNode", x.AuditHandler(st.removeNode))
+	http.HandleFunc("/moveTablet", x.AuditHandler(st.moveTablet))
+	http.HandleFunc("/pinTablet", x.AuditHandler(st.pinTablet))
+	http.HandleFunc("/unpinTablet", x.AuditHandler(st.unpinTablet))
+	http.HandleFunc("/createNamespace", x.AuditHandler(st.createNamespace))
+	http.HandleFunc("/removeNamespace", x.AuditHandler(st.removeNamespace))
+	http.HandleFunc("/assign", x.AuditHandler(st.assign))
 	zpages.Handle(http.DefaultServeMux, "/z")
 
 	// This must be here. It does not work if placed before Grpc init.
@@ -19,6 +19,7 @@ package zero
 import (
 	"fmt"
 	"sort"
+	"sync/atomic"
 	"time"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -62,7 +63,10 @@ func (s *Server) rebalanceTablets() {
 	for {
 		select {
 		case <-ticker.C:
-			predicate, srcGroup, dstGroup := s.chooseTablet()
+			predicate, srcGroup, dstGroup := s.misplacedTablet()
+			if len(predicate) == 0 {
+				predicate, srcGroup, dstGroup = s.chooseTablet()
+			}
 			if len(predicate) == 0 {
 				break
 			}
@@ -73,7 +77,25 @@ func (s *Server) rebalanceTablets() {
 	}
 }
 
+// moveDuration returns how long moving the given number of bytes is expected to take at the rate
+// set by --move_rate_mb, or zero if there's no limit.
+func moveDuration(size int64) time.Duration {
+	if opts.moveRate == 0 {
+		return 0
+	}
+	return time.Duration(float64(size) / float64(opts.moveRate) * float64(time.Second))
+}
+
 func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) error {
+	// Only --max_concurrent_moves predicates are moved at a time, so that the moves don't
+	// saturate the network and the disks of the groups.
+	if n := atomic.AddInt32(&s.numMoves, 1); int(n) > opts.maxMoves {
+		atomic.AddInt32(&s.numMoves, -1)
+		return x.Errorf("Unable to move predicate %v: %d predicate moves already in progress",
+			predicate, opts.maxMoves)
+	}
+	defer atomic.AddInt32(&s.numMoves, -1)
+
 	// Typically move predicate is run only on leader. But, in this case, an external HTTP request
 	// can also trigger a predicate move. We could render them invalid here by checking if this node
 	// is actually the leader. But, I have noticed no side effects with allowing them to run, even
@@ -83,7 +105,9 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 	glog.Infof("Going to move predicate: [%v], size: [%v] from group %d to %d\n", predicate,
 		humanize.Bytes(uint64(tab.Space)), srcGroup, dstGroup)
 
-	ctx, cancel := context.WithTimeout(context.Background(), predicateMoveTimeout)
+	// Give a rate limited move the time it needs on top of the usual timeout.
+	timeout := predicateMoveTimeout + moveDuration(tab.Space)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	done := make(chan struct{}, 1)
 
 	go func(done chan struct{}, cancel context.CancelFunc) {
@@ -158,6 +182,25 @@ func (s *Server) runRecovery() {
 	}
 }
 
+// misplacedTablet returns a tablet pinned to a group other than the one serving it, e.g. because
+// moving it failed when it was pinned, along with the groups to move it from and to.
+func (s *Server) misplacedTablet() (predicate string, srcGroup uint32, dstGroup uint32) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.state == nil || !s.Node.AmLeader() {
+		return
+	}
+	for _, pin := range s.state.Pins {
+		tab := s.servingTablet(pin.Predicate)
+		if pin.GroupId == 0 || tab == nil || tab.GroupId == pin.GroupId ||
+			!s.hasLeader(pin.GroupId) {
+			continue
+		}
+		return pin.Predicate, tab.GroupId, pin.GroupId
+	}
+	return
+}
+
 func (s *Server) chooseTablet() (predicate string, srcGroup uint32, dstGroup uint32) {
 	s.RLock()
 	defer s.RUnlock()
@@ -207,6 +250,10 @@ func (s *Server) chooseTablet() (predicate string, srcGroup uint32, dstGroup uin
 		size := int64(0)
 		group := s.state.Groups[srcGroup]
 		for _, tab := range group.Tablets {
+			// Pinned tablets are only moved by hand.
+			if _, ok := s.state.Pins[tab.Predicate]; ok {
+				continue
+			}
 			// Finds a tablet as big a possible such that on moving it dstGroup's size is
 			// less than or equal to srcGroup.
 			if tab.Space <= size_diff/2 && tab.Space > size {
@@ -278,6 +325,7 @@ func (s *Server) movePredicateHelper(ctx context.Context, predicate string, srcG
 		State:         s.membershipState(),
 		SourceGroupId: srcGroup,
 		DestGroupId:   dstGroup,
+		RateLimit:     opts.moveRate,
 	}
 	if _, err := c.MovePredicate(ctx, in); err != nil {
 		return fmt.Errorf("While calling MovePredicate: %+v\n", err)
@@ -58,6 +58,10 @@ type Server struct {
 	leaderChangeCh chan struct{}
 	shutDownCh     chan struct{} // Used to tell stream to close.
 	connectLock    sync.Mutex    // Used to serialize connect requests from servers.
+	numMoves       int32         // The number of predicate moves in progress.
+
+	seenLock sync.Mutex
+	lastSeen map[uint64]time.Time // Raft ID -> when the Alpha last updated its membership.
 }
 
 func (s *Server) Init() {
@@ -76,6 +80,7 @@ func (s *Server) Init() {
 	s.leaderChangeCh = make(chan struct{}, 1)
 	s.shutDownCh = make(chan struct{}, 1)
 	go s.rebalanceTablets()
+	go s.removeDeadMembers()
 }
 
 func (s *Server) periodicallyPostTelemetry() {
@@ -359,6 +364,172 @@ func (s *Server) removeNode(ctx context.Context, nodeId uint64, groupId uint32)
 	return s.Node.proposeAndWait(ctx, zp)
 }
 
+// createNamespace adds a namespace with the name to the catalog, and returns it.
+func (s *Server) createNamespace(ctx context.Context, name string) (*pb.Namespace, error) {
+	if len(name) == 0 {
+		return nil, x.Errorf("The name of the namespace can't be empty")
+	}
+	s.RLock()
+	ns := &pb.Namespace{Id: x.DefaultNamespace + 1, Name: name}
+	for id := range s.state.Namespaces {
+		if id >= ns.Id {
+			ns.Id = id + 1
+		}
+	}
+	s.RUnlock()
+	return ns, s.Node.proposeAndWait(ctx, &pb.ZeroProposal{Namespace: ns})
+}
+
+// removeNamespace removes the namespace from the catalog. Its data must have been dropped
+// beforehand, it's not reachable anymore once the namespace is removed.
+func (s *Server) removeNamespace(ctx context.Context, id uint64) error {
+	s.RLock()
+	_, ok := s.state.Namespaces[id]
+	s.RUnlock()
+	if !ok {
+		return x.Errorf("No namespace with id %d found", id)
+	}
+	zp := &pb.ZeroProposal{Namespace: &pb.Namespace{Id: id, Remove: true}}
+	return s.Node.proposeAndWait(ctx, zp)
+}
+
+// pinTablet keeps the tablet out of the automatic rebalancing. If gid isn't 0, the tablet is
+// also placed in that group, and moved to it if it's served by another one.
+func (s *Server) pinTablet(ctx context.Context, predicate string, gid uint32) error {
+	if len(predicate) == 0 {
+		return x.Errorf("The tablet to pin can't be empty")
+	}
+	if gid != 0 {
+		var known bool
+		for _, g := range s.KnownGroups() {
+			if g == gid {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return x.Errorf("Group: [%d] is not a known group", gid)
+		}
+	}
+	pin := &pb.TabletPin{Predicate: predicate, GroupId: gid}
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{Pin: pin}); err != nil {
+		return err
+	}
+	if tab := s.ServingTablet(predicate); gid != 0 && tab != nil && tab.GroupId != gid {
+		return s.movePredicate(predicate, tab.GroupId, gid)
+	}
+	return nil
+}
+
+// unpinTablet lets the tablet be rebalanced again.
+func (s *Server) unpinTablet(ctx context.Context, predicate string) error {
+	if s.pin(predicate) == nil {
+		return x.Errorf("Tablet: [%s] isn't pinned", predicate)
+	}
+	pin := &pb.TabletPin{Predicate: predicate, Remove: true}
+	return s.Node.proposeAndWait(ctx, &pb.ZeroProposal{Pin: pin})
+}
+
+// pin returns the pin of the tablet, or nil if it isn't pinned.
+func (s *Server) pin(predicate string) *pb.TabletPin {
+	s.RLock()
+	defer s.RUnlock()
+	if s.state == nil {
+		return nil
+	}
+	return s.state.Pins[predicate]
+}
+
+// pinnedGroup returns the group the tablet is pinned to, or 0 if it isn't pinned to a group or
+// the group has no leader to serve it.
+func (s *Server) pinnedGroup(predicate string) uint32 {
+	s.RLock()
+	defer s.RUnlock()
+	if s.state == nil {
+		return 0
+	}
+	if pin := s.state.Pins[predicate]; pin != nil && s.hasLeader(pin.GroupId) {
+		return pin.GroupId
+	}
+	return 0
+}
+
+// markSeen records that the Alpha with the Raft ID is alive.
+func (s *Server) markSeen(id uint64) {
+	s.seenLock.Lock()
+	defer s.seenLock.Unlock()
+	if s.lastSeen == nil {
+		s.lastSeen = make(map[uint64]time.Time)
+	}
+	s.lastSeen[id] = time.Now()
+}
+
+// deadMembers returns the Alphas which haven't updated their membership for longer than the
+// timeout. A member is only found dead if another member of its group is alive, which can then
+// remove it from the Raft config of the group.
+func (s *Server) deadMembers(timeout time.Duration) []*pb.Member {
+	s.RLock()
+	defer s.RUnlock()
+	s.seenLock.Lock()
+	defer s.seenLock.Unlock()
+	if s.lastSeen == nil {
+		s.lastSeen = make(map[uint64]time.Time)
+	}
+
+	var dead []*pb.Member
+	for _, group := range s.state.Groups {
+		var alive bool
+		var suspects []*pb.Member
+		for id, m := range group.Members {
+			seen, ok := s.lastSeen[id]
+			if !ok {
+				// Give the members the full timeout, e.g. after this Zero became the leader.
+				s.lastSeen[id] = time.Now()
+				seen = s.lastSeen[id]
+			}
+			if time.Since(seen) > timeout {
+				suspects = append(suspects, m)
+			} else {
+				alive = true
+			}
+		}
+		if alive {
+			dead = append(dead, suspects...)
+		}
+	}
+	return dead
+}
+
+// removeDeadMembers removes the Alphas found dead after --dead_member_timeout, so that the
+// Alphas joining later take their place in the group.
+func (s *Server) removeDeadMembers() {
+	if opts.deadMemberTimeout == 0 {
+		return
+	}
+	ticker := time.NewTicker(opts.deadMemberTimeout / 4)
+	defer ticker.Stop()
+	for range ticker.C {
+		if !s.Node.AmLeader() {
+			// The next leader keeps track of the members from scratch.
+			s.seenLock.Lock()
+			s.lastSeen = nil
+			s.seenLock.Unlock()
+			continue
+		}
+		for _, m := range s.deadMembers(opts.deadMemberTimeout) {
+			glog.Warningf("Removing member %d of group %d at %s, not seen for over %v",
+				m.Id, m.GroupId, m.Addr, opts.deadMemberTimeout)
+			if err := s.removeNode(context.Background(), m.Id, m.GroupId); err != nil {
+				glog.Errorf("While removing dead member %d: %v", m.Id, err)
+				continue
+			}
+			s.seenLock.Lock()
+			delete(s.lastSeen, m.Id)
+			s.seenLock.Unlock()
+		}
+	}
+}
+
 // Connect is used to connect the very first time with group zero.
 func (s *Server) Connect(ctx context.Context,
 	m *pb.Member) (resp *pb.ConnectionState, err error) {
@@ -388,6 +559,14 @@ func (s *Server) Connect(ctx context.Context,
 	if len(m.Addr) == 0 {
 		return &emptyConnectionState, x.Errorf("NO_ADDR: No address provided: %+v", m)
 	}
+	if m.Learner {
+		// A learner can't start a group, it needs members to replicate the group from.
+		if group, has := ms.Groups[m.GroupId]; !has || numReplicas(group) == 0 {
+			return &emptyConnectionState, x.Errorf(
+				"LEARNER_NO_GROUP: Group %d has no members for the learner to join: %+v",
+				m.GroupId, m)
+		}
+	}
 
 	for _, member := range ms.Removed {
 		// It is not recommended to reuse RAFT ids.
@@ -444,6 +623,12 @@ func (s *Server) Connect(ctx context.Context,
 			proposal.MaxRaftId = m.Id
 		}
 
+		// Learners join the group they ask for, without counting as one of its replicas.
+		if m.Learner {
+			proposal.Member = m
+			return proposal
+		}
+
 		// We don't have this member. So, let's see if it has preference for a group.
 		if m.GroupId > 0 {
 			group, has := s.state.Groups[m.GroupId]
@@ -459,21 +644,28 @@ func (s *Server) Connect(ctx context.Context,
 			}
 
 			// We don't have this server in the list.
-			if len(group.Members) < s.NumReplicas {
+			if numReplicas(group) < s.NumReplicas {
 				// We need more servers here, so let's add it.
 				proposal.Member = m
 				return proposal
 			}
 			// Already have plenty of servers serving this group.
 		}
-		// Let's assign this server to a new group.
+		// Let's assign this server to the group short of the most replicas, e.g. after one of
+		// its members died.
+		var target uint32
+		var fewest int
 		for gid, group := range s.state.Groups {
-			if len(group.Members) < s.NumReplicas {
-				m.GroupId = gid
-				proposal.Member = m
-				return proposal
+			n := numReplicas(group)
+			if n < s.NumReplicas && (target == 0 || n < fewest) {
+				target, fewest = gid, n
 			}
 		}
+		if target != 0 {
+			m.GroupId = target
+			proposal.Member = m
+			return proposal
+		}
 		// We either don't have any groups, or don't have any groups which need another member.
 		m.GroupId = s.nextGroup
 		// We shouldn't increase nextGroup here as we don't know whether we have enough
@@ -488,6 +680,7 @@ func (s *Server) Connect(ctx context.Context,
 			return &emptyConnectionState, err
 		}
 	}
+	s.markSeen(m.Id)
 	resp = &pb.ConnectionState{
 		State:  s.membershipState(),
 		Member: m,
@@ -517,7 +710,10 @@ func (s *Server) ShouldServe(
 		return tab, nil
 	}
 
-	// Set the tablet to be served by this server's group.
+	// Set the tablet to be served by this server's group, or by the group it's pinned to.
+	if gid := s.pinnedGroup(tablet.Predicate); gid != 0 {
+		tablet.GroupId = gid
+	}
 	var proposal pb.ZeroProposal
 	// Multiple Groups might be assigned to same tablet, so during proposal we will check again.
 	tablet.Force = false
@@ -533,6 +729,9 @@ func (s *Server) ShouldServe(
 }
 
 func (s *Server) UpdateMembership(ctx context.Context, group *pb.Group) (*api.Payload, error) {
+	for id := range group.Members {
+		s.markSeen(id)
+	}
 	proposals, err := s.createProposals(group)
 	if err != nil {
 		// Sleep here so the caller doesn't keep on retrying indefinitely, creating a busy
@@ -19,6 +19,7 @@ package zero
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/stretchr/testify/require"
@@ -37,3 +38,60 @@ func TestRemoveNode(t *testing.T) {
 	err = server.removeNode(nil, 1, 2)
 	require.Error(t, err)
 }
+
+func TestNumReplicas(t *testing.T) {
+	group := &pb.Group{Members: map[uint64]*pb.Member{
+		1: {Id: 1},
+		2: {Id: 2},
+		3: {Id: 3, Learner: true},
+	}}
+	require.Equal(t, 2, numReplicas(group))
+	require.Equal(t, 0, numReplicas(newGroup()))
+}
+
+func TestPinnedGroup(t *testing.T) {
+	server := &Server{
+		state: &pb.MembershipState{
+			Groups: map[uint32]*pb.Group{
+				1: {Members: map[uint64]*pb.Member{1: {Id: 1, Leader: true}}},
+				2: {Members: map[uint64]*pb.Member{2: {Id: 2}}},
+			},
+			Pins: map[string]*pb.TabletPin{
+				"name":   {Predicate: "name", GroupId: 1},
+				"friend": {Predicate: "friend", GroupId: 2},
+				"age":    {Predicate: "age"},
+			},
+		},
+	}
+	require.Equal(t, uint32(1), server.pinnedGroup("name"))
+	// Group 2 has no leader to serve the tablet.
+	require.Equal(t, uint32(0), server.pinnedGroup("friend"))
+	// Only excluded from rebalancing.
+	require.Equal(t, uint32(0), server.pinnedGroup("age"))
+	require.Equal(t, uint32(0), server.pinnedGroup("email"))
+	require.NotNil(t, server.pin("age"))
+	require.Nil(t, server.pin("email"))
+}
+
+func TestDeadMembers(t *testing.T) {
+	server := &Server{
+		state: &pb.MembershipState{
+			Groups: map[uint32]*pb.Group{
+				1: {Members: map[uint64]*pb.Member{1: {Id: 1}, 2: {Id: 2}, 3: {Id: 3}}},
+				2: {Members: map[uint64]*pb.Member{4: {Id: 4}, 5: {Id: 5}}},
+			},
+		},
+	}
+	// Members not seen yet are given the full timeout.
+	require.Empty(t, server.deadMembers(time.Minute))
+
+	past := time.Now().Add(-time.Hour)
+	server.lastSeen[2] = past
+	server.lastSeen[4] = past
+	server.lastSeen[5] = past
+	server.markSeen(1)
+	dead := server.deadMembers(time.Minute)
+	// The members of group 2 aren't removed, as none of them is alive.
+	require.Len(t, dead, 1)
+	require.Equal(t, uint64(2), dead[0].Id)
+}
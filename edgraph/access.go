@@ -22,6 +22,7 @@ import (
 	"context"
 
 	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
 )
@@ -30,5 +31,35 @@ func (s *Server) Login(ctx context.Context,
 	request *api.LoginRequest) (*api.Response, error) {
 
 	glog.Warningf("Login failed: %s", x.ErrNotSupported)
+	auditLogin(ctx, request.GetUserid(), x.ErrNotSupported)
 	return &api.Response{}, x.ErrNotSupported
 }
+
+// requestUser returns an empty string, the users are an enterprise feature.
+func requestUser(ctx context.Context) string {
+	return ""
+}
+
+// requestUserNamespace returns no user, the users are an enterprise feature.
+func requestUserNamespace(ctx context.Context) (string, uint64) {
+	return "", 0
+}
+
+// RefreshAcls does nothing, the acls are an enterprise feature.
+func RefreshAcls(closeCh <-chan struct{}) {}
+
+func authorizeQuery(ctx context.Context, parsedReq *gql.Result) error {
+	return nil
+}
+
+func filterSchema(ctx context.Context, nodes []*pb.SchemaNode) ([]*pb.SchemaNode, error) {
+	return nodes, nil
+}
+
+func authorizeMutation(ctx context.Context, gmu *gql.Mutation) error {
+	return nil
+}
+
+func authorizeAlter(ctx context.Context, op *api.Operation) error {
+	return nil
+}
@@ -16,23 +16,37 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/dgrijalva/jwt-go"
 	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	otrace "go.opencensus.io/trace"
 )
 
 func (s *Server) Login(ctx context.Context,
-	request *api.LoginRequest) (*api.Response, error) {
+	request *api.LoginRequest) (resp *api.Response, err error) {
 	ctx, span := otrace.StartSpan(ctx, "server.Login")
 	defer span.End()
 
+	userId := request.GetUserid()
+	defer func() { auditLogin(ctx, userId, err) }()
+
 	// record the client ip for this login request
 	var addr string
 	if ip, ok := peer.FromContext(ctx); ok {
@@ -43,22 +57,28 @@ func (s *Server) Login(ctx context.Context,
 		}, "client ip for login")
 	}
 
-	user, err := s.authenticate(ctx, request)
+	// the users and their groups are kept in the namespace they log into
+	ns, err := requestNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.authenticate(ctx, request, ns)
 	if err != nil {
 		errMsg := fmt.Sprintf("authentication from address %s failed: %v", addr, err)
 		glog.Errorf(errMsg)
 		return nil, fmt.Errorf(errMsg)
 	}
+	userId = user.UserID
 
-	resp := &api.Response{}
-	accessJwt, err := getAccessJwt(request.Userid, user.Groups)
+	resp = &api.Response{}
+	accessJwt, err := getAccessJwt(request.Userid, user.Groups, ns)
 	if err != nil {
 		errMsg := fmt.Sprintf("unable to get access jwt (userid=%s,addr=%s):%v",
 			request.Userid, addr, err)
 		glog.Errorf(errMsg)
 		return nil, fmt.Errorf(errMsg)
 	}
-	refreshJwt, err := getRefreshJwt(request.Userid)
+	refreshJwt, err := getRefreshJwt(request.Userid, ns)
 	if err != nil {
 		errMsg := fmt.Sprintf("unable to get refresh jwt (userid=%s,addr=%s):%v",
 			request.Userid, addr, err)
@@ -82,18 +102,22 @@ func (s *Server) Login(ctx context.Context,
 	return resp, nil
 }
 
-func (s *Server) authenticate(ctx context.Context, request *api.LoginRequest) (*acl.User, error) {
+func (s *Server) authenticate(ctx context.Context, request *api.LoginRequest,
+	ns uint64) (*acl.User, error) {
 	if err := validateLoginRequest(request); err != nil {
 		return nil, fmt.Errorf("invalid login request: %v", err)
 	}
 
 	var user *acl.User
 	if len(request.RefreshToken) > 0 {
-		userId, err := authenticateRefreshToken(request.RefreshToken)
+		userId, tokenNs, err := authenticateRefreshToken(request.RefreshToken)
 		if err != nil {
 			return nil, fmt.Errorf("unable to authenticate the refresh token %v: %v",
 				request.RefreshToken, err)
 		}
+		if tokenNs != ns {
+			return nil, fmt.Errorf("the refresh token was issued for namespace %d", tokenNs)
+		}
 
 		user, err = s.queryUser(ctx, userId, "")
 		if err != nil {
@@ -123,8 +147,41 @@ func (s *Server) authenticate(ctx context.Context, request *api.LoginRequest) (*
 	return user, nil
 }
 
-func authenticateRefreshToken(refreshToken string) (string, error) {
-	token, err := jwt.Parse(refreshToken, func(token *jwt.Token) (interface{}, error) {
+func authenticateRefreshToken(refreshToken string) (string, uint64, error) {
+	claims, err := validateToken(refreshToken)
+	if err != nil {
+		return "", 0, fmt.Errorf("unable to validate the refresh token:%v", err)
+	}
+
+	userId, ok := claims["userid"].(string)
+	if !ok {
+		return "", 0, fmt.Errorf("userid in claims is not a string:%v", userId)
+	}
+	ns, err := claimsNamespace(claims)
+	if err != nil {
+		return "", 0, err
+	}
+	return userId, ns, nil
+}
+
+// claimsNamespace returns the namespace the jwt was issued for. The jwts issued before
+// namespaces existed are for the default one.
+func claimsNamespace(claims jwt.MapClaims) (uint64, error) {
+	rawNs, ok := claims["namespace"]
+	if !ok {
+		return x.DefaultNamespace, nil
+	}
+	nsStr, ok := rawNs.(string)
+	if !ok {
+		return 0, fmt.Errorf("namespace in claims is not a string:%v", rawNs)
+	}
+	return strconv.ParseUint(nsStr, 10, 64)
+}
+
+// validateToken parses the jwt and returns its claims, if it's been signed by us and hasn't
+// expired.
+func validateToken(jwtStr string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(jwtStr, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
@@ -132,26 +189,21 @@ func authenticateRefreshToken(refreshToken string) (string, error) {
 	})
 
 	if err != nil {
-		return "", fmt.Errorf("unable to parse refresh token:%v", err)
+		return nil, fmt.Errorf("unable to parse jwt token:%v", err)
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok || !token.Valid {
-		return "", fmt.Errorf("claims in refresh token is not map claims:%v", refreshToken)
+		return nil, fmt.Errorf("claims in jwt token is not map claims:%v", jwtStr)
 	}
 
 	// by default, the MapClaims.Valid will return true if the exp field is not set
-	// here we enforce the checking to make sure that the refresh token has not expired
+	// here we enforce the checking to make sure that the token has not expired
 	now := time.Now().Unix()
 	if !claims.VerifyExpiresAt(now, true) {
-		return "", fmt.Errorf("refresh token has expired: %v", refreshToken)
-	}
-
-	userId, ok := claims["userid"].(string)
-	if !ok {
-		return "", fmt.Errorf("userid in claims is not a string:%v", userId)
+		return nil, fmt.Errorf("jwt token has expired: %v", jwtStr)
 	}
-	return userId, nil
+	return claims, nil
 }
 
 func validateLoginRequest(request *api.LoginRequest) error {
@@ -173,10 +225,11 @@ func validateLoginRequest(request *api.LoginRequest) error {
 	return nil
 }
 
-func getAccessJwt(userId string, groups []acl.Group) (string, error) {
+func getAccessJwt(userId string, groups []acl.Group, ns uint64) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"userid": userId,
-		"groups": acl.GetGroupIDs(groups),
+		"userid":    userId,
+		"groups":    acl.GetGroupIDs(groups),
+		"namespace": strconv.FormatUint(ns, 10),
 		// set the jwt exp according to the ttl
 		"exp": json.Number(
 			strconv.FormatInt(time.Now().Add(Config.AccessJwtTtl).Unix(), 10)),
@@ -189,9 +242,10 @@ func getAccessJwt(userId string, groups []acl.Group) (string, error) {
 	return jwtString, nil
 }
 
-func getRefreshJwt(userId string) (string, error) {
+func getRefreshJwt(userId string, ns uint64) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"userid": userId,
+		"userid":    userId,
+		"namespace": strconv.FormatUint(ns, 10),
 		// set the jwt exp according to the ttl
 		"exp": json.Number(
 			strconv.FormatInt(time.Now().Add(Config.RefreshJwtTtl).Unix(), 10)),
@@ -227,7 +281,7 @@ func (s *Server) queryUser(ctx context.Context, userid string, password string)
 		Vars:  queryVars,
 	}
 
-	queryResp, err := s.Query(ctx, &queryRequest)
+	queryResp, err := s.doQuery(ctx, &queryRequest, false)
 	if err != nil {
 		glog.Errorf("Error while query user with id %s: %v", userid, err)
 		return nil, err
@@ -238,3 +292,395 @@ func (s *Server) queryUser(ctx context.Context, userid string, password string)
 	}
 	return user, nil
 }
+
+// aclCache holds the permissions of every group, keyed by the namespace of the group, the
+// group and then by the predicate. It's refreshed by RefreshAcls, which is how changes to the
+// acls made through any alpha reach all the others.
+var aclCache = struct {
+	sync.RWMutex
+	perms map[uint64]map[string]map[string]int32
+}{perms: make(map[uint64]map[string]map[string]int32)}
+
+const queryAcls = `
+{
+  allAcls(func: has(dgraph.group.acl)) {
+    dgraph.xid
+    dgraph.group.acl
+  }
+}`
+
+// RefreshAcls reloads the acl cache every Config.AclRefreshInterval, until closeCh is closed.
+// It returns right away if the acls aren't enforced.
+func RefreshAcls(closeCh <-chan struct{}) {
+	if len(Config.HmacSecret) == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(Config.AclRefreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := retrieveAcls(); err != nil {
+			glog.Errorf("Error while retrieving the acls: %v", err)
+		}
+		select {
+		case <-closeCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// retrieveAcls reads the acls of the groups of every namespace.
+func retrieveAcls() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	groups := make(map[uint64][]acl.Group)
+	for _, ns := range worker.Namespaces() {
+		queryRequest := api.Request{
+			Query:    queryAcls,
+			ReadOnly: true,
+		}
+		nsCtx := metadata.NewIncomingContext(ctx,
+			metadata.Pairs("namespace", strconv.FormatUint(ns, 10)))
+		queryResp, err := (&Server{}).doQuery(nsCtx, &queryRequest, false)
+		if err != nil {
+			return err
+		}
+		m := make(map[string][]acl.Group)
+		if err := json.Unmarshal(queryResp.GetJson(), &m); err != nil {
+			return fmt.Errorf("unable to unmarshal the acls: %v", err)
+		}
+		groups[ns] = m["allAcls"]
+	}
+	storeAcls(groups)
+	return nil
+}
+
+// storeAcls replaces the content of the acl cache with the acls of the groups of every
+// namespace.
+func storeAcls(groups map[uint64][]acl.Group) {
+	perms := make(map[uint64]map[string]map[string]int32)
+	for ns, nsGroups := range groups {
+		perms[ns] = make(map[string]map[string]int32)
+		for _, group := range nsGroups {
+			acls, err := acl.UnmarshalAcls(group.Acls)
+			if err != nil {
+				glog.Errorf("Skipping the acls of group %v: %v", group.GroupID, err)
+				continue
+			}
+			predPerms := make(map[string]int32)
+			for _, entry := range acls {
+				predPerms[entry.Predicate] = entry.Perm
+			}
+			perms[ns][group.GroupID] = predPerms
+		}
+	}
+
+	aclCache.Lock()
+	defer aclCache.Unlock()
+	aclCache.perms = perms
+}
+
+var (
+	errNoJwt = fmt.Errorf("no accessJwt available, please log in first")
+	// expand() and deleting all the predicates of a node would go through predicates the user
+	// isn't granted, so they're left to the requests carrying the auth token.
+	errExpandNotAllowed  = fmt.Errorf("expand() is not allowed when access control is enabled")
+	errDelStarNotAllowed = fmt.Errorf("deleting all the predicates of a node is not allowed" +
+		" when access control is enabled")
+	errDropAllNotAllowed = fmt.Errorf("dropping all the data needs the auth token" +
+		" when access control is enabled")
+)
+
+// hasAuthToken tells if the request carries the auth token the alpha was started with. Such
+// requests aren't subject to the acls, they're how the users and groups get managed.
+func hasAuthToken(ctx context.Context) bool {
+	if len(Config.AuthToken) == 0 {
+		return false
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	tokens := md.Get("auth-token")
+	return len(tokens) > 0 && tokens[0] == Config.AuthToken
+}
+
+// requestUser returns the id of the user who sent the request, read from the access jwt in its
+// metadata, or an empty string if there's no valid one.
+func requestUser(ctx context.Context) string {
+	userId, _ := requestUserNamespace(ctx)
+	return userId
+}
+
+// requestUserNamespace returns the id of the user who sent the request along with the
+// namespace the jwt was issued for, read from the access jwt in its metadata. The id is an empty
+// string if there's no valid jwt.
+func requestUserNamespace(ctx context.Context) (string, uint64) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", 0
+	}
+	accessJwt := md.Get("accessJwt")
+	if len(accessJwt) == 0 || len(accessJwt[0]) == 0 {
+		return "", 0
+	}
+	claims, err := validateToken(accessJwt[0])
+	if err != nil {
+		return "", 0
+	}
+	userId, _ := claims["userid"].(string)
+	ns, err := claimsNamespace(claims)
+	if err != nil {
+		return "", 0
+	}
+	return userId, ns
+}
+
+// userGroups returns the namespace and the groups of the user who sent the request, read from
+// the access jwt in its metadata.
+func userGroups(ctx context.Context) (uint64, []string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil, errNoJwt
+	}
+	accessJwt := md.Get("accessJwt")
+	if len(accessJwt) == 0 || len(accessJwt[0]) == 0 {
+		return 0, nil, errNoJwt
+	}
+	claims, err := validateToken(accessJwt[0])
+	if err != nil {
+		return 0, nil, err
+	}
+	ns, err := claimsNamespace(claims)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// the groups are missing from the claims if the user doesn't belong to any
+	rawGroups, _ := claims["groups"].([]interface{})
+	groups := make([]string, 0, len(rawGroups))
+	for _, rawGroup := range rawGroups {
+		group, ok := rawGroup.(string)
+		if !ok {
+			return 0, nil, fmt.Errorf("group in claims is not a string:%v", rawGroup)
+		}
+		groups = append(groups, group)
+	}
+	return ns, groups, nil
+}
+
+// isAllowed tells if any of the groups of the namespace has been granted the permission on
+// the predicate.
+func isAllowed(ns uint64, groups []string, pred string, perm int32) bool {
+	aclCache.RLock()
+	defer aclCache.RUnlock()
+	for _, group := range groups {
+		if aclCache.perms[ns][group][pred]&perm != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func permName(perm int32) string {
+	switch perm {
+	case acl.Read:
+		return "read"
+	case acl.Write:
+		return "write"
+	default:
+		return "modify"
+	}
+}
+
+// authorize checks that the user who sent the request has been granted the permission on
+// every one of the predicates, in the namespace the request runs in.
+func authorize(ctx context.Context, preds []string, perm int32) error {
+	userNs, groups, err := userGroups(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	ns, err := requestNamespace(ctx)
+	if err != nil {
+		return err
+	}
+	if ns != userNs {
+		return status.Errorf(codes.PermissionDenied,
+			"the access jwt was issued for namespace %d", userNs)
+	}
+	for _, pred := range preds {
+		if !isAllowed(ns, groups, pred, perm) {
+			return status.Errorf(codes.PermissionDenied,
+				"unauthorized to %s the predicate %s", permName(perm), pred)
+		}
+	}
+	return nil
+}
+
+// aclEnforced tells if the request has to go through the acls.
+func aclEnforced(ctx context.Context) bool {
+	return len(Config.HmacSecret) > 0 && !hasAuthToken(ctx)
+}
+
+// authorizeQuery checks that the user can read all the predicates the query uses, along with
+// the predicates the schema query lists. The schema of the other predicates a schema query
+// returns is filtered by filterSchema.
+func authorizeQuery(ctx context.Context, parsedReq *gql.Result) error {
+	if !aclEnforced(ctx) {
+		return nil
+	}
+	preds, err := predsFromQuery(parsedReq.Query)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if parsedReq.Schema != nil {
+		preds = append(preds, parsedReq.Schema.Predicates...)
+	}
+	return authorize(ctx, preds, acl.Read)
+}
+
+// filterSchema drops the schema of the predicates the user can't read, e.g. the ones matched by
+// pred_pattern or returned by a schema query for all the predicates. The names of the predicates
+// are the ones of the namespace of the user.
+func filterSchema(ctx context.Context, nodes []*pb.SchemaNode) ([]*pb.SchemaNode, error) {
+	if !aclEnforced(ctx) {
+		return nodes, nil
+	}
+	ns, groups, err := userGroups(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	out := nodes[:0]
+	for _, node := range nodes {
+		if isAllowed(ns, groups, node.Predicate, acl.Read) {
+			out = append(out, node)
+		}
+	}
+	return out, nil
+}
+
+// authorizeMutation checks that the user can write all the predicates the mutation sets or
+// deletes.
+func authorizeMutation(ctx context.Context, gmu *gql.Mutation) error {
+	if !aclEnforced(ctx) {
+		return nil
+	}
+	preds, err := predsFromMutation(gmu)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return authorize(ctx, preds, acl.Write)
+}
+
+// authorizeAlter checks that the user can modify the schema of the predicates the operation
+// changes or drops.
+func authorizeAlter(ctx context.Context, op *api.Operation) error {
+	if !aclEnforced(ctx) {
+		return nil
+	}
+	if op.DropAll || op.DropOp == api.Operation_DATA {
+		return status.Error(codes.PermissionDenied, errDropAllNotAllowed.Error())
+	}
+
+	var preds []string
+	if len(op.DropAttr) > 0 {
+		preds = append(preds, op.DropAttr)
+	}
+	updates, err := schema.Parse(op.Schema)
+	if err != nil {
+		return err
+	}
+	for _, update := range updates {
+		preds = append(preds, update.Predicate)
+	}
+	return authorize(ctx, preds, acl.Modify)
+}
+
+// predsFromQuery returns the predicates read by the query blocks, including the ones only
+// used in functions, filters, sorting and grouping.
+func predsFromQuery(gqs []*gql.GraphQuery) ([]string, error) {
+	predsMap := make(map[string]struct{})
+	addPred := func(attr string) {
+		// reading the reverse edges of a predicate needs the permission on the predicate
+		attr = strings.TrimPrefix(attr, "~")
+		if len(attr) == 0 || attr == "uid" || attr == "_predicate_" ||
+			strings.HasPrefix(attr, "val(") {
+			return
+		}
+		predsMap[attr] = struct{}{}
+	}
+	addFunc := func(fn *gql.Function) {
+		if fn != nil && !fn.IsValueVar {
+			addPred(fn.Attr)
+		}
+	}
+	var addFilter func(ft *gql.FilterTree)
+	addFilter = func(ft *gql.FilterTree) {
+		if ft == nil {
+			return
+		}
+		addFunc(ft.Func)
+		for _, child := range ft.Child {
+			addFilter(child)
+		}
+	}
+
+	var visit func(gq *gql.GraphQuery) error
+	visit = func(gq *gql.GraphQuery) error {
+		if len(gq.Expand) > 0 {
+			return errExpandNotAllowed
+		}
+		if !gq.IsInternal {
+			addPred(gq.Attr)
+		}
+		addFunc(gq.Func)
+		addFilter(gq.Filter)
+		for _, order := range gq.Order {
+			addPred(order.Attr)
+		}
+		for _, groupBy := range gq.GroupbyAttrs {
+			addPred(groupBy.Attr)
+		}
+		for _, child := range gq.Children {
+			if err := visit(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, gq := range gqs {
+		if err := visit(gq); err != nil {
+			return nil, err
+		}
+	}
+
+	preds := make([]string, 0, len(predsMap))
+	for pred := range predsMap {
+		preds = append(preds, pred)
+	}
+	sort.Strings(preds)
+	return preds, nil
+}
+
+// predsFromMutation returns the predicates set or deleted by the mutation.
+func predsFromMutation(gmu *gql.Mutation) ([]string, error) {
+	predsMap := make(map[string]struct{})
+	for _, nq := range gmu.Set {
+		predsMap[nq.Predicate] = struct{}{}
+	}
+	for _, nq := range gmu.Del {
+		if nq.Predicate == x.Star {
+			return nil, errDelStarNotAllowed
+		}
+		predsMap[nq.Predicate] = struct{}{}
+	}
+
+	preds := make([]string, 0, len(predsMap))
+	for pred := range predsMap {
+		preds = append(preds, pred)
+	}
+	sort.Strings(preds)
+	return preds, nil
+}
@@ -0,0 +1,174 @@
+// +build !oss
+
+/*
+ * Copyright 2018 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestPredsFromQuery(t *testing.T) {
+	res, err := gql.Parse(gql.Request{Str: `
+	{
+		me(func: eq(name, "alice"), orderasc: age) @filter(has(~friend) or uid_in(boss, 0x1)) {
+			uid
+			friend @filter(ge(count(follows), 2)) {
+				nickname
+			}
+			count(pets)
+		}
+		stats(func: has(city)) @groupby(city) {
+			count(uid)
+		}
+	}`})
+	require.NoError(t, err)
+	preds, err := predsFromQuery(res.Query)
+	require.NoError(t, err)
+	require.Equal(t, []string{"age", "boss", "city", "follows", "friend", "name", "nickname",
+		"pets"}, preds)
+
+	res, err = gql.Parse(gql.Request{Str: `{ me(func: uid(0x1)) { expand(_all_) } }`})
+	require.NoError(t, err)
+	_, err = predsFromQuery(res.Query)
+	require.Equal(t, errExpandNotAllowed, err)
+}
+
+func TestPredsFromMutation(t *testing.T) {
+	mu, err := gql.ParseMutation(`{
+		set {
+			_:a <name> "alice" .
+			_:a <friend> _:b .
+		}
+		delete {
+			<0x1> <name> * .
+		}
+	}`)
+	require.NoError(t, err)
+	gmu, err := parseMutationObject(mu)
+	require.NoError(t, err)
+	preds, err := predsFromMutation(gmu)
+	require.NoError(t, err)
+	require.Equal(t, []string{"friend", "name"}, preds)
+
+	mu, err = gql.ParseMutation(`{ delete { <0x1> * * . } }`)
+	require.NoError(t, err)
+	gmu, err = parseMutationObject(mu)
+	require.NoError(t, err)
+	_, err = predsFromMutation(gmu)
+	require.Equal(t, errDelStarNotAllowed, err)
+}
+
+func TestAuthorize(t *testing.T) {
+	Config.HmacSecret = []byte("secret")
+	Config.AccessJwtTtl = time.Minute
+	defer func() { Config.HmacSecret = nil }()
+
+	storeAcls(map[uint64][]acl.Group{
+		0: {
+			{GroupID: "dev", Acls: `[{"predicate":"name","perm":4},{"predicate":"age","perm":6}]`},
+			{GroupID: "ops", Acls: `[{"predicate":"name","perm":1}]`},
+		},
+		2: {{GroupID: "dev", Acls: `[{"predicate":"city","perm":7}]`}},
+	})
+	defer storeAcls(nil)
+
+	accessJwt, err := getAccessJwt("alice", []acl.Group{{GroupID: "dev"}}, 0)
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("accessJwt", accessJwt))
+
+	require.NoError(t, authorize(ctx, []string{"name", "age"}, acl.Read))
+	require.NoError(t, authorize(ctx, []string{"age"}, acl.Write))
+	require.Error(t, authorize(ctx, []string{"name"}, acl.Write))
+	require.Error(t, authorize(ctx, []string{"name"}, acl.Modify))
+	require.Error(t, authorize(ctx, []string{"city"}, acl.Read))
+
+	// the schema can only be asked for the predicates the user can read, and only theirs is
+	// returned otherwise
+	schemaReq := func(preds ...string) *gql.Result {
+		return &gql.Result{Schema: &pb.SchemaRequest{Predicates: preds}}
+	}
+	require.NoError(t, authorizeQuery(ctx, schemaReq("name", "age")))
+	require.Error(t, authorizeQuery(ctx, schemaReq("name", "city")))
+	nodes, err := filterSchema(ctx, []*pb.SchemaNode{
+		{Predicate: "age"}, {Predicate: "city"}, {Predicate: "name"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*pb.SchemaNode{{Predicate: "age"}, {Predicate: "name"}}, nodes)
+
+	// the groups of another namespace don't grant anything in this one
+	accessJwt, err = getAccessJwt("alice", []acl.Group{{GroupID: "dev"}}, 2)
+	require.NoError(t, err)
+	ctx = metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("accessJwt", accessJwt))
+	require.Error(t, authorize(ctx, []string{"city"}, acl.Read))
+	require.True(t, isAllowed(2, []string{"dev"}, "city", acl.Modify))
+	require.False(t, isAllowed(2, []string{"dev"}, "name", acl.Read))
+
+	// the request needs to carry a valid access jwt
+	require.Error(t, authorize(context.Background(), []string{"name"}, acl.Read))
+	refreshJwt, err := getRefreshJwt("alice", 0)
+	require.NoError(t, err)
+	ctx = metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("accessJwt", refreshJwt+"x"))
+	require.Error(t, authorize(ctx, []string{"name"}, acl.Read))
+
+	// unless it carries the auth token
+	Config.AuthToken = "token"
+	defer func() { Config.AuthToken = "" }()
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("auth-token", "token"))
+	require.NoError(t, authorizeAlter(ctx, &api.Operation{DropAll: true}))
+	require.Error(t, authorizeAlter(context.Background(), &api.Operation{DropAll: true}))
+	require.Error(t, authorizeAlter(context.Background(),
+		&api.Operation{DropOp: api.Operation_DATA}))
+}
+
+func TestClientKey(t *testing.T) {
+	Config.HmacSecret = []byte("secret")
+	Config.AccessJwtTtl = time.Minute
+	defer func() { Config.HmacSecret = nil }()
+
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 9080}
+	withJwt := func(accessJwt string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("accessJwt", accessJwt))
+	}
+
+	// the jwts of the same user share a key, whatever the groups they were issued with
+	jwt1, err := getAccessJwt("alice", []acl.Group{{GroupID: "dev"}}, 0)
+	require.NoError(t, err)
+	jwt2, err := getAccessJwt("alice", []acl.Group{{GroupID: "ops"}}, 0)
+	require.NoError(t, err)
+	require.Equal(t, "user:0:alice", clientKey(withJwt(jwt1)))
+	require.Equal(t, clientKey(withJwt(jwt1)), clientKey(withJwt(jwt2)))
+
+	// the same user in another namespace is another client
+	jwt3, err := getAccessJwt("alice", []acl.Group{{GroupID: "dev"}}, 2)
+	require.NoError(t, err)
+	require.Equal(t, "user:2:alice", clientKey(withJwt(jwt3)))
+
+	// a jwt which isn't valid falls back to the IP address
+	require.Equal(t, "ip:10.0.0.1", clientKey(withJwt("invalid")))
+	require.Equal(t, "ip:10.0.0.1", clientKey(peer.NewContext(context.Background(),
+		&peer.Peer{Addr: addr})))
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// The errors returned when a request isn't admitted. Clients can retry the requests later.
+var (
+	errTooManyQueries = status.Error(codes.ResourceExhausted,
+		"Too many pending queries. Please retry later.")
+	errTooManyMutations = status.Error(codes.ResourceExhausted,
+		"Too many pending mutations. Please retry later.")
+	errTooManyEdges = status.Error(codes.ResourceExhausted,
+		"Too many edges mutated per second. Please retry later.")
+	errTooManyClientEdges = status.Error(codes.ResourceExhausted,
+		"Too many edges mutated per second by this client. Please retry later.")
+)
+
+// clientBucketIdle is how long the bucket of a client can go unused before it's dropped.
+const clientBucketIdle = time.Minute
+
+// admission limits the number of queries and mutations the server runs at once, and the rate
+// of the edges written by mutations overall and per client, so that clients flooding the server
+// get an error they can retry on instead of taking it down.
+type admission struct {
+	pendingQueries   int64
+	pendingMutations int64
+
+	sync.Mutex
+	edges     *tokenBucket
+	clients   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+var admit admission
+
+// admitQuery reserves a slot for a query, returning the func releasing it.
+func (a *admission) admitQuery() (func(), error) {
+	return admitPending(&a.pendingQueries, Config.MaxPendingQueries, errTooManyQueries)
+}
+
+// admitMutation reserves a slot for a mutation, returning the func releasing it.
+func (a *admission) admitMutation() (func(), error) {
+	return admitPending(&a.pendingMutations, Config.MaxPendingMutations, errTooManyMutations)
+}
+
+func admitPending(pending *int64, max int, errFull error) (func(), error) {
+	if n := atomic.AddInt64(pending, 1); max > 0 && n > int64(max) {
+		atomic.AddInt64(pending, -1)
+		return nil, errFull
+	}
+	return func() { atomic.AddInt64(pending, -1) }, nil
+}
+
+// admitEdges takes the tokens for numEdges mutated edges from the bucket of the server and the
+// bucket of the client making the request with ctx.
+func (a *admission) admitEdges(ctx context.Context, numEdges int) error {
+	if Config.MutationEdgesPerSec <= 0 && Config.ClientMutationEdgesPerSec <= 0 {
+		return nil
+	}
+	n := float64(numEdges)
+	now := time.Now()
+
+	a.Lock()
+	defer a.Unlock()
+	var client *tokenBucket
+	if Config.ClientMutationEdgesPerSec > 0 {
+		client = a.clientBucket(clientKey(ctx), now)
+		if !client.has(n, now) {
+			return errTooManyClientEdges
+		}
+	}
+	if Config.MutationEdgesPerSec > 0 {
+		if a.edges == nil {
+			a.edges = newTokenBucket(Config.MutationEdgesPerSec, now)
+		}
+		if !a.edges.has(n, now) {
+			return errTooManyEdges
+		}
+		a.edges.take(n)
+	}
+	if client != nil {
+		client.take(n)
+	}
+	return nil
+}
+
+// clientBucket returns the bucket of the client with the given key, dropping the buckets of
+// the clients which haven't mutated for a while.
+func (a *admission) clientBucket(key string, now time.Time) *tokenBucket {
+	if a.clients == nil {
+		a.clients = make(map[string]*tokenBucket)
+	}
+	if now.Sub(a.lastSweep) > clientBucketIdle {
+		for k, b := range a.clients {
+			if now.Sub(b.last) > clientBucketIdle {
+				delete(a.clients, k)
+			}
+		}
+		a.lastSweep = now
+	}
+	b, ok := a.clients[key]
+	if !ok {
+		b = newTokenBucket(Config.ClientMutationEdgesPerSec, now)
+		a.clients[key] = b
+	}
+	return b
+}
+
+// clientKey identifies the client making the request with ctx, by the user and namespace of
+// its access jwt if it has a valid one, or else by its IP address. Keying on the user rather
+// than on the jwt keeps a client from getting a new bucket by logging in again.
+func clientKey(ctx context.Context) string {
+	if user, ns := requestUserNamespace(ctx); user != "" {
+		return fmt.Sprintf("user:%d:%s", ns, user)
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr := p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			return "ip:" + host
+		}
+		return "ip:" + addr
+	}
+	return ""
+}
+
+// tokenBucket fills up with rate tokens per second, up to rate tokens.
+type tokenBucket struct {
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, now time.Time) *tokenBucket {
+	return &tokenBucket{rate: rate, tokens: rate, last: now}
+}
+
+// has refills the bucket up to now and returns whether n tokens can be taken from it. A full
+// bucket allows taking more tokens than it holds, so that a request larger than the rate
+// isn't rejected forever. The bucket then has to refill the difference first.
+func (b *tokenBucket) has(n float64, now time.Time) bool {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.last = now
+	return b.tokens >= n || b.tokens >= b.rate
+}
+
+func (b *tokenBucket) take(n float64) {
+	b.tokens -= n
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(10, now)
+	require.True(t, b.has(6, now))
+	b.take(6)
+	require.False(t, b.has(6, now))
+
+	// Half a second adds 5 tokens.
+	now = now.Add(500 * time.Millisecond)
+	require.True(t, b.has(9, now))
+	b.take(9)
+
+	// A full bucket allows more tokens than it holds, which have to be refilled first.
+	now = now.Add(time.Hour)
+	require.True(t, b.has(25, now))
+	b.take(25)
+	now = now.Add(time.Second)
+	require.False(t, b.has(1, now))
+	now = now.Add(time.Second)
+	require.True(t, b.has(1, now))
+}
+
+func TestAdmitPending(t *testing.T) {
+	var pending int64
+	release, err := admitPending(&pending, 1, errTooManyQueries)
+	require.NoError(t, err)
+	_, err = admitPending(&pending, 1, errTooManyQueries)
+	require.Equal(t, errTooManyQueries, err)
+	release()
+	release, err = admitPending(&pending, 1, errTooManyQueries)
+	require.NoError(t, err)
+	release()
+	require.EqualValues(t, 0, pending)
+}
+
+func TestDraining(t *testing.T) {
+	defer atomic.StoreUint32(&draining, 0)
+	require.NoError(t, admitTxn(0))
+	StartDraining()
+	require.Equal(t, errDraining, admitTxn(0))
+	// The transactions already started can still run, commit or abort.
+	require.NoError(t, admitTxn(10))
+
+	release, err := admit.admitQuery()
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, WaitForDrain(ctx))
+	release()
+	require.NoError(t, WaitForDrain(context.Background()))
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"sort"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+	"google.golang.org/grpc/peer"
+)
+
+// newAuditEvent returns the entry of the audit log for the operation requested with ctx, which
+// ended with err.
+func newAuditEvent(ctx context.Context, op string, err error) x.AuditEvent {
+	ev := x.AuditEvent{Operation: op, User: requestUser(ctx)}
+	// The namespace of a request naming an unknown one is left out.
+	ev.Namespace, _ = requestNamespace(ctx)
+	if p, ok := peer.FromContext(ctx); ok {
+		ev.Address = p.Addr.String()
+	}
+	if err != nil {
+		ev.Error = err.Error()
+	}
+	return ev
+}
+
+// auditAlter records the alter operation op in the audit log, with the predicates it changes.
+func auditAlter(ctx context.Context, op *api.Operation, err error) {
+	if !x.AuditEnabled() {
+		return
+	}
+	ev := newAuditEvent(ctx, "alter", err)
+	switch {
+	case op.DropAll:
+		ev.Operation = "drop_all"
+	case op.DropOp == api.Operation_DATA:
+		ev.Operation = "drop_data"
+	case len(op.DropAttr) > 0:
+		ev.Operation = "drop_attr"
+		ev.Predicates = []string{op.DropAttr}
+	default:
+		// The schema is parsed again, as it may not have been if the operation was denied.
+		if result, perr := schema.ParseWithTypes(op.Schema); perr == nil {
+			for _, update := range result.Schemas {
+				_, attr := x.ParseNamespaceAttr(update.Predicate)
+				ev.Predicates = append(ev.Predicates, attr)
+			}
+			sort.Strings(ev.Predicates)
+		}
+	}
+	x.Audit(ev)
+}
+
+// auditMutation records the mutation gmu, run in the transaction started at startTs, in the
+// audit log. gmu is nil if the mutation couldn't be parsed.
+func auditMutation(ctx context.Context, gmu *gql.Mutation, startTs uint64,
+	resp *api.Assigned, err error) {
+	if !x.AuditEnabled() {
+		return
+	}
+	ev := newAuditEvent(ctx, "mutate", err)
+	ev.StartTs = startTs
+	ev.CommitTs = resp.GetContext().GetCommitTs()
+	if gmu != nil {
+		ev.Predicates = mutationPredicates(gmu)
+	}
+	x.Audit(ev)
+}
+
+// auditUpsert records the upsert run in the transaction started at startTs in the audit log.
+// Each of the mutations it ran was recorded on its own.
+func auditUpsert(ctx context.Context, startTs uint64, resp *api.Assigned, err error) {
+	if !x.AuditEnabled() {
+		return
+	}
+	ev := newAuditEvent(ctx, "upsert", err)
+	ev.StartTs = startTs
+	ev.CommitTs = resp.GetContext().GetCommitTs()
+	x.Audit(ev)
+}
+
+// AuditCommit records the commit or the abort of the transaction tc in the audit log, along
+// with its commit timestamp if it was committed.
+func AuditCommit(ctx context.Context, tc *api.TxnContext, commitTs uint64, err error) {
+	if !x.AuditEnabled() {
+		return
+	}
+	op := "commit"
+	if tc.Aborted {
+		op = "abort"
+	}
+	ev := newAuditEvent(ctx, op, err)
+	ev.StartTs = tc.StartTs
+	ev.CommitTs = commitTs
+	x.Audit(ev)
+}
+
+// auditLogin records the login of the user in the audit log.
+func auditLogin(ctx context.Context, userId string, err error) {
+	if !x.AuditEnabled() {
+		return
+	}
+	ev := newAuditEvent(ctx, "login", err)
+	ev.User = userId
+	x.Audit(ev)
+}
+
+// mutationPredicates returns the sorted predicates set or deleted by the mutation, without
+// their namespace. The deletions of all the predicates of a node are logged as "*".
+func mutationPredicates(gmu *gql.Mutation) []string {
+	seen := make(map[string]struct{})
+	for _, nqs := range [][]*api.NQuad{gmu.Set, gmu.Del} {
+		for _, nq := range nqs {
+			_, attr := x.ParseNamespaceAttr(nq.Predicate)
+			if attr == x.Star {
+				attr = "*"
+			}
+			seen[attr] = struct{}{}
+		}
+	}
+
+	preds := make([]string, 0, len(seen))
+	for attr := range seen {
+		preds = append(preds, attr)
+	}
+	sort.Strings(preds)
+	return preds
+}
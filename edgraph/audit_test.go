@@ -0,0 +1,42 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"testing"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMutationPredicates(t *testing.T) {
+	mu, err := gql.ParseMutation(`{
+		set {
+			_:a <name> "alice" .
+			_:a <friend> _:b .
+		}
+		delete {
+			<0x1> <name> * .
+			<0x2> * * .
+		}
+	}`)
+	require.NoError(t, err)
+	gmu, err := parseMutationObject(mu)
+	require.NoError(t, err)
+	require.NoError(t, namespaceMutation(2, gmu))
+	require.Equal(t, []string{"*", "friend", "name"}, mutationPredicates(gmu))
+}
@@ -17,6 +17,7 @@
 package edgraph
 
 import (
+	"context"
 	"expvar"
 	"path/filepath"
 	"time"
@@ -35,10 +36,46 @@ type Options struct {
 	AuthToken    string
 
 	AllottedMemory float64
-
-	HmacSecret    []byte
-	AccessJwtTtl  time.Duration
-	RefreshJwtTtl time.Duration
+	// LruFraction is the fraction of the available memory the LRU cache is limited to, instead
+	// of sizing it from AllottedMemory.
+	LruFraction float64
+
+	// The queries taking at least QueryLogThreshold are logged to QueryLogFile, or the server
+	// log if empty, which is rotated every QueryLogSizeMB.
+	QueryLogThreshold time.Duration
+	QueryLogFile      string
+	QueryLogSizeMB    int64
+
+	// QueryTimeout is the longest a query may run before it's stopped. Zero means no limit
+	// other than the deadline of the request.
+	QueryTimeout time.Duration
+
+	// The results of the read-only queries are cached, up to QueryCacheMB, until a transaction
+	// commits to the predicates they read or QueryCacheTTL has passed. Zero disables the cache.
+	QueryCacheMB  int64
+	QueryCacheTTL time.Duration
+
+	// The queries and mutations run at once are limited to MaxPendingQueries and
+	// MaxPendingMutations, and the edges mutated to MutationEdgesPerSec overall and
+	// ClientMutationEdgesPerSec per client. Zero means no limit.
+	MaxPendingQueries         int
+	MaxPendingMutations       int
+	MutationEdgesPerSec       float64
+	ClientMutationEdgesPerSec float64
+
+	// LudicrousMode commits the mutations run in a transaction of their own as soon as they're
+	// applied, without waiting for Zero, trading consistency for throughput.
+	LudicrousMode bool
+
+	// GraphQLSchema returns the Dgraph schema the GraphQL schema uploaded to the namespace of
+	// the context expects, empty if none was uploaded. The schema queries asking for the graphql
+	// field compare the predicates against it.
+	GraphQLSchema func(ctx context.Context) (string, error)
+
+	HmacSecret         []byte
+	AccessJwtTtl       time.Duration
+	RefreshJwtTtl      time.Duration
+	AclRefreshInterval time.Duration
 }
 
 var Config Options
@@ -81,6 +118,12 @@ func setConfVar(conf Options) {
 	x.Conf.Set("posting_dir", newStr(conf.PostingDir))
 	x.Conf.Set("wal_dir", newStr(conf.WALDir))
 	x.Conf.Set("allotted_memory", newFloat(conf.AllottedMemory))
+	x.Conf.Set("lru_fraction", newFloat(conf.LruFraction))
+	x.Conf.Set("query_log_threshold", newStr(conf.QueryLogThreshold.String()))
+	x.Conf.Set("query_log", newStr(conf.QueryLogFile))
+	x.Conf.Set("query_timeout", newStr(conf.QueryTimeout.String()))
+	x.Conf.Set("query_cache_mb", newInt(int(conf.QueryCacheMB)))
+	x.Conf.Set("query_cache_ttl", newStr(conf.QueryCacheTTL.String()))
 
 	// Set some vars from worker.Config.
 	x.Conf.Set("tracing", newFloat(worker.Config.Tracing))
@@ -95,6 +138,7 @@ func SetConfiguration(newConfig Options) {
 
 	posting.Config.Mu.Lock()
 	posting.Config.AllottedMemory = Config.AllottedMemory
+	posting.Config.LruFraction = Config.LruFraction
 	posting.Config.Mu.Unlock()
 }
 
@@ -106,9 +150,19 @@ func (o *Options) validate() {
 	wd, err := filepath.Abs(o.WALDir)
 	x.Check(err)
 	x.AssertTruef(pd != wd, "Posting and WAL directory cannot be the same ('%s').", o.PostingDir)
-	x.AssertTruefNoTrace(o.AllottedMemory != -1,
-		"LRU memory (--lru_mb) must be specified. (At least 1024 MB)")
-	x.AssertTruefNoTrace(o.AllottedMemory >= MinAllottedMemory,
-		"LRU memory (--lru_mb) must be at least %.0f MB. Currently set to: %f",
-		MinAllottedMemory, o.AllottedMemory)
+	x.AssertTruefNoTrace(o.LruFraction >= 0 && o.LruFraction < 1,
+		"The LRU fraction (--lru_fraction) must be at least 0 and less than 1.")
+	if o.LruFraction == 0 {
+		x.AssertTruefNoTrace(o.AllottedMemory != -1,
+			"LRU memory (--lru_mb) must be specified. (At least 1024 MB)")
+		x.AssertTruefNoTrace(o.AllottedMemory >= MinAllottedMemory,
+			"LRU memory (--lru_mb) must be at least %.0f MB. Currently set to: %f",
+			MinAllottedMemory, o.AllottedMemory)
+	}
+	x.AssertTruefNoTrace(len(o.HmacSecret) == 0 || o.AclRefreshInterval > 0,
+		"The acl refresh interval (--acl_refresh_interval) must be positive.")
+	x.AssertTruefNoTrace(o.QueryLogThreshold == 0 || o.QueryLogFile == "" ||
+		o.QueryLogSizeMB > 0, "The size of the query log (--query_log_size_mb) must be positive.")
+	x.AssertTruefNoTrace(o.QueryCacheMB >= 0,
+		"The size of the query cache (--query_cache_mb) must not be negative.")
 }
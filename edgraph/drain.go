@@ -0,0 +1,77 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errDraining is returned for the requests starting a transaction once the server is shutting
+// down. Clients can retry them on another server.
+var errDraining = status.Error(codes.Unavailable,
+	"Server is shutting down. Please retry on another server.")
+
+var draining uint32
+
+// StartDraining makes the server refuse the requests starting new transactions, while the ones
+// of the transactions already started, including their commits and aborts, are still served.
+func StartDraining() {
+	atomic.StoreUint32(&draining, 1)
+}
+
+// Draining returns whether the server is shutting down.
+func Draining() bool {
+	return atomic.LoadUint32(&draining) == 1
+}
+
+// admitTxn returns errDraining if the server is shutting down and the request would start a new
+// transaction, i.e. it has no start ts.
+func admitTxn(startTs uint64) error {
+	if startTs == 0 && Draining() {
+		return errDraining
+	}
+	return nil
+}
+
+// WaitForDrain waits until the queries and mutations in flight are done and the transactions
+// pending in the group are committed or aborted, or until ctx is done.
+func WaitForDrain(ctx context.Context) error {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		queries := atomic.LoadInt64(&admit.pendingQueries)
+		mutations := atomic.LoadInt64(&admit.pendingMutations)
+		txns := posting.Oracle().NumPendingTxns()
+		if queries == 0 && mutations == 0 && txns == 0 {
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			glog.Warningf("Stopped waiting for %d queries, %d mutations and %d transactions: %v",
+				queries, mutations, txns, ctx.Err())
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import "sync"
+
+// In ludicrous mode, the mutations committed immediately in a new transaction skip Zero entirely:
+// their start ts is taken from a block of timestamps leased from Zero ahead of time, and every
+// group commits them at that ts as soon as they're applied, without any conflict detection. Such
+// a mutation can be partially applied if it fails in some group, and it isn't guaranteed to be
+// seen by the transactions started after it returns.
+
+// leaseSize is the number of timestamps leased from Zero at once in ludicrous mode.
+const leaseSize = 1000
+
+// tsLease is the block of timestamps leased from Zero not handed out yet, [next, end).
+type tsLease struct {
+	sync.Mutex
+	next, end uint64
+}
+
+// leaseTimestamp returns the start ts of a ludicrous mutation, leasing a new block of timestamps
+// from Zero if needed.
+func (s *ServerState) leaseTimestamp() uint64 {
+	s.lease.Lock()
+	defer s.lease.Unlock()
+	if s.lease.next == s.lease.end {
+		s.lease.next = s.getTimestamps(false, leaseSize)
+		s.lease.end = s.lease.next + leaseSize
+	}
+	ts := s.lease.next
+	s.lease.next++
+	return ts
+}
+
+// isLudicrous returns whether the mutation runs in ludicrous mode, i.e. it's committed
+// immediately in the new transaction it starts.
+func isLudicrous(startTs uint64, commitNow bool) bool {
+	return Config.LudicrousMode && startTs == 0 && commitNow
+}
@@ -0,0 +1,42 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaseTimestamp(t *testing.T) {
+	s := &ServerState{needTs: make(chan tsReq)}
+	var leases int
+	go func() {
+		next := uint64(10)
+		for req := range s.needTs {
+			leases++
+			req.ch <- next
+			next += req.num
+		}
+	}()
+	defer close(s.needTs)
+
+	for i := uint64(0); i < 2*leaseSize+1; i++ {
+		require.Equal(t, 10+i, s.leaseTimestamp())
+	}
+	require.Equal(t, 3, leases)
+}
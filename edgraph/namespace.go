@@ -0,0 +1,233 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// requestNamespace returns the namespace the request runs in, read from the "namespace" key of
+// its metadata. It's the default namespace if the request doesn't ask for one.
+func requestNamespace(ctx context.Context) (uint64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return x.DefaultNamespace, nil
+	}
+	vals := md.Get("namespace")
+	if len(vals) == 0 || len(vals[0]) == 0 {
+		return x.DefaultNamespace, nil
+	}
+	ns, err := strconv.ParseUint(vals[0], 0, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid namespace %q", vals[0])
+	}
+	if !worker.KnownNamespace(ns) {
+		return 0, status.Errorf(codes.NotFound, "namespace %d doesn't exist", ns)
+	}
+	return ns, nil
+}
+
+// withNamespace returns the context of the request, carrying the namespace it runs in.
+func withNamespace(ctx context.Context) (context.Context, uint64, error) {
+	ns, err := requestNamespace(ctx)
+	if err != nil {
+		return ctx, 0, err
+	}
+	return x.AttachNamespace(ctx, ns), ns, nil
+}
+
+// namespaceAttr returns the attribute the predicate is stored under in the namespace.
+func namespaceAttr(ns uint64, pred string) (string, error) {
+	if strings.Contains(pred, x.NamespaceSeparator) {
+		return "", x.Errorf("Predicate name %q can't contain %q", pred, x.NamespaceSeparator)
+	}
+	return x.NamespaceAttr(ns, pred), nil
+}
+
+// namespaceQuery moves the predicates used by the query blocks into the namespace, including
+// the ones only used in functions, filters, sorting and grouping.
+func namespaceQuery(ns uint64, gqs []*gql.GraphQuery) error {
+	setAttr := func(attr *string) error {
+		if len(*attr) == 0 || *attr == "uid" || *attr == "_predicate_" ||
+			strings.HasPrefix(*attr, "val(") {
+			return nil
+		}
+		nsAttr, err := namespaceAttr(ns, *attr)
+		*attr = nsAttr
+		return err
+	}
+	setFunc := func(fn *gql.Function) error {
+		if fn == nil || fn.IsValueVar {
+			return nil
+		}
+		return setAttr(&fn.Attr)
+	}
+	var setFilter func(ft *gql.FilterTree) error
+	setFilter = func(ft *gql.FilterTree) error {
+		if ft == nil {
+			return nil
+		}
+		if err := setFunc(ft.Func); err != nil {
+			return err
+		}
+		for _, child := range ft.Child {
+			if err := setFilter(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var visit func(gq *gql.GraphQuery) error
+	visit = func(gq *gql.GraphQuery) error {
+		if !gq.IsInternal {
+			if err := setAttr(&gq.Attr); err != nil {
+				return err
+			}
+		}
+		if err := setFunc(gq.Func); err != nil {
+			return err
+		}
+		if err := setFilter(gq.Filter); err != nil {
+			return err
+		}
+		for _, order := range gq.Order {
+			if err := setAttr(&order.Attr); err != nil {
+				return err
+			}
+		}
+		for i := range gq.GroupbyAttrs {
+			if err := setAttr(&gq.GroupbyAttrs[i].Attr); err != nil {
+				return err
+			}
+		}
+		for _, child := range gq.Children {
+			if err := visit(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, gq := range gqs {
+		if err := visit(gq); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// namespaceMutation moves the predicates set or deleted by the mutation into the namespace.
+func namespaceMutation(ns uint64, gmu *gql.Mutation) error {
+	for _, nqs := range [][]*api.NQuad{gmu.Set, gmu.Del} {
+		for _, nq := range nqs {
+			if nq.Predicate == x.Star {
+				continue
+			}
+			attr, err := namespaceAttr(ns, nq.Predicate)
+			if err != nil {
+				return err
+			}
+			nq.Predicate = attr
+		}
+	}
+	return nil
+}
+
+// namespaceTypes moves the types, along with the predicates they declare, into the namespace.
+func namespaceTypes(ns uint64, types []*pb.TypeUpdate) error {
+	for _, typ := range types {
+		var err error
+		if typ.TypeName, err = namespaceAttr(ns, typ.TypeName); err != nil {
+			return err
+		}
+		for i := range typ.Fields {
+			if typ.Fields[i], err = namespaceAttr(ns, typ.Fields[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// typeAttrSchema returns the schema of the predicate holding the types of the nodes of the
+// namespace.
+func typeAttrSchema(ns uint64) *pb.SchemaUpdate {
+	return &pb.SchemaUpdate{
+		Predicate: x.NamespaceAttr(ns, x.TypeAttr),
+		ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact"},
+		List:      true,
+	}
+}
+
+// namespaceSchema keeps the schema nodes of the predicates of the namespace, under their name
+// in the namespace.
+func namespaceSchema(ns uint64, nodes []*pb.SchemaNode) []*pb.SchemaNode {
+	out := nodes[:0]
+	for _, node := range nodes {
+		pns, pred := x.ParseNamespaceAttr(node.Predicate)
+		if pns != ns {
+			continue
+		}
+		node.Predicate = pred
+		out = append(out, node)
+	}
+	return out
+}
+
+// stripTypesNamespace sets the types read from the namespace under their name in the namespace,
+// along with their fields.
+func stripTypesNamespace(types []*pb.TypeUpdate) {
+	for _, typ := range types {
+		_, typ.TypeName = x.ParseNamespaceAttr(typ.TypeName)
+		for i, field := range typ.Fields {
+			_, typ.Fields[i] = x.ParseNamespaceAttr(field)
+		}
+	}
+}
+
+// namespacePredicates returns the predicates of the namespace, as they're stored. The schema is
+// streamed from the groups, so that only the predicates of the namespace are held in memory.
+func namespacePredicates(ctx context.Context, ns uint64) ([]string, error) {
+	var preds []string
+	err := worker.StreamSchemaBatchesOverNetwork(ctx, &pb.SchemaRequest{},
+		func(node *pb.SchemaNode) error {
+			if pns, _ := x.ParseNamespaceAttr(node.Predicate); pns == ns {
+				preds = append(preds, node.Predicate)
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(preds)
+	return preds, nil
+}
@@ -139,7 +139,7 @@ func handleBasicType(k string, v interface{}, op int, nq *api.NQuad) error {
 		nq.Predicate, nq.Lang = x.PredicateLang(k)
 
 		// Default value is considered as S P * deletion.
-		if v == "" && op == delete {
+		if v == "" && op == deleteNquads {
 			nq.ObjectValue = &api.Value{Val: &api.Value_DefaultVal{DefaultVal: x.Star}}
 			return nil
 		}
@@ -150,14 +150,14 @@ func handleBasicType(k string, v interface{}, op int, nq *api.NQuad) error {
 		nq.ObjectValue = &api.Value{Val: &api.Value_StrVal{StrVal: v}}
 
 	case float64:
-		if v == 0 && op == delete {
+		if v == 0 && op == deleteNquads {
 			nq.ObjectValue = &api.Value{Val: &api.Value_DefaultVal{DefaultVal: x.Star}}
 			return nil
 		}
 		nq.ObjectValue = &api.Value{Val: &api.Value_DoubleVal{DoubleVal: v}}
 
 	case bool:
-		if v == false && op == delete {
+		if v == false && op == deleteNquads {
 			nq.ObjectValue = &api.Value{Val: &api.Value_DefaultVal{DefaultVal: x.Star}}
 			return nil
 		}
@@ -172,7 +172,7 @@ func handleBasicType(k string, v interface{}, op int, nq *api.NQuad) error {
 
 func checkForDeletion(mr *mapResponse, m map[string]interface{}, op int) {
 	// Since uid is the only key, this must be S * * deletion.
-	if op == delete && len(mr.uid) > 0 && len(m) == 1 {
+	if op == deleteNquads && len(mr.uid) > 0 && len(m) == 1 {
 		mr.nquads = append(mr.nquads, &api.NQuad{
 			Subject:     mr.uid,
 			Predicate:   x.Star,
@@ -247,7 +247,7 @@ func mapToNquads(m map[string]interface{}, idx *int, op int, parentPred string)
 	}
 
 	if len(mr.uid) == 0 {
-		if op == delete {
+		if op == deleteNquads {
 			// Delete operations with a non-nil value must have a uid specified.
 			return mr, x.Errorf("uid must be present and non-zero while deleting edges.")
 		}
@@ -265,7 +265,7 @@ func mapToNquads(m map[string]interface{}, idx *int, op int, parentPred string)
 			continue
 		}
 
-		if op == delete {
+		if op == deleteNquads {
 			// This corresponds to edge deletion.
 			if v == nil {
 				mr.nquads = append(mr.nquads, &api.NQuad{
@@ -292,7 +292,7 @@ func mapToNquads(m map[string]interface{}, idx *int, op int, parentPred string)
 		}
 
 		if v == nil {
-			if op == delete {
+			if op == deleteNquads {
 				nq.ObjectValue = &api.Value{Val: &api.Value_DefaultVal{DefaultVal: x.Star}}
 				mr.nquads = append(mr.nquads, &nq)
 			}
@@ -379,8 +379,8 @@ func mapToNquads(m map[string]interface{}, idx *int, op int, parentPred string)
 }
 
 const (
-	set = iota
-	delete
+	setNquads = iota
+	deleteNquads
 )
 
 func nquadsFromJson(b []byte, op int) ([]*api.NQuad, error) {
@@ -426,5 +426,5 @@ func nquadsFromJson(b []byte, op int) ([]*api.NQuad, error) {
 }
 
 func NquadsFromJson(b []byte) ([]*api.NQuad, error) {
-	return nquadsFromJson(b, set)
+	return nquadsFromJson(b, setNquads)
 }
@@ -0,0 +1,190 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+)
+
+// maxQueryLogBackups is the number of rotated files of the query log kept, named after the
+// file with the suffixes .1 to .5 from the most recent.
+const maxQueryLogBackups = 5
+
+// slowQuery is the entry of a query in the slow query log.
+type slowQuery struct {
+	Time         time.Time         `json:"time"`
+	Query        string            `json:"query"`
+	Vars         map[string]string `json:"vars,omitempty"`
+	Namespace    uint64            `json:"namespace,omitempty"`
+	StartTs      uint64            `json:"start_ts"`
+	ParsingNs    int64             `json:"parsing_ns"`
+	ProcessingNs int64             `json:"processing_ns"`
+	EncodingNs   int64             `json:"encoding_ns"`
+	TotalNs      int64             `json:"total_ns"`
+	Predicates   []string          `json:"predicates,omitempty"`
+	Error        string            `json:"error,omitempty"`
+}
+
+// queryLog writes the slow queries to a file, rotating it once it grows beyond its max size.
+type queryLog struct {
+	sync.Mutex
+	path    string
+	maxSize int64
+	f       *os.File
+	size    int64
+}
+
+var slowQueryLog queryLog
+
+// logSlowQuery logs the query of req, run with ctx and latency l, if it took at least the
+// threshold of the query log. The predicates are read from the subgraphs it was run with.
+func logSlowQuery(ctx context.Context, req *api.Request, l *query.Latency,
+	sgl []*query.SubGraph, err error) {
+	if Config.QueryLogThreshold == 0 || l.Start.IsZero() {
+		return
+	}
+	total := time.Since(l.Start)
+	if total < Config.QueryLogThreshold {
+		return
+	}
+
+	// The namespace was checked when the query was run.
+	ns, _ := requestNamespace(ctx)
+	entry := slowQuery{
+		Time:         l.Start,
+		Query:        req.Query,
+		Vars:         req.Vars,
+		Namespace:    ns,
+		StartTs:      req.StartTs,
+		ParsingNs:    l.Parsing.Nanoseconds(),
+		ProcessingNs: l.Processing.Nanoseconds(),
+		EncodingNs:   l.Json.Nanoseconds(),
+		TotalNs:      total.Nanoseconds(),
+		Predicates:   queryPredicates(sgl),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		glog.Errorf("Unable to marshal slow query: %v", err)
+		return
+	}
+
+	if Config.QueryLogFile == "" {
+		glog.Warningf("Slow query: %s", b)
+		return
+	}
+	if err := slowQueryLog.write(append(b, '\n')); err != nil {
+		glog.Errorf("Unable to write to the query log: %v", err)
+	}
+}
+
+// queryPredicates returns the sorted predicates read by the subgraphs, without their namespace.
+func queryPredicates(sgl []*query.SubGraph) []string {
+	seen := make(map[string]struct{})
+	var walk func(sg *query.SubGraph)
+	walk = func(sg *query.SubGraph) {
+		if sg.Attr != "" && sg.Attr != "uid" {
+			_, attr := x.ParseNamespaceAttr(sg.Attr)
+			seen[attr] = struct{}{}
+		}
+		for _, child := range sg.Children {
+			walk(child)
+		}
+		for _, filter := range sg.Filters {
+			walk(filter)
+		}
+	}
+	for _, sg := range sgl {
+		walk(sg)
+	}
+
+	preds := make([]string, 0, len(seen))
+	for attr := range seen {
+		preds = append(preds, attr)
+	}
+	sort.Strings(preds)
+	return preds
+}
+
+func (ql *queryLog) write(b []byte) error {
+	ql.Lock()
+	defer ql.Unlock()
+
+	if ql.f == nil {
+		ql.path, ql.maxSize = Config.QueryLogFile, Config.QueryLogSizeMB<<20
+		if err := ql.open(); err != nil {
+			return err
+		}
+	}
+	if ql.size > 0 && ql.size+int64(len(b)) > ql.maxSize {
+		if err := ql.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := ql.f.Write(b)
+	ql.size += int64(n)
+	return err
+}
+
+func (ql *queryLog) open() error {
+	f, err := os.OpenFile(ql.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	ql.f, ql.size = f, fi.Size()
+	return nil
+}
+
+// rotate moves the current file to the first backup, shifting the older backups and dropping
+// the oldest one, and opens a new file.
+func (ql *queryLog) rotate() error {
+	if err := ql.f.Close(); err != nil {
+		return err
+	}
+	ql.f = nil
+	for i := maxQueryLogBackups - 1; i >= 1; i-- {
+		from := fmt.Sprintf("%s.%d", ql.path, i)
+		if _, err := os.Stat(from); os.IsNotExist(err) {
+			continue
+		}
+		if err := os.Rename(from, fmt.Sprintf("%s.%d", ql.path, i+1)); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(ql.path, ql.path+".1"); err != nil {
+		return err
+	}
+	return ql.open()
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryLogRotate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "querylog")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "slow.log")
+	ql := &queryLog{path: path, maxSize: 10}
+	require.NoError(t, ql.open())
+	for i := 0; i < maxQueryLogBackups+3; i++ {
+		require.NoError(t, ql.write([]byte(fmt.Sprintf("query %d\n", i))))
+	}
+
+	b, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("query %d\n", maxQueryLogBackups+2), string(b))
+	for i := 1; i <= maxQueryLogBackups; i++ {
+		b, err := ioutil.ReadFile(fmt.Sprintf("%s.%d", path, i))
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("query %d\n", maxQueryLogBackups+2-i), string(b))
+	}
+	_, err = os.Stat(fmt.Sprintf("%s.%d", path, maxQueryLogBackups+1))
+	require.True(t, os.IsNotExist(err))
+}
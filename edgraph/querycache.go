@@ -0,0 +1,183 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"container/list"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	"golang.org/x/net/context"
+)
+
+// The results of a query read at a ts are the same at any later ts, as long as no transaction
+// committed in between wrote to the predicates the query reads, and no data was dropped nor
+// schema changed outside of transactions. Zero sends the predicates of every commit to the
+// Alphas along with the commit ts, so the oracle knows the last commit to each predicate up to
+// MaxAssigned, and the cached results of the queries run over and over, like the ones of
+// dashboards, are served until one of their predicates is written to.
+
+// queryEpoch changes whenever data is dropped or the schema changed on this server, which isn't
+// tracked by the commit timestamps.
+type queryEpoch struct {
+	data   uint64
+	schema uint64
+}
+
+func currentEpoch() queryEpoch {
+	return queryEpoch{data: posting.DataEpoch(), schema: schema.State().Version()}
+}
+
+// cachedQuery is a query whose results can be cached, along with its results once read.
+type cachedQuery struct {
+	key    string
+	preds  []string
+	readTs uint64
+	epoch  queryEpoch
+	added  time.Time
+
+	json []byte
+}
+
+func (cq *cachedQuery) size() int64 {
+	return int64(len(cq.key) + len(cq.json))
+}
+
+// newCachedQuery returns the query of the request in the namespace, or nil if its results can't
+// be cached. Only the queries the server picks the read ts of can be, as a transaction might
+// read its own pending writes, and only the ones whose predicates are known before they run.
+// The queries run in debug mode or explaining their plan return more than their results.
+func newCachedQuery(ctx context.Context, ns uint64, req *api.Request,
+	parsed *gql.Result) *cachedQuery {
+	if Config.QueryCacheMB <= 0 || Config.LudicrousMode || req.StartTs != 0 ||
+		parsed.Schema != nil || !knownPredicates(parsed.Query) {
+		return nil
+	}
+	if query.IsDebug(ctx) || worker.QueryPlanFrom(ctx) != nil {
+		return nil
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d\x00%d:%s", ns, len(req.Query), req.Query)
+	names := make([]string, 0, len(req.Vars))
+	for name := range req.Vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		val := req.Vars[name]
+		fmt.Fprintf(&b, "%d:%s%d:%s", len(name), name, len(val), val)
+	}
+	return &cachedQuery{key: b.String(), preds: query.QueryPredicates(parsed.Query)}
+}
+
+// knownPredicates returns whether all the predicates the query blocks read are named in them,
+// unlike the ones read by expand, which depend on the types of the nodes.
+func knownPredicates(gqs []*gql.GraphQuery) bool {
+	for _, gq := range gqs {
+		if gq.Expand != "" || !knownPredicates(gq.Children) {
+			return false
+		}
+	}
+	return true
+}
+
+// queryCache is an LRU cache of the JSON results of queries, bounded by their size.
+type queryCache struct {
+	sync.Mutex
+	size  int64
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+var queryResults queryCache
+
+// get returns the cached results of the query, if they're the same as the ones read at readTs.
+// The oracle must have caught up to readTs, so that it knows about all the commits until then.
+func (c *queryCache) get(cq *cachedQuery, readTs uint64) (*cachedQuery, bool) {
+	c.Lock()
+	defer c.Unlock()
+	e, ok := c.items[cq.key]
+	if !ok {
+		x.QueryCacheMiss.Add(1)
+		return nil, false
+	}
+	cached := e.Value.(*cachedQuery)
+	switch {
+	case readTs < cached.readTs:
+		// The results are newer than the ones asked for, but might still be of use later on.
+		x.QueryCacheMiss.Add(1)
+		return nil, false
+	case cached.epoch != currentEpoch(),
+		Config.QueryCacheTTL > 0 && time.Since(cached.added) > Config.QueryCacheTTL,
+		posting.Oracle().LastCommit(cached.preds) > cached.readTs:
+		c.remove(e)
+		x.QueryCacheMiss.Add(1)
+		return nil, false
+	}
+	c.ll.MoveToFront(e)
+	x.QueryCacheHit.Add(1)
+	return cached, true
+}
+
+// add caches the results of the query, evicting the least recently used ones beyond the size
+// of the cache.
+func (c *queryCache) add(cq *cachedQuery) {
+	maxSize := Config.QueryCacheMB << 20
+	if cq.size() > maxSize || cq.epoch != currentEpoch() {
+		// The results might have been read partly before a drop and partly after.
+		return
+	}
+	cq.added = time.Now()
+
+	c.Lock()
+	defer c.Unlock()
+	if c.items == nil {
+		c.ll = list.New()
+		c.items = make(map[string]*list.Element)
+	}
+	if e, ok := c.items[cq.key]; ok {
+		if e.Value.(*cachedQuery).readTs > cq.readTs {
+			return
+		}
+		c.remove(e)
+	}
+	c.items[cq.key] = c.ll.PushFront(cq)
+	c.size += cq.size()
+	for c.size > maxSize {
+		c.remove(c.ll.Back())
+	}
+	x.QueryCacheSize.Set(c.size)
+}
+
+// remove must be called with the lock held.
+func (c *queryCache) remove(e *list.Element) {
+	cq := c.ll.Remove(e).(*cachedQuery)
+	delete(c.items, cq.key)
+	c.size -= cq.size()
+	x.QueryCacheSize.Set(c.size)
+}
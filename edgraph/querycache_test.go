@@ -0,0 +1,153 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/worker"
+)
+
+func parseCachedQuery(t *testing.T, ns uint64, req *api.Request) *cachedQuery {
+	parsed, err := gql.Parse(gql.Request{Str: req.Query, Variables: req.Vars})
+	require.NoError(t, err)
+	return newCachedQuery(context.Background(), ns, req, &parsed)
+}
+
+func TestNewCachedQuery(t *testing.T) {
+	defer func(conf Options) { Config = conf }(Config)
+	Config.QueryCacheMB = 1
+
+	const q = `query q($name: string) {
+		q(func: eq(name, $name), orderasc: age) @filter(has(email)) { name ~friend { uid } }
+	}`
+	req := &api.Request{Query: q, Vars: map[string]string{"$name": "alice"}}
+	cq := parseCachedQuery(t, 0, req)
+	require.NotNil(t, cq)
+	require.Equal(t, []string{"name", "email", "age", "friend"}, cq.preds)
+
+	// The key tells apart the namespaces and the values of the variables.
+	require.Equal(t, cq.key, parseCachedQuery(t, 0, req).key)
+	require.NotEqual(t, cq.key, parseCachedQuery(t, 1, req).key)
+	other := &api.Request{Query: q, Vars: map[string]string{"$name": "bob"}}
+	require.NotEqual(t, cq.key, parseCachedQuery(t, 0, other).key)
+
+	// A transaction might read its own writes.
+	require.Nil(t, parseCachedQuery(t, 0, &api.Request{Query: q, StartTs: 10}))
+	// The predicates read by expand aren't known before the query runs.
+	require.Nil(t, parseCachedQuery(t, 0, &api.Request{
+		Query: `{ q(func: uid(1)) { friend { expand(_all_) } } }`,
+	}))
+	require.Nil(t, parseCachedQuery(t, 0, &api.Request{Query: `schema {}`}))
+	// Neither are the uids returned in debug mode nor the plans.
+	parsed, err := gql.Parse(gql.Request{Str: req.Query, Variables: req.Vars})
+	require.NoError(t, err)
+	debug := context.WithValue(context.Background(), "debug", "true")
+	require.Nil(t, newCachedQuery(debug, 0, req, &parsed))
+	explain, _ := worker.WithQueryPlan(context.Background())
+	require.Nil(t, newCachedQuery(explain, 0, req, &parsed))
+
+	Config.QueryCacheMB = 0
+	require.Nil(t, parseCachedQuery(t, 0, req))
+}
+
+func TestQueryCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "querycache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	opt := badger.DefaultOptions
+	opt.Dir = dir
+	opt.ValueDir = dir
+	ps, err := badger.OpenManaged(opt)
+	require.NoError(t, err)
+	defer ps.Close()
+	posting.Init(ps)
+	schema.Init(ps)
+
+	defer func(conf Options) { Config = conf }(Config)
+	Config.QueryCacheMB = 1
+
+	var c queryCache
+	add := func(readTs uint64) *cachedQuery {
+		cq := parseCachedQuery(t, 0, &api.Request{Query: `{ q(func: has(name)) { age } }`})
+		cq.readTs, cq.epoch, cq.json = readTs, currentEpoch(), []byte(`{"q":[]}`)
+		c.add(cq)
+		return cq
+	}
+	commit := func(commitTs uint64, preds ...string) {
+		posting.Oracle().ProcessDelta(&pb.OracleDelta{
+			Txns:        []*pb.TxnStatus{{StartTs: commitTs - 1, CommitTs: commitTs, Preds: preds}},
+			MaxAssigned: commitTs,
+		})
+	}
+
+	cq := add(10)
+	cached, ok := c.get(cq, 10)
+	require.True(t, ok)
+	require.Equal(t, `{"q":[]}`, string(cached.json))
+	// The results read later on aren't served at an earlier ts.
+	_, ok = c.get(cq, 9)
+	require.False(t, ok)
+
+	// A commit to other predicates leaves the results as they are.
+	commit(12, "email")
+	_, ok = c.get(cq, 12)
+	require.True(t, ok)
+	// A commit to one of the predicates read makes them stale.
+	commit(14, "age")
+	_, ok = c.get(cq, 14)
+	require.False(t, ok)
+	require.Empty(t, c.items)
+
+	// So does a commit whose predicates aren't known.
+	cq = add(14)
+	commit(16)
+	_, ok = c.get(cq, 16)
+	require.False(t, ok)
+
+	// And so does a schema change.
+	cq = add(16)
+	schema.State().Set("age", pb.SchemaUpdate{ValueType: pb.Posting_INT})
+	_, ok = c.get(cq, 16)
+	require.False(t, ok)
+	require.Zero(t, c.size)
+
+	// The least recently used results are evicted once the cache is full.
+	cq = add(16)
+	big := &cachedQuery{
+		key:    "big",
+		readTs: 16,
+		epoch:  currentEpoch(),
+		json:   make([]byte, 1<<20-len(cq.key)-1),
+	}
+	c.add(big)
+	_, ok = c.get(cq, 16)
+	require.False(t, ok)
+	_, ok = c.get(big, 16)
+	require.True(t, ok)
+}
@@ -18,9 +18,12 @@ package edgraph
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"math"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -31,10 +34,12 @@ import (
 	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgo/y"
 	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/query"
 	"github.com/dgraph-io/dgraph/rdf"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
@@ -60,6 +65,7 @@ type ServerState struct {
 
 	mu     sync.Mutex
 	needTs chan tsReq
+	lease  tsLease
 }
 
 var State ServerState
@@ -139,6 +145,7 @@ func (s *ServerState) initStorage() {
 	{
 		// Write Ahead Log directory
 		x.Checkf(os.MkdirAll(Config.WALDir, 0700), "Error while creating WAL dir.")
+		x.Check(x.CheckFormatVersion(Config.WALDir))
 		opt := badger.LSMOnlyOptions
 		opt = setBadgerOptions(opt, Config.WALDir)
 		opt.ValueLogMaxEntries = 10000 // Allow for easy space reclamation.
@@ -159,8 +166,9 @@ func (s *ServerState) initStorage() {
 		// All the writes to posting store should be synchronous. We use batched writers
 		// for posting lists, so the cost of sync writes is amortized.
 		x.Check(os.MkdirAll(Config.PostingDir, 0700))
+		x.Check(x.CheckFormatVersion(Config.PostingDir))
 		opt := badger.DefaultOptions
-		opt.ValueThreshold = 1 << 10 // 1KB
+		opt.ValueThreshold = x.PostingValueThreshold
 		opt.NumVersionsToKeep = math.MaxInt32
 		opt = setBadgerOptions(opt, Config.PostingDir)
 
@@ -218,7 +226,7 @@ func (s *ServerState) fillTimestampRequests() {
 			if r.readOnly {
 				num.ReadOnly = true
 			} else {
-				num.Val += 1
+				num.Val += r.num
 			}
 		}
 
@@ -243,7 +251,7 @@ func (s *ServerState) fillTimestampRequests() {
 				req.ch <- ts.ReadOnly
 			} else {
 				req.ch <- ts.StartId + offset
-				offset++
+				offset += req.num
 			}
 		}
 		x.AssertTrue(ts.StartId == 0 || ts.StartId+offset-1 == ts.EndId)
@@ -252,26 +260,37 @@ func (s *ServerState) fillTimestampRequests() {
 
 type tsReq struct {
 	readOnly bool
+	// The number of timestamps needed if not readOnly, of which the first is sent.
+	num uint64
 	// A one-shot chan which we can send a txn timestamp upon.
 	ch chan uint64
 }
 
 func (s *ServerState) getTimestamp(readOnly bool) uint64 {
-	tr := tsReq{readOnly: readOnly, ch: make(chan uint64)}
+	return s.getTimestamps(readOnly, 1)
+}
+
+// getTimestamps returns the first of num consecutive timestamps.
+func (s *ServerState) getTimestamps(readOnly bool, num uint64) uint64 {
+	tr := tsReq{readOnly: readOnly, num: num, ch: make(chan uint64)}
 	s.needTs <- tr
 	return <-tr.ch
 }
 
-func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, error) {
+func (s *Server) Alter(ctx context.Context, op *api.Operation) (resp *api.Payload, err error) {
 	ctx, span := otrace.StartSpan(ctx, "Server.Alter")
 	defer span.End()
 	span.Annotatef(nil, "Alter operation: %+v", op)
+	defer func() { auditAlter(ctx, op, err) }()
 
 	// Always print out Alter operations because they are important and rare.
 	glog.Infof("Received ALTER op: %+v", op)
 
+	if err := setDropOp(op); err != nil {
+		return nil, err
+	}
 	// The following code block checks if the operation should run or not.
-	if op.Schema == "" && op.DropAttr == "" && !op.DropAll {
+	if op.Schema == "" && op.DropAttr == "" && !op.DropAll && op.DropOp != api.Operation_DATA {
 		// Must have at least one field set. This helps users if they attempt
 		// to set a field but use the wrong name (could be decoded from JSON).
 		return nil, x.Errorf("Operation must have at least one field set")
@@ -280,51 +299,123 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 	if err := x.HealthCheck(); err != nil {
 		return empty, err
 	}
+	if Draining() {
+		return empty, errDraining
+	}
 	if !isMutationAllowed(ctx) {
 		return nil, x.Errorf("No mutations allowed by server.")
 	}
+	ctx, ns, err := withNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if err := isAlterAllowed(ctx); err != nil {
 		glog.Warningf("Alter denied with error: %v\n", err)
 		return nil, err
 	}
+	if err := authorizeAlter(ctx, op); err != nil {
+		glog.Warningf("Alter denied with error: %v\n", err)
+		return nil, err
+	}
 	// All checks done.
 
 	defer glog.Infof("ALTER op: %+v done", op)
 	// StartTs is not needed if the predicate to be dropped lies on this server but is required
 	// if it lies on some other machine. Let's get it for safety.
 	m := &pb.Mutations{StartTs: State.getTimestamp(false)}
-	if op.DropAll {
+	if op.DropAll && ns == x.DefaultNamespace {
+		// Dropping all the data from the default namespace drops the data of every namespace.
 		m.DropAll = true
 		_, err := query.ApplyMutations(ctx, m)
 		return empty, err
 	}
-	if len(op.DropAttr) > 0 {
-		nq := &api.NQuad{
-			Subject:     x.Star,
-			Predicate:   op.DropAttr,
-			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: x.Star}},
-		}
-		wnq := &gql.NQuad{NQuad: nq}
-		edge, err := wnq.ToDeletePredEdge()
-		if err != nil {
+	if op.DropOp == api.Operation_DATA {
+		if ns == x.DefaultNamespace {
+			// Like DropAll, dropping the data of the default namespace drops the data of every
+			// namespace.
+			m.DropData = true
+		} else if m.DropDataPreds, err = namespacePredicates(ctx, ns); err != nil {
 			return empty, err
+		} else if len(m.DropDataPreds) == 0 {
+			return empty, nil
+		}
+		_, err := query.ApplyMutations(ctx, m)
+		return empty, err
+	}
+	if op.DropAll || len(op.DropAttr) > 0 {
+		var preds []string
+		if op.DropAll {
+			// Only the predicates of the namespace are dropped.
+			if preds, err = namespacePredicates(ctx, ns); err != nil {
+				return empty, err
+			}
+		} else {
+			attr, err := namespaceAttr(ns, op.DropAttr)
+			if err != nil {
+				return empty, err
+			}
+			preds = append(preds, attr)
+		}
+		for _, pred := range preds {
+			nq := &api.NQuad{
+				Subject:     x.Star,
+				Predicate:   pred,
+				ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: x.Star}},
+			}
+			wnq := &gql.NQuad{NQuad: nq}
+			edge, err := wnq.ToDeletePredEdge()
+			if err != nil {
+				return empty, err
+			}
+			m.Edges = append(m.Edges, edge)
+		}
+		if len(m.Edges) == 0 {
+			return empty, nil
 		}
-		edges := []*pb.DirectedEdge{edge}
-		m.Edges = edges
 		_, err = query.ApplyMutations(ctx, m)
 		return empty, err
 	}
-	updates, err := schema.Parse(op.Schema)
+	result, err := schema.ParseWithTypes(op.Schema)
 	if err != nil {
 		return empty, err
 	}
-	glog.Infof("Got schema: %+v\n", updates)
+	for _, update := range result.Schemas {
+		if update.Predicate, err = namespaceAttr(ns, update.Predicate); err != nil {
+			return empty, err
+		}
+	}
+	if err := namespaceTypes(ns, result.Types); err != nil {
+		return empty, err
+	}
+	if len(result.Types) > 0 && ns != x.DefaultNamespace {
+		// Only the default namespace gets dgraph.type with the initial schema.
+		result.Schemas = append(result.Schemas, typeAttrSchema(ns))
+	}
+	glog.Infof("Got schema: %+v, types: %+v\n", result.Schemas, result.Types)
 	// TODO: Maybe add some checks about the schema.
-	m.Schema = updates
+	m.Schema = result.Schemas
+	m.Types = result.Types
 	_, err = query.ApplyMutations(ctx, m)
 	return empty, err
 }
 
+// setDropOp sets the fields of the operation the drop op stands for, so that an operation with
+// drop_op ALL or ATTR runs like one with drop_all or drop_attr. Dropping types isn't supported.
+func setDropOp(op *api.Operation) error {
+	switch op.DropOp {
+	case api.Operation_ALL:
+		op.DropAll = true
+	case api.Operation_ATTR:
+		if len(op.DropValue) == 0 {
+			return x.Errorf("The predicate to drop must be set in drop_value")
+		}
+		op.DropAttr = op.DropValue
+	case api.Operation_TYPE:
+		return x.Errorf("Dropping a type isn't supported")
+	}
+	return nil
+}
+
 func annotateStartTs(span *otrace.Span, ts uint64) {
 	span.Annotate([]otrace.Attribute{otrace.Int64Attribute("startTs", int64(ts))}, "")
 }
@@ -333,6 +424,9 @@ func (s *Server) Mutate(ctx context.Context, mu *api.Mutation) (resp *api.Assign
 	ctx, span := otrace.StartSpan(ctx, "Server.Mutate")
 	defer span.End()
 
+	var gmu *gql.Mutation
+	defer func() { auditMutation(ctx, gmu, mu.StartTs, resp, err) }()
+
 	resp = &api.Assigned{}
 	if err := x.HealthCheck(); err != nil {
 		return resp, err
@@ -347,7 +441,22 @@ func (s *Server) Mutate(ctx context.Context, mu *api.Mutation) (resp *api.Assign
 	if !isMutationAllowed(ctx) {
 		return nil, x.Errorf("No mutations allowed.")
 	}
-	if mu.StartTs == 0 {
+	if err := admitTxn(mu.StartTs); err != nil {
+		return resp, err
+	}
+	release, err := admit.admitMutation()
+	if err != nil {
+		return resp, err
+	}
+	defer release()
+	ctx, ns, err := withNamespace(ctx)
+	if err != nil {
+		return resp, err
+	}
+	ludicrous := isLudicrous(mu.StartTs, mu.CommitNow)
+	if ludicrous {
+		mu.StartTs = State.leaseTimestamp()
+	} else if mu.StartTs == 0 {
 		mu.StartTs = State.getTimestamp(false)
 	}
 	annotateStartTs(span, mu.StartTs)
@@ -361,10 +470,16 @@ func (s *Server) Mutate(ctx context.Context, mu *api.Mutation) (resp *api.Assign
 
 	var l query.Latency
 	l.Start = time.Now()
-	gmu, err := parseMutationObject(mu)
+	gmu, err = parseMutationObject(mu)
 	if err != nil {
 		return resp, err
 	}
+	if err := authorizeMutation(ctx, gmu); err != nil {
+		return resp, err
+	}
+	if err := namespaceMutation(ns, gmu); err != nil {
+		return resp, err
+	}
 	parseEnd := time.Now()
 	l.Parsing = parseEnd.Sub(l.Start)
 	defer func() {
@@ -384,14 +499,27 @@ func (s *Server) Mutate(ctx context.Context, mu *api.Mutation) (resp *api.Assign
 	if err != nil {
 		return resp, err
 	}
+	if err := admit.admitEdges(ctx, len(edges)); err != nil {
+		return resp, err
+	}
 
 	m := &pb.Mutations{
-		Edges:   edges,
-		StartTs: mu.StartTs,
+		Edges:     edges,
+		StartTs:   mu.StartTs,
+		Ludicrous: ludicrous,
 	}
 	span.Annotatef(nil, "Applying mutations: %+v", m)
 	resp.Context, err = query.ApplyMutations(ctx, m)
 	span.Annotatef(nil, "Txn Context: %+v. Err=%v", resp.Context, err)
+	if ludicrous {
+		// The groups committed the mutations on their own, there's nothing to abort either.
+		if err != nil {
+			return resp, err
+		}
+		resp.Context.Keys = resp.Context.Keys[:0]
+		resp.Context.CommitTs = mu.StartTs
+		return resp, nil
+	}
 	if !mu.CommitNow {
 		if err == y.ErrConflict {
 			err = status.Error(codes.FailedPrecondition, err.Error())
@@ -434,9 +562,153 @@ func (s *Server) Mutate(ctx context.Context, mu *api.Mutation) (resp *api.Assign
 	return resp, nil
 }
 
+// Upsert runs the query of the upsert block, and then the mutations whose conditions hold for the
+// uids it found, with uid(v) in them standing for the uids in the variable v. They all run in the
+// transaction started at startTs, or in a new one if it's zero, which is committed if commitNow
+// is set. The transaction is aborted if a mutation fails while committing immediately; otherwise
+// it's up to the client to abort it.
+func (s *Server) Upsert(ctx context.Context, up *gql.Upsert, startTs uint64,
+	commitNow bool) (resp *api.Assigned, err error) {
+	ctx, span := otrace.StartSpan(ctx, "Server.Upsert")
+	defer span.End()
+	defer func() { auditUpsert(ctx, startTs, resp, err) }()
+
+	resp = &api.Assigned{Uids: make(map[string]string)}
+	if err := x.HealthCheck(); err != nil {
+		return resp, err
+	}
+	if !isMutationAllowed(ctx) {
+		return nil, x.Errorf("No mutations allowed.")
+	}
+	if err := admitTxn(startTs); err != nil {
+		return resp, err
+	}
+	if startTs == 0 {
+		startTs = State.getTimestamp(false)
+	}
+	annotateStartTs(span, startTs)
+
+	var l query.Latency
+	l.Start = time.Now()
+	vars := up.Vars()
+	parsedReq, err := gql.ParseWithNeedVars(gql.Request{Str: up.Query}, vars)
+	if err != nil {
+		return resp, err
+	}
+	if err := authorizeQuery(ctx, &parsedReq); err != nil {
+		return resp, err
+	}
+	ctx, ns, err := withNamespace(ctx)
+	if err != nil {
+		return resp, err
+	}
+	if err := namespaceQuery(ns, parsedReq.Query); err != nil {
+		return resp, err
+	}
+	defer func() {
+		resp.Latency = &api.Latency{
+			ParsingNs:    uint64(l.Parsing.Nanoseconds()),
+			ProcessingNs: uint64(l.Processing.Nanoseconds()),
+		}
+	}()
+
+	queryRequest := query.QueryRequest{
+		Latency:  &l,
+		GqlQuery: &parsedReq,
+		ReadTs:   startTs,
+	}
+	if err := queryRequest.ProcessQuery(ctx); err != nil {
+		return resp, x.Wrap(err)
+	}
+	upVars, err := upsertVars(&queryRequest, vars)
+	if err != nil {
+		return resp, err
+	}
+	span.Annotatef(nil, "Upsert variables: %+v", upVars)
+
+	resp.Context = &api.TxnContext{StartTs: startTs}
+	for _, umu := range up.Mutations {
+		if !umu.Holds(upVars) {
+			continue
+		}
+		mu, err := umu.WithVars(upVars)
+		if err != nil {
+			return resp, err
+		}
+		if len(mu.SetNquads) == 0 && len(mu.DelNquads) == 0 {
+			// All of its N-Quads used variables without uids or values.
+			continue
+		}
+		mu.StartTs = startTs
+		mu.CommitNow = false
+		assigned, err := s.Mutate(ctx, mu)
+		if err != nil {
+			if commitNow {
+				resp.Context.Aborted = true
+				_, _ = worker.CommitOverNetwork(ctx, resp.Context)
+			}
+			return resp, err
+		}
+		for blank, uid := range assigned.Uids {
+			resp.Uids[blank] = uid
+		}
+		resp.Context.Keys = append(resp.Context.Keys, assigned.Context.GetKeys()...)
+	}
+	if !commitNow {
+		return resp, nil
+	}
+
+	cts, err := worker.CommitOverNetwork(ctx, resp.Context)
+	span.Annotatef(nil, "Status of commit at ts: %d: %v", startTs, err)
+	if err != nil {
+		if err == y.ErrAborted {
+			err = status.Errorf(codes.Aborted, err.Error())
+			resp.Context.Aborted = true
+		}
+		return resp, err
+	}
+	// CommitNow was true, no need to send keys.
+	resp.Context.Keys = resp.Context.Keys[:0]
+	resp.Context.CommitTs = cts
+	return resp, nil
+}
+
+// upsertVars returns the variables used by the mutations of an upsert, with the values of the
+// value variables turned into the literals of N-Quads.
+func upsertVars(req *query.QueryRequest, vars []string) (*gql.UpsertVars, error) {
+	upVars := &gql.UpsertVars{
+		Uids: make(map[string][]uint64, len(vars)),
+		Vals: make(map[string]map[uint64]string),
+	}
+	for _, v := range vars {
+		upVars.Uids[v] = req.Uids(v)
+		vals := req.Vals(v)
+		if len(vals) == 0 {
+			continue
+		}
+		upVars.Vals[v] = make(map[uint64]string, len(vals))
+		for uid, val := range vals {
+			str := types.ValueForType(types.StringID)
+			if err := types.Marshal(val, &str); err != nil {
+				return nil, x.Wrapf(err, "While reading the value of %s for uid %#x", v, uid)
+			}
+			upVars.Vals[v][uid] = strconv.Quote(str.Value.(string))
+		}
+	}
+	return upVars, nil
+}
+
 // This method is used to execute the query and return the response to the
 // client as a protocol buffer message.
-func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Response, err error) {
+func (s *Server) Query(ctx context.Context, req *api.Request) (*api.Response, error) {
+	return s.doQuery(ctx, req, true)
+}
+
+// doQuery runs the query, checking the user is allowed to read the predicates it uses if
+// authorize is set. It's unset for the queries the server runs on its own behalf, like the
+// ones looking up the users and their acls.
+func (s *Server) doQuery(ctx context.Context, req *api.Request, authorize bool) (
+	resp *api.Response, err error) {
 	if glog.V(3) {
 		glog.Infof("Got a query: %+v", req)
 	}
@@ -450,17 +722,140 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Respons
 	x.PendingQueries.Add(1)
 	x.NumQueries.Add(1)
 	defer x.PendingQueries.Add(-1)
+
+	var l query.Latency
+	var er query.ExecuteResult
+	if authorize {
+		// The queries the server runs on its own behalf aren't limited, nor logged as they
+		// might have passwords in their variables.
+		if err := admitTxn(req.StartTs); err != nil {
+			return resp, err
+		}
+		var release func()
+		if release, err = admit.admitQuery(); err != nil {
+			return resp, err
+		}
+		defer release()
+		var cancel context.CancelFunc
+		ctx, cancel = withQueryTimeout(ctx)
+		defer cancel()
+		defer func() {
+			logSlowQuery(ctx, req, &l, er.Subgraphs, err)
+		}()
+	}
+	var cq *cachedQuery
+	resp, er, cq, err = processQuery(ctx, req, authorize, &l)
+	if err != nil {
+		return resp, timeoutError(ctx, err)
+	}
+	if resp.Json != nil {
+		// Served from the query cache, or the results of a schema query.
+		resp.Latency = queryLatency(&l)
+		return resp, nil
+	}
+
+	json, err := query.ToJson(&l, er.Subgraphs)
+	if err != nil {
+		return resp, err
+	}
+	resp.Json = json
+	span.Annotatef(nil, "Response = %s", json)
+	if cq != nil {
+		cq.json = json
+		queryResults.add(cq)
+	}
+
+	resp.Latency = queryLatency(&l)
+	return resp, err
+}
+
+// queryChunkSize is the size of the chunks of the JSON encoding of the results sent by
+// QueryStream.
+const queryChunkSize = 1 << 20
+
+// QueryStream runs the query like Query does, but sends the JSON encoding of the results in
+// chunks as it's written, so that large results are never held in memory at once. The first
+// response has the txn context and the schema, and the last one has the latency.
+func (s *Server) QueryStream(req *api.Request, stream pb.Dgraph_QueryStreamServer) (
+	err error) {
+	if glog.V(3) {
+		glog.Infof("Got a streaming query: %+v", req)
+	}
+	ctx, span := otrace.StartSpan(stream.Context(), "Server.QueryStream")
+	defer span.End()
+
+	if err := x.HealthCheck(); err != nil {
+		return err
+	}
+
+	x.PendingQueries.Add(1)
+	x.NumQueries.Add(1)
+	defer x.PendingQueries.Add(-1)
+
+	if err := admitTxn(req.StartTs); err != nil {
+		return err
+	}
+	release, err := admit.admitQuery()
+	if err != nil {
+		return err
+	}
+	defer release()
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var l query.Latency
+	var er query.ExecuteResult
+	defer func() {
+		logSlowQuery(ctx, req, &l, er.Subgraphs, err)
+	}()
+	var resp *api.Response
+	// The results are only served from the query cache, not added to it, as the ones worth
+	// streaming are too large to be kept.
+	resp, er, _, err = processQuery(ctx, req, true, &l)
+	if err != nil {
+		return timeoutError(ctx, err)
+	}
+	if resp.Json != nil {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		return stream.Send(&api.Response{Latency: queryLatency(&l)})
+	}
+
+	// Send blocks while the client is behind on reading the stream, which holds back the
+	// encoding of the rest of the results until it catches up.
+	var numChunks int
+	err = query.ToJsonStream(&l, er.Subgraphs, queryChunkSize, func(chunk []byte) error {
+		resp.Json = chunk
+		numChunks++
+		err := stream.Send(resp)
+		resp = &api.Response{}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	span.Annotatef(nil, "Sent the response in %d chunks", numChunks)
+	return stream.Send(&api.Response{Latency: queryLatency(&l)})
+}
+
+// processQuery parses and processes the query of req, leaving only the encoding of the results
+// to be done. The returned response has the txn context set, and also the results if they were
+// served from the query cache or if the query is a schema query. Otherwise, the returned
+// cachedQuery is set if the results can be added to the cache.
+func processQuery(ctx context.Context, req *api.Request, authorize bool, l *query.Latency) (
+	resp *api.Response, er query.ExecuteResult, cq *cachedQuery, err error) {
+	span := otrace.FromContext(ctx)
 	if ctx.Err() != nil {
-		return resp, ctx.Err()
+		return resp, er, nil, ctx.Err()
 	}
 
 	resp = new(api.Response)
 	if len(req.Query) == 0 {
 		span.Annotate(nil, "Empty query")
-		return resp, fmt.Errorf("empty query")
+		return resp, er, nil, fmt.Errorf("empty query")
 	}
 
-	var l query.Latency
 	l.Start = time.Now()
 	span.Annotatef(nil, "Query received: %v", req)
 
@@ -469,9 +864,55 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Respons
 		Variables: req.Vars,
 	})
 	if err != nil {
-		return resp, err
+		return resp, er, nil, err
+	}
+	if authorize {
+		if err := authorizeQuery(ctx, &parsedReq); err != nil {
+			return resp, er, nil, err
+		}
+	}
+	ctx, ns, err := withNamespace(ctx)
+	if err != nil {
+		return resp, er, nil, err
+	}
+	if err := namespaceQuery(ns, parsedReq.Query); err != nil {
+		return resp, er, nil, err
+	}
+	if parsedReq.Schema != nil {
+		for i, pred := range parsedReq.Schema.Predicates {
+			if parsedReq.Schema.Predicates[i], err = namespaceAttr(ns, pred); err != nil {
+				return resp, er, nil, err
+			}
+		}
+		for i, name := range parsedReq.Schema.TypeNames {
+			if parsedReq.Schema.TypeNames[i], err = namespaceAttr(ns, name); err != nil {
+				return resp, er, nil, err
+			}
+		}
+		// The patterns are matched against the names of the predicates in the namespace.
+		parsedReq.Schema.Namespace = ns
+		if x.HasString(parsedReq.Schema.Fields, "graphql") {
+			if parsedReq.Schema.GraphqlSchema, err = graphqlSchema(ctx, ns); err != nil {
+				return resp, er, nil, err
+			}
+		}
+	}
+
+	if authorize {
+		// The queries the server runs on its own behalf might have passwords in their variables.
+		cq = newCachedQuery(ctx, ns, req, &parsedReq)
 	}
 
+	if req.BestEffort {
+		if !req.ReadOnly {
+			return resp, er, nil, x.Errorf("A best effort query must be read-only.")
+		}
+		// Serve the query at the highest timestamp this Alpha has applied, instead of
+		// asking Zero for a new one. The results might be slightly stale.
+		if req.StartTs == 0 {
+			req.StartTs = posting.Oracle().MaxAssigned()
+		}
+	}
 	if req.StartTs == 0 {
 		req.StartTs = State.getTimestamp(req.ReadOnly)
 	}
@@ -480,34 +921,116 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Respons
 	}
 	annotateStartTs(span, req.StartTs)
 
+	if cq != nil {
+		// The oracle has to catch up to the read ts to know about the commits until then, which
+		// the query would wait for anyway.
+		if err := posting.Oracle().WaitForTs(ctx, req.StartTs); err != nil {
+			return resp, er, nil, err
+		}
+		if cached, ok := queryResults.get(cq, req.StartTs); ok {
+			span.Annotatef(nil, "Served from the query cache, read at %d", cached.readTs)
+			resp.Json = cached.json
+			return resp, er, nil, nil
+		}
+		cq.readTs, cq.epoch = req.StartTs, currentEpoch()
+	}
+
 	var queryRequest = query.QueryRequest{
-		Latency:  &l,
+		Latency:  l,
 		GqlQuery: &parsedReq,
 		ReadTs:   req.StartTs,
 	}
 
 	// Core processing happens here.
-	var er query.ExecuteResult
 	if er, err = queryRequest.Process(ctx); err != nil {
-		return resp, x.Wrap(err)
+		return resp, er, nil, x.Wrap(err)
+	}
+	if parsedReq.Schema != nil {
+		schema := namespaceSchema(ns, er.SchemaNode)
+		if authorize {
+			if schema, err = filterSchema(ctx, schema); err != nil {
+				return resp, er, nil, err
+			}
+		}
+		stripTypesNamespace(er.Types)
+		if err = setSchema(resp, parsedReq.Schema, schema, er); err != nil {
+			return resp, er, nil, err
+		}
 	}
-	resp.Schema = er.SchemaNode
 
-	json, err := query.ToJson(&l, er.Subgraphs)
+	return resp, er, cq, nil
+}
+
+// graphqlSchema returns the schema the GraphQL schema of the namespace expects of its
+// predicates, under their names in the namespace. It's empty if no GraphQL schema was uploaded.
+func graphqlSchema(ctx context.Context, ns uint64) ([]*pb.SchemaUpdate, error) {
+	if Config.GraphQLSchema == nil {
+		return nil, nil
+	}
+	sch, err := Config.GraphQLSchema(ctx)
 	if err != nil {
-		return resp, err
+		return nil, x.Wrapf(err, "while reading the GraphQL schema")
 	}
-	resp.Json = json
-	span.Annotatef(nil, "Response = %s", json)
+	updates, err := schema.Parse(sch)
+	if err != nil {
+		return nil, x.Wrapf(err, "while parsing the schema of the GraphQL schema")
+	}
+	for _, update := range updates {
+		if update.Predicate, err = namespaceAttr(ns, update.Predicate); err != nil {
+			return nil, err
+		}
+	}
+	return updates, nil
+}
 
-	gl := &api.Latency{
+func queryLatency(l *query.Latency) *api.Latency {
+	return &api.Latency{
 		ParsingNs:    uint64(l.Parsing.Nanoseconds()),
 		ProcessingNs: uint64(l.Processing.Nanoseconds()),
 		EncodingNs:   uint64(l.Json.Nanoseconds()),
 	}
+}
 
-	resp.Latency = gl
-	return resp, err
+// setSchema sets the schema read by the schema query s in the response, ordered by predicate
+// unless s asked for another order, along with the types it read. Its JSON has every field of
+// the schema nodes, while the deprecated schema of the response, which the clients of older
+// versions read, only has the fields api.SchemaNode has.
+func setSchema(resp *api.Response, s *pb.SchemaRequest, nodes []*pb.SchemaNode,
+	er query.ExecuteResult) error {
+	if nodes == nil {
+		nodes = []*pb.SchemaNode{}
+	}
+	if s.Sort == "" {
+		sort.Slice(nodes, func(i, j int) bool {
+			return nodes[i].Predicate < nodes[j].Predicate
+		})
+	}
+	js, err := json.Marshal(struct {
+		Schema             []*pb.SchemaNode `json:"schema"`
+		SchemaVersion      uint64           `json:"schema_version,omitempty"`
+		SchemaFailedGroups []uint32         `json:"schema_failed_groups,omitempty"`
+		Types              []*pb.TypeUpdate `json:"types,omitempty"`
+	}{Schema: nodes, SchemaVersion: er.SchemaVersion, SchemaFailedGroups: er.SchemaFailedGroups,
+		Types: er.Types})
+	if err != nil {
+		return err
+	}
+	resp.Json = js
+	resp.Schema = make([]*api.SchemaNode, 0, len(nodes))
+	for _, n := range nodes {
+		resp.Schema = append(resp.Schema, &api.SchemaNode{
+			Predicate: n.Predicate,
+			Type:      n.Type,
+			Index:     n.Index,
+			Tokenizer: n.Tokenizer,
+			Reverse:   n.Reverse,
+			Count:     n.Count,
+			List:      n.List,
+			Upsert:    n.Upsert,
+			Lang:      n.Lang,
+		})
+	}
+	return nil
 }
 
 func (s *Server) CommitOrAbort(ctx context.Context, tc *api.TxnContext) (*api.TxnContext, error) {
@@ -526,6 +1049,7 @@ func (s *Server) CommitOrAbort(ctx context.Context, tc *api.TxnContext) (*api.Tx
 
 	span.Annotatef(nil, "Txn Context received: %+v", tc)
 	commitTs, err := worker.CommitOverNetwork(ctx, tc)
+	AuditCommit(ctx, tc, commitTs, err)
 	if err == y.ErrAborted {
 		tctx.Aborted = true
 		return tctx, status.Errorf(codes.Aborted, err.Error())
@@ -606,14 +1130,14 @@ func parseNQuads(b []byte) ([]*api.NQuad, error) {
 func parseMutationObject(mu *api.Mutation) (*gql.Mutation, error) {
 	res := &gql.Mutation{}
 	if len(mu.SetJson) > 0 {
-		nqs, err := nquadsFromJson(mu.SetJson, set)
+		nqs, err := nquadsFromJson(mu.SetJson, setNquads)
 		if err != nil {
 			return nil, err
 		}
 		res.Set = append(res.Set, nqs...)
 	}
 	if len(mu.DeleteJson) > 0 {
-		nqs, err := nquadsFromJson(mu.DeleteJson, delete)
+		nqs, err := nquadsFromJson(mu.DeleteJson, deleteNquads)
 		if err != nil {
 			return nil, err
 		}
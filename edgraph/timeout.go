@@ -0,0 +1,46 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A query is stopped once the deadline of its request, set by the client, or the server-wide
+// QueryTimeout has passed, whichever comes first. The deadline is checked between the blocks of
+// work all the way down to the tasks run on the posting lists, so that a runaway query is killed
+// instead of pinning a CPU until it's done.
+
+// withQueryTimeout returns ctx with the deadline of Config.QueryTimeout, unless it's unset or the
+// request already has an earlier deadline.
+func withQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if Config.QueryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, Config.QueryTimeout)
+}
+
+// timeoutError returns the error to send back for a query which failed with err, telling the
+// client with DeadlineExceeded that it was stopped because it took too long.
+func timeoutError(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() != context.DeadlineExceeded {
+		return err
+	}
+	return status.Errorf(codes.DeadlineExceeded, "Query timed out: %v", err)
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQueryTimeout(t *testing.T) {
+	defer func(d time.Duration) { Config.QueryTimeout = d }(Config.QueryTimeout)
+
+	Config.QueryTimeout = 0
+	ctx, cancel := withQueryTimeout(context.Background())
+	_, ok := ctx.Deadline()
+	require.False(t, ok)
+	cancel()
+
+	// The earlier deadline of the request is kept.
+	Config.QueryTimeout = time.Hour
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancelParent()
+	ctx, cancel = withQueryTimeout(parent)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	require.True(t, time.Until(deadline) < time.Minute)
+
+	err := errors.New("failed")
+	require.Equal(t, err, timeoutError(ctx, err))
+	<-ctx.Done()
+	require.Equal(t, codes.DeadlineExceeded, status.Code(timeoutError(ctx, err)))
+	require.NoError(t, timeoutError(ctx, nil))
+}
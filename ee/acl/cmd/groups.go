@@ -16,7 +16,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/dgraph-io/dgo"
 	"github.com/dgraph-io/dgo/protos/api"
@@ -34,7 +33,7 @@ func groupAdd(conf *viper.Viper) error {
 
 	dc, close := getDgraphClient(conf)
 	defer close()
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := newContext(conf)
 	defer cancel()
 	txn := dc.NewTxn()
 	defer func() {
@@ -79,7 +78,7 @@ func groupDel(conf *viper.Viper) error {
 
 	dc, close := getDgraphClient(conf)
 	defer close()
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := newContext(conf)
 	defer cancel()
 	txn := dc.NewTxn()
 	defer func() {
@@ -140,11 +139,6 @@ func queryGroup(ctx context.Context, txn *dgo.Txn, groupid string,
 	return group, nil
 }
 
-type Acl struct {
-	Predicate string `json:"predicate"`
-	Perm      int32  `json:"perm"`
-}
-
 func chMod(conf *viper.Viper) error {
 	groupId := conf.GetString("group")
 	predicate := conf.GetString("pred")
@@ -158,7 +152,7 @@ func chMod(conf *viper.Viper) error {
 
 	dc, close := getDgraphClient(conf)
 	defer close()
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := newContext(conf)
 	defer cancel()
 	txn := dc.NewTxn()
 	defer func() {
@@ -176,15 +170,13 @@ func chMod(conf *viper.Viper) error {
 			groupId)
 	}
 
-	var currentAcls []Acl
-	if len(group.Acls) != 0 {
-		if err := json.Unmarshal([]byte(group.Acls), &currentAcls); err != nil {
-			return fmt.Errorf("unable to unmarshal the acls associated with the group %v:%v",
-				groupId, err)
-		}
+	currentAcls, err := acl.UnmarshalAcls(group.Acls)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal the acls associated with the group %v:%v",
+			groupId, err)
 	}
 
-	newAcls, updated := updateAcl(currentAcls, Acl{
+	newAcls, updated := updateAcl(currentAcls, acl.Acl{
 		Predicate: predicate,
 		Perm:      int32(perm),
 	})
@@ -218,7 +210,7 @@ func chMod(conf *viper.Viper) error {
 }
 
 // returns whether the existing acls slice is changed
-func updateAcl(acls []Acl, newAcl Acl) ([]Acl, bool) {
+func updateAcl(acls []acl.Acl, newAcl acl.Acl) ([]acl.Acl, bool) {
 	for idx, aclEntry := range acls {
 		if aclEntry.Predicate == newAcl.Predicate {
 			if aclEntry.Perm == newAcl.Perm {
@@ -14,12 +14,13 @@ package acl
 import (
 	"testing"
 
+	"github.com/dgraph-io/dgraph/ee/acl"
 	"github.com/stretchr/testify/require"
 )
 
 func TestUpdateAcl(t *testing.T) {
-	var currenAcls []Acl
-	newAcl := Acl{
+	var currenAcls []acl.Acl
+	newAcl := acl.Acl{
 		Predicate: "friend",
 		Perm:      4,
 	}
@@ -41,7 +42,7 @@ func TestUpdateAcl(t *testing.T) {
 	require.Equal(t, 1, len(updatedAcls3), "the updated acl list should still have 1 element")
 	require.Equal(t, int32(6), updatedAcls3[0].Perm, "the updated perm should be 6 now")
 
-	newAcl = Acl{
+	newAcl = acl.Acl{
 		Predicate: "buddy",
 		Perm:      6,
 	}
@@ -50,7 +51,7 @@ func TestUpdateAcl(t *testing.T) {
 		"with element of new predicate")
 	require.Equal(t, 2, len(updatedAcls4), "the acl list should have 2 elements now")
 
-	newAcl = Acl{
+	newAcl = acl.Acl{
 		Predicate: "buddy",
 		Perm:      -3,
 	}
@@ -14,7 +14,6 @@ package acl
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -22,10 +21,12 @@ import (
 
 	"github.com/dgraph-io/dgo"
 	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/ee/acl"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc/metadata"
 )
 
 type options struct {
@@ -45,6 +46,9 @@ func init() {
 
 	flag := CmdAcl.Cmd.PersistentFlags()
 	flag.StringP("dgraph", "d", "127.0.0.1:9080", "Dgraph gRPC server address")
+	flag.String("auth_token", "", "The auth token the alpha was started with. It's needed"+
+		" to manage the users and groups once their access is controlled.")
+	flag.Uint64("namespace", 0, "The namespace of the users and groups managed.")
 
 	// TLS configuration
 	x.RegisterTLSFlags(flag)
@@ -224,6 +228,20 @@ func getDgraphClient(conf *viper.Viper) (*dgo.Dgraph, CloseFunc) {
 	}
 }
 
+// newContext returns the context to send the requests with, carrying the auth token so that
+// the alpha lets them through its access control, and the namespace they run in.
+func newContext(conf *viper.Viper) (context.Context, context.CancelFunc) {
+	md := metadata.New(nil)
+	if token := conf.GetString("auth_token"); len(token) > 0 {
+		md.Append("auth-token", token)
+	}
+	if ns := conf.GetString("namespace"); len(ns) > 0 && ns != "0" {
+		md.Append("namespace", ns)
+	}
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	return context.WithTimeout(ctx, 30*time.Second)
+}
+
 func info(conf *viper.Viper) error {
 	userId := conf.GetString("user")
 	groupId := conf.GetString("group")
@@ -234,7 +252,7 @@ func info(conf *viper.Viper) error {
 
 	dc, close := getDgraphClient(conf)
 	defer close()
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := newContext(conf)
 	defer cancel()
 	txn := dc.NewTxn()
 	defer func() {
@@ -278,14 +296,15 @@ func info(conf *viper.Viper) error {
 		groupSB.WriteString(fmt.Sprintf("users:%v\n", strings.Join(userNames, " ")))
 
 		var aclStrs []string
-		var acls []Acl
-		if err := json.Unmarshal([]byte(group.Acls), &acls); err != nil {
+		acls, err := acl.UnmarshalAcls(group.Acls)
+		if err != nil {
 			return fmt.Errorf("unable to unmarshal the acls associated with the group %v:%v",
 				groupId, err)
 		}
 
-		for _, acl := range acls {
-			aclStrs = append(aclStrs, fmt.Sprintf("(predicate:%v,perm:%v)", acl.Predicate, acl.Perm))
+		for _, entry := range acls {
+			aclStrs = append(aclStrs, fmt.Sprintf("(predicate:%v,perm:%v)", entry.Predicate,
+				entry.Perm))
 		}
 		groupSB.WriteString(fmt.Sprintf("acls:%v\n", strings.Join(aclStrs, " ")))
 
@@ -16,7 +16,6 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/dgraph-io/dgo"
 	"github.com/dgraph-io/dgo/protos/api"
@@ -39,7 +38,7 @@ func userAdd(conf *viper.Viper) error {
 
 	dc, close := getDgraphClient(conf)
 	defer close()
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := newContext(conf)
 	defer cancel()
 	txn := dc.NewTxn()
 	defer func() {
@@ -90,7 +89,7 @@ func userDel(conf *viper.Viper) error {
 
 	dc, close := getDgraphClient(conf)
 	defer close()
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := newContext(conf)
 	defer cancel()
 	txn := dc.NewTxn()
 	defer func() {
@@ -141,7 +140,7 @@ func userLogin(conf *viper.Viper) error {
 
 	dc, close := getDgraphClient(conf)
 	defer close()
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := newContext(conf)
 	defer cancel()
 	txn := dc.NewTxn()
 	defer func() {
@@ -153,9 +152,7 @@ func userLogin(conf *viper.Viper) error {
 	if err := dc.Login(ctx, userid, password); err != nil {
 		return fmt.Errorf("unable to login:%v", err)
 	}
-	updatedContext := dc.GetContext(ctx)
-	glog.Infof("Login successfully.\naccess jwt:\n%v\nrefresh jwt:\n%v",
-		updatedContext.Value("accessJwt"), updatedContext.Value("refreshJwt"))
+	glog.Infof("Login successfully.")
 	return nil
 }
 
@@ -195,7 +192,7 @@ func userMod(conf *viper.Viper) error {
 
 	dc, close := getDgraphClient(conf)
 	defer close()
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := newContext(conf)
 	defer cancel()
 	txn := dc.NewTxn()
 	defer func() {
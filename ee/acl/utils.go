@@ -89,6 +89,33 @@ func UnmarshalGroup(input []byte, groupKey string) (group *Group, err error) {
 	return &groups[0], nil
 }
 
+// Acl is the permission of a group on a predicate, stored as part of the JSON list in the
+// dgraph.group.acl predicate of the group.
+type Acl struct {
+	Predicate string `json:"predicate"`
+	Perm      int32  `json:"perm"`
+}
+
+// The operations which can be allowed on a predicate. The permission of an Acl is a
+// combination of them, e.g. 6 allows both reading and writing the predicate.
+const (
+	Read   int32 = 4
+	Write  int32 = 2
+	Modify int32 = 1
+)
+
+// UnmarshalAcls parses the acls stored in the dgraph.group.acl predicate of a group.
+func UnmarshalAcls(acls string) ([]Acl, error) {
+	var res []Acl
+	if len(acls) == 0 {
+		return res, nil
+	}
+	if err := json.Unmarshal([]byte(acls), &res); err != nil {
+		return nil, x.Errorf("Unable to unmarshal the acls %q: %v", acls, err)
+	}
+	return res, nil
+}
+
 type JwtGroup struct {
 	Group string
 }
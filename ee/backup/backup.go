@@ -13,17 +13,35 @@
 package backup
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"path"
 
 	"github.com/dgraph-io/badger"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/storage"
 	"github.com/dgraph-io/dgraph/stream"
 	"github.com/dgraph-io/dgraph/x"
 
 	"github.com/golang/glog"
 )
 
+// Manifest records a backup of the whole cluster, it's written next to the backup files once all
+// the groups have been backed up. A backup with SinceTs set is an incremental one, holding the
+// posting lists changed since the backup at that read ts. MaxLeaseId is the greatest uid leased
+// by Zero when the backup was taken, a restored cluster must not lease it again.
+type Manifest struct {
+	ReadTs     uint64   `json:"read_ts"`
+	SinceTs    uint64   `json:"since_ts"`
+	Groups     []uint32 `json:"groups"`
+	MaxLeaseId uint64   `json:"max_lease_id"`
+
+	// path is the directory of the backup, relative to the target.
+	path string
+}
+
 // Request has all the information needed to perform a backup.
 type Request struct {
 	DB     *badger.DB // Badger pstore managed by this node.
@@ -45,19 +63,8 @@ func (r *Request) Process(ctx context.Context) error {
 	sl.ChooseKeyFunc = nil
 	sl.ItemToKVFunc = func(key []byte, itr *badger.Iterator) (*pb.KV, error) {
 		item := itr.Item()
-		pk := x.Parse(key)
-		if pk.IsSchema() {
-			val, err := item.ValueCopy(nil)
-			if err != nil {
-				return nil, err
-			}
-			kv := &pb.KV{
-				Key:      key,
-				Val:      val,
-				UserMeta: []byte{item.UserMeta()},
-				Version:  item.Version(),
-			}
-			return kv, nil
+		if isSchemaKey(key) {
+			return schemaToKv(key, item)
 		}
 		l, err := posting.ReadPostingList(key, itr)
 		if err != nil {
@@ -65,8 +72,23 @@ func (r *Request) Process(ctx context.Context) error {
 		}
 		return l.MarshalToKv()
 	}
+	if since := r.Backup.SinceTs; since > 0 {
+		// Incremental backup, only ship the keys written after the previous backup, every
+		// version of them so that the backups can be restored up to any ts in between. The
+		// schema is always written at version 1, it's shipped whole with every backup.
+		sl.ChooseKeyFunc = func(item *badger.Item) bool {
+			return item.Version() > since || isSchemaKey(item.Key())
+		}
+		sl.ItemToKVsFunc = func(key []byte, itr *badger.Iterator) ([]*pb.KV, error) {
+			if isSchemaKey(key) {
+				kv, err := schemaToKv(key, itr.Item())
+				return []*pb.KV{kv}, err
+			}
+			return versionsToKvs(key, itr, since)
+		}
+	}
 
-	glog.V(2).Infof("Backup started ...")
+	glog.V(2).Infof("Backup started since ts %d ...", r.Backup.SinceTs)
 	if err = sl.Orchestrate(ctx, "Backup:", r.Backup.ReadTs); err != nil {
 		return err
 	}
@@ -77,3 +99,121 @@ func (r *Request) Process(ctx context.Context) error {
 
 	return nil
 }
+
+// isSchemaKey returns whether the key holds the schema of a predicate or the declaration of a
+// type, which are both stored as is at version 1.
+func isSchemaKey(key []byte) bool {
+	pk := x.Parse(key)
+	return pk.IsSchema() || pk.IsTypeDef()
+}
+
+func schemaToKv(key []byte, item *badger.Item) (*pb.KV, error) {
+	val, err := item.ValueCopy(nil)
+	if err != nil {
+		return nil, err
+	}
+	kv := &pb.KV{
+		Key:      key,
+		Val:      val,
+		UserMeta: []byte{item.UserMeta()},
+		Version:  item.Version(),
+	}
+	return kv, nil
+}
+
+// versionsToKvs returns the versions of the key written after the since ts, as they're stored.
+// Deleted versions have no user meta.
+func versionsToKvs(key []byte, itr *badger.Iterator, since uint64) ([]*pb.KV, error) {
+	var kvs []*pb.KV
+	for ; itr.Valid(); itr.Next() {
+		item := itr.Item()
+		if !bytes.Equal(item.Key(), key) || item.Version() <= since {
+			break
+		}
+		kv := &pb.KV{Key: key, Version: item.Version()}
+		if !item.IsDeletedOrExpired() {
+			val, err := item.ValueCopy(nil)
+			if err != nil {
+				return nil, err
+			}
+			kv.Val = val
+			kv.UserMeta = []byte{item.UserMeta()}
+		}
+		kvs = append(kvs, kv)
+	}
+	return kvs, nil
+}
+
+// readManifests returns the manifests of all the backups found in the storage.
+func readManifests(st storage.Storage) ([]*Manifest, error) {
+	paths, err := st.List("")
+	if err != nil {
+		return nil, err
+	}
+	var manifests []*Manifest
+	for _, p := range paths {
+		if path.Base(p) != manifestName {
+			continue
+		}
+		r, err := st.Open(p)
+		if err != nil {
+			return nil, err
+		}
+		m := &Manifest{path: path.Dir(p)}
+		err = json.NewDecoder(r).Decode(m)
+		x.Ignore(r.Close())
+		if err != nil {
+			return nil, x.Wrapf(err, "while reading manifest %q", p)
+		}
+		manifests = append(manifests, m)
+	}
+	return manifests, nil
+}
+
+// LastReadTs returns the read ts of the latest complete backup found at the target, which the
+// next backup can be based on. It returns zero if there's none.
+func LastReadTs(target string) (uint64, error) {
+	st, err := storage.New(target)
+	if err != nil {
+		return 0, err
+	}
+	manifests, err := readManifests(st)
+	if err != nil {
+		return 0, err
+	}
+	var readTs uint64
+	for _, m := range manifests {
+		if m.ReadTs > readTs {
+			readTs = m.ReadTs
+		}
+	}
+	return readTs, nil
+}
+
+// WriteManifest marks the backup of the request complete, once all the groups have been backed
+// up.
+func WriteManifest(req *pb.BackupRequest, groups []uint32, maxLeaseId uint64) error {
+	st, err := storage.New(req.Target)
+	if err != nil {
+		return err
+	}
+	m := &Manifest{
+		ReadTs:     req.ReadTs,
+		SinceTs:    req.SinceTs,
+		Groups:     groups,
+		MaxLeaseId: maxLeaseId,
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	w, err := st.Create(path.Join(backupDir(req), manifestName))
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(b); err != nil {
+		x.Ignore(w.Close())
+		return err
+	}
+	return w.Close()
+}
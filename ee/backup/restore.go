@@ -0,0 +1,239 @@
+// +build !oss
+
+/*
+ * Copyright 2018 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package backup
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/storage"
+	"github.com/dgraph-io/dgraph/x"
+
+	"github.com/golang/glog"
+)
+
+// backupFileRe matches the names of the backup files, capturing the group they belong to. Older
+// backups to S3 separate the read ts from the group with a dot instead of a dash.
+var backupFileRe = regexp.MustCompile(`^r\d+[.-]g(\d+)\.backup$`)
+
+// backupSeries returns the backups to restore the cluster as it was at the restore ts, in order:
+// the latest full backup taken at or before it, followed by the incremental backups based on it
+// up to the first one taken at or after it. The latest full backup and all the incremental
+// backups after it are returned if the restore ts is zero. Every incremental backup must be based
+// on the one right before it, a missing one would lose its changes.
+func backupSeries(manifests []*Manifest, restoreTs uint64) ([]*Manifest, error) {
+	sorted := make([]*Manifest, len(manifests))
+	copy(sorted, manifests)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ReadTs < sorted[j].ReadTs
+	})
+
+	start := -1
+	for i := len(sorted) - 1; i >= 0; i-- {
+		if sorted[i].SinceTs == 0 && (restoreTs == 0 || sorted[i].ReadTs <= restoreTs) {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		if restoreTs > 0 {
+			return nil, x.Errorf("No full backup found at or before ts %d", restoreTs)
+		}
+		return nil, x.Errorf("No full backup found")
+	}
+
+	series := sorted[start : start+1]
+	for _, m := range sorted[start+1:] {
+		last := series[len(series)-1]
+		if restoreTs > 0 && last.ReadTs >= restoreTs {
+			break
+		}
+		if m.SinceTs != last.ReadTs {
+			return nil, x.Errorf("Backup at ts %d is based on ts %d, but the previous backup is"+
+				" at ts %d", m.ReadTs, m.SinceTs, last.ReadTs)
+		}
+		series = append(series, m)
+	}
+	if last := series[len(series)-1]; restoreTs > last.ReadTs {
+		return nil, x.Errorf("Backups only go up to ts %d, can't restore to ts %d",
+			last.ReadTs, restoreTs)
+	}
+	return series, nil
+}
+
+// restoredGroup tracks what has been restored of a group, to build the state of Zero from.
+type restoredGroup struct {
+	id      uint32
+	tablets map[string]*pb.Tablet
+	maxUid  uint64
+}
+
+func (rg *restoredGroup) add(key []byte) {
+	pk := x.Parse(key)
+	if pk == nil || pk.IsTypeDef() {
+		return
+	}
+	if _, ok := rg.tablets[pk.Attr]; !ok {
+		rg.tablets[pk.Attr] = &pb.Tablet{GroupId: rg.id, Predicate: pk.Attr}
+	}
+	if (pk.IsData() || pk.IsReverse()) && pk.Uid > rg.maxUid {
+		rg.maxUid = pk.Uid
+	}
+}
+
+// RunRestore restores the backups found at the location into the postings directory, as the
+// cluster was at the restore ts, or as of the latest backup if it's zero. Only the files of the
+// group are restored if it's set. When more than one group is restored, each of them gets its own
+// directory in the postings directory, named after the group, e.g. g1.
+// It returns the state Zero must start from to serve the restored groups, without its members.
+func RunRestore(pdir, location string, group uint32,
+	restoreTs uint64) (*pb.MembershipState, error) {
+	st, err := storage.New(location)
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := readManifests(st)
+	if err != nil {
+		return nil, err
+	}
+	series, err := backupSeries(manifests, restoreTs)
+	if err != nil {
+		return nil, err
+	}
+	if restoreTs == 0 {
+		restoreTs = series[len(series)-1].ReadTs
+	}
+
+	state := &pb.MembershipState{
+		Groups:   make(map[uint32]*pb.Group),
+		MaxTxnTs: restoreTs,
+	}
+	// The files of every group, in the order they must be restored.
+	files := make(map[uint32][]string)
+	for _, m := range series {
+		state.MaxLeaseId = x.Max(state.MaxLeaseId, m.MaxLeaseId)
+		paths, err := st.List(m.path + "/")
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range paths {
+			match := backupFileRe.FindStringSubmatch(path.Base(p))
+			if match == nil {
+				continue
+			}
+			gid, err := strconv.ParseUint(match[1], 10, 32)
+			if err != nil {
+				return nil, x.Wrapf(err, "while parsing the group of %s", p)
+			}
+			if group > 0 && uint32(gid) != group {
+				continue
+			}
+			files[uint32(gid)] = append(files[uint32(gid)], p)
+		}
+	}
+	if len(files) == 0 {
+		return nil, x.Errorf("No backup files found at %s", location)
+	}
+
+	for gid, paths := range files {
+		dir := pdir
+		if len(files) > 1 {
+			dir = filepath.Join(pdir, fmt.Sprintf("g%d", gid))
+		}
+		rg := &restoredGroup{id: gid, tablets: make(map[string]*pb.Tablet)}
+		if err := restoreGroup(dir, st, paths, restoreTs, rg); err != nil {
+			return nil, err
+		}
+		state.Groups[gid] = &pb.Group{Tablets: rg.tablets}
+		state.MaxLeaseId = x.Max(state.MaxLeaseId, rg.maxUid)
+	}
+	return state, nil
+}
+
+func restoreGroup(dir string, st storage.Storage, paths []string, restoreTs uint64,
+	rg *restoredGroup) error {
+	opt := badger.DefaultOptions
+	opt.SyncWrites = false
+	opt.Dir = dir
+	opt.ValueDir = dir
+	db, err := badger.OpenManaged(opt)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	for _, p := range paths {
+		glog.Infof("Restoring backup file %s into %s", p, dir)
+		if err := loadFile(db, st, p, restoreTs, rg); err != nil {
+			return x.Wrapf(err, "while restoring %s", p)
+		}
+	}
+	return nil
+}
+
+// loadFile writes the key-values of the backup file to the database at their version, leaving
+// out the versions after the restore ts. Key-values without user meta are deletions.
+func loadFile(db *badger.DB, st storage.Storage, p string, restoreTs uint64,
+	rg *restoredGroup) error {
+	fd, err := st.Open(p)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	r := bufio.NewReader(fd)
+	writer := x.NewTxnWriter(db)
+	// The versions of a key are restored newest first, and the schema which is always at
+	// version 1 must be overwritten by the later backups.
+	writer.BlindWrite = true
+	for {
+		var sz uint64
+		err := binary.Read(r, binary.LittleEndian, &sz)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		buf := make([]byte, sz)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return err
+		}
+		kv := &pb.KV{}
+		if err := kv.Unmarshal(buf); err != nil {
+			return err
+		}
+		if kv.Version > restoreTs {
+			continue
+		}
+		if len(kv.UserMeta) == 0 {
+			err = writer.Delete(kv.Key, kv.Version)
+		} else {
+			err = writer.Send(&pb.KVS{Kv: []*pb.KV{kv}})
+		}
+		if err != nil {
+			return err
+		}
+		rg.add(kv.Key)
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,153 @@
+// +build !oss
+
+/*
+ * Copyright 2018 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package backup
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/storage"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupSeries(t *testing.T) {
+	full1 := &Manifest{ReadTs: 10}
+	incr1 := &Manifest{ReadTs: 20, SinceTs: 10}
+	full2 := &Manifest{ReadTs: 30}
+	incr2 := &Manifest{ReadTs: 40, SinceTs: 30}
+	incr3 := &Manifest{ReadTs: 50, SinceTs: 40}
+
+	series, err := backupSeries([]*Manifest{incr3, full1, incr2, full2, incr1}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []*Manifest{full2, incr2, incr3}, series)
+
+	series, err = backupSeries([]*Manifest{incr1, full1}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []*Manifest{full1, incr1}, series)
+
+	// the changes between 30 and 40 are missing
+	_, err = backupSeries([]*Manifest{full2, incr3}, 0)
+	require.Error(t, err)
+
+	_, err = backupSeries([]*Manifest{incr1}, 0)
+	require.Error(t, err)
+}
+
+func TestBackupSeriesRestoreTs(t *testing.T) {
+	full1 := &Manifest{ReadTs: 10}
+	incr1 := &Manifest{ReadTs: 20, SinceTs: 10}
+	full2 := &Manifest{ReadTs: 30}
+	incr2 := &Manifest{ReadTs: 40, SinceTs: 30}
+	incr3 := &Manifest{ReadTs: 50, SinceTs: 40}
+	all := []*Manifest{incr3, full1, incr2, full2, incr1}
+
+	series, err := backupSeries(all, 15)
+	require.NoError(t, err)
+	require.Equal(t, []*Manifest{full1, incr1}, series)
+
+	series, err = backupSeries(all, 30)
+	require.NoError(t, err)
+	require.Equal(t, []*Manifest{full2}, series)
+
+	series, err = backupSeries(all, 41)
+	require.NoError(t, err)
+	require.Equal(t, []*Manifest{full2, incr2, incr3}, series)
+
+	// no backup before 5 or after 50
+	_, err = backupSeries(all, 5)
+	require.Error(t, err)
+	_, err = backupSeries(all, 51)
+	require.Error(t, err)
+
+	// the changes between 20 and 30 can only be restored from an incremental backup
+	_, err = backupSeries([]*Manifest{full1, full2}, 25)
+	require.Error(t, err)
+}
+
+func TestLoadFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	name := x.DataKey("name", 0x12)
+	age := x.DataKey("age", 0x21)
+	st, err := storage.New(dir)
+	require.NoError(t, err)
+	fw, err := st.Create("dgraph.20181106.011305/r30-g1.backup")
+	require.NoError(t, err)
+	w := &writer{w: fw}
+	require.NoError(t, w.Send(&pb.KVS{Kv: []*pb.KV{
+		{Key: name, Val: []byte("n2"), UserMeta: []byte{1}, Version: 25},
+		{Key: name, Val: []byte("n1"), UserMeta: []byte{1}, Version: 17},
+		{Key: age, Version: 22},
+		{Key: age, Val: []byte("a1"), UserMeta: []byte{1}, Version: 12},
+	}}))
+	require.NoError(t, w.flush())
+
+	opt := badger.DefaultOptions
+	opt.Dir = filepath.Join(dir, "p")
+	opt.ValueDir = opt.Dir
+	db, err := badger.OpenManaged(opt)
+	require.NoError(t, err)
+	defer db.Close()
+
+	rg := &restoredGroup{id: 1, tablets: make(map[string]*pb.Tablet)}
+	require.NoError(t, loadFile(db, st, "dgraph.20181106.011305/r30-g1.backup", 24, rg))
+	require.Equal(t, uint64(0x21), rg.maxUid)
+	require.Equal(t, map[string]*pb.Tablet{
+		"name": {GroupId: 1, Predicate: "name"},
+		"age":  {GroupId: 1, Predicate: "age"},
+	}, rg.tablets)
+
+	txn := db.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	// the version after the restore ts is left out
+	item, err := txn.Get(name)
+	require.NoError(t, err)
+	require.Equal(t, uint64(17), item.Version())
+	require.Equal(t, byte(1), item.UserMeta())
+	val, err := item.ValueCopy(nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("n1"), val)
+
+	_, err = txn.Get(age)
+	require.Equal(t, badger.ErrKeyNotFound, err)
+	txn = db.NewTransactionAt(20, false)
+	defer txn.Discard()
+	_, err = txn.Get(age)
+	require.NoError(t, err)
+}
+
+func TestAddMembers(t *testing.T) {
+	state := &pb.MembershipState{Groups: map[uint32]*pb.Group{2: {}, 1: {}}}
+	require.Error(t, addMembers(state, "zero:5080", []string{"alpha1:7080"}))
+
+	require.NoError(t, addMembers(state, "zero:5080", []string{"alpha1:7080", "alpha2:7080"}))
+	require.Equal(t, "zero:5080", state.Zeros[zeroId].Addr)
+	require.Equal(t, map[uint64]*pb.Member{1: {Id: 1, GroupId: 1, Addr: "alpha1:7080"}},
+		state.Groups[1].Members)
+	require.Equal(t, map[uint64]*pb.Member{2: {Id: 2, GroupId: 2, Addr: "alpha2:7080"}},
+		state.Groups[2].Members)
+	require.Equal(t, uint64(2), state.MaxRaftId)
+	require.NotEmpty(t, state.Cid)
+
+	// alphas joining a single group are assigned to it by Zero
+	state = &pb.MembershipState{Groups: map[uint32]*pb.Group{1: {}}}
+	require.NoError(t, addMembers(state, "zero:5080", nil))
+}
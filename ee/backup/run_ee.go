@@ -0,0 +1,102 @@
+// +build !oss
+
+/*
+ * Copyright 2018 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package backup
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+)
+
+var CmdRestore x.SubCommand
+
+func init() {
+	CmdRestore.Cmd = &cobra.Command{
+		Use:   "restore",
+		Short: "Run Dgraph (EE) Restore backup",
+		Long: `
+Dgraph Restore is used to load the backups taken with the /admin/backup endpoint into the
+postings directories of a new cluster. The cluster is restored as it was at the given timestamp,
+from the latest full backup taken before it and the incremental backups taken after that, in
+order. Without a timestamp, it's restored as of the latest backup.
+
+The state Zero must start from is written into the WAL directory of the new Zero: the leased uids
+and timestamps, which predicates each group serves and which alpha serves each group.
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			defer x.StartProfile(CmdRestore.Conf).Stop()
+			if err := run(); err != nil {
+				glog.Errorf("Unable to restore: %v", err)
+				os.Exit(1)
+			}
+		},
+	}
+	CmdRestore.EnvPrefix = "DGRAPH_RESTORE"
+
+	flag := CmdRestore.Cmd.Flags()
+	flag.StringP("postings", "p", "",
+		"Directory to restore the posting lists into, it's where the alpha will read them from.")
+	flag.StringP("location", "l", "",
+		"Location of the backups, as passed as destination to /admin/backup.")
+	flag.Uint32P("group", "g", 0,
+		"Only restore the backups of this group. If unset, every group is restored into its"+
+			" own directory in the postings directory, e.g. g1 for group 1.")
+	flag.Uint64("restore_ts", 0,
+		"Timestamp to restore the cluster to. The latest backup is restored if unset.")
+	flag.StringP("zero_wal", "w", "",
+		"WAL directory of the new Zero to write its state into. It must be empty.")
+	flag.String("zero", "localhost:5080", "Address of the new Zero, as passed to its --my flag.")
+	flag.String("alphas", "",
+		"Comma separated addresses of the new alphas, as passed to their --my flag. They serve"+
+			" the restored groups in order, one each.")
+}
+
+func run() error {
+	pdir := CmdRestore.Conf.GetString("postings")
+	location := CmdRestore.Conf.GetString("location")
+	if pdir == "" || location == "" {
+		return x.Errorf("Both the --postings and --location flags must be set")
+	}
+
+	state, err := RunRestore(pdir, location, uint32(CmdRestore.Conf.GetInt("group")),
+		uint64(CmdRestore.Conf.GetInt64("restore_ts")))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Restored %d groups up to ts %d, with uids leased up to %d.\n",
+		len(state.Groups), state.MaxTxnTs, state.MaxLeaseId)
+
+	wdir := CmdRestore.Conf.GetString("zero_wal")
+	if wdir == "" {
+		fmt.Println("No Zero WAL directory given. Zero must lease uids and timestamps beyond" +
+			" the restored ones before the alphas start.")
+		return nil
+	}
+	var alphas []string
+	if list := CmdRestore.Conf.GetString("alphas"); list != "" {
+		alphas = strings.Split(list, ",")
+	}
+	if err := addMembers(state, CmdRestore.Conf.GetString("zero"), alphas); err != nil {
+		return err
+	}
+	if err := writeZeroState(wdir, state); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote the state of Zero into %s. Start Zero with --wal %s --idx %d.\n",
+		wdir, wdir, zeroId)
+	return nil
+}
@@ -14,98 +14,68 @@ package backup
 
 import (
 	"encoding/binary"
+	"fmt"
 	"io"
-	"net/url"
-	"strings"
+	"path"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
-	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgraph-io/dgraph/storage"
 
+	humanize "github.com/dustin/go-humanize"
 	"github.com/golang/glog"
 )
 
-// handler interface is implemented by URI scheme handlers.
-type handler interface {
-	// Handlers know how to Write and Close to their target.
-	io.WriteCloser
-	// Session receives the host and path of the target. It should get all its configuration
-	// from the environment.
-	Open(*url.URL, *Request) error
+// manifestName is the name of the manifest file in the directory of a backup.
+const manifestName = "manifest.json"
+
+// backupDir returns the name of the directory holding the files of the backup.
+func backupDir(req *pb.BackupRequest) string {
+	return fmt.Sprintf("dgraph.%s", req.UnixTs)
 }
 
 // writer handles the writes from stream.Orchestrate. It implements the kvStream interface.
 type writer struct {
-	h handler
+	w io.WriteCloser
 }
 
-// newWriter parses the requested target URI, finds a handler and then tries to create a session.
-// Target URI formats:
-//   [scheme]://[host]/[path]?[args]
-//   [scheme]:///[path]?[args]
-//   /[path]?[args] (only for local or NFS)
-//
-// Target URI parts:
-//   scheme - service handler, one of: "s3", "gs", "az", "http", "file"
-//     host - remote address. ex: "dgraph.s3.amazonaws.com"
-//     path - directory, bucket or container at target. ex: "/dgraph/backups/"
-//     args - specific arguments that are ok to appear in logs.
-//
-// Global args (might not be support by all handlers):
-//     secure - true|false turn on/off TLS.
-//   compress - true|false turn on/off data compression.
-//
-// Examples:
-//   s3://dgraph.s3.amazonaws.com/dgraph/backups?secure=true
+// newWriter creates the backup file of the group in the storage of the target. See storage.New
+// for the format of the target URI, e.g.:
+//   s3://dgraph/backups?secure=true
 //   gs://dgraph/backups/
-//   as://dgraph-container/backups/
-//   http://backups.dgraph.io/upload
-//   file:///tmp/dgraph/backups or /tmp/dgraph/backups?compress=gzip
+//   file:///tmp/dgraph/backups or /tmp/dgraph/backups
 func (r *Request) newWriter() (*writer, error) {
-	uri, err := url.Parse(r.Backup.Target)
+	st, err := storage.New(r.Backup.Target)
 	if err != nil {
 		return nil, err
 	}
 
-	// find handler for this URI scheme
-	var h handler
-	switch uri.Scheme {
-	case "file":
-		h = &fileHandler{}
-	case "s3":
-		h = &s3Handler{}
-	case "http", "https":
-		if strings.HasPrefix(uri.Host, "s3") &&
-			strings.HasSuffix(uri.Host, ".amazonaws.com") {
-			h = &s3Handler{}
-		}
-	}
-	if h == nil {
-		return nil, x.Errorf("Unable to handle url: %v", uri)
-	}
-
-	if err := h.Open(uri, r); err != nil {
+	name := path.Join(backupDir(r.Backup),
+		fmt.Sprintf("r%d-g%d.backup", r.Backup.ReadTs, r.Backup.GroupId))
+	w, err := st.Create(name)
+	if err != nil {
 		return nil, err
 	}
-
-	return &writer{h: h}, nil
+	glog.Infof("Writing backup to %s in %s, estimated size %s", name, r.Backup.Target,
+		humanize.Bytes(r.Sizex))
+	return &writer{w: w}, nil
 }
 
 func (w *writer) flush() error {
-	glog.V(2).Infof("Backup closing handler.")
-	return w.h.Close()
+	glog.V(2).Infof("Backup closing writer.")
+	return w.w.Close()
 }
 
 // write uses the data length as delimiter.
 // XXX: we could use CRC for restore.
 func (w *writer) write(kv *pb.KV) error {
-	if err := binary.Write(w.h, binary.LittleEndian, uint64(kv.Size())); err != nil {
+	if err := binary.Write(w.w, binary.LittleEndian, uint64(kv.Size())); err != nil {
 		return err
 	}
 	b, err := kv.Marshal()
 	if err != nil {
 		return err
 	}
-	_, err = w.h.Write(b)
+	_, err = w.w.Write(b)
 	return err
 }
 
@@ -0,0 +1,97 @@
+// +build !oss
+
+/*
+ * Copyright 2018 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package backup
+
+import (
+	"os"
+	"sort"
+
+	"github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/raftwal"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/google/uuid"
+)
+
+// zeroId is the Raft id of the Zero started from the restored state.
+const zeroId = 1
+
+// addMembers adds the Zero and the alphas of the restored cluster to its state. The alphas serve
+// the groups in order, one each. Replicas can join them once the cluster is up.
+func addMembers(state *pb.MembershipState, zero string, alphas []string) error {
+	gids := make([]uint32, 0, len(state.Groups))
+	for gid := range state.Groups {
+		gids = append(gids, gid)
+	}
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+	// Zero assigns new alphas to the groups needing members in no particular order, which is only
+	// right if there's a single group.
+	if len(alphas) != len(gids) && (len(alphas) > 0 || len(gids) > 1) {
+		return x.Errorf("Restored %d groups, but got the address of %d alphas",
+			len(gids), len(alphas))
+	}
+
+	state.Zeros = map[uint64]*pb.Member{zeroId: {Id: zeroId, Addr: zero, Leader: true}}
+	for i, addr := range alphas {
+		id := uint64(i + 1)
+		gid := gids[i]
+		state.Groups[gid].Members = map[uint64]*pb.Member{
+			id: {Id: id, GroupId: gid, Addr: addr},
+		}
+		state.MaxRaftId = id
+	}
+	state.Cid = uuid.New().String()
+	return nil
+}
+
+// writeZeroState writes the state as a snapshot into the WAL directory of a new Zero, which picks
+// it up when started with it.
+func writeZeroState(wdir string, state *pb.MembershipState) error {
+	if err := os.MkdirAll(wdir, 0700); err != nil {
+		return err
+	}
+	kvOpt := badger.LSMOnlyOptions
+	kvOpt.SyncWrites = true
+	kvOpt.Dir = wdir
+	kvOpt.ValueDir = wdir
+	kv, err := badger.Open(kvOpt)
+	if err != nil {
+		return err
+	}
+	defer kv.Close()
+
+	store := raftwal.Init(kv, wdir, zeroId, 0)
+	defer store.Close()
+	if hs, err := store.HardState(); err != nil {
+		return err
+	} else if !raft.IsEmptyHardState(hs) {
+		return x.Errorf("WAL directory %s already holds the state of a Zero", wdir)
+	}
+
+	data, err := state.Marshal()
+	if err != nil {
+		return err
+	}
+	snap := raftpb.Snapshot{
+		Data: data,
+		Metadata: raftpb.SnapshotMetadata{
+			Index:     1,
+			Term:      1,
+			ConfState: raftpb.ConfState{Nodes: []uint64{zeroId}},
+		},
+	}
+	hs := raftpb.HardState{Term: 1, Vote: zeroId, Commit: 1}
+	return store.Save(hs, nil, snap)
+}
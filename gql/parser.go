@@ -19,12 +19,14 @@ package gql
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/dgraph-io/dgraph/lex"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
 )
@@ -51,21 +53,22 @@ type GraphQuery struct {
 
 	Args map[string]string
 	// Query can have multiple sort parameters.
-	Order        []*pb.Order
-	Children     []*GraphQuery
-	Filter       *FilterTree
-	MathExp      *MathTree
-	Normalize    bool
-	Recurse      bool
-	RecurseArgs  RecurseArgs
-	Cascade      bool
-	IgnoreReflex bool
-	Facets       *pb.FacetParams
-	FacetsFilter *FilterTree
-	GroupbyAttrs []GroupByAttr
-	FacetVar     map[string]string
-	FacetOrder   string
-	FacetDesc    bool
+	Order          []*pb.Order
+	Children       []*GraphQuery
+	Filter         *FilterTree
+	MathExp        *MathTree
+	Normalize      bool
+	NormalizeDepth int // The levels of child blocks kept as lists, @normalize(depth: 1)
+	Recurse        bool
+	RecurseArgs    RecurseArgs
+	Cascade        bool
+	IgnoreReflex   bool
+	Facets         *pb.FacetParams
+	FacetsFilter   *FilterTree
+	GroupbyAttrs   []GroupByAttr
+	FacetVar       map[string]string
+	FacetOrder     string
+	FacetDesc      bool
 
 	// Internal fields below.
 	// If gq.fragment is nonempty, then it is a fragment reference / spread.
@@ -85,12 +88,17 @@ type GraphQuery struct {
 type RecurseArgs struct {
 	Depth     uint64
 	AllowLoop bool
+	// Until is the filter of the nodes which aren't expanded any further.
+	Until *FilterTree
+	// Report marks the nodes whose expansion was cut short by a loop or by the depth.
+	Report bool
 }
 
 type GroupByAttr struct {
-	Attr  string
-	Alias string
-	Langs []string
+	Attr     string
+	Alias    string
+	Langs    []string
+	DatePart string // @groupby(month(dob))
 }
 
 // pair denotes the key value pair that is part of the GraphQL query root in parenthesis.
@@ -143,6 +151,7 @@ type FilterTree struct {
 type Arg struct {
 	Value        string
 	IsValueVar   bool // If argument is val(a)
+	IsUidVar     bool // If argument is uid(a), uid_in(friend, uid(a))
 	IsGraphQLVar bool
 }
 
@@ -156,6 +165,8 @@ type Function struct {
 	NeedsVar   []VarContext // If the function requires some variable
 	IsCount    bool         // gt(count(friends),0)
 	IsValueVar bool         // eq(val(s), 5)
+	IsLenVar   bool         // eq(len(s), 0)
+	DatePart   string       // le(month(dob), 6)
 }
 
 // filterOpPrecedence is a map from filterOp (a string) to its precedence.
@@ -169,6 +180,10 @@ var mathOpPrecedence = map[string]int{
 	"floor":   105,
 	"ceil":    104,
 	"since":   103,
+	"year":    102,
+	"month":   102,
+	"day":     102,
+	"hour":    102,
 	"exp":     100,
 	"ln":      99,
 	"sqrt":    98,
@@ -200,6 +215,11 @@ func (f *Function) IsPasswordVerifier() bool {
 	return f.Name == "checkpwd"
 }
 
+// IsScorer returns whether the function scores the values of the nodes, like bm25.
+func (f *Function) IsScorer() bool {
+	return f.Name == "bm25"
+}
+
 // DebugPrint is useful for debugging.
 func (gq *GraphQuery) DebugPrint(prefix string) {
 	glog.Infof("%s[%x %q %q]\n", prefix, gq.UID, gq.Attr, gq.Alias)
@@ -400,6 +420,11 @@ func substituteVariables(gq *GraphQuery, vmap varMap) error {
 			return err
 		}
 	}
+	if gq.RecurseArgs.Until != nil {
+		if err := substituteVariablesFilter(gq.RecurseArgs.Until, vmap); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -459,6 +484,13 @@ type Result struct {
 // Parse initializes and runs the lexer. It also constructs the GraphQuery subgraph
 // from the lexed items.
 func Parse(r Request) (res Result, rerr error) {
+	return ParseWithNeedVars(r, nil)
+}
+
+// ParseWithNeedVars is like Parse, but lets the caller name variables that it uses itself, so
+// that defining them in the query isn't an error. Upserts use it for the variables that their
+// mutations refer to.
+func ParseWithNeedVars(r Request, needVars []string) (res Result, rerr error) {
 	query := r.Str
 	vmap := convertToVarMap(r.Variables)
 
@@ -538,6 +570,9 @@ func Parse(r Request) (res Result, rerr error) {
 		}
 
 		allVars := res.QueryVars
+		if len(needVars) > 0 {
+			allVars = append(allVars, &Vars{Needs: needVars})
+		}
 		if err := checkDependency(allVars); err != nil {
 			return res, err
 		}
@@ -622,6 +657,9 @@ func (qu *GraphQuery) collectVars(v *Vars) {
 	if qu.Filter != nil {
 		qu.Filter.collectVars(v)
 	}
+	if qu.RecurseArgs.Until != nil {
+		qu.RecurseArgs.Until.collectVars(v)
+	}
 	if qu.MathExp != nil {
 		qu.MathExp.collectVars(v)
 	}
@@ -722,6 +760,21 @@ func parseRecurseArgs(it *lex.ItemIterator, gq *GraphQuery) error {
 			return fmt.Errorf("Expected colon(:) after %s", key)
 		}
 
+		if key == "until" {
+			until, err := parseRecurseUntil(it)
+			if err != nil {
+				return err
+			}
+			gq.RecurseArgs.Until = until
+			if _, ok := tryParseItemType(it, itemRightRound); ok {
+				return nil
+			}
+			if _, ok := tryParseItemType(it, itemComma); !ok {
+				return fmt.Errorf("Expected comma after until inside recurse block.")
+			}
+			continue
+		}
+
 		if item, ok = tryParseItemType(it, itemName); !ok {
 			return fmt.Errorf("Expected value inside @recurse() for key: %s.", key)
 		}
@@ -740,6 +793,12 @@ func parseRecurseArgs(it *lex.ItemIterator, gq *GraphQuery) error {
 				return err
 			}
 			gq.RecurseArgs.AllowLoop = allowLoop
+		case "report":
+			report, err := strconv.ParseBool(val)
+			if err != nil {
+				return err
+			}
+			gq.RecurseArgs.Report = report
 		default:
 			return fmt.Errorf("Unexpected key: [%s] inside @recurse block", key)
 		}
@@ -755,6 +814,60 @@ func parseRecurseArgs(it *lex.ItemIterator, gq *GraphQuery) error {
 	return nil
 }
 
+// parseNormalizeArgs parses the arguments of @normalize(depth: 1), which keeps the child blocks
+// of the first level as lists of normalized nodes.
+func parseNormalizeArgs(it *lex.ItemIterator, gq *GraphQuery) error {
+	if ok := trySkipItemTyp(it, itemLeftRound); !ok {
+		// We don't have a (, we can return.
+		return nil
+	}
+
+	item, ok := tryParseItemType(it, itemName)
+	if !ok || strings.ToLower(item.Val) != "depth" {
+		return x.Errorf("Expected key depth inside @normalize().")
+	}
+	if ok := trySkipItemTyp(it, itemColon); !ok {
+		return x.Errorf("Expected colon(:) after depth")
+	}
+	if item, ok = tryParseItemType(it, itemName); !ok {
+		return x.Errorf("Expected value inside @normalize() for key: depth.")
+	}
+	depth, err := strconv.ParseUint(item.Val, 0, 32)
+	if err != nil {
+		return x.Wrapf(err, "Invalid depth %q inside @normalize()", item.Val)
+	}
+	gq.NormalizeDepth = int(depth)
+	if ok := trySkipItemTyp(it, itemRightRound); !ok {
+		return x.Errorf("Expected ) after the depth inside @normalize()")
+	}
+	return nil
+}
+
+// parseRecurseUntil parses the filter at which @recurse stops expanding the nodes, either a single
+// function or a filter within parentheses, like until: eq(name, "Alice") or
+// until: (has(a) or has(b)).
+func parseRecurseUntil(it *lex.ItemIterator) (*FilterTree, error) {
+	item, ok := it.PeekOne()
+	if !ok {
+		return nil, x.Errorf("Expected value inside @recurse() for key: until.")
+	}
+	if item.Typ == itemLeftRound {
+		until, err := parseFilter(it)
+		if err != nil {
+			return nil, err
+		}
+		if until == nil {
+			return nil, x.Errorf("Expected filter inside @recurse() for key: until.")
+		}
+		return until, nil
+	}
+	f, err := parseFunction(it, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FilterTree{Func: f}, nil
+}
+
 // getQuery creates a GraphQuery object tree by calling getRoot
 // and goDeep functions by looking at '{'.
 func getQuery(it *lex.ItemIterator) (gq *GraphQuery, rerr error) {
@@ -794,6 +907,9 @@ L:
 
 			case "normalize":
 				gq.Normalize = true
+				if err := parseNormalizeArgs(it, gq); err != nil {
+					return nil, err
+				}
 			case "cascade":
 				gq.Cascade = true
 			case "groupby":
@@ -887,39 +1003,174 @@ func parseListItemNames(it *lex.ItemIterator) ([]string, error) {
 }
 
 // parses till rightround is found
-func parseSchemaPredicates(it *lex.ItemIterator, s *pb.SchemaRequest) error {
-	// pred should be followed by colon
-	it.Next()
-	item := it.Item()
-	if item.Typ != itemName && item.Val != "pred" {
-		return x.Errorf("Invalid schema block")
-	}
-	it.Next()
-	item = it.Item()
-	if item.Typ != itemColon {
-		return x.Errorf("Invalid schema block")
-	}
+func parseSchemaArgs(it *lex.ItemIterator, s *pb.SchemaRequest) error {
+	var numArgs int
+	for it.Next() {
+		item := it.Item()
+		switch item.Typ {
+		case itemRightRound:
+			if numArgs == 0 {
+				return x.Errorf("Invalid schema block")
+			}
+			return nil
+		case itemComma:
+			if numArgs == 0 {
+				return x.Errorf("Invalid schema block")
+			}
+			continue
+		case itemName:
+		default:
+			return x.Errorf("Invalid schema block")
+		}
+		numArgs++
 
-	// can be a or [a,b]
-	it.Next()
-	item = it.Item()
-	if item.Typ == itemName {
-		s.Predicates = append(s.Predicates, item.Val)
-	} else if item.Typ == itemLeftSquare {
-		var err error
-		if s.Predicates, err = parseListItemNames(it); err != nil {
+		// Every argument should be followed by colon.
+		name := item.Val
+		it.Next()
+		if it.Item().Typ != itemColon {
+			return x.Errorf("Invalid schema block")
+		}
+
+		// The value can be a, [a,b] or /regex/flags
+		var vals []string
+		it.Next()
+		item = it.Item()
+		if item.Typ == itemName {
+			vals = append(vals, collectName(it, item.Val))
+		} else if item.Typ == itemRegex {
+			vals = append(vals, item.Val)
+		} else if item.Typ == itemLeftSquare {
+			var err error
+			if vals, err = parseListItemNames(it); err != nil {
+				return err
+			}
+		} else {
+			return x.Errorf("Invalid schema block")
+		}
+		if err := setSchemaArg(s, name, vals); err != nil {
 			return err
 		}
-	} else {
-		return x.Errorf("Invalid schema block")
 	}
+	return x.Errorf("Invalid schema blocks")
+}
 
-	it.Next()
-	item = it.Item()
-	if item.Typ == itemRightRound {
-		return nil
+// setSchemaArg sets the argument with the given name and values on the schema request.
+func setSchemaArg(s *pb.SchemaRequest, name string, vals []string) error {
+	uint32Arg := func() (uint32, error) {
+		if len(vals) != 1 {
+			return 0, x.Errorf("Schema argument %s expects a single value", name)
+		}
+		v, err := strconv.ParseUint(vals[0], 10, 32)
+		if err != nil {
+			return 0, x.Errorf("Schema argument %s expects a non-negative integer. Got: %s",
+				name, vals[0])
+		}
+		return uint32(v), nil
 	}
-	return x.Errorf("Invalid schema blocks")
+	boolArg := func() (bool, error) {
+		if len(vals) != 1 {
+			return false, x.Errorf("Schema argument %s expects a single value", name)
+		}
+		v, err := strconv.ParseBool(vals[0])
+		if err != nil {
+			return false, x.Errorf("Schema argument %s expects true or false. Got: %s",
+				name, vals[0])
+		}
+		return v, nil
+	}
+	uint64Arg := func() (uint64, error) {
+		if len(vals) != 1 {
+			return 0, x.Errorf("Schema argument %s expects a single value", name)
+		}
+		v, err := strconv.ParseUint(vals[0], 10, 64)
+		if err != nil {
+			return 0, x.Errorf("Schema argument %s expects a non-negative integer. Got: %s",
+				name, vals[0])
+		}
+		return v, nil
+	}
+	regexArg := func() (string, error) {
+		if len(vals) != 1 || !strings.HasPrefix(vals[0], "/") {
+			return "", x.Errorf("Schema argument %s expects a regular expression like /expr/",
+				name)
+		}
+		ra, err := parseRegexArgs(vals[0])
+		if err != nil {
+			return "", err
+		}
+		var pattern string
+		switch ra.flags {
+		case "":
+			pattern = ra.expr
+		case "i":
+			pattern = "(?i)" + ra.expr
+		default:
+			return "", x.Errorf("Invalid regexp modifier: %s", ra.flags)
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return "", x.Wrapf(err, "while parsing schema argument %s", name)
+		}
+		return pattern, nil
+	}
+
+	var err error
+	switch name {
+	case "pred":
+		s.Predicates = append(s.Predicates, vals...)
+	case "types":
+		s.Types = append(s.Types, vals...)
+	case "type":
+		s.TypeNames = append(s.TypeNames, vals...)
+	case "min_name_len":
+		s.MinNameLen, err = uint32Arg()
+	case "max_name_len":
+		s.MaxNameLen, err = uint32Arg()
+	case "since_version":
+		s.SinceVersion, err = uint64Arg()
+	case "limit":
+		s.Limit, err = uint32Arg()
+	case "reverses_only":
+		s.ReversesOnly, err = boolArg()
+	case "indexed_only":
+		s.IndexedOnly, err = boolArg()
+	case "include_moving":
+		s.IncludeMoving, err = boolArg()
+	case "group_by_leader":
+		s.GroupByLeader, err = boolArg()
+	case "validate_constraints":
+		s.ValidateConstraints, err = boolArg()
+	case "missing_index_only":
+		s.MissingIndexOnly, err = boolArg()
+	case "best_effort":
+		s.BestEffort, err = boolArg()
+	case "read_from_any":
+		s.ReadFromAny, err = boolArg()
+	case "exists_only":
+		s.ExistsOnly, err = boolArg()
+	case "exclude_groups":
+		for _, val := range vals {
+			gid, err := strconv.ParseUint(val, 10, 32)
+			if err != nil {
+				return x.Errorf("Schema argument %s expects group ids. Got: %s", name, val)
+			}
+			s.ExcludeGroups = append(s.ExcludeGroups, uint32(gid))
+		}
+	case "sort":
+		if len(vals) != 1 {
+			return x.Errorf("Schema argument %s expects a single value", name)
+		}
+		s.Sort = vals[0]
+	case "value_pattern":
+		s.ValuePattern, err = regexArg()
+	case "pred_pattern":
+		var pattern string
+		if pattern, err = regexArg(); err == nil {
+			s.PredicatePatterns = append(s.PredicatePatterns, pattern)
+		}
+	default:
+		return x.Errorf("Invalid schema argument: %s", name)
+	}
+	return err
 }
 
 // parses till rightcurl is found
@@ -954,7 +1205,7 @@ func getSchema(it *lex.ItemIterator) (*pb.SchemaRequest, error) {
 				return nil, x.Errorf("Too many left rounds in schema block")
 			}
 			leftRoundSeen = true
-			if err := parseSchemaPredicates(it, &s); err != nil {
+			if err := parseSchemaArgs(it, &s); err != nil {
 				return nil, err
 			}
 		default:
@@ -1140,6 +1391,26 @@ func parseArguments(it *lex.ItemIterator, gq *GraphQuery) (result []pair, rerr e
 			continue
 		}
 
+		if isSortkey(p.Key) && item.Val == "facet" {
+			key, err := parseFacetSortKey(it)
+			if err != nil {
+				return result, err
+			}
+			p.Val = "facet(" + key + ")"
+			result = append(result, p)
+			continue
+		}
+
+		if isSortkey(p.Key) && isDistanceSortKey(it) {
+			attr, point, err := parseDistanceSortKey(it)
+			if err != nil {
+				return result, err
+			}
+			p.Val = "distance(" + attr + "," + point + ")"
+			result = append(result, p)
+			continue
+		}
+
 		if item.Typ == itemDollar {
 			val = "$"
 			it.Next()
@@ -1196,9 +1467,11 @@ func (t *FilterTree) stringHelper(buf *bytes.Buffer) {
 			buf.WriteRune(' ')
 			if t.Func.IsCount {
 				buf.WriteString("count(")
+			} else if t.Func.DatePart != "" {
+				buf.WriteString(t.Func.DatePart + "(")
 			}
 			buf.WriteString(t.Func.Attr)
-			if t.Func.IsCount {
+			if t.Func.IsCount || t.Func.DatePart != "" {
 				buf.WriteRune(')')
 			}
 			if len(t.Func.Lang) > 0 {
@@ -1347,7 +1620,7 @@ func validFuncName(name string) bool {
 	}
 
 	switch name {
-	case "regexp", "anyofterms", "allofterms", "alloftext", "anyoftext",
+	case "regexp", "match", "anyofterms", "allofterms", "alloftext", "anyoftext",
 		"has", "uid", "uid_in", "anyof", "allof":
 		return true
 	}
@@ -1418,12 +1691,28 @@ L:
 				}
 				it.Prev()
 				it.Prev()
-				nestedFunc, err := parseFunction(it, gq)
+				// The uids given to a nested uid function are its arguments, not the uids of the
+				// block.
+				nestedGq := gq
+				if function.Name == "uid_in" {
+					nestedGq = nil
+				}
+				nestedFunc, err := parseFunction(it, nestedGq)
 				if err != nil {
 					return nil, err
 				}
 				seenFuncArg = true
-				if nestedFunc.Name == value {
+				if nestedFunc.Name == uid && function.Name == "uid_in" {
+					// The uids of variables computed in other blocks, uid_in(friend, uid(a)).
+					for _, u := range nestedFunc.UID {
+						function.Args = append(function.Args, Arg{Value: fmt.Sprintf("%#x", u)})
+					}
+					function.Args = append(function.Args, nestedFunc.Args...)
+					for _, v := range nestedFunc.NeedsVar {
+						function.Args = append(function.Args, Arg{Value: v.Name, IsUidVar: true})
+					}
+					function.NeedsVar = append(function.NeedsVar, nestedFunc.NeedsVar...)
+				} else if nestedFunc.Name == value {
 					if len(nestedFunc.NeedsVar) > 1 {
 						return nil, x.Errorf("Multiple variables not allowed in a function")
 					}
@@ -1437,6 +1726,23 @@ L:
 					}
 					function.NeedsVar = append(function.NeedsVar, nestedFunc.NeedsVar...)
 					function.NeedsVar[0].Typ = VALUE_VAR
+				} else if nestedFunc.Name == "len" {
+					// The number of uids in a variable, eq(len(a), 0). Only conditions of
+					// upsert mutations are allowed to use it.
+					function.Attr = nestedFunc.Attr
+					function.IsLenVar = true
+					function.NeedsVar = append(function.NeedsVar, VarContext{
+						Name: nestedFunc.Attr,
+						Typ:  UID_VAR,
+					})
+				} else if types.IsDatePart(nestedFunc.Name) {
+					// A component of a datetime compared in place of the value, ge(year(dob), 1990).
+					if !isInequalityFn(function.Name) {
+						return nil, x.Errorf("Function %s can't be used within %s",
+							nestedFunc.Name, function.Name)
+					}
+					function.Attr = nestedFunc.Attr
+					function.DatePart = nestedFunc.Name
 				} else {
 					if nestedFunc.Name != "count" {
 						return nil,
@@ -1604,6 +1910,9 @@ L:
 	if function.Name != uid && len(function.Attr) == 0 {
 		return nil, x.Errorf("Got empty attr for function: [%s]", function.Name)
 	}
+	if function.Name == "uid_in" && len(function.Args) == 0 {
+		return nil, x.Errorf("Function uid_in requires the uids to look for")
+	}
 
 	return function, nil
 }
@@ -1825,6 +2134,20 @@ func parseGroupby(it *lex.ItemIterator, gq *GraphQuery) error {
 				continue
 			}
 
+			// Group by a component of a datetime, like month(dob).
+			var datePart string
+			if peekIt[0].Typ == itemLeftRound && types.IsDatePart(strings.ToLower(val)) {
+				datePart = strings.ToLower(val)
+				it.Next() // Consume the '('
+				if !it.Next() || it.Item().Typ != itemName {
+					return x.Errorf("Expected a predicate in %s() in groupby", datePart)
+				}
+				val = collectName(it, it.Item().Val)
+				if !it.Next() || it.Item().Typ != itemRightRound {
+					return x.Errorf("Expected ) after the predicate of %s() in groupby", datePart)
+				}
+			}
+
 			var langs []string
 			items, err := it.Peek(1)
 			if err == nil && items[0].Typ == itemAt {
@@ -1836,9 +2159,10 @@ func parseGroupby(it *lex.ItemIterator, gq *GraphQuery) error {
 				}
 			}
 			attrLang := GroupByAttr{
-				Attr:  val,
-				Alias: alias,
-				Langs: langs,
+				Attr:     val,
+				Alias:    alias,
+				Langs:    langs,
+				DatePart: datePart,
 			}
 			alias = ""
 			gq.GroupbyAttrs = append(gq.GroupbyAttrs, attrLang)
@@ -2131,7 +2455,7 @@ func validKeyAtRoot(k string) bool {
 	switch k {
 	case "func", "orderasc", "orderdesc", "first", "offset", "after":
 		return true
-	case "from", "to", "numpaths":
+	case "from", "to", "numpaths", "maxweight":
 		// Specific to shortest path
 		return true
 	case "depth":
@@ -2143,7 +2467,7 @@ func validKeyAtRoot(k string) bool {
 // Check for validity of key at non-root nodes.
 func validKey(k string) bool {
 	switch k {
-	case "orderasc", "orderdesc", "first", "offset", "after":
+	case "orderasc", "orderdesc", "first", "offset", "after", "depth":
 		return true
 	}
 	return false
@@ -2254,6 +2578,21 @@ func getRoot(it *lex.ItemIterator) (gq *GraphQuery, rerr error) {
 			}
 			item := it.Item()
 
+			if isSortkey(key) && isDistanceSortKey(it) {
+				attr, point, err := parseDistanceSortKey(it)
+				if err != nil {
+					return nil, err
+				}
+				val = "distance(" + attr + ")"
+				if order[val] {
+					return nil, x.Errorf("Sorting by an attribute: [%s] can only be done once", val)
+				}
+				gq.Order = append(gq.Order,
+					&pb.Order{Attr: attr, Desc: key == "orderdesc", Distance: point})
+				order[val] = true
+				continue
+			}
+
 			if item.Typ == itemDollar {
 				it.Next()
 				item = it.Item()
@@ -2340,6 +2679,77 @@ func isSortkey(k string) bool {
 	return k == "orderasc" || k == "orderdesc"
 }
 
+// parseFacetSortKey parses the facet of the edge to order by, e.g. weight in
+// orderasc: facet(weight).
+func parseFacetSortKey(it *lex.ItemIterator) (string, error) {
+	if !it.Next() || it.Item().Typ != itemLeftRound {
+		return "", x.Errorf("Expected ( after facet in order.")
+	}
+	if !it.Next() || it.Item().Typ != itemName {
+		return "", x.Errorf("Expected a facet name to order by. Got: %v", it.Item())
+	}
+	key := collectName(it, it.Item().Val)
+	if !it.Next() || it.Item().Typ != itemRightRound {
+		return "", x.Errorf("Expected ) after the facet %s to order by.", key)
+	}
+	return key, nil
+}
+
+// facetSortKey returns the facet ordered by if val is of the form facet(key).
+func facetSortKey(val string) (string, bool) {
+	if !strings.HasPrefix(val, "facet(") || !strings.HasSuffix(val, ")") {
+		return "", false
+	}
+	return val[len("facet(") : len(val)-1], true
+}
+
+// isDistanceSortKey returns whether the current item starts the geodesic distance to order by, as
+// in orderasc: distance(loc, [-122.08, 37.42]).
+func isDistanceSortKey(it *lex.ItemIterator) bool {
+	if it.Item().Val != "distance" {
+		return false
+	}
+	items, err := it.Peek(1)
+	return err == nil && items[0].Typ == itemLeftRound
+}
+
+// parseDistanceSortKey parses the geo predicate and the point of the distance to order by.
+func parseDistanceSortKey(it *lex.ItemIterator) (string, string, error) {
+	it.Next() // consume '('
+	if !it.Next() || it.Item().Typ != itemName {
+		return "", "", x.Errorf("Expected a predicate to order by distance. Got: %v", it.Item())
+	}
+	attr := collectName(it, it.Item().Val)
+	if !it.Next() || it.Item().Typ != itemComma {
+		return "", "", x.Errorf("Expected a comma after %s in distance. Got: %v", attr, it.Item())
+	}
+	if !it.Next() || it.Item().Typ != itemLeftSquare {
+		return "", "", x.Errorf("Expected a point [lon, lat] in distance. Got: %v", it.Item())
+	}
+	g := &Function{}
+	if err := parseGeoArgs(it, g); err != nil {
+		return "", "", err
+	}
+	if !it.Next() || it.Item().Typ != itemRightRound {
+		return "", "", x.Errorf("Expected ) after the point in distance. Got: %v", it.Item())
+	}
+	return attr, g.Args[0].Value, nil
+}
+
+// distanceSortKey returns the predicate and the point ordered by if val is of the form
+// distance(attr,point).
+func distanceSortKey(val string) (string, string, bool) {
+	if !strings.HasPrefix(val, "distance(") || !strings.HasSuffix(val, ")") {
+		return "", "", false
+	}
+	args := val[len("distance(") : len(val)-1]
+	idx := strings.Index(args, ",")
+	if idx < 0 {
+		return "", "", false
+	}
+	return args[:idx], args[idx+1:], true
+}
+
 type Count int
 
 const (
@@ -2443,7 +2853,7 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 				}
 			}
 
-			if valLower == "checkpwd" {
+			if valLower == "checkpwd" || valLower == "bm25" {
 				child := &GraphQuery{
 					Args:  make(map[string]string),
 					Var:   varName,
@@ -2454,7 +2864,9 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 				if child.Func, err = parseFunction(it, gq); err != nil {
 					return err
 				}
-				child.Func.Args = append(child.Func.Args, Arg{Value: child.Func.Attr})
+				if valLower == "checkpwd" {
+					child.Func.Args = append(child.Func.Args, Arg{Value: child.Func.Attr})
+				}
 				child.Attr = child.Func.Attr
 				gq.Children = append(gq.Children, child)
 				curp = nil
@@ -2488,11 +2900,8 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 					}
 					child.Attr = attr
 					child.IsInternal = false
-				} else {
-					if it.Item().Val != value {
-						return x.Errorf("Only variables allowed in aggregate functions. Got: %v",
-							it.Item().Val)
-					}
+					it.Next()
+				} else if it.Item().Val == value {
 					count, err := parseVarList(it, child)
 					if err != nil {
 						return err
@@ -2501,12 +2910,47 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 						x.Errorf("Expected one variable inside val() of aggregator but got %v", count)
 					}
 					child.NeedsVar[len(child.NeedsVar)-1].Typ = VALUE_VAR
+					it.Next()
+				} else {
+					// Aggregate a math function over value variables, like sum(cond(a > 5, a, 0)).
+					item = it.Item()
+					peekIt, err := it.Peek(1)
+					if err != nil || !isMathFunc(strings.ToLower(item.Val)) ||
+						peekIt[0].Typ != itemLeftRound {
+						return x.Errorf("Only variables allowed in aggregate functions. Got: %v",
+							item.Val)
+					}
+					if child.Var == "" && child.Alias == "" {
+						return x.Errorf("Aggregation of math should be used with a variable" +
+							" or have an alias")
+					}
+					it.Prev()
+					// The expression ends at the closing ')', or at the ',' before the
+					// percentile.
+					mathTree, _, err := parseMathFunc(it, true)
+					if err != nil {
+						return err
+					}
+					var vars Vars
+					mathTree.collectVars(&vars)
+					if len(vars.Needs) == 0 {
+						return x.Errorf("Expected a value variable in %v", valLower)
+					}
+					for _, name := range vars.Needs {
+						child.NeedsVar = append(child.NeedsVar, VarContext{
+							Name: name,
+							Typ:  VALUE_VAR,
+						})
+					}
+					child.MathExp = mathTree
 				}
 				child.Func = &Function{
 					Name:     valLower,
 					NeedsVar: child.NeedsVar,
 				}
-				it.Next() // Skip the closing ')'
+				if err := parseAggregatorArgs(it, child.Func); err != nil {
+					return err
+				}
 				gq.Children = append(gq.Children, child)
 				curp = nil
 				continue
@@ -2724,10 +3168,20 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 					if order[p.Val] {
 						return x.Errorf("Sorting by an attribute: [%s] can only be done once", p.Val)
 					}
+					order[p.Val] = true
+					if key, ok := facetSortKey(p.Val); ok {
+						curp.Order = append(curp.Order,
+							&pb.Order{Attr: key, Desc: p.Key == "orderdesc", Facet: true})
+						continue
+					}
+					if attr, point, ok := distanceSortKey(p.Val); ok {
+						curp.Order = append(curp.Order,
+							&pb.Order{Attr: attr, Desc: p.Key == "orderdesc", Distance: point})
+						continue
+					}
 					attr, langs := attrAndLang(p.Val)
 					curp.Order = append(curp.Order,
 						&pb.Order{Attr: attr, Desc: p.Key == "orderdesc", Langs: langs})
-					order[p.Val] = true
 					continue
 				}
 
@@ -2750,7 +3204,39 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 }
 
 func isAggregator(fname string) bool {
-	return fname == "min" || fname == "max" || fname == "sum" || fname == "avg"
+	switch fname {
+	case "min", "max", "sum", "avg", "stddev", "variance", "median", "percentile":
+		return true
+	}
+	return false
+}
+
+// parseAggregatorArgs parses the arguments of the aggregator f following the aggregated value,
+// starting at the ',' or ')' after it. Only percentile takes one, the percentile to compute.
+func parseAggregatorArgs(it *lex.ItemIterator, f *Function) error {
+	item := it.Item()
+	if f.Name != "percentile" {
+		if item.Typ != itemRightRound {
+			return x.Errorf("Expected ) after the argument of %v. Got: %v", f.Name, item.Val)
+		}
+		return nil
+	}
+
+	if item.Typ != itemComma {
+		return x.Errorf("Expected the percentile to compute in percentile")
+	}
+	it.Next()
+	item = it.Item()
+	p, err := strconv.ParseFloat(item.Val, 64)
+	if err != nil || p < 0 || p > 100 {
+		return x.Errorf("Expected a percentile between 0 and 100. Got: %v", item.Val)
+	}
+	f.Args = append(f.Args, Arg{Value: item.Val})
+	it.Next()
+	if item = it.Item(); item.Typ != itemRightRound {
+		return x.Errorf("Expected ) after the percentile. Got: %v", item.Val)
+	}
+	return nil
 }
 
 func isExpandFunc(name string) bool {
@@ -2762,7 +3248,11 @@ func isMathBlock(name string) bool {
 }
 
 func isGeoFunc(name string) bool {
-	return name == "near" || name == "contains" || name == "within" || name == "intersects"
+	switch name {
+	case "near", "nearest", "contains", "within", "intersects":
+		return true
+	}
+	return false
 }
 
 func isInequalityFn(name string) bool {
@@ -273,6 +273,47 @@ func TestParseQueryAggChild(t *testing.T) {
 	require.Contains(t, err.Error(), "Only variables allowed in aggregate functions")
 }
 
+func TestParseQueryAggMath(t *testing.T) {
+	query := `
+	{
+		var(func: uid(0x0a)) {
+			friends {
+				a as age
+			}
+			adults: sum(cond(a >= 18, 1, 0))
+			p90: percentile(val(a), 90)
+		}
+	}
+`
+	gq, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := gq.Query[0].Children
+	require.Equal(t, 3, len(children))
+	require.Equal(t, "sum", children[1].Func.Name)
+	require.Equal(t, "adults", children[1].Alias)
+	require.Equal(t, "cond", children[1].MathExp.Fn)
+	require.Equal(t, []VarContext{{Name: "a", Typ: VALUE_VAR}}, children[1].NeedsVar)
+	require.Equal(t, "percentile", children[2].Func.Name)
+	require.Equal(t, []Arg{{Value: "90"}}, children[2].Func.Args)
+	require.Equal(t, "p90", children[2].Alias)
+}
+
+func TestParseQueryAggMathWithoutVar(t *testing.T) {
+	query := `
+	{
+		var(func: uid(0x0a)) {
+			friends {
+				a as age
+			}
+			sum(cond(a >= 18, 1, 0))
+		}
+	}
+`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Aggregation of math should be used with a variable")
+}
+
 func TestParseQueryWithXIDError(t *testing.T) {
 	query := `
 {
@@ -1596,6 +1637,236 @@ func TestParseSchemaErrorMulti(t *testing.T) {
 	require.Contains(t, err.Error(), "Only one schema block allowed")
 }
 
+func TestParseSchemaNameLen(t *testing.T) {
+	query := `
+		schema (pred: [name, hi], min_name_len: 2, max_name_len: 40) {
+			type
+		}
+	`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, []string{"name", "hi"}, res.Schema.Predicates)
+	require.Equal(t, uint32(2), res.Schema.MinNameLen)
+	require.Equal(t, uint32(40), res.Schema.MaxNameLen)
+}
+
+func TestParseSchemaSort(t *testing.T) {
+	query := `
+		schema (sort: type) {
+			type
+		}
+	`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "type", res.Schema.Sort)
+
+	query = `
+		schema (sort: [type, predicate]) {
+			type
+		}
+	`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "expects a single value")
+}
+
+func TestParseSchemaValuePattern(t *testing.T) {
+	query := `
+		schema (value_pattern: /^[^@]+@[^@]+$/i) {
+			type
+		}
+	`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "(?i)^[^@]+@[^@]+$", res.Schema.ValuePattern)
+
+	query = `
+		schema (value_pattern: /[a-/) {
+			type
+		}
+	`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+
+	query = `
+		schema (value_pattern: email) {
+			type
+		}
+	`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "expects a regular expression")
+}
+
+func TestParseSchemaExcludeGroups(t *testing.T) {
+	query := `
+		schema (exclude_groups: [2, 3]) {
+			type
+		}
+	`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, []uint32{2, 3}, res.Schema.ExcludeGroups)
+
+	query = `
+		schema (exclude_groups: two) {
+			type
+		}
+	`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "expects group ids")
+}
+
+func TestParseSchemaReversesOnly(t *testing.T) {
+	query := `
+		schema (reverses_only: true) {
+			type
+		}
+	`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.True(t, res.Schema.ReversesOnly)
+
+	query = `
+		schema (reverses_only: false, group_by_leader: true) {
+			type
+		}
+	`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.False(t, res.Schema.ReversesOnly)
+	require.True(t, res.Schema.GroupByLeader)
+
+	query = `
+		schema (validate_constraints: true) {
+			type
+		}
+	`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.True(t, res.Schema.ValidateConstraints)
+
+	query = `
+		schema (missing_index_only: true) {
+			type
+		}
+	`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.True(t, res.Schema.MissingIndexOnly)
+
+	query = `
+		schema (best_effort: true) {
+			type
+		}
+	`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.True(t, res.Schema.BestEffort)
+
+	query = `
+		schema (read_from_any: true) {
+			type
+		}
+	`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.True(t, res.Schema.ReadFromAny)
+
+	query = `
+		schema (pred: [name, nickname], exists_only: true) {
+			type
+		}
+	`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.True(t, res.Schema.ExistsOnly)
+
+	query = `
+		schema (sort: alter_freq_desc, limit: 10) {
+			type
+		}
+	`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), res.Schema.Limit)
+
+	query = `
+		schema (type: [Person, Animal]) {}
+	`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Person", "Animal"}, res.Schema.TypeNames)
+
+	query = `
+		schema (indexed_only: true, reverses_only: true) {
+			type
+		}
+	`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.True(t, res.Schema.IndexedOnly)
+	require.True(t, res.Schema.ReversesOnly)
+
+	query = `
+		schema (include_moving: true) {
+			type
+		}
+	`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.True(t, res.Schema.IncludeMoving)
+
+	query = `
+		schema (types: [datetime, int]) {
+			type
+		}
+	`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, []string{"datetime", "int"}, res.Schema.Types)
+
+	query = `
+		schema (pred: title, pred_pattern: /^user\./, pred_pattern: /\.EMAIL$/i) {
+			type
+		}
+	`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, []string{"title"}, res.Schema.Predicates)
+	require.Equal(t, []string{`^user\.`, `(?i)\.EMAIL$`}, res.Schema.PredicatePatterns)
+
+	query = `
+		schema (reverses_only: yes) {
+			type
+		}
+	`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "expects true or false")
+}
+
+func TestParseSchemaArgError(t *testing.T) {
+	query := `
+		schema (min_name_len: abc) {
+			type
+		}
+	`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "expects a non-negative integer")
+
+	query = `
+		schema (foo: bar) {
+			type
+		}
+	`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid schema argument: foo")
+}
+
 func TestParseMutationError(t *testing.T) {
 	query := `
 		mutation {
@@ -2438,6 +2709,29 @@ func TestParseCheckPwd(t *testing.T) {
 	require.Equal(t, "password", gq.Query[0].Children[0].Attr)
 }
 
+func TestParseBM25(t *testing.T) {
+	query := `{
+		me(func: uid(1)) {
+			friend {
+				s as bm25(description@en, "quick brown fox")
+			}
+		}
+		ranked(func: uid(s), orderdesc: val(s)) {
+			description@en
+		}
+	}
+`
+	gq, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	child := gq.Query[0].Children[0].Children[0]
+	require.Equal(t, "bm25", child.Func.Name)
+	require.Equal(t, 1, len(child.Func.Args))
+	require.Equal(t, "quick brown fox", child.Func.Args[0].Value)
+	require.Equal(t, "en", child.Func.Lang)
+	require.Equal(t, "description", child.Attr)
+	require.Equal(t, "s", child.Var)
+}
+
 func TestParseComments(t *testing.T) {
 	query := `
 	# Something
@@ -2800,6 +3094,116 @@ func TestParseGroupbyRoot(t *testing.T) {
 	require.Equal(t, "a", res.Query[0].Children[0].Var)
 }
 
+func TestParseGroupbyDatePart(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(1, 2, 3)) {
+			friends @groupby(Month: month(dob), year(dob)) {
+				count(uid)
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, []GroupByAttr{
+		{Attr: "dob", Alias: "Month", DatePart: "month"},
+		{Attr: "dob", DatePart: "year"},
+	}, res.Query[0].Children[0].GroupbyAttrs)
+}
+
+func TestParseFilterDatePart(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(1)) @filter(ge(year(dob), 1990) and eq(month(dob), 1, 12)) {
+			name
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, `(AND (ge year(dob) "1990") (eq month(dob) "1" "12"))`,
+		res.Query[0].Filter.debugString())
+	require.Equal(t, "year", res.Query[0].Filter.Child[0].Func.DatePart)
+}
+
+func TestParseFilterDatePartError(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(1)) @filter(has(year(dob))) {
+			name
+		}
+	}
+`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Function year can't be used within has")
+}
+
+func TestParseUidInWithVar(t *testing.T) {
+	query := `
+	query {
+		var(func: uid(0x1)) {
+			f as friend
+		}
+		me(func: uid(0x1, 0x2)) @filter(uid_in(friend, uid(f, 0x3))) {
+			name
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	fn := res.Query[1].Filter.Func
+	require.Equal(t, "uid_in", fn.Name)
+	require.Equal(t, "friend", fn.Attr)
+	require.Equal(t, []Arg{{Value: "0x3"}, {Value: "f", IsUidVar: true}}, fn.Args)
+	require.Equal(t, []VarContext{{Name: "f", Typ: UID_VAR}}, fn.NeedsVar)
+	require.Equal(t, []uint64{0x1, 0x2}, res.Query[1].UID)
+}
+
+func TestParseUidInWithoutUids(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) @filter(uid_in(friend)) {
+			name
+		}
+	}
+`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Function uid_in requires the uids to look for")
+}
+
+func TestParseNormalizeDepth(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) @normalize(depth: 2) {
+			n: name
+			friend {
+				fn: name
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.True(t, res.Query[0].Normalize)
+	require.Equal(t, 2, res.Query[0].NormalizeDepth)
+}
+
+func TestParseNormalizeDepthError(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) @normalize(levels: 2) {
+			n: name
+		}
+	}
+`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected key depth inside @normalize()")
+}
+
 func TestParseGroupbyWithCountVar(t *testing.T) {
 	query := `
 	query {
@@ -3521,6 +3925,23 @@ func TestParseRegexp2(t *testing.T) {
 	require.Equal(t, "", res.Query[0].Func.Args[1].Value)
 }
 
+func TestParseMatch(t *testing.T) {
+	query := `
+	{
+	  me(func:match(name, "Stephen", 2)) @filter(match(alias, "Steve", 1)) {
+	    name
+	  }
+    }
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Query))
+	require.Equal(t, "match", res.Query[0].Func.Name)
+	require.Equal(t, "Stephen", res.Query[0].Func.Args[0].Value)
+	require.Equal(t, "2", res.Query[0].Func.Args[1].Value)
+	require.Equal(t, "Steve", res.Query[0].Filter.Func.Args[0].Value)
+}
+
 func TestParseRegexp3(t *testing.T) {
 	query := `
 	{
@@ -4167,6 +4588,80 @@ func TestOrderByVarAndPred(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestOrderByFacetArg(t *testing.T) {
+	query := `
+	{
+		me(func: uid(0x1)) {
+			friend (orderdesc: facet(weight), orderasc: name) {
+				name
+			}
+		}
+	}
+	`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	orders := res.Query[0].Children[0].Order
+	require.Equal(t, 2, len(orders))
+	require.Equal(t, "weight", orders[0].Attr)
+	require.True(t, orders[0].Desc)
+	require.True(t, orders[0].Facet)
+	require.Equal(t, "name", orders[1].Attr)
+	require.False(t, orders[1].Desc)
+	require.False(t, orders[1].Facet)
+
+	query = `
+	{
+		me(func: uid(0x1)) {
+			friend (orderasc: facet(weight, since)) {
+				name
+			}
+		}
+	}
+	`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+}
+
+func TestOrderByDistance(t *testing.T) {
+	query := `
+	{
+		me(func: nearest(loc, [-122.08, 37.42], 5), orderasc: distance(loc, [-122.08, 37.42])) {
+			name
+			friend (orderdesc: distance(loc, [1.1, 2.0]), orderasc: name) {
+				name
+			}
+		}
+	}
+	`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "nearest", res.Query[0].Func.Name)
+	require.Equal(t, "[-122.08,37.42]", res.Query[0].Func.Args[0].Value)
+	orders := res.Query[0].Order
+	require.Equal(t, 1, len(orders))
+	require.Equal(t, "loc", orders[0].Attr)
+	require.Equal(t, "[-122.08,37.42]", orders[0].Distance)
+	require.False(t, orders[0].Desc)
+
+	orders = res.Query[0].Children[1].Order
+	require.Equal(t, 2, len(orders))
+	require.Equal(t, "loc", orders[0].Attr)
+	require.Equal(t, "[1.1,2.0]", orders[0].Distance)
+	require.True(t, orders[0].Desc)
+	require.Equal(t, "name", orders[1].Attr)
+	require.Empty(t, orders[1].Distance)
+
+	query = `
+	{
+		me(func: uid(0x1), orderasc: distance(loc)) {
+			name
+		}
+	}
+	`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+}
+
 func TestInvalidValUsage(t *testing.T) {
 	query := `
 		{
@@ -4375,3 +4870,35 @@ func TestParseGraphQLVarPaginationRootMultiple(t *testing.T) {
 	require.Equal(t, args["after"], "0x123")
 	require.Equal(t, gq.Query[0].Order[0].Attr, "name")
 }
+
+func TestParseRecurseArgs(t *testing.T) {
+	query := `
+	{
+		me(func: uid(0x1)) @recurse(depth: 5, until: eq(name, "Andrea"), report: true) {
+			friend (depth: 2)
+			name
+		}
+	}
+	`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	args := res.Query[0].RecurseArgs
+	require.Equal(t, uint64(5), args.Depth)
+	require.True(t, args.Report)
+	require.NotNil(t, args.Until)
+	require.Equal(t, "eq", args.Until.Func.Name)
+	require.Equal(t, "name", args.Until.Func.Attr)
+	require.Equal(t, "2", res.Query[0].Children[0].Args["depth"])
+
+	query = `
+	{
+		me(func: uid(0x1)) @recurse(until: (has(school) or eq(name, "Andrea"))) {
+			friend
+		}
+	}
+	`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, `(OR (has school) (eq name "Andrea"))`,
+		res.Query[0].RecurseArgs.Until.debugString())
+}
@@ -0,0 +1,434 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gql
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/lex"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Upsert is a query along with the mutations to run on its results, in the same transaction.
+type Upsert struct {
+	Query     string
+	Mutations []*UpsertMutation
+}
+
+// UpsertMutation is one of the mutations of an upsert block. It's only run if its condition
+// holds, and uid(v) in its subjects and objects stands for each of the uids in the variable v,
+// while val(v) in its objects stands for the value of the variable v for the subject.
+type UpsertMutation struct {
+	Cond     *FilterTree // nil if the mutation always runs
+	Mutation *api.Mutation
+}
+
+// UpsertVars holds the variables of the query of an upsert, as found when running it.
+type UpsertVars struct {
+	Uids map[string][]uint64
+	// Vals holds the values of the value variables by uid, as literals of N-Quads.
+	Vals map[string]map[uint64]string
+}
+
+// varRegex matches uid(v) and val(v) in the N-Quads of an upsert mutation.
+var varRegex = regexp.MustCompile(`(uid|val)\(\s*([^\s(),]+)\s*\)`)
+
+// ParseUpsert parses an upsert block, with a query and the mutations to run on its results, each
+// with an optional condition over the number of uids in the variables of the query:
+//
+//	upsert {
+//	  query {
+//	    u as var(func: eq(email, "alice@dgraph.io"))
+//	  }
+//	  mutation @if(eq(len(u), 0)) {
+//	    set { _:alice <email> "alice@dgraph.io" . }
+//	  }
+//	  mutation @if(gt(len(u), 0)) {
+//	    set { uid(u) <seen> "true" . }
+//	  }
+//	}
+func ParseUpsert(upsert string) (*Upsert, error) {
+	s := &upsertScanner{input: upsert}
+	if s.word() != "upsert" {
+		return nil, x.Errorf("Expected upsert at the start of block.")
+	}
+	block, err := s.block(leftCurl, rightCurl)
+	if err != nil {
+		return nil, err
+	}
+	if !s.done() {
+		return nil, x.Errorf("Unexpected %q after upsert block.", s.input[s.pos:])
+	}
+
+	up := new(Upsert)
+	s = &upsertScanner{input: block[1 : len(block)-1]}
+	for !s.done() {
+		switch op := s.word(); op {
+		case "query":
+			if len(up.Query) > 0 {
+				return nil, x.Errorf("Only one query block allowed in an upsert block.")
+			}
+			if up.Query, err = s.block(leftCurl, rightCurl); err != nil {
+				return nil, err
+			}
+		case "mutation":
+			mu, err := s.mutation()
+			if err != nil {
+				return nil, err
+			}
+			up.Mutations = append(up.Mutations, mu)
+		default:
+			return nil, x.Errorf("Expected query or mutation inside upsert block, got %q.", op)
+		}
+	}
+	if len(up.Query) == 0 {
+		return nil, x.Errorf("Expected a query block inside upsert block.")
+	}
+	if len(up.Mutations) == 0 {
+		return nil, x.Errorf("Expected at least one mutation block inside upsert block.")
+	}
+	return up, nil
+}
+
+// Vars returns the variables of the query that the mutations use, in their conditions or in
+// their N-Quads.
+func (up *Upsert) Vars() []string {
+	var vars []string
+	for _, mu := range up.Mutations {
+		vars = append(vars, mu.Cond.lenVars()...)
+		for _, nquads := range [][]byte{mu.Mutation.SetNquads, mu.Mutation.DelNquads} {
+			for _, line := range strings.Split(string(nquads), "\n") {
+				for _, loc := range varLocs(line) {
+					vars = append(vars, line[loc[4]:loc[5]])
+				}
+			}
+		}
+	}
+	return x.RemoveDuplicates(vars)
+}
+
+// Holds returns whether the condition of the mutation holds for the uids in the variables.
+func (mu *UpsertMutation) Holds(vars *UpsertVars) bool {
+	return mu.Cond == nil || mu.Cond.evalLen(vars.Uids)
+}
+
+// WithVars returns the mutation to run, with uid(v) replaced by the uids in the variable v and
+// val(v) by the value of v for the subject. An N-Quad is repeated for each of the uids, and
+// dropped if the variable is empty or has no value for the subject.
+func (mu *UpsertMutation) WithVars(vars *UpsertVars) (*api.Mutation, error) {
+	m := *mu.Mutation
+	var err error
+	if m.SetNquads, err = expandVars(m.SetNquads, vars); err != nil {
+		return nil, err
+	}
+	if m.DelNquads, err = expandVars(m.DelNquads, vars); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func expandVars(nquads []byte, vars *UpsertVars) ([]byte, error) {
+	if len(nquads) == 0 {
+		return nquads, nil
+	}
+	var buf bytes.Buffer
+	for _, line := range strings.Split(string(nquads), "\n") {
+		if len(strings.TrimSpace(line)) == 0 {
+			continue
+		}
+		lines, err := expandLine(line, vars)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range lines {
+			buf.WriteString(l)
+			buf.WriteByte('\n')
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func expandLine(line string, vars *UpsertVars) ([]string, error) {
+	locs := varLocs(line)
+	if len(locs) == 0 {
+		return []string{line}, nil
+	}
+	// The subject comes first, so it has already been replaced when we get to val(v).
+	loc := locs[0]
+	name := line[loc[4]:loc[5]]
+	if line[loc[2]:loc[3]] == "val" {
+		subject, err := lineSubject(line)
+		if err != nil {
+			return nil, x.Wrapf(err, "While replacing val(%s)", name)
+		}
+		val, ok := vars.Vals[name][subject]
+		if !ok {
+			return nil, nil
+		}
+		return expandLine(line[:loc[0]]+val+line[loc[1]:], vars)
+	}
+
+	var lines []string
+	for _, uid := range vars.Uids[name] {
+		ls, err := expandLine(fmt.Sprintf("%s<%#x>%s", line[:loc[0]], uid, line[loc[1]:]), vars)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, ls...)
+	}
+	return lines, nil
+}
+
+// lineSubject returns the uid that is the subject of the N-Quad.
+func lineSubject(line string) (uint64, error) {
+	line = strings.TrimSpace(line)
+	end := strings.IndexByte(line, grThan)
+	if !strings.HasPrefix(line, "<") || end < 0 {
+		return 0, x.Errorf("Expected a uid as the subject of %q", line)
+	}
+	uid, err := strconv.ParseUint(line[1:end], 0, 64)
+	if err != nil {
+		return 0, x.Errorf("Expected a uid as the subject of %q", line)
+	}
+	return uid, nil
+}
+
+// varLocs returns the locations of uid(v) and val(v) in the N-Quad, leaving out the ones in
+// literals.
+func varLocs(line string) [][]int {
+	var locs [][]int
+	for _, loc := range varRegex.FindAllStringSubmatchIndex(line, -1) {
+		quoted := false
+		for i := 0; i < loc[0]; i++ {
+			switch line[i] {
+			case '\\':
+				i++
+			case quote:
+				quoted = !quoted
+			}
+		}
+		if !quoted {
+			locs = append(locs, loc)
+		}
+	}
+	return locs
+}
+
+// lenVars returns the variables used in the condition.
+func (ft *FilterTree) lenVars() []string {
+	if ft == nil {
+		return nil
+	}
+	if ft.Func != nil {
+		return []string{ft.Func.Attr}
+	}
+	var vars []string
+	for _, ch := range ft.Child {
+		vars = append(vars, ch.lenVars()...)
+	}
+	return vars
+}
+
+func (ft *FilterTree) evalLen(uids map[string][]uint64) bool {
+	switch ft.Op {
+	case "and":
+		for _, ch := range ft.Child {
+			if !ch.evalLen(uids) {
+				return false
+			}
+		}
+		return true
+	case "or":
+		for _, ch := range ft.Child {
+			if ch.evalLen(uids) {
+				return true
+			}
+		}
+		return false
+	case "not":
+		return !ft.Child[0].evalLen(uids)
+	}
+
+	n := len(uids[ft.Func.Attr])
+	// The argument was checked when the condition was parsed.
+	arg, _ := strconv.Atoi(ft.Func.Args[0].Value)
+	switch ft.Func.Name {
+	case "eq":
+		return n == arg
+	case "lt":
+		return n < arg
+	case "le":
+		return n <= arg
+	case "gt":
+		return n > arg
+	case "ge":
+		return n >= arg
+	}
+	return false
+}
+
+// parseUpsertCond parses the condition of an upsert mutation, like the (...) of a filter whose
+// functions compare the lengths of variables with numbers.
+func parseUpsertCond(cond string) (*FilterTree, error) {
+	lexer := lex.Lexer{Input: cond}
+	lexer.Run(lexUpsertCond)
+	it := lexer.NewIterator()
+	for it.Next() {
+		if item := it.Item(); item.Typ == lex.ItemError {
+			return nil, x.Errorf(item.Val)
+		}
+	}
+
+	it = lexer.NewIterator()
+	ft, err := parseFilter(it)
+	if err != nil {
+		return nil, err
+	}
+	if it.Next() && it.Item().Typ != lex.ItemEOF {
+		return nil, x.Errorf("Unexpected %q after @if condition.", it.Item().Val)
+	}
+	if ft == nil {
+		return nil, x.Errorf("Empty @if condition.")
+	}
+	return ft, ft.checkLen()
+}
+
+func (ft *FilterTree) checkLen() error {
+	for _, ch := range ft.Child {
+		if err := ch.checkLen(); err != nil {
+			return err
+		}
+	}
+	if ft.Func == nil {
+		return nil
+	}
+	switch ft.Func.Name {
+	case "eq", "lt", "le", "gt", "ge":
+	default:
+		return x.Errorf("Function %s not allowed in @if, only eq, lt, le, gt and ge are.",
+			ft.Func.Name)
+	}
+	if !ft.Func.IsLenVar {
+		return x.Errorf("Expected len(var) in %s of @if condition.", ft.Func.Name)
+	}
+	if len(ft.Func.Args) != 1 {
+		return x.Errorf("Expected one number to compare len(%s) with in @if condition.",
+			ft.Func.Attr)
+	}
+	if _, err := strconv.Atoi(ft.Func.Args[0].Value); err != nil {
+		return x.Errorf("Expected a number to compare len(%s) with in @if condition, got %q.",
+			ft.Func.Attr, ft.Func.Args[0].Value)
+	}
+	return nil
+}
+
+// upsertScanner finds the blocks of an upsert, leaving them to the query and mutation parsers.
+type upsertScanner struct {
+	input string
+	pos   int
+}
+
+func (s *upsertScanner) skip() {
+	for s.pos < len(s.input) {
+		switch r := rune(s.input[s.pos]); {
+		case isSpace(r) || isEndOfLine(r):
+			s.pos++
+		case r == '#':
+			for s.pos < len(s.input) && !isEndOfLine(rune(s.input[s.pos])) {
+				s.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (s *upsertScanner) done() bool {
+	s.skip()
+	return s.pos == len(s.input)
+}
+
+func (s *upsertScanner) word() string {
+	s.skip()
+	start := s.pos
+	for s.pos < len(s.input) && isNameSuffix(rune(s.input[s.pos])) {
+		s.pos++
+	}
+	return s.input[start:s.pos]
+}
+
+// block returns the block starting at the current position, delimiters included.
+func (s *upsertScanner) block(open, close byte) (string, error) {
+	s.skip()
+	if s.pos == len(s.input) || s.input[s.pos] != open {
+		return "", x.Errorf("Expected %c inside upsert block.", open)
+	}
+	start, depth := s.pos, 0
+	for ; s.pos < len(s.input); s.pos++ {
+		switch s.input[s.pos] {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				s.pos++
+				return s.input[start:s.pos], nil
+			}
+		case quote:
+			for s.pos++; s.pos < len(s.input) && s.input[s.pos] != quote; s.pos++ {
+				if s.input[s.pos] == backslash {
+					s.pos++
+				}
+			}
+		}
+	}
+	return "", x.Errorf("Unclosed %c inside upsert block.", open)
+}
+
+func (s *upsertScanner) mutation() (*UpsertMutation, error) {
+	mu := new(UpsertMutation)
+	if s.skip(); s.pos < len(s.input) && s.input[s.pos] == at {
+		s.pos++
+		if dir := s.word(); dir != "if" {
+			return nil, x.Errorf("Unknown directive @%s on upsert mutation.", dir)
+		}
+		cond, err := s.block(leftRound, rightRound)
+		if err != nil {
+			return nil, err
+		}
+		if mu.Cond, err = parseUpsertCond(cond); err != nil {
+			return nil, err
+		}
+	}
+
+	block, err := s.block(leftCurl, rightCurl)
+	if err != nil {
+		return nil, err
+	}
+	if mu.Mutation, err = ParseMutation(block); err != nil {
+		return nil, err
+	}
+	if len(mu.Mutation.SetNquads) == 0 && len(mu.Mutation.DelNquads) == 0 {
+		return nil, x.Errorf("Empty mutation inside upsert block.")
+	}
+	return mu, nil
+}
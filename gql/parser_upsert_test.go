@@ -0,0 +1,185 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUpsert(t *testing.T) {
+	up, err := ParseUpsert(`
+	upsert {
+		# find the user by email
+		query {
+			u as var(func: eq(email, "alice@dgraph.io"))
+			f as var(func: eq(name, "{bob}"))
+		}
+
+		mutation @if(eq(len(u), 0)) {
+			set {
+				_:alice <email> "alice@dgraph.io" .
+				_:alice <friend> uid(f) .
+			}
+		}
+
+		mutation @if(gt(len(u), 0) AND NOT le(len(f), 1)) {
+			set {
+				uid(u) <friend> uid(f) .
+				uid(u) <note> "uid(x) is kept" .
+			}
+			delete {
+				uid(u) <seen> * .
+			}
+		}
+	}`)
+	require.NoError(t, err)
+	require.Contains(t, up.Query, `f as var(func: eq(name, "{bob}"))`)
+	require.Len(t, up.Mutations, 2)
+	require.Equal(t, []string{"f", "u"}, up.Vars())
+
+	vars := &UpsertVars{Uids: map[string][]uint64{"f": {2, 3}}}
+	require.True(t, up.Mutations[0].Holds(vars))
+	require.False(t, up.Mutations[1].Holds(vars))
+	mu, err := up.Mutations[0].WithVars(vars)
+	require.NoError(t, err)
+	require.Contains(t, string(mu.SetNquads), "_:alice <friend> <0x2> .\n")
+	require.Contains(t, string(mu.SetNquads), "_:alice <friend> <0x3> .\n")
+
+	vars.Uids["u"] = []uint64{1}
+	require.False(t, up.Mutations[0].Holds(vars))
+	require.True(t, up.Mutations[1].Holds(vars))
+	mu, err = up.Mutations[1].WithVars(vars)
+	require.NoError(t, err)
+	require.Contains(t, string(mu.SetNquads), "<0x1> <friend> <0x2> .\n")
+	require.Contains(t, string(mu.SetNquads), "<0x1> <friend> <0x3> .\n")
+	require.Contains(t, string(mu.SetNquads), `<0x1> <note> "uid(x) is kept" .`)
+	require.Contains(t, string(mu.DelNquads), "<0x1> <seen> * .")
+
+	// N-Quads using empty variables are dropped.
+	mu, err = up.Mutations[1].WithVars(&UpsertVars{Uids: map[string][]uint64{"u": {1}}})
+	require.NoError(t, err)
+	require.NotContains(t, string(mu.SetNquads), "friend")
+}
+
+func TestParseUpsertValVars(t *testing.T) {
+	up, err := ParseUpsert(`upsert {
+		query {
+			u as var(func: has(email)) {
+				e as email
+			}
+		}
+		mutation {
+			delete {
+				uid(u) <email> val(e) .
+			}
+			set {
+				uid(u) <old_email> val(e) .
+			}
+		}
+	}`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"e", "u"}, up.Vars())
+
+	mu, err := up.Mutations[0].WithVars(&UpsertVars{
+		Uids: map[string][]uint64{"u": {1, 2, 3}, "e": {1, 2}},
+		Vals: map[string]map[uint64]string{"e": {1: `"a@dgraph.io"`, 2: `"b@dgraph.io"`}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "<0x1> <email> \"a@dgraph.io\" .\n<0x2> <email> \"b@dgraph.io\" .\n",
+		trimLines(mu.DelNquads))
+	require.Equal(t, "<0x1> <old_email> \"a@dgraph.io\" .\n<0x2> <old_email> \"b@dgraph.io\" .\n",
+		trimLines(mu.SetNquads))
+
+	// The value of a variable can only be used for a uid.
+	up, err = ParseUpsert(`upsert {
+		query { u as var(func: has(email)) { e as email } }
+		mutation { set { _:a <email> val(e) . } }
+	}`)
+	require.NoError(t, err)
+	_, err = up.Mutations[0].WithVars(&UpsertVars{
+		Uids: map[string][]uint64{"u": {1}, "e": {1}},
+		Vals: map[string]map[uint64]string{"e": {1: `"a@dgraph.io"`}},
+	})
+	require.Error(t, err)
+}
+
+func trimLines(nquads []byte) string {
+	var out []string
+	for _, line := range strings.Split(string(nquads), "\n") {
+		out = append(out, strings.TrimSpace(line))
+	}
+	return strings.Join(out, "\n")
+}
+
+func TestParseUpsertNoCondition(t *testing.T) {
+	up, err := ParseUpsert(`upsert {
+		query { u as var(func: has(email)) }
+		mutation { delete { uid(u) <email> * . } }
+	}`)
+	require.NoError(t, err)
+	require.Nil(t, up.Mutations[0].Cond)
+	require.True(t, up.Mutations[0].Holds(&UpsertVars{}))
+	require.Equal(t, []string{"u"}, up.Vars())
+}
+
+func TestParseUpsertErrors(t *testing.T) {
+	for _, upsert := range []string{
+		`{ set { _:a <name> "a" . } }`,
+		`upsert { query { u as var(func: has(email)) } }`,
+		`upsert { mutation { set { _:a <name> "a" . } } }`,
+		`upsert { query { u as var(func: has(email)) } mutation { set { _:a <name> "a" . } }`,
+		`upsert { query { u as var(func: has(email)) } mutation { } }`,
+		`upsert { query { a } query { b } mutation { set { _:a <name> "a" . } } }`,
+		`upsert { schema { } mutation { set { _:a <name> "a" . } } }`,
+		`upsert { query { u as var(func: has(email)) }
+			mutation @filter(eq(len(u), 0)) { set { _:a <name> "a" . } } }`,
+		`upsert { query { u as var(func: has(email)) }
+			mutation @if(eq(u, 0)) { set { _:a <name> "a" . } } }`,
+		`upsert { query { u as var(func: has(email)) }
+			mutation @if(anyofterms(len(u), 0)) { set { _:a <name> "a" . } } }`,
+		`upsert { query { u as var(func: has(email)) }
+			mutation @if(eq(len(u), "zero")) { set { _:a <name> "a" . } } }`,
+		`upsert { query { u as var(func: has(email)) }
+			mutation @if(eq(len(u), 0) { set { _:a <name> "a" . } } }`,
+		`upsert { query { u as var(func: has(email)) } mutation { set { _:a <name> "a" . } } } x`,
+	} {
+		_, err := ParseUpsert(upsert)
+		require.Error(t, err, upsert)
+	}
+}
+
+func TestParseWithNeedVars(t *testing.T) {
+	query := `{ u as var(func: eq(email, "alice@dgraph.io")) }`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	_, err = ParseWithNeedVars(Request{Str: query}, []string{"u"})
+	require.NoError(t, err)
+	_, err = ParseWithNeedVars(Request{Str: query}, []string{"u", "v"})
+	require.Error(t, err)
+}
+
+func TestParseLenOutsideUpsert(t *testing.T) {
+	res, err := Parse(Request{Str: `{
+		u as var(func: has(email))
+		me(func: uid(u)) @filter(eq(len(u), 1)) { name }
+	}`})
+	require.NoError(t, err)
+	require.True(t, res.Query[1].Filter.Func.IsLenVar)
+}
@@ -118,8 +118,10 @@ func lexInsideSchema(l *lex.Lexer) lex.StateFn {
 			l.Emit(itemRightSquare)
 		case isSpace(r) || isEndOfLine(r):
 			l.Ignore()
-		case isNameBegin(r):
+		case isNameBegin(r) || isNumber(r):
 			return lexArgName
+		case r == slash:
+			return lexRegex(l)
 		case r == '#':
 			return lexComment
 		case r == colon:
@@ -134,6 +136,39 @@ func lexInsideSchema(l *lex.Lexer) lex.StateFn {
 	}
 }
 
+// lexUpsertCond lexes the condition of a mutation in an upsert block, which looks like a filter
+// over the lengths of the query variables, e.g. (eq(len(a), 0) AND gt(len(b), 1)).
+func lexUpsertCond(l *lex.Lexer) lex.StateFn {
+	l.Mode = lexUpsertCond
+	for {
+		switch r := l.Next(); {
+		case r == leftRound:
+			l.ArgDepth++
+			l.Emit(itemLeftRound)
+		case r == rightRound:
+			if l.ArgDepth == 0 {
+				return l.Errorf("Unexpected right round bracket")
+			}
+			l.ArgDepth--
+			l.Emit(itemRightRound)
+		case r == comma:
+			l.Emit(itemComma)
+		case isNameBegin(r) || isNumber(r):
+			return lexArgName
+		case isSpace(r) || isEndOfLine(r):
+			l.Ignore()
+		case r == lex.EOF:
+			if l.ArgDepth != 0 {
+				return l.Errorf("Unclosed Brackets")
+			}
+			l.Emit(lex.ItemEOF)
+			return nil
+		default:
+			return l.Errorf("Unrecognized character in condition: %#U", r)
+		}
+	}
+}
+
 func lexFuncOrArg(l *lex.Lexer) lex.StateFn {
 	l.Mode = lexFuncOrArg
 	var empty bool
@@ -0,0 +1,506 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// SchemaPredicate stores the GraphQL schema uploaded.
+const SchemaPredicate = "dgraph.graphql.schema"
+
+// dgraph runs the GraphQL+- queries and the mutations GraphQL requests are compiled into. It's
+// edgraph.Server, through which the access control lists and the namespaces apply.
+type dgraph interface {
+	Query(ctx context.Context, req *api.Request) (*api.Response, error)
+	Mutate(ctx context.Context, mu *api.Mutation) (*api.Assigned, error)
+	Alter(ctx context.Context, op *api.Operation) (*api.Payload, error)
+	CommitOrAbort(ctx context.Context, tc *api.TxnContext) (*api.TxnContext, error)
+}
+
+// Request is a GraphQL request, as it's sent over HTTP.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Error is an error of a GraphQL response, with the path of the field it happened in if any.
+type Error struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// Response is the response to a GraphQL request. Its data is left out if the request couldn't
+// be run at all.
+type Response struct {
+	Data   interface{} `json:"data,omitempty"`
+	Errors []*Error    `json:"errors,omitempty"`
+}
+
+func errorResponse(err error) *Response {
+	return &Response{Errors: []*Error{{Message: err.Error()}}}
+}
+
+// Execute runs the request against the GraphQL schema uploaded.
+func Execute(ctx context.Context, req *Request) *Response {
+	dg := &edgraph.Server{}
+	schema, err := getSchema(ctx, dg)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return execute(ctx, dg, schema, req)
+}
+
+func execute(ctx context.Context, dg dgraph, schema *Schema, req *Request) *Response {
+	doc, err := ParseRequest(req.Query)
+	if err != nil {
+		return errorResponse(err)
+	}
+	op, err := doc.operation(req.OperationName)
+	if err != nil {
+		return errorResponse(err)
+	}
+	if op.Type == "subscription" {
+		return errorResponse(x.Errorf("Subscriptions aren't supported"))
+	}
+	vars, err := coerceVariables(op, req.Variables)
+	if err != nil {
+		return errorResponse(err)
+	}
+	r := &request{schema: schema, doc: doc, op: op, vars: vars}
+	roots, err := r.rootFields()
+	if err != nil {
+		return errorResponse(err)
+	}
+
+	data := newObject()
+	resp := &Response{Data: data}
+	if op.Type == "query" {
+		qb, err := queryOf(roots)
+		if err != nil {
+			return errorResponse(err)
+		}
+		if err := r.query(ctx, dg, roots, qb, data); err != nil {
+			resp.Errors = append(resp.Errors, &Error{Message: err.Error()})
+		}
+		return resp
+	}
+	// Mutations are run one after the other, in the order they're asked for.
+	for _, root := range roots {
+		var val interface{}
+		var err error
+		switch root.kind {
+		case "__typename":
+			val = "Mutation"
+		case "add":
+			val, err = r.add(ctx, dg, root)
+		case "update":
+			val, err = r.update(ctx, dg, root)
+		case "delete":
+			val, err = r.delete(ctx, dg, root)
+		}
+		if err != nil {
+			resp.Errors = append(resp.Errors, &Error{Message: err.Error(),
+				Path: []interface{}{root.key}})
+		}
+		data.set(root.key, val)
+	}
+	return resp
+}
+
+// queryOf builds the GraphQL+- query of the queries of an operation.
+func queryOf(roots []*rootField) (*queryBuilder, error) {
+	qb := newQueryBuilder()
+	for _, root := range roots {
+		var err error
+		switch root.kind {
+		case "get":
+			var uid string
+			if uid, err = parseID(root.args[root.typ.ID.Name]); err == nil {
+				err = qb.byUids(root.field, []string{uid})
+			}
+		case "query":
+			err = qb.byType(root.field)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return qb, nil
+}
+
+// query runs the queries of the operation as a single GraphQL+- query. They're all null if it
+// fails.
+func (r *request) query(ctx context.Context, dg dgraph, roots []*rootField, qb *queryBuilder,
+	data *object) error {
+	var result map[string]interface{}
+	var err error
+	if qb.buf.Len() > 0 {
+		result, err = runQuery(ctx, dg, qb.request())
+	}
+	for _, root := range roots {
+		switch {
+		case root.kind == "__typename":
+			data.set(root.key, "Query")
+		case err != nil:
+			data.set(root.key, nil)
+		case root.kind == "get":
+			if nodes := completeList(root.children, root.typ, result[root.key]); len(nodes) > 0 {
+				data.set(root.key, nodes[0])
+			} else {
+				data.set(root.key, nil)
+			}
+		default:
+			data.set(root.key, completeList(root.children, root.typ, result[root.key]))
+		}
+	}
+	return err
+}
+
+func runQuery(ctx context.Context, dg dgraph, req *api.Request) (map[string]interface{},
+	error) {
+	resp, err := dg.Query(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(resp.Json))
+	dec.UseNumber()
+	if err := dec.Decode(&result); err != nil {
+		return nil, x.Wrapf(err, "while decoding the query result")
+	}
+	return result, nil
+}
+
+// fetch returns the objects of the type among the uids, in the order of the uids.
+func (r *request) fetch(ctx context.Context, dg dgraph, f *field,
+	uids []string) ([]interface{}, error) {
+	if len(uids) == 0 {
+		return []interface{}{}, nil
+	}
+	qb := newQueryBuilder()
+	if err := qb.byUids(f, uids); err != nil {
+		return nil, err
+	}
+	result, err := runQuery(ctx, dg, qb.request())
+	if err != nil {
+		return nil, err
+	}
+	nodes, _ := result[f.key].([]interface{})
+	byUid := make(map[string]interface{})
+	for _, n := range nodes {
+		if node, ok := n.(map[string]interface{}); ok {
+			byUid[fmt.Sprint(node["uid"])] = complete(f.children, f.typ, node)
+		}
+	}
+	list := []interface{}{}
+	for _, uid := range uids {
+		if obj, ok := byUid[uid]; ok {
+			list = append(list, obj)
+		}
+	}
+	return list, nil
+}
+
+// existing returns the uids which are nodes of the type, and the start ts of the transaction
+// the mutations on them must run in.
+func existing(ctx context.Context, dg dgraph, typ *Type, uids []string) (uint64, []string,
+	error) {
+	req := &api.Request{Query: fmt.Sprintf("{ n(func: uid(%s)) @filter(%s) { uid } }",
+		strings.Join(uids, ", "), typeFunc(typ))}
+	resp, err := dg.Query(ctx, req)
+	if err != nil {
+		return 0, nil, err
+	}
+	var result struct {
+		N []struct {
+			Uid string `json:"uid"`
+		} `json:"n"`
+	}
+	if err := json.Unmarshal(resp.Json, &result); err != nil {
+		return 0, nil, x.Wrapf(err, "while decoding the query result")
+	}
+	found := make(map[string]bool)
+	for _, n := range result.N {
+		found[n.Uid] = true
+	}
+	var out []string
+	for _, uid := range uids {
+		if found[uid] {
+			out = append(out, uid)
+			delete(found, uid)
+		}
+	}
+	return resp.GetTxn().GetStartTs(), out, nil
+}
+
+// add creates the objects of the input, and returns them.
+func (r *request) add(ctx context.Context, dg dgraph, root *rootField) (interface{}, error) {
+	m := &mutationBuilder{schema: r.schema}
+	var nodes []interface{}
+	var blanks []string
+	for _, val := range asList(root.args["input"]) {
+		input, err := inputObject(root.typ, val)
+		if err != nil {
+			return nil, err
+		}
+		node, err := m.node(root.typ, input, "")
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+		blanks = append(blanks, strings.TrimPrefix(node["uid"].(string), "_:"))
+	}
+	js, err := json.Marshal(nodes)
+	if err != nil {
+		return nil, err
+	}
+	assigned, err := dg.Mutate(ctx, &api.Mutation{SetJson: js, CommitNow: true})
+	if err != nil {
+		return nil, err
+	}
+	var uids []string
+	for _, blank := range blanks {
+		uids = append(uids, assigned.Uids[blank])
+	}
+	return r.fetch(ctx, dg, root.field, uids)
+}
+
+// update sets and removes the values of the fields of an object, and returns it. The values
+// of the fields holding a single object are replaced.
+func (r *request) update(ctx context.Context, dg dgraph, root *rootField) (interface{}, error) {
+	typ := root.typ
+	uid, err := parseID(root.args[typ.ID.Name])
+	if err != nil {
+		return nil, err
+	}
+	m := &mutationBuilder{schema: r.schema}
+	var set, del map[string]interface{}
+	if root.args["set"] != nil {
+		input, err := inputObject(typ, root.args["set"])
+		if err != nil {
+			return nil, err
+		}
+		if set, err = m.node(typ, input, uid); err != nil {
+			return nil, err
+		}
+	}
+	if root.args["remove"] != nil {
+		input, err := inputObject(typ, root.args["remove"])
+		if err != nil {
+			return nil, err
+		}
+		if del, err = m.removal(typ, input, uid); err != nil {
+			return nil, err
+		}
+	}
+	for _, def := range typ.Fields {
+		if _, ok := set[def.Predicate]; ok && !def.isScalar() && !def.isList() {
+			if del == nil {
+				del = map[string]interface{}{"uid": uid}
+			}
+			del[def.Predicate] = nil
+		}
+	}
+
+	startTs, found, err := existing(ctx, dg, typ, []string{uid})
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, x.Errorf("%s %s not found", typ.Name, uid)
+	}
+	var mus []*api.Mutation
+	if len(del) > 1 {
+		js, err := json.Marshal(del)
+		if err != nil {
+			return nil, err
+		}
+		mus = append(mus, &api.Mutation{DeleteJson: js, StartTs: startTs})
+	}
+	if len(set) > 1 {
+		js, err := json.Marshal(set)
+		if err != nil {
+			return nil, err
+		}
+		mus = append(mus, &api.Mutation{SetJson: js, StartTs: startTs})
+	}
+	if err := runMutations(ctx, dg, startTs, mus); err != nil {
+		return nil, err
+	}
+	objs, err := r.fetch(ctx, dg, root.field, []string{uid})
+	if err != nil || len(objs) == 0 {
+		return nil, err
+	}
+	return objs[0], nil
+}
+
+// runMutations runs the mutations in the transaction, and commits it.
+func runMutations(ctx context.Context, dg dgraph, startTs uint64, mus []*api.Mutation) error {
+	for i, mu := range mus {
+		mu.CommitNow = i == len(mus)-1
+		if _, err := dg.Mutate(ctx, mu); err != nil {
+			if i > 0 {
+				_, _ = dg.CommitOrAbort(ctx, &api.TxnContext{StartTs: startTs, Aborted: true})
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// delete removes all the fields of the objects, and returns the IDs of those which existed.
+func (r *request) delete(ctx context.Context, dg dgraph, root *rootField) (interface{}, error) {
+	typ := root.typ
+	var uids []string
+	for _, val := range asList(root.args[typ.ID.Name]) {
+		uid, err := parseID(val)
+		if err != nil {
+			return nil, err
+		}
+		uids = append(uids, uid)
+	}
+	startTs, found, err := existing(ctx, dg, typ, uids)
+	if err != nil {
+		return nil, err
+	}
+	deleted := []interface{}{}
+	if len(found) == 0 {
+		return deleted, nil
+	}
+	var nodes []interface{}
+	for _, uid := range found {
+		node := map[string]interface{}{"uid": uid, TypePredicate: nil}
+		for _, def := range typ.Fields {
+			if def != typ.ID {
+				node[def.Predicate] = nil
+			}
+		}
+		nodes = append(nodes, node)
+		deleted = append(deleted, uid)
+	}
+	js, err := json.Marshal(nodes)
+	if err != nil {
+		return nil, err
+	}
+	mu := &api.Mutation{DeleteJson: js, StartTs: startTs}
+	if err := runMutations(ctx, dg, startTs, []*api.Mutation{mu}); err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// ErrNoSchema is returned by GetSchema when no GraphQL schema has been uploaded.
+var ErrNoSchema = x.Errorf("No GraphQL schema has been uploaded")
+
+// schemaCache keeps the GraphQL schema last read, to parse it again only once it changes.
+var schemaCache struct {
+	sync.Mutex
+	schema *Schema
+}
+
+// GetSchema returns the GraphQL schema uploaded.
+func GetSchema(ctx context.Context) (*Schema, error) {
+	return getSchema(ctx, &edgraph.Server{})
+}
+
+func getSchema(ctx context.Context, dg dgraph) (*Schema, error) {
+	resp, err := dg.Query(ctx, &api.Request{
+		Query: fmt.Sprintf("{ s(func: has(%[1]s)) { %[1]s } }", SchemaPredicate),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		S []map[string]string `json:"s"`
+	}
+	if err := json.Unmarshal(resp.Json, &result); err != nil {
+		return nil, x.Wrapf(err, "while decoding the GraphQL schema")
+	}
+	if len(result.S) == 0 {
+		return nil, ErrNoSchema
+	}
+	sdl := result.S[0][SchemaPredicate]
+
+	schemaCache.Lock()
+	defer schemaCache.Unlock()
+	if schemaCache.schema != nil && schemaCache.schema.SDL == sdl {
+		return schemaCache.schema, nil
+	}
+	schema, err := ParseSchema(sdl)
+	if err != nil {
+		return nil, x.Wrapf(err, "while parsing the GraphQL schema uploaded")
+	}
+	schemaCache.schema = schema
+	return schema, nil
+}
+
+// UpdateSchema validates the GraphQL schema, alters the schema of Dgraph to hold its types, and
+// stores it, replacing the previous one.
+func UpdateSchema(ctx context.Context, sdl string) (*Schema, error) {
+	return updateSchema(ctx, &edgraph.Server{}, sdl)
+}
+
+func updateSchema(ctx context.Context, dg dgraph, sdl string) (*Schema, error) {
+	schema, err := ParseSchema(sdl)
+	if err != nil {
+		return nil, err
+	}
+	op := &api.Operation{Schema: schema.Dgraph() + fmt.Sprintf("<%s>: string .\n",
+		SchemaPredicate)}
+	if _, err := dg.Alter(ctx, op); err != nil {
+		return nil, err
+	}
+
+	resp, err := dg.Query(ctx, &api.Request{
+		Query: fmt.Sprintf("{ s(func: has(%s)) { uid } }", SchemaPredicate),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		S []struct {
+			Uid string `json:"uid"`
+		} `json:"s"`
+	}
+	if err := json.Unmarshal(resp.Json, &result); err != nil {
+		return nil, x.Wrapf(err, "while decoding the query result")
+	}
+	uid := "_:schema"
+	if len(result.S) > 0 {
+		uid = result.S[0].Uid
+	}
+	js, err := json.Marshal(map[string]string{"uid": uid, SchemaPredicate: sdl})
+	if err != nil {
+		return nil, err
+	}
+	mu := &api.Mutation{SetJson: js, StartTs: resp.GetTxn().GetStartTs(), CommitNow: true}
+	if _, err := dg.Mutate(ctx, mu); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
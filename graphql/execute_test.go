@@ -0,0 +1,180 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDgraph records the requests it gets, and answers the queries with the results given.
+type fakeDgraph struct {
+	queries   []*api.Request
+	results   []string
+	mutations []*api.Mutation
+	uids      map[string]string
+}
+
+func (dg *fakeDgraph) Query(ctx context.Context, req *api.Request) (*api.Response, error) {
+	dg.queries = append(dg.queries, req)
+	res := dg.results[0]
+	dg.results = dg.results[1:]
+	return &api.Response{Json: []byte(res), Txn: &api.TxnContext{StartTs: 5}}, nil
+}
+
+func (dg *fakeDgraph) Mutate(ctx context.Context, mu *api.Mutation) (*api.Assigned, error) {
+	dg.mutations = append(dg.mutations, mu)
+	return &api.Assigned{Uids: dg.uids}, nil
+}
+
+func (dg *fakeDgraph) Alter(ctx context.Context, op *api.Operation) (*api.Payload, error) {
+	return &api.Payload{}, nil
+}
+
+func (dg *fakeDgraph) CommitOrAbort(ctx context.Context,
+	tc *api.TxnContext) (*api.TxnContext, error) {
+	return tc, nil
+}
+
+func run(t *testing.T, dg *fakeDgraph, query string, vars map[string]interface{}) string {
+	s, err := ParseSchema(testSchema)
+	require.NoError(t, err)
+	resp := execute(context.Background(), dg, s, &Request{Query: query, Variables: vars})
+	js, err := json.Marshal(resp)
+	require.NoError(t, err)
+	return string(js)
+}
+
+func TestExecuteQuery(t *testing.T) {
+	dg := &fakeDgraph{results: []string{`{
+		"me": [{"uid": "0x1", "name": "alice", "friends": [{"uid": "0x2", "name": "bob"}]}],
+		"people": [{"uid": "0x2", "name": "bob", "nicknames": ["b"]}, {"uid": "0x3"}]
+	}`}}
+	resp := run(t, dg, `query q($name: String) {
+		me: getPerson(id: "1") { __typename id name friends(first: 1) { name } boss { name } }
+		people: queryPerson(filter: {name: {anyofterms: $name}, not: {age: {lt: 18}}},
+				order: {desc: age}, offset: 1) {
+			name
+			nicknames
+		}
+	}`, map[string]interface{}{"name": "bob"})
+
+	require.Len(t, dg.queries, 1)
+	require.Equal(t, `query q($v0: string, $v1: string) { `+
+		`me(func: uid(0x1)) @filter(eq(dgraph.type, "Person")) { uid name : Person.name `+
+		`friends : Person.friends (first: 1) { uid name : Person.name } `+
+		`boss : Person.boss { uid name : Person.name } } `+
+		`people(func: eq(dgraph.type, "Person"), offset: 1, orderdesc: Person.age) `+
+		`@filter(anyofterms(Person.name, $v0) AND NOT (lt(Person.age, $v1))) `+
+		`{ uid name : Person.name nicknames : Person.nicknames } }`, dg.queries[0].Query)
+	require.Equal(t, map[string]string{"$v0": "bob", "$v1": "18"}, dg.queries[0].Vars)
+
+	require.JSONEq(t, `{"data": {
+		"me": {"__typename": "Person", "id": "0x1", "name": "alice",
+			"friends": [{"name": "bob"}], "boss": null},
+		"people": [{"name": "bob", "nicknames": ["b"]}, {"name": null, "nicknames": []}]
+	}}`, resp)
+}
+
+func TestExecuteErrors(t *testing.T) {
+	for _, query := range []string{
+		`{ getPerson(id: "1") { city } }`,
+		`{ getPerson(id: "1") { friends } }`,
+		`{ getPerson(id: "1") { name { first } } }`,
+		`{ getPerson { name } }`,
+		`{ queryPerson(filter: {nicknames: {eq: "b"}}) { name } }`,
+		`{ queryPerson(filter: {age: {anyofterms: "b"}}) { name } }`,
+		`{ queryPerson(filter: {age: {eq: "b"}}) { name } }`,
+		`{ queryCity { name } }`,
+		`{ __schema { types { name } } }`,
+		`mutation { getPerson(id: "1") { name } }`,
+		`query ($id: ID!) { getPerson(id: $id) { name } }`,
+	} {
+		resp := run(t, &fakeDgraph{}, query, nil)
+		require.Contains(t, resp, `"errors"`, query)
+		require.NotContains(t, resp, `"data"`, query)
+	}
+}
+
+func TestExecuteAdd(t *testing.T) {
+	dg := &fakeDgraph{
+		uids: map[string]string{"n1": "0x10", "n2": "0x11", "n3": "0x12"},
+		results: []string{`{"addPerson": [
+			{"uid": "0x12", "name": "carol"},
+			{"uid": "0x10", "name": "alice"}
+		]}`},
+	}
+	resp := run(t, dg, `mutation {
+		addPerson(input: [
+			{name: "alice", age: 30, friends: [{id: "0x2"}, {name: "dave"}]},
+			{name: "carol"}
+		]) { name }
+	}`, nil)
+
+	require.Len(t, dg.mutations, 1)
+	require.True(t, dg.mutations[0].CommitNow)
+	require.JSONEq(t, `[
+		{"uid": "_:n1", "dgraph.type": "Person", "Person.name": "alice", "Person.age": 30,
+			"Person.friends": [{"uid": "0x2"},
+				{"uid": "_:n2", "dgraph.type": "Person", "Person.name": "dave"}]},
+		{"uid": "_:n3", "dgraph.type": "Person", "Person.name": "carol"}
+	]`, string(dg.mutations[0].SetJson))
+	require.Contains(t, dg.queries[0].Query, "addPerson(func: uid(0x10, 0x12))")
+	// the objects are returned in the order of the input
+	require.JSONEq(t, `{"data": {"addPerson": [{"name": "alice"}, {"name": "carol"}]}}`, resp)
+}
+
+func TestExecuteUpdate(t *testing.T) {
+	dg := &fakeDgraph{results: []string{
+		`{"n": [{"uid": "0x1"}]}`,
+		`{"updatePerson": [{"uid": "0x1", "name": "alice"}]}`,
+	}}
+	resp := run(t, dg, `mutation {
+		updatePerson(id: "0x1", set: {name: "alice", boss: {id: "0x3"}},
+			remove: {nicknames: ["al"], friends: [{id: "0x2"}]}) { name }
+	}`, nil)
+
+	require.Len(t, dg.mutations, 2)
+	require.JSONEq(t, `{"uid": "0x1", "Person.nicknames": ["al"],
+		"Person.friends": [{"uid": "0x2"}], "Person.boss": null}`,
+		string(dg.mutations[0].DeleteJson))
+	require.False(t, dg.mutations[0].CommitNow)
+	require.JSONEq(t, `{"uid": "0x1", "Person.name": "alice", "Person.boss": [{"uid": "0x3"}]}`,
+		string(dg.mutations[1].SetJson))
+	require.True(t, dg.mutations[1].CommitNow)
+	require.Equal(t, uint64(5), dg.mutations[1].StartTs)
+	require.JSONEq(t, `{"data": {"updatePerson": {"name": "alice"}}}`, resp)
+
+	// nothing is updated if the object doesn't exist
+	dg = &fakeDgraph{results: []string{`{"n": []}`}}
+	resp = run(t, dg, `mutation { updatePerson(id: "0x1", set: {name: "a"}) { name } }`, nil)
+	require.Empty(t, dg.mutations)
+	require.Contains(t, resp, `"path":["updatePerson"]`)
+}
+
+func TestExecuteDelete(t *testing.T) {
+	dg := &fakeDgraph{results: []string{`{"n": [{"uid": "0x2"}]}`}}
+	resp := run(t, dg, `mutation { deletePerson(id: ["0x1", "0x2"]) }`, nil)
+	require.JSONEq(t, `[{"uid": "0x2", "dgraph.type": null, "Person.name": null,
+		"Person.age": null, "Person.nicknames": null, "Person.friends": null,
+		"Person.boss": null}]`, string(dg.mutations[0].DeleteJson))
+	require.JSONEq(t, `{"data": {"deletePerson": ["0x2"]}}`, resp)
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"strings"
+
+	"github.com/dgraph-io/dgraph/lex"
+)
+
+// The constants represent the tokens of GraphQL documents, both schemas and requests.
+const (
+	itemName   lex.ItemType = 5 + iota // name, 5
+	itemInt                            // integer value, 6
+	itemFloat                          // float value, 7
+	itemString                         // quoted or block string, 8
+	itemSpread                         // ..., 9
+	itemPunct                          // one of ! $ & ( ) : = @ [ ] { } |, 10
+)
+
+const punctuators = "!$&():=@[]{}|"
+
+// lexDocument lexes a GraphQL document. Commas, white space and comments aren't significant.
+func lexDocument(l *lex.Lexer) lex.StateFn {
+	for {
+		switch r := l.Next(); {
+		case r == lex.EOF:
+			l.Emit(lex.ItemEOF)
+			return nil
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == ',' || r == '\uFEFF':
+			l.Ignore()
+		case r == '#':
+			l.AcceptUntil(func(r rune) bool { return r == '\n' || r == '\r' })
+			l.Ignore()
+		case strings.ContainsRune(punctuators, r):
+			l.Emit(itemPunct)
+		case r == '.':
+			if !accept(l, ".") || !accept(l, ".") {
+				return l.Errorf("Invalid input: expected ...")
+			}
+			l.Emit(itemSpread)
+		case r == '"':
+			return lexString
+		case r == '-' || isDigit(r):
+			l.Backup()
+			return lexNumber
+		case isNameBegin(r):
+			l.AcceptRun(isNameSuffix)
+			l.Emit(itemName)
+		default:
+			return l.Errorf("Invalid input: %c", r)
+		}
+	}
+}
+
+// lexString lexes a quoted or a block string, the opening quote having been read. Its value is
+// emitted with its quotes, the parser unescapes it.
+func lexString(l *lex.Lexer) lex.StateFn {
+	if strings.HasPrefix(l.Input[l.Pos:], `""`) {
+		l.Pos += 2
+		end := strings.Index(l.Input[l.Pos:], `"""`)
+		for end > 0 && l.Input[l.Pos+end-1] == '\\' {
+			next := strings.Index(l.Input[l.Pos+end+1:], `"""`)
+			if next < 0 {
+				end = -1
+				break
+			}
+			end += next + 1
+		}
+		if end < 0 {
+			return l.Errorf("Unterminated block string")
+		}
+		l.Pos += end + 3
+		l.Emit(itemString)
+		return lexDocument
+	}
+	for {
+		switch l.Next() {
+		case lex.EOF, '\n', '\r':
+			return l.Errorf("Unterminated string")
+		case '\\':
+			l.Next()
+		case '"':
+			l.Emit(itemString)
+			return lexDocument
+		}
+	}
+}
+
+// lexNumber lexes an integer or a float value.
+func lexNumber(l *lex.Lexer) lex.StateFn {
+	accept(l, "-")
+	if _, ok := l.AcceptRun(isDigit); !ok {
+		return l.Errorf("Invalid number")
+	}
+	typ := itemInt
+	if accept(l, ".") {
+		if _, ok := l.AcceptRun(isDigit); !ok {
+			return l.Errorf("Invalid number")
+		}
+		typ = itemFloat
+	}
+	if accept(l, "eE") {
+		accept(l, "+-")
+		if _, ok := l.AcceptRun(isDigit); !ok {
+			return l.Errorf("Invalid number")
+		}
+		typ = itemFloat
+	}
+	if isNameBegin(l.Peek()) {
+		return l.Errorf("Invalid character after number: %c", l.Peek())
+	}
+	l.Emit(typ)
+	return lexDocument
+}
+
+// accept consumes the next rune if it's one of the valid ones.
+func accept(l *lex.Lexer, valid string) bool {
+	if strings.ContainsRune(valid, l.Next()) {
+		return true
+	}
+	l.Backup()
+	return false
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isNameBegin(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isNameSuffix(r rune) bool {
+	return isNameBegin(r) || isDigit(r)
+}
@@ -0,0 +1,586 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dgraph-io/dgraph/lex"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Enum is an enum value, written as a bare name.
+type Enum string
+
+// Variable is a reference to a variable of the operation, e.g. $id.
+type Variable string
+
+// Argument is an argument of a field or a directive. Its value is nil, a bool, an int64, a
+// float64, a string, an Enum, a Variable, a []interface{} or a map[string]interface{}.
+type Argument struct {
+	Name  string
+	Value interface{}
+}
+
+// Directive is a directive applied to a selection, e.g. @include(if: $flag).
+type Directive struct {
+	Name string
+	Args []*Argument
+}
+
+// Selection is a field, a fragment spread or an inline fragment of a selection set.
+type Selection struct {
+	Alias      string
+	Name       string
+	Args       []*Argument
+	Directives []*Directive
+	Selections []*Selection
+
+	// Fragment is the name of the fragment spread, if the selection is one.
+	Fragment string
+	// Inline is set for inline fragments, On being the type they apply to, if any.
+	Inline bool
+	On     string
+}
+
+// Key returns the key of the field in the response, its alias if it has one.
+func (s *Selection) Key() string {
+	if len(s.Alias) > 0 {
+		return s.Alias
+	}
+	return s.Name
+}
+
+// TypeRef is a reference to a type, e.g. [Person!]!. Lists have an element type.
+type TypeRef struct {
+	Name    string
+	Elem    *TypeRef
+	NonNull bool
+}
+
+func (t *TypeRef) String() string {
+	s := t.Name
+	if t.Elem != nil {
+		s = "[" + t.Elem.String() + "]"
+	}
+	if t.NonNull {
+		s += "!"
+	}
+	return s
+}
+
+// VarDef is the definition of a variable of an operation.
+type VarDef struct {
+	Name       string
+	Type       *TypeRef
+	Default    interface{}
+	HasDefault bool
+}
+
+// Operation is a query or a mutation of a request.
+type Operation struct {
+	Type       string
+	Name       string
+	Vars       []*VarDef
+	Directives []*Directive
+	Selections []*Selection
+}
+
+// Fragment is a named fragment of a request.
+type Fragment struct {
+	Name       string
+	On         string
+	Selections []*Selection
+}
+
+// Document is a parsed GraphQL request.
+type Document struct {
+	Operations []*Operation
+	Fragments  map[string]*Fragment
+}
+
+type parser struct {
+	it   *lex.ItemIterator
+	item lex.Item
+}
+
+func newParser(input string) *parser {
+	l := lex.Lexer{Input: input}
+	l.Run(lexDocument)
+	p := &parser{it: l.NewIterator()}
+	p.advance()
+	return p
+}
+
+// advance moves on to the next item. The current item is the first one not consumed yet.
+func (p *parser) advance() {
+	if p.it.Next() {
+		p.item = p.it.Item()
+	} else {
+		p.item = lex.Item{Typ: lex.ItemEOF}
+	}
+}
+
+func (p *parser) atEOF() bool {
+	return p.item.Typ == lex.ItemEOF
+}
+
+func (p *parser) atPunct(val string) bool {
+	return p.item.Typ == itemPunct && p.item.Val == val
+}
+
+func (p *parser) atName(val string) bool {
+	return p.item.Typ == itemName && p.item.Val == val
+}
+
+// skipPunct consumes the punctuator if it's the current item.
+func (p *parser) skipPunct(val string) bool {
+	if p.atPunct(val) {
+		p.advance()
+		return true
+	}
+	return false
+}
+
+func (p *parser) expectPunct(val string) error {
+	if !p.skipPunct(val) {
+		return p.unexpected(strconv.Quote(val))
+	}
+	return nil
+}
+
+func (p *parser) unexpected(want string) error {
+	switch p.item.Typ {
+	case lex.ItemError:
+		return x.Errorf("%s", p.item.Val)
+	case lex.ItemEOF:
+		return x.Errorf("Expected %s, got end of input", want)
+	}
+	return x.Errorf("Expected %s, got %q", want, p.item.Val)
+}
+
+func (p *parser) name() (string, error) {
+	if p.item.Typ != itemName {
+		return "", p.unexpected("a name")
+	}
+	name := p.item.Val
+	p.advance()
+	return name, nil
+}
+
+// ParseRequest parses the query document of a GraphQL request.
+func ParseRequest(query string) (*Document, error) {
+	p := newParser(query)
+	doc := &Document{Fragments: make(map[string]*Fragment)}
+	for !p.atEOF() {
+		switch {
+		case p.atPunct("{"):
+			sels, err := p.selectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.Operations = append(doc.Operations, &Operation{Type: "query", Selections: sels})
+		case p.atName("query") || p.atName("mutation") || p.atName("subscription"):
+			op, err := p.operation()
+			if err != nil {
+				return nil, err
+			}
+			doc.Operations = append(doc.Operations, op)
+		case p.atName("fragment"):
+			frag, err := p.fragment()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := doc.Fragments[frag.Name]; ok {
+				return nil, x.Errorf("Fragment %s is defined more than once", frag.Name)
+			}
+			doc.Fragments[frag.Name] = frag
+		default:
+			return nil, p.unexpected("an operation or a fragment")
+		}
+	}
+	if len(doc.Operations) == 0 {
+		return nil, x.Errorf("The request doesn't contain any operation")
+	}
+	return doc, nil
+}
+
+func (p *parser) operation() (*Operation, error) {
+	op := &Operation{Type: p.item.Val}
+	p.advance()
+	if p.item.Typ == itemName {
+		op.Name = p.item.Val
+		p.advance()
+	}
+	if p.skipPunct("(") {
+		for !p.skipPunct(")") {
+			def, err := p.varDef()
+			if err != nil {
+				return nil, err
+			}
+			op.Vars = append(op.Vars, def)
+		}
+	}
+	var err error
+	if op.Directives, err = p.directives(); err != nil {
+		return nil, err
+	}
+	if op.Selections, err = p.selectionSet(); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func (p *parser) varDef() (*VarDef, error) {
+	if err := p.expectPunct("$"); err != nil {
+		return nil, err
+	}
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectPunct(":"); err != nil {
+		return nil, err
+	}
+	typ, err := p.typeRef()
+	if err != nil {
+		return nil, err
+	}
+	def := &VarDef{Name: name, Type: typ}
+	if p.skipPunct("=") {
+		def.HasDefault = true
+		if def.Default, err = p.value(true); err != nil {
+			return nil, err
+		}
+	}
+	// Directives on variables aren't used, but they're valid.
+	_, err = p.directives()
+	return def, err
+}
+
+func (p *parser) typeRef() (*TypeRef, error) {
+	typ := &TypeRef{}
+	if p.skipPunct("[") {
+		elem, err := p.typeRef()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPunct("]"); err != nil {
+			return nil, err
+		}
+		typ.Elem = elem
+	} else {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		typ.Name = name
+	}
+	typ.NonNull = p.skipPunct("!")
+	return typ, nil
+}
+
+func (p *parser) fragment() (*Fragment, error) {
+	p.advance()
+	if p.atName("on") {
+		return nil, x.Errorf("Fragments can't be named on")
+	}
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	if !p.atName("on") {
+		return nil, p.unexpected(`"on"`)
+	}
+	p.advance()
+	frag := &Fragment{Name: name}
+	if frag.On, err = p.name(); err != nil {
+		return nil, err
+	}
+	if _, err := p.directives(); err != nil {
+		return nil, err
+	}
+	if frag.Selections, err = p.selectionSet(); err != nil {
+		return nil, err
+	}
+	return frag, nil
+}
+
+func (p *parser) selectionSet() ([]*Selection, error) {
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+	var sels []*Selection
+	for !p.skipPunct("}") {
+		sel, err := p.selection()
+		if err != nil {
+			return nil, err
+		}
+		sels = append(sels, sel)
+	}
+	if len(sels) == 0 {
+		return nil, x.Errorf("Selection sets can't be empty")
+	}
+	return sels, nil
+}
+
+func (p *parser) selection() (*Selection, error) {
+	sel := &Selection{}
+	var err error
+	if p.item.Typ == itemSpread {
+		p.advance()
+		if p.item.Typ == itemName && p.item.Val != "on" {
+			sel.Fragment = p.item.Val
+			p.advance()
+			sel.Directives, err = p.directives()
+			return sel, err
+		}
+		sel.Inline = true
+		if p.atName("on") {
+			p.advance()
+			if sel.On, err = p.name(); err != nil {
+				return nil, err
+			}
+		}
+		if sel.Directives, err = p.directives(); err != nil {
+			return nil, err
+		}
+		sel.Selections, err = p.selectionSet()
+		return sel, err
+	}
+
+	if sel.Name, err = p.name(); err != nil {
+		return nil, err
+	}
+	if p.skipPunct(":") {
+		sel.Alias = sel.Name
+		if sel.Name, err = p.name(); err != nil {
+			return nil, err
+		}
+	}
+	if p.atPunct("(") {
+		if sel.Args, err = p.arguments(false); err != nil {
+			return nil, err
+		}
+	}
+	if sel.Directives, err = p.directives(); err != nil {
+		return nil, err
+	}
+	if p.atPunct("{") {
+		if sel.Selections, err = p.selectionSet(); err != nil {
+			return nil, err
+		}
+	}
+	return sel, nil
+}
+
+func (p *parser) arguments(isConst bool) ([]*Argument, error) {
+	if err := p.expectPunct("("); err != nil {
+		return nil, err
+	}
+	var args []*Argument
+	for !p.skipPunct(")") {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPunct(":"); err != nil {
+			return nil, err
+		}
+		val, err := p.value(isConst)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, &Argument{Name: name, Value: val})
+	}
+	return args, nil
+}
+
+func (p *parser) directives() ([]*Directive, error) {
+	var dirs []*Directive
+	for p.skipPunct("@") {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		dir := &Directive{Name: name}
+		if p.atPunct("(") {
+			if dir.Args, err = p.arguments(true); err != nil {
+				return nil, err
+			}
+		}
+		dirs = append(dirs, dir)
+	}
+	return dirs, nil
+}
+
+// value parses a value. Constant values can't contain variables.
+func (p *parser) value(isConst bool) (interface{}, error) {
+	item := p.item
+	switch item.Typ {
+	case itemPunct:
+		switch item.Val {
+		case "$":
+			if isConst {
+				return nil, x.Errorf("Variables aren't allowed in constant values")
+			}
+			p.advance()
+			name, err := p.name()
+			return Variable(name), err
+		case "[":
+			p.advance()
+			list := []interface{}{}
+			for !p.skipPunct("]") {
+				val, err := p.value(isConst)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, val)
+			}
+			return list, nil
+		case "{":
+			p.advance()
+			obj := make(map[string]interface{})
+			for !p.skipPunct("}") {
+				name, err := p.name()
+				if err != nil {
+					return nil, err
+				}
+				if err := p.expectPunct(":"); err != nil {
+					return nil, err
+				}
+				if obj[name], err = p.value(isConst); err != nil {
+					return nil, err
+				}
+			}
+			return obj, nil
+		}
+	case itemInt:
+		p.advance()
+		val, err := strconv.ParseInt(item.Val, 10, 64)
+		if err != nil {
+			return nil, x.Errorf("Invalid integer %s", item.Val)
+		}
+		return val, nil
+	case itemFloat:
+		p.advance()
+		val, err := strconv.ParseFloat(item.Val, 64)
+		if err != nil {
+			return nil, x.Errorf("Invalid float %s", item.Val)
+		}
+		return val, nil
+	case itemString:
+		p.advance()
+		return unquote(item.Val)
+	case itemName:
+		p.advance()
+		switch item.Val {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+		return Enum(item.Val), nil
+	}
+	return nil, p.unexpected("a value")
+}
+
+// unquote returns the value of a quoted or a block string.
+func unquote(s string) (string, error) {
+	if strings.HasPrefix(s, `"""`) {
+		return blockString(strings.Replace(s[3:len(s)-3], `\"""`, `"""`, -1)), nil
+	}
+	s = s[1 : len(s)-1]
+	var b strings.Builder
+	for len(s) > 0 {
+		c := s[0]
+		if c != '\\' {
+			r, size := utf8.DecodeRuneInString(s)
+			b.WriteRune(r)
+			s = s[size:]
+			continue
+		}
+		if len(s) < 2 {
+			return "", x.Errorf("Invalid escape at the end of string")
+		}
+		switch s[1] {
+		case '"', '\\', '/':
+			b.WriteByte(s[1])
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'u':
+			if len(s) < 6 {
+				return "", x.Errorf("Invalid unicode escape: %s", s)
+			}
+			r, err := strconv.ParseUint(s[2:6], 16, 32)
+			if err != nil {
+				return "", x.Errorf("Invalid unicode escape: %s", s[:6])
+			}
+			b.WriteRune(rune(r))
+			s = s[6:]
+			continue
+		default:
+			return "", x.Errorf("Invalid escape: %s", s[:2])
+		}
+		s = s[2:]
+	}
+	return b.String(), nil
+}
+
+// blockString removes the indentation common to the lines of a block string, after the first
+// one, and its leading and trailing blank lines.
+func blockString(s string) string {
+	lines := strings.Split(strings.Replace(s, "\r\n", "\n", -1), "\n")
+	indent := -1
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimLeft(line, " \t")
+		if len(trimmed) == 0 {
+			continue
+		}
+		if n := len(line) - len(trimmed); indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	if indent > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) >= indent {
+				lines[i] = lines[i][indent:]
+			} else {
+				lines[i] = ""
+			}
+		}
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
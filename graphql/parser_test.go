@@ -0,0 +1,110 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRequest(t *testing.T) {
+	doc, err := ParseRequest(`
+	# the friends of a person
+	query friends($id: ID!, $first: Int = 10) {
+		me: getPerson(id: $id) {
+			name
+			friends(first: $first, filter: {name: {anyofterms: "alice bob"}}) @include(if: true) {
+				...personFields
+			}
+		}
+	}
+
+	fragment personFields on Person {
+		id, name
+		... on Person { age }
+	}`)
+	require.NoError(t, err)
+	require.Len(t, doc.Operations, 1)
+	op := doc.Operations[0]
+	require.Equal(t, "query", op.Type)
+	require.Equal(t, "friends", op.Name)
+	require.Equal(t, []*VarDef{
+		{Name: "id", Type: &TypeRef{Name: "ID", NonNull: true}},
+		{Name: "first", Type: &TypeRef{Name: "Int"}, Default: int64(10), HasDefault: true},
+	}, op.Vars)
+
+	me := op.Selections[0]
+	require.Equal(t, "me", me.Key())
+	require.Equal(t, "getPerson", me.Name)
+	require.Equal(t, []*Argument{{Name: "id", Value: Variable("id")}}, me.Args)
+	friends := me.Selections[1]
+	require.Equal(t, []*Argument{
+		{Name: "first", Value: Variable("first")},
+		{Name: "filter", Value: map[string]interface{}{
+			"name": map[string]interface{}{"anyofterms": "alice bob"},
+		}},
+	}, friends.Args)
+	require.Equal(t, []*Directive{{Name: "include", Args: []*Argument{{Name: "if", Value: true}}}},
+		friends.Directives)
+	require.Equal(t, "personFields", friends.Selections[0].Fragment)
+
+	frag := doc.Fragments["personFields"]
+	require.Equal(t, "Person", frag.On)
+	require.Len(t, frag.Selections, 3)
+	require.True(t, frag.Selections[2].Inline)
+	require.Equal(t, "age", frag.Selections[2].Selections[0].Name)
+}
+
+func TestParseRequestShorthand(t *testing.T) {
+	doc, err := ParseRequest(`{ queryPerson(order: {asc: name}, first: 2) { name } }`)
+	require.NoError(t, err)
+	require.Equal(t, "query", doc.Operations[0].Type)
+	require.Equal(t, []*Argument{
+		{Name: "order", Value: map[string]interface{}{"asc": Enum("name")}},
+		{Name: "first", Value: int64(2)},
+	}, doc.Operations[0].Selections[0].Args)
+}
+
+func TestParseRequestErrors(t *testing.T) {
+	for _, query := range []string{
+		``,
+		`{ }`,
+		`{ me(id: ) { name } }`,
+		`{ me { name }`,
+		`query ($id ID) { me { name } }`,
+		`{ me(name: "unterminated) { name } }`,
+		`{ me(n: 1x) { name } }`,
+		`fragment on on Person { name } { me { name } }`,
+	} {
+		_, err := ParseRequest(query)
+		require.Error(t, err, query)
+	}
+}
+
+func TestParseValues(t *testing.T) {
+	doc, err := ParseRequest(`{ f(a: -1.5e3, b: "a\"bé\n", c: [1, null, false], d: """
+		first
+		  second
+	""") }`)
+	require.NoError(t, err)
+	args := doc.Operations[0].Selections[0].Args
+	require.Equal(t, -1500.0, args[0].Value)
+	require.Equal(t, "a\"bé\n", args[1].Value)
+	require.Equal(t, []interface{}{int64(1), nil, false}, args[2].Value)
+	require.Equal(t, "first\n  second", args[3].Value)
+}
@@ -0,0 +1,881 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// field is a field of a request, checked against the schema, with its arguments resolved.
+type field struct {
+	key string
+	// def is nil for __typename, and for the fields of the roots.
+	def *FieldDef
+	// typ is the type of the objects the field returns, nil for scalars.
+	typ      *Type
+	args     map[string]interface{}
+	children []*field
+}
+
+// rootField is a query or a mutation of an operation.
+type rootField struct {
+	*field
+	// kind is the prefix of the name of the field, e.g. get for getPerson, or __typename.
+	kind string
+}
+
+// rootArgs lists the arguments of each kind of root field, the required ones first.
+var rootArgs = map[string]struct {
+	required []string
+	optional []string
+}{
+	"get":    {required: []string{"<id>"}},
+	"query":  {optional: []string{"filter", "order", "first", "offset"}},
+	"add":    {required: []string{"input"}},
+	"update": {required: []string{"<id>"}, optional: []string{"set", "remove"}},
+	"delete": {required: []string{"<id>"}},
+}
+
+// request holds what's needed to resolve the fields of an operation.
+type request struct {
+	schema *Schema
+	doc    *Document
+	op     *Operation
+	vars   map[string]interface{}
+}
+
+// operation returns the operation of the document to run, the one with the name if given.
+func (doc *Document) operation(name string) (*Operation, error) {
+	if len(name) == 0 {
+		if len(doc.Operations) > 1 {
+			return nil, x.Errorf("The operation to run must be named, the request has %d",
+				len(doc.Operations))
+		}
+		return doc.Operations[0], nil
+	}
+	for _, op := range doc.Operations {
+		if op.Name == name {
+			return op, nil
+		}
+	}
+	return nil, x.Errorf("Operation %s not found", name)
+}
+
+// coerceVariables returns the values of the variables of the operation, their default values
+// if they're not given. Numbers decoded from JSON are turned into int64 or float64.
+func coerceVariables(op *Operation, given map[string]interface{}) (map[string]interface{},
+	error) {
+	vars := make(map[string]interface{})
+	for _, def := range op.Vars {
+		val, ok := given[def.Name]
+		switch {
+		case ok:
+			vars[def.Name] = fromJSON(val)
+		case def.HasDefault:
+			vars[def.Name] = def.Default
+		case def.Type.NonNull:
+			return nil, x.Errorf("Variable $%s of type %s must be given", def.Name, def.Type)
+		}
+		if def.Type.NonNull && vars[def.Name] == nil {
+			return nil, x.Errorf("Variable $%s of type %s can't be null", def.Name, def.Type)
+		}
+	}
+	return vars, nil
+}
+
+func fromJSON(val interface{}) interface{} {
+	switch val := val.(type) {
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i
+		}
+		f, _ := val.Float64()
+		return f
+	case float64:
+		if val == float64(int64(val)) {
+			return int64(val)
+		}
+	case []interface{}:
+		list := make([]interface{}, len(val))
+		for i, elem := range val {
+			list[i] = fromJSON(elem)
+		}
+		return list
+	case map[string]interface{}:
+		obj := make(map[string]interface{}, len(val))
+		for k, elem := range val {
+			obj[k] = fromJSON(elem)
+		}
+		return obj
+	}
+	return val
+}
+
+// value returns the value with its variables replaced by their values.
+func (r *request) value(val interface{}) (interface{}, error) {
+	switch val := val.(type) {
+	case Variable:
+		for _, def := range r.op.Vars {
+			if def.Name == string(val) {
+				return r.vars[def.Name], nil
+			}
+		}
+		return nil, x.Errorf("Variable $%s isn't defined by the operation", val)
+	case []interface{}:
+		list := make([]interface{}, len(val))
+		for i, elem := range val {
+			var err error
+			if list[i], err = r.value(elem); err != nil {
+				return nil, err
+			}
+		}
+		return list, nil
+	case map[string]interface{}:
+		obj := make(map[string]interface{}, len(val))
+		for k, elem := range val {
+			var err error
+			if obj[k], err = r.value(elem); err != nil {
+				return nil, err
+			}
+		}
+		return obj, nil
+	}
+	return val, nil
+}
+
+func (r *request) args(args []*Argument) (map[string]interface{}, error) {
+	vals := make(map[string]interface{}, len(args))
+	for _, arg := range args {
+		if _, ok := vals[arg.Name]; ok {
+			return nil, x.Errorf("Argument %s is given more than once", arg.Name)
+		}
+		val, err := r.value(arg.Value)
+		if err != nil {
+			return nil, err
+		}
+		vals[arg.Name] = val
+	}
+	return vals, nil
+}
+
+// included evaluates the @skip and @include directives of a selection.
+func (r *request) included(dirs []*Directive) (bool, error) {
+	for _, dir := range dirs {
+		if dir.Name != "skip" && dir.Name != "include" {
+			return false, x.Errorf("Unknown directive @%s", dir.Name)
+		}
+		args, err := r.args(dir.Args)
+		if err != nil {
+			return false, err
+		}
+		cond, ok := args["if"].(bool)
+		if !ok || len(args) != 1 {
+			return false, x.Errorf("Directive @%s takes a single Boolean argument named if",
+				dir.Name)
+		}
+		if cond == (dir.Name == "skip") {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// collect returns the fields of the selections, with the fragments spread and the fields
+// sharing a key merged.
+func (r *request) collect(sels []*Selection) ([]*Selection, error) {
+	var out []*Selection
+	byKey := make(map[string]int)
+	var walk func(sels []*Selection, visited map[string]bool) error
+	walk = func(sels []*Selection, visited map[string]bool) error {
+		for _, sel := range sels {
+			if ok, err := r.included(sel.Directives); err != nil {
+				return err
+			} else if !ok {
+				continue
+			}
+			switch {
+			case len(sel.Fragment) > 0:
+				frag, ok := r.doc.Fragments[sel.Fragment]
+				if !ok {
+					return x.Errorf("Fragment %s isn't defined", sel.Fragment)
+				}
+				if visited[frag.Name] {
+					return x.Errorf("Fragment %s spreads itself", frag.Name)
+				}
+				visited[frag.Name] = true
+				if err := walk(frag.Selections, visited); err != nil {
+					return err
+				}
+				delete(visited, frag.Name)
+			case sel.Inline:
+				if err := walk(sel.Selections, visited); err != nil {
+					return err
+				}
+			default:
+				idx, ok := byKey[sel.Key()]
+				if !ok {
+					byKey[sel.Key()] = len(out)
+					out = append(out, sel)
+					continue
+				}
+				prev := out[idx]
+				if prev.Name != sel.Name {
+					return x.Errorf("Fields %s and %s can't both be returned as %s", prev.Name,
+						sel.Name, sel.Key())
+				}
+				merged := *prev
+				merged.Selections = append(append([]*Selection{}, prev.Selections...),
+					sel.Selections...)
+				out[idx] = &merged
+			}
+		}
+		return nil
+	}
+	if err := walk(sels, make(map[string]bool)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// fields resolves the selections of an object of the type.
+func (r *request) fields(sels []*Selection, typ *Type) ([]*field, error) {
+	sels, err := r.collect(sels)
+	if err != nil {
+		return nil, err
+	}
+	var fields []*field
+	for _, sel := range sels {
+		f := &field{key: sel.Key()}
+		if sel.Name == "__typename" {
+			if len(sel.Selections) > 0 || len(sel.Args) > 0 {
+				return nil, x.Errorf("Field __typename doesn't take arguments or subfields")
+			}
+			fields = append(fields, f)
+			continue
+		}
+		def := typ.Field(sel.Name)
+		switch {
+		case def == nil:
+			return nil, x.Errorf("Type %s doesn't have a field %s", typ.Name, sel.Name)
+		case f.key == "uid" && def != typ.ID:
+			return nil, x.Errorf("Field %s.%s can't be returned as uid", typ.Name, sel.Name)
+		}
+		f.def = def
+		if f.args, err = r.args(sel.Args); err != nil {
+			return nil, err
+		}
+		if def.isScalar() {
+			if len(sel.Selections) > 0 {
+				return nil, x.Errorf("Field %s.%s is a scalar, it can't have subfields",
+					typ.Name, def.Name)
+			}
+			if len(f.args) > 0 {
+				return nil, x.Errorf("Field %s.%s doesn't take arguments", typ.Name, def.Name)
+			}
+			fields = append(fields, f)
+			continue
+		}
+
+		f.typ = r.schema.Types[def.base()]
+		var allowed []string
+		if def.isList() {
+			allowed = rootArgs["query"].optional
+		}
+		if err := checkArgs(typ.Name+"."+def.Name, f.args, nil, allowed); err != nil {
+			return nil, err
+		}
+		if len(sel.Selections) == 0 {
+			return nil, x.Errorf("Field %s.%s of type %s must have subfields", typ.Name,
+				def.Name, f.typ.Name)
+		}
+		if f.children, err = r.fields(sel.Selections, f.typ); err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+func checkArgs(name string, args map[string]interface{}, required, optional []string) error {
+	for _, arg := range required {
+		if args[arg] == nil {
+			return x.Errorf("Field %s needs the argument %s", name, arg)
+		}
+	}
+	for arg := range args {
+		known := false
+		for _, a := range append(required, optional...) {
+			known = known || a == arg
+		}
+		if !known {
+			return x.Errorf("Field %s doesn't take the argument %s", name, arg)
+		}
+	}
+	return nil
+}
+
+// rootFields resolves the queries or the mutations of the operation.
+func (r *request) rootFields() ([]*rootField, error) {
+	op := r.op
+	sels, err := r.collect(op.Selections)
+	if err != nil {
+		return nil, err
+	}
+	kinds := []string{"get", "query"}
+	if op.Type == "mutation" {
+		kinds = []string{"add", "update", "delete"}
+	}
+
+	var roots []*rootField
+	for _, sel := range sels {
+		root := &rootField{field: &field{key: sel.Key()}}
+		if sel.Name == "__typename" {
+			root.kind = sel.Name
+			roots = append(roots, root)
+			continue
+		}
+		if sel.Name == "__schema" || sel.Name == "__type" {
+			return nil, x.Errorf("Introspection isn't supported, the generated schema can be " +
+				"read from /admin/schema/graphql")
+		}
+		for _, kind := range kinds {
+			if typ, ok := r.schema.Types[strings.TrimPrefix(sel.Name, kind)]; ok &&
+				strings.HasPrefix(sel.Name, kind) {
+				root.kind, root.typ = kind, typ
+			}
+		}
+		if root.typ == nil {
+			return nil, x.Errorf("The %s type doesn't have a field %s",
+				strings.Title(op.Type), sel.Name)
+		}
+		if root.args, err = r.args(sel.Args); err != nil {
+			return nil, err
+		}
+		var required []string
+		for _, arg := range rootArgs[root.kind].required {
+			if arg == "<id>" {
+				arg = root.typ.ID.Name
+			}
+			required = append(required, arg)
+		}
+		if err := checkArgs(sel.Name, root.args, required,
+			rootArgs[root.kind].optional); err != nil {
+			return nil, err
+		}
+		if root.kind == "delete" {
+			if len(sel.Selections) > 0 {
+				return nil, x.Errorf("Field %s returns IDs, it can't have subfields", sel.Name)
+			}
+		} else {
+			if len(sel.Selections) == 0 {
+				return nil, x.Errorf("Field %s must have subfields", sel.Name)
+			}
+			if root.children, err = r.fields(sel.Selections, root.typ); err != nil {
+				return nil, err
+			}
+		}
+		roots = append(roots, root)
+	}
+	return roots, nil
+}
+
+// queryBuilder builds the GraphQL+- query retrieving fields. The values given in filters are
+// passed as variables of the query.
+type queryBuilder struct {
+	buf     bytes.Buffer
+	varDefs []string
+	vars    map[string]string
+}
+
+func newQueryBuilder() *queryBuilder {
+	return &queryBuilder{vars: make(map[string]string)}
+}
+
+func (qb *queryBuilder) addVar(val string) string {
+	name := fmt.Sprintf("$v%d", len(qb.varDefs))
+	qb.varDefs = append(qb.varDefs, name+": string")
+	qb.vars[name] = val
+	return name
+}
+
+func (qb *queryBuilder) request() *api.Request {
+	query := "{ " + qb.buf.String() + "}"
+	if len(qb.varDefs) > 0 {
+		query = "query q(" + strings.Join(qb.varDefs, ", ") + ") " + query
+	}
+	return &api.Request{Query: query, Vars: qb.vars}
+}
+
+// typeFunc returns the function finding the nodes of the type.
+func typeFunc(typ *Type) string {
+	return fmt.Sprintf("eq(%s, %q)", TypePredicate, typ.Name)
+}
+
+// byUids adds the block retrieving the fields of the nodes of the type among the uids.
+func (qb *queryBuilder) byUids(f *field, uids []string) error {
+	fmt.Fprintf(&qb.buf, "%s(func: uid(%s)) @filter(%s) ", f.key, strings.Join(uids, ", "),
+		typeFunc(f.typ))
+	return qb.children(f.children)
+}
+
+// byType adds the block retrieving the fields of the nodes of the type matching its arguments.
+func (qb *queryBuilder) byType(f *field) error {
+	params, filter, err := qb.params(f.typ, f.args)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(&qb.buf, "%s(func: %s", f.key, typeFunc(f.typ))
+	for _, param := range params {
+		qb.buf.WriteString(", " + param)
+	}
+	qb.buf.WriteString(") ")
+	if len(filter) > 0 {
+		fmt.Fprintf(&qb.buf, "@filter(%s) ", filter)
+	}
+	return qb.children(f.children)
+}
+
+func (qb *queryBuilder) children(fields []*field) error {
+	// The uid is always retrieved, it's how the results are matched to the nodes they're for.
+	qb.buf.WriteString("{ uid ")
+	for _, f := range fields {
+		switch {
+		case f.def == nil:
+		case f.typ == nil:
+			if !f.def.isID() {
+				fmt.Fprintf(&qb.buf, "%s : %s ", f.key, f.def.Predicate)
+			}
+		default:
+			params, filter, err := qb.params(f.typ, f.args)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(&qb.buf, "%s : %s ", f.key, f.def.Predicate)
+			if len(params) > 0 {
+				fmt.Fprintf(&qb.buf, "(%s) ", strings.Join(params, ", "))
+			}
+			if len(filter) > 0 {
+				fmt.Fprintf(&qb.buf, "@filter(%s) ", filter)
+			}
+			if err := qb.children(f.children); err != nil {
+				return err
+			}
+		}
+	}
+	qb.buf.WriteString("} ")
+	return nil
+}
+
+// params returns the pagination and the ordering of a list of objects of the type, and its
+// filter, from the arguments of the field.
+func (qb *queryBuilder) params(typ *Type, args map[string]interface{}) ([]string, string,
+	error) {
+	var params []string
+	for _, arg := range []string{"first", "offset"} {
+		if args[arg] == nil {
+			continue
+		}
+		n, ok := args[arg].(int64)
+		if !ok || n < 0 {
+			return nil, "", x.Errorf("Argument %s must be a positive Int", arg)
+		}
+		params = append(params, fmt.Sprintf("%s: %d", arg, n))
+	}
+	if order := args["order"]; order != nil {
+		obj, ok := order.(map[string]interface{})
+		if !ok || len(obj) != 1 {
+			return nil, "", x.Errorf("Argument order must give either asc or desc")
+		}
+		for dir, name := range obj {
+			fieldName, _ := name.(Enum)
+			def := typ.Field(string(fieldName))
+			if (dir != "asc" && dir != "desc") || def == nil || !def.isScalar() || def.isID() {
+				return nil, "", x.Errorf("Invalid order %s: %v of %s", dir, name, typ.Name)
+			}
+			params = append(params, fmt.Sprintf("order%s: %s", dir, def.Predicate))
+		}
+	}
+	if args["filter"] == nil {
+		return params, "", nil
+	}
+	filter, err := qb.filter(typ, args["filter"])
+	return params, filter, err
+}
+
+// filter returns the GraphQL+- filter for a filter of the type. The conditions of a filter
+// must all hold.
+func (qb *queryBuilder) filter(typ *Type, val interface{}) (string, error) {
+	obj, ok := val.(map[string]interface{})
+	if !ok || len(obj) == 0 {
+		return "", x.Errorf("The filters of %s must be non empty objects", typ.Name)
+	}
+	var conds []string
+	for _, key := range sortedKeys(obj) {
+		switch key {
+		case "and", "or":
+			list, ok := obj[key].([]interface{})
+			if !ok {
+				list = []interface{}{obj[key]}
+			}
+			var subs []string
+			for _, elem := range list {
+				sub, err := qb.filter(typ, elem)
+				if err != nil {
+					return "", err
+				}
+				subs = append(subs, "("+sub+")")
+			}
+			if len(subs) > 0 {
+				conds = append(conds, "("+strings.Join(subs, " "+strings.ToUpper(key)+" ")+")")
+			}
+		case "not":
+			sub, err := qb.filter(typ, obj[key])
+			if err != nil {
+				return "", err
+			}
+			conds = append(conds, "NOT ("+sub+")")
+		default:
+			def := typ.Field(key)
+			if def == nil || len(def.Search) == 0 {
+				return "", x.Errorf("Field %s.%s can't be filtered on, it isn't searchable",
+					typ.Name, key)
+			}
+			ops, ok := obj[key].(map[string]interface{})
+			if !ok || len(ops) == 0 {
+				return "", x.Errorf("The filter of %s.%s must be a non empty object", typ.Name,
+					key)
+			}
+			for _, op := range sortedKeys(ops) {
+				if !hasOp(def.base(), op) {
+					return "", x.Errorf("Field %s.%s can't be filtered with %s", typ.Name, key,
+						op)
+				}
+				arg, err := scalarString(def.base(), ops[op])
+				if err != nil {
+					return "", x.Wrapf(err, "while filtering %s.%s", typ.Name, key)
+				}
+				conds = append(conds, fmt.Sprintf("%s(%s, %s)", op, def.Predicate,
+					qb.addVar(arg)))
+			}
+		}
+	}
+	return strings.Join(conds, " AND "), nil
+}
+
+func hasOp(scalar, op string) bool {
+	for _, o := range filterOps[scalar] {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (f *FieldDef) isID() bool {
+	return f.base() == "ID"
+}
+
+// scalarValue checks that the value is of the scalar type, and returns it as it's written in
+// JSON mutations.
+func scalarValue(scalar string, val interface{}) (interface{}, error) {
+	switch val := val.(type) {
+	case string:
+		if scalar == "String" || scalar == "DateTime" || scalar == "ID" {
+			return val, nil
+		}
+	case int64:
+		if scalar == "Int" || scalar == "Float" {
+			return val, nil
+		}
+	case float64:
+		if scalar == "Float" {
+			return val, nil
+		}
+	case bool:
+		if scalar == "Boolean" {
+			return val, nil
+		}
+	}
+	return nil, x.Errorf("Invalid value %v for a %s", val, scalar)
+}
+
+// scalarString returns the value of the scalar type as it's given to GraphQL+- functions.
+func scalarString(scalar string, val interface{}) (string, error) {
+	v, err := scalarValue(scalar, val)
+	if err != nil {
+		return "", err
+	}
+	switch v := v.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case string:
+		return v, nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+// parseID returns the uid given as the ID of an object, in the hex form of the query results.
+func parseID(val interface{}) (string, error) {
+	s, ok := val.(string)
+	if !ok {
+		return "", x.Errorf("Invalid ID %v, IDs are strings", val)
+	}
+	uid, err := strconv.ParseUint(s, 0, 64)
+	if err != nil || uid == 0 {
+		return "", x.Errorf("Invalid ID %q", s)
+	}
+	return fmt.Sprintf("%#x", uid), nil
+}
+
+func asList(val interface{}) []interface{} {
+	if list, ok := val.([]interface{}); ok {
+		return list
+	}
+	return []interface{}{val}
+}
+
+// mutationBuilder builds the JSON of the nodes of mutations from input objects.
+type mutationBuilder struct {
+	schema *Schema
+	blanks int
+}
+
+func inputObject(typ *Type, val interface{}) (map[string]interface{}, error) {
+	obj, ok := val.(map[string]interface{})
+	if !ok {
+		return nil, x.Errorf("Invalid input %v for a %s, it must be an object", val, typ.Name)
+	}
+	for key := range obj {
+		if typ.Field(key) == nil {
+			return nil, x.Errorf("Type %s doesn't have a field %s", typ.Name, key)
+		}
+	}
+	return obj, nil
+}
+
+// node returns the JSON setting the fields of the input on the node with the uid. A new node of
+// the type is created if the uid is empty.
+func (m *mutationBuilder) node(typ *Type, input map[string]interface{},
+	uid string) (map[string]interface{}, error) {
+	node := map[string]interface{}{"uid": uid}
+	if len(uid) == 0 {
+		m.blanks++
+		node["uid"] = fmt.Sprintf("_:n%d", m.blanks)
+		node[TypePredicate] = typ.Name
+	}
+	for _, key := range sortedKeys(input) {
+		def, val := typ.Field(key), input[key]
+		switch {
+		case def == typ.ID:
+			return nil, x.Errorf("Field %s.%s can't be set", typ.Name, key)
+		case val == nil:
+		case def.isScalar():
+			v, err := scalarValues(def, val)
+			if err != nil {
+				return nil, x.Wrapf(err, "while setting %s.%s", typ.Name, key)
+			}
+			node[def.Predicate] = v
+		default:
+			vals := asList(val)
+			if !def.isList() && len(vals) > 1 {
+				return nil, x.Errorf("Field %s.%s takes a single object", typ.Name, key)
+			}
+			var children []interface{}
+			for _, v := range vals {
+				child, err := m.child(m.schema.Types[def.base()], v)
+				if err != nil {
+					return nil, err
+				}
+				children = append(children, child)
+			}
+			node[def.Predicate] = children
+		}
+	}
+	return node, nil
+}
+
+// child returns the JSON of an object linked to by another one. It's either a reference to an
+// existing node by its ID, or a new node.
+func (m *mutationBuilder) child(typ *Type, val interface{}) (map[string]interface{}, error) {
+	obj, err := inputObject(typ, val)
+	if err != nil {
+		return nil, err
+	}
+	id, ok := obj[typ.ID.Name]
+	if !ok {
+		return m.node(typ, obj, "")
+	}
+	if len(obj) > 1 {
+		return nil, x.Errorf("A reference to a %s by its %s can't set other fields", typ.Name,
+			typ.ID.Name)
+	}
+	uid, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"uid": uid}, nil
+}
+
+// removal returns the JSON deleting the values of the input from the node. Null removes every
+// value of a field.
+func (m *mutationBuilder) removal(typ *Type, input map[string]interface{},
+	uid string) (map[string]interface{}, error) {
+	node := map[string]interface{}{"uid": uid}
+	for _, key := range sortedKeys(input) {
+		def, val := typ.Field(key), input[key]
+		switch {
+		case def == typ.ID:
+			return nil, x.Errorf("Field %s.%s can't be removed", typ.Name, key)
+		case val == nil:
+			node[def.Predicate] = nil
+		case def.isScalar():
+			v, err := scalarValues(def, val)
+			if err != nil {
+				return nil, x.Wrapf(err, "while removing %s.%s", typ.Name, key)
+			}
+			node[def.Predicate] = v
+		default:
+			var refs []interface{}
+			for _, v := range asList(val) {
+				ref, err := m.child(m.schema.Types[def.base()], v)
+				if err != nil {
+					return nil, err
+				}
+				if _, ok := ref[TypePredicate]; ok {
+					return nil, x.Errorf("Objects removed from %s.%s must be given by their ID",
+						typ.Name, key)
+				}
+				refs = append(refs, ref)
+			}
+			node[def.Predicate] = refs
+		}
+	}
+	return node, nil
+}
+
+func scalarValues(def *FieldDef, val interface{}) (interface{}, error) {
+	if !def.isList() {
+		return scalarValue(def.base(), val)
+	}
+	var vals []interface{}
+	for _, v := range asList(val) {
+		sv, err := scalarValue(def.base(), v)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, sv)
+	}
+	return vals, nil
+}
+
+// object is a JSON object keeping its keys in the order of the fields of the request.
+type object struct {
+	keys []string
+	vals map[string]interface{}
+}
+
+func newObject() *object {
+	return &object{vals: make(map[string]interface{})}
+}
+
+func (o *object) set(key string, val interface{}) {
+	if _, ok := o.vals[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.vals[key] = val
+}
+
+func (o *object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(o.vals[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// complete returns the fields of the node of the type, shaped as the request asked for them
+// from the GraphQL+- result.
+func complete(fields []*field, typ *Type, node map[string]interface{}) *object {
+	obj := newObject()
+	for _, f := range fields {
+		switch {
+		case f.def == nil:
+			obj.set(f.key, typ.Name)
+		case f.def.isID():
+			obj.set(f.key, node["uid"])
+		case f.typ == nil:
+			val := node[f.key]
+			if val == nil && f.def.isList() {
+				val = []interface{}{}
+			}
+			obj.set(f.key, val)
+		default:
+			children := completeList(f.children, f.typ, node[f.key])
+			if f.def.isList() {
+				obj.set(f.key, children)
+			} else if len(children) > 0 {
+				obj.set(f.key, children[0])
+			} else {
+				obj.set(f.key, nil)
+			}
+		}
+	}
+	return obj
+}
+
+func completeList(fields []*field, typ *Type, val interface{}) []interface{} {
+	nodes, _ := val.([]interface{})
+	list := []interface{}{}
+	for _, n := range nodes {
+		if node, ok := n.(map[string]interface{}); ok {
+			list = append(list, complete(fields, typ, node))
+		}
+	}
+	return list
+}
@@ -0,0 +1,378 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package graphql serves standard GraphQL requests over the data of Dgraph. The object types of
+// a GraphQL schema are translated into predicates, and the queries and mutations generated for
+// them are compiled into GraphQL+- queries and JSON mutations run by edgraph.
+package graphql
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// TypePredicate stores the name of the type of the nodes created through GraphQL, which is how
+// the nodes of a type are found.
+const TypePredicate = "dgraph.type"
+
+// scalars maps the scalar types of GraphQL to the types of the predicates storing them.
+var scalars = map[string]string{
+	"ID":       "",
+	"String":   "string",
+	"Int":      "int",
+	"Float":    "float",
+	"Boolean":  "bool",
+	"DateTime": "datetime",
+}
+
+// defaultSearch is the tokenizer used for the fields searched without giving one.
+var defaultSearch = map[string]string{
+	"String":   "term",
+	"Int":      "int",
+	"Float":    "float",
+	"Boolean":  "bool",
+	"DateTime": "year",
+}
+
+// filterOps lists the comparisons the fields of each scalar type can be filtered by.
+var filterOps = map[string][]string{
+	"String": {"eq", "le", "lt", "ge", "gt", "anyofterms", "allofterms", "anyoftext",
+		"alloftext"},
+	"Int":      {"eq", "le", "lt", "ge", "gt"},
+	"Float":    {"eq", "le", "lt", "ge", "gt"},
+	"DateTime": {"eq", "le", "lt", "ge", "gt"},
+	"Boolean":  {"eq"},
+}
+
+// Schema is a GraphQL schema made of object types.
+type Schema struct {
+	SDL   string
+	Types map[string]*Type
+	// names lists the types in the order they're defined.
+	names []string
+}
+
+// Type is an object type of the schema. Every type has an ID field, holding the uid of its nodes.
+type Type struct {
+	Name   string
+	Fields []*FieldDef
+	ID     *FieldDef
+	fields map[string]*FieldDef
+}
+
+// Field returns the field of the type with the name, nil if there's none.
+func (t *Type) Field(name string) *FieldDef {
+	return t.fields[name]
+}
+
+// FieldDef is a field of an object type. Fields other than the ID are stored in the predicate
+// named after the type and the field, e.g. Person.name.
+type FieldDef struct {
+	Name      string
+	Type      *TypeRef
+	Search    []string
+	Predicate string
+}
+
+// base returns the name of the type of the field, or of its elements if it's a list.
+func (f *FieldDef) base() string {
+	if f.Type.Elem != nil {
+		return f.Type.Elem.Name
+	}
+	return f.Type.Name
+}
+
+func (f *FieldDef) isList() bool {
+	return f.Type.Elem != nil
+}
+
+func (f *FieldDef) isScalar() bool {
+	_, ok := scalars[f.base()]
+	return ok
+}
+
+// ParseSchema parses and validates a GraphQL schema.
+func ParseSchema(sdl string) (*Schema, error) {
+	s := &Schema{SDL: sdl, Types: make(map[string]*Type)}
+	p := newParser(sdl)
+	for !p.atEOF() {
+		if p.item.Typ == itemString {
+			// Descriptions are allowed, but not kept.
+			p.advance()
+			continue
+		}
+		if !p.atName("type") {
+			return nil, p.unexpected("a type definition, only object types are supported,")
+		}
+		p.advance()
+		typ, err := p.typeDef()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := s.Types[typ.Name]; ok {
+			return nil, x.Errorf("Type %s is defined more than once", typ.Name)
+		}
+		s.Types[typ.Name] = typ
+		s.names = append(s.names, typ.Name)
+	}
+	if len(s.names) == 0 {
+		return nil, x.Errorf("The schema doesn't define any type")
+	}
+	for _, name := range s.names {
+		if err := s.validate(s.Types[name]); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (p *parser) typeDef() (*Type, error) {
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	typ := &Type{Name: name, fields: make(map[string]*FieldDef)}
+	if p.atName("implements") {
+		return nil, x.Errorf("Type %s can't implement interfaces, they aren't supported", name)
+	}
+	if dirs, err := p.directives(); err != nil {
+		return nil, err
+	} else if len(dirs) > 0 {
+		return nil, x.Errorf("Unknown directive @%s on type %s", dirs[0].Name, name)
+	}
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+	for !p.skipPunct("}") {
+		if p.item.Typ == itemString {
+			p.advance()
+			continue
+		}
+		field := &FieldDef{}
+		if field.Name, err = p.name(); err != nil {
+			return nil, err
+		}
+		if p.atPunct("(") {
+			return nil, x.Errorf("Field %s.%s can't have arguments", name, field.Name)
+		}
+		if err := p.expectPunct(":"); err != nil {
+			return nil, err
+		}
+		if field.Type, err = p.typeRef(); err != nil {
+			return nil, err
+		}
+		dirs, err := p.directives()
+		if err != nil {
+			return nil, err
+		}
+		if err := field.applyDirectives(name, dirs); err != nil {
+			return nil, err
+		}
+		if _, ok := typ.fields[field.Name]; ok {
+			return nil, x.Errorf("Field %s.%s is defined more than once", name, field.Name)
+		}
+		field.Predicate = name + "." + field.Name
+		typ.Fields = append(typ.Fields, field)
+		typ.fields[field.Name] = field
+	}
+	return typ, nil
+}
+
+// applyDirectives reads the tokenizers of the field from its @search directive. The default
+// tokenizer of the type of the field is used if none is given.
+func (f *FieldDef) applyDirectives(typ string, dirs []*Directive) error {
+	for _, dir := range dirs {
+		if dir.Name != "search" {
+			return x.Errorf("Unknown directive @%s on field %s.%s", dir.Name, typ, f.Name)
+		}
+		f.Search = []string{defaultSearch[f.base()]}
+		for _, arg := range dir.Args {
+			if arg.Name != "by" {
+				return x.Errorf("Unknown argument %s of @search on field %s.%s", arg.Name, typ,
+					f.Name)
+			}
+			toks, ok := arg.Value.([]interface{})
+			if !ok {
+				toks = []interface{}{arg.Value}
+			}
+			f.Search = f.Search[:0]
+			for _, tok := range toks {
+				name, ok := tok.(Enum)
+				if !ok {
+					return x.Errorf("Invalid tokenizer %v in @search on field %s.%s", tok, typ,
+						f.Name)
+				}
+				f.Search = append(f.Search, string(name))
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Schema) validate(typ *Type) error {
+	switch {
+	case strings.HasPrefix(typ.Name, "_"):
+		return x.Errorf("Type %s can't start with _", typ.Name)
+	case typ.Name == "Query" || typ.Name == "Mutation" || typ.Name == "Subscription":
+		return x.Errorf("Type %s is generated from the other types, it can't be defined",
+			typ.Name)
+	case scalars[typ.Name] != "" || typ.Name == "ID":
+		return x.Errorf("Type %s is a scalar type, it can't be redefined", typ.Name)
+	case len(typ.Fields) == 0:
+		return x.Errorf("Type %s doesn't have any field", typ.Name)
+	}
+	for _, field := range typ.Fields {
+		name := typ.Name + "." + field.Name
+		base := field.base()
+		switch {
+		case strings.HasPrefix(field.Name, "_"):
+			return x.Errorf("Field %s can't start with _", name)
+		case field.isList() && field.Type.Elem.Elem != nil:
+			return x.Errorf("Field %s can't be a list of lists", name)
+		case !field.isScalar() && s.Types[base] == nil:
+			return x.Errorf("Field %s has the undefined type %s", name, base)
+		case field.isList() && (base == "ID" || base == "Boolean"):
+			return x.Errorf("Field %s can't be a list of %s", name, base)
+		case base == "ID" && typ.ID != nil:
+			return x.Errorf("Type %s has more than one field of type ID", typ.Name)
+		case len(field.Search) > 0 && (!field.isScalar() || base == "ID"):
+			return x.Errorf("Field %s of type %s can't be searched", name, base)
+		case len(field.Search) > 0 && (field.Name == "and" || field.Name == "or" ||
+			field.Name == "not"):
+			return x.Errorf("Field %s can't be searched, %s is used to combine filters", name,
+				field.Name)
+		}
+		if base == "ID" {
+			typ.ID = field
+		}
+	}
+	if typ.ID == nil {
+		return x.Errorf("Type %s must have a field of type ID", typ.Name)
+	}
+	return nil
+}
+
+// Dgraph returns the Dgraph schema of the predicates storing the fields of the types, along
+// with the declarations of the types, so that expand(_all_) returns the fields of a node.
+func (s *Schema) Dgraph() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<%s>: [string] @index(exact) .\n", TypePredicate)
+	for _, name := range s.names {
+		for _, field := range s.Types[name].Fields {
+			if field == s.Types[name].ID {
+				continue
+			}
+			typ := "uid"
+			if field.isScalar() {
+				typ = scalars[field.base()]
+				if field.isList() {
+					typ = "[" + typ + "]"
+				}
+			}
+			fmt.Fprintf(&buf, "<%s>: %s", field.Predicate, typ)
+			if len(field.Search) > 0 {
+				fmt.Fprintf(&buf, " @index(%s)", strings.Join(field.Search, ", "))
+			}
+			buf.WriteString(" .\n")
+		}
+	}
+	for _, name := range s.names {
+		if len(s.Types[name].Fields) == 1 {
+			// Types need fields, the ID isn't stored in one.
+			continue
+		}
+		fmt.Fprintf(&buf, "type %s {\n", name)
+		for _, field := range s.Types[name].Fields {
+			if field != s.Types[name].ID {
+				fmt.Fprintf(&buf, "  <%s>\n", field.Predicate)
+			}
+		}
+		buf.WriteString("}\n")
+	}
+	return buf.String()
+}
+
+// API returns the schema of the queries and mutations generated for the types, which is the
+// schema GraphQL clients see.
+func (s *Schema) API() string {
+	var buf bytes.Buffer
+	buf.WriteString(s.SDL)
+	usedScalars := make(map[string]bool)
+	for _, name := range s.names {
+		typ := s.Types[name]
+		fmt.Fprintf(&buf, "\ninput %sInput {\n", name)
+		for _, field := range typ.Fields {
+			t := field.base()
+			if !field.isScalar() {
+				t += "Input"
+			}
+			if field.isList() {
+				t = "[" + t + "]"
+			}
+			fmt.Fprintf(&buf, "  %s: %s\n", field.Name, t)
+		}
+		buf.WriteString("}\n")
+
+		fmt.Fprintf(&buf, "\ninput %sFilter {\n", name)
+		for _, field := range typ.Fields {
+			if len(field.Search) > 0 {
+				fmt.Fprintf(&buf, "  %s: %sFilter\n", field.Name, field.base())
+				usedScalars[field.base()] = true
+			}
+		}
+		fmt.Fprintf(&buf, "  and: [%[1]sFilter]\n  or: [%[1]sFilter]\n  not: %[1]sFilter\n}\n",
+			name)
+
+		fmt.Fprintf(&buf, "\nenum %sField {\n", name)
+		for _, field := range typ.Fields {
+			if field.isScalar() && field != typ.ID {
+				fmt.Fprintf(&buf, "  %s\n", field.Name)
+			}
+		}
+		fmt.Fprintf(&buf, "}\n\ninput %[1]sOrder {\n  asc: %[1]sField\n  desc: %[1]sField\n}\n",
+			name)
+	}
+	for _, scalar := range []string{"String", "Int", "Float", "DateTime", "Boolean"} {
+		if !usedScalars[scalar] {
+			continue
+		}
+		fmt.Fprintf(&buf, "\ninput %sFilter {\n", scalar)
+		for _, op := range filterOps[scalar] {
+			fmt.Fprintf(&buf, "  %s: %s\n", op, scalar)
+		}
+		buf.WriteString("}\n")
+	}
+
+	buf.WriteString("\ntype Query {\n")
+	for _, name := range s.names {
+		fmt.Fprintf(&buf, "  get%[1]s(%[2]s: ID!): %[1]s\n", name, s.Types[name].ID.Name)
+		fmt.Fprintf(&buf, "  query%[1]s(filter: %[1]sFilter, order: %[1]sOrder, first: Int, "+
+			"offset: Int): [%[1]s]\n", name)
+	}
+	buf.WriteString("}\n\ntype Mutation {\n")
+	for _, name := range s.names {
+		id := s.Types[name].ID.Name
+		fmt.Fprintf(&buf, "  add%[1]s(input: [%[1]sInput!]!): [%[1]s]\n", name)
+		fmt.Fprintf(&buf, "  update%[1]s(%[2]s: ID!, set: %[1]sInput, remove: %[1]sInput): "+
+			"%[1]s\n", name, id)
+		fmt.Fprintf(&buf, "  delete%[1]s(%[2]s: [ID!]!): [ID]\n", name, id)
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `
+"A person"
+type Person {
+	id: ID!
+	name: String! @search(by: [term, exact])
+	age: Int @search
+	nicknames: [String]
+	friends: [Person]
+	boss: Person
+}
+`
+
+func TestParseSchema(t *testing.T) {
+	s, err := ParseSchema(testSchema)
+	require.NoError(t, err)
+	person := s.Types["Person"]
+	require.Equal(t, "id", person.ID.Name)
+	require.Equal(t, []string{"term", "exact"}, person.Field("name").Search)
+	require.Equal(t, []string{"int"}, person.Field("age").Search)
+	require.Equal(t, "Person.friends", person.Field("friends").Predicate)
+	require.Equal(t, "[Person]", person.Field("friends").Type.String())
+
+	require.Equal(t, `<dgraph.type>: [string] @index(exact) .
+<Person.name>: string @index(term, exact) .
+<Person.age>: int @index(int) .
+<Person.nicknames>: [string] .
+<Person.friends>: uid .
+<Person.boss>: uid .
+type Person {
+  <Person.name>
+  <Person.age>
+  <Person.nicknames>
+  <Person.friends>
+  <Person.boss>
+}
+`, s.Dgraph())
+
+	api := s.API()
+	for _, def := range []string{
+		"getPerson(id: ID!): Person",
+		"queryPerson(filter: PersonFilter, order: PersonOrder, first: Int, offset: Int): [Person]",
+		"addPerson(input: [PersonInput!]!): [Person]",
+		"updatePerson(id: ID!, set: PersonInput, remove: PersonInput): Person",
+		"deletePerson(id: [ID!]!): [ID]",
+		"friends: [PersonInput]",
+		"name: StringFilter",
+		"anyofterms: String",
+	} {
+		require.True(t, strings.Contains(api, def), def)
+	}
+	require.False(t, strings.Contains(api, "nicknames: StringFilter"))
+}
+
+func TestParseSchemaErrors(t *testing.T) {
+	for _, sdl := range []string{
+		``,
+		`type Person { name: String }`,
+		`type Person { id: ID! id2: ID }`,
+		`type Person { id: ID! friend: Friend }`,
+		`type Person { id: ID! flags: [Boolean] }`,
+		`type Person { id: ID! friends: [Person] @search }`,
+		`type Person { id: ID! name: String @unique }`,
+		`type Person { id: ID! name(lang: String): String }`,
+		`type Person { id: ID! } type Person { id: ID! }`,
+		`type Query { id: ID! }`,
+		`interface Node { id: ID! }`,
+		`type Person { id: ID! and: String @search }`,
+	} {
+		_, err := ParseSchema(sdl)
+		require.Error(t, err, sdl)
+	}
+}
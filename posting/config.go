@@ -21,6 +21,9 @@ import "sync"
 type Options struct {
 	Mu             sync.Mutex
 	AllottedMemory float64
+	// LruFraction is the fraction of the available memory the LRU cache of the posting lists
+	// is limited to. Zero sizes it from AllottedMemory, as the memory use changes.
+	LruFraction float64
 
 	CommitFraction float64
 }
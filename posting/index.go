@@ -22,16 +22,21 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/golang/glog"
 	otrace "go.opencensus.io/trace"
 
 	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -91,14 +96,68 @@ func (txn *Txn) addIndexMutations(ctx context.Context, t *pb.DirectedEdge, p typ
 		Op:      op,
 	}
 
+	// The full-text tokens carry the facets the matches are ranked with by bm25, and the uid is
+	// listed under FullTextDocsToken, which the number of indexed values is read from.
+	var ftFacets map[string][]*api.Facet
+	if hasFullTextIndex(attr) {
+		sv, err := types.Convert(p, types.StringID)
+		if err != nil {
+			return err
+		}
+		if ftFacets, err = FullTextFacets(sv.Value.(string), t.GetLang()); err != nil {
+			return err
+		}
+		if len(ftFacets) > 0 {
+			tokens = append(tokens, tok.FullTextDocsToken)
+		}
+	}
+
 	for _, token := range tokens {
-		if err := txn.addIndexMutation(ctx, edge, token); err != nil {
+		e := edge
+		if fcs, ok := ftFacets[token]; ok && op == pb.DirectedEdge_SET {
+			e = &pb.DirectedEdge{ValueId: uid, Attr: attr, Op: op, Facets: fcs}
+		}
+		if err := txn.addIndexMutation(ctx, e, token); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// hasFullTextIndex returns whether the predicate has a full-text index.
+func hasFullTextIndex(attr string) bool {
+	for _, it := range schema.State().Tokenizer(attr) {
+		if it.Name() == "fulltext" {
+			return true
+		}
+	}
+	return false
+}
+
+// FullTextFacets returns the facets of the postings of a value in the full-text index, keyed by
+// their tokens: the number of occurrences tf of the token in the value and the length dl of the
+// value in terms.
+func FullTextFacets(val, lang string) (map[string][]*api.Facet, error) {
+	counts, length := tok.GetFullTextTermCounts(val, lang)
+	if len(counts) == 0 {
+		return nil, nil
+	}
+	dl, err := facets.FacetFor("dl", strconv.Itoa(length))
+	if err != nil {
+		return nil, err
+	}
+	fcs := make(map[string][]*api.Facet, len(counts))
+	for token, count := range counts {
+		tf, err := facets.FacetFor("tf", strconv.Itoa(count))
+		if err != nil {
+			return nil, err
+		}
+		// The facets have to be sorted by their keys.
+		fcs[token] = []*api.Facet{dl, tf}
+	}
+	return fcs, nil
+}
+
 func (txn *Txn) addIndexMutation(ctx context.Context, edge *pb.DirectedEdge,
 	token string) error {
 	key := x.IndexKey(edge.Attr, token)
@@ -113,6 +172,7 @@ func (txn *Txn) addIndexMutation(ctx context.Context, edge *pb.DirectedEdge,
 		return err
 	}
 	x.PredicateStats.Add("i."+edge.Attr, 1)
+	x.PredicateIndexTokens.Add(edge.Attr, 1)
 	return nil
 }
 
@@ -449,11 +509,47 @@ func DeleteCountIndex(attr string) error {
 	return nil
 }
 
+// rebuildProgress holds the progress of the index rebuilds running, by predicate.
+var rebuildProgress = struct {
+	sync.RWMutex
+	m map[string]*progress
+}{m: make(map[string]*progress)}
+
+// progress counts the keys a rebuild went through, out of the keys it has to go through. It's
+// updated atomically.
+type progress struct {
+	done  uint64
+	total uint64
+}
+
+// RebuildProgress returns whether an index of the predicate is being rebuilt, and how far the
+// rebuild is in percent. It stays below 100 until the rebuilt index is written.
+func RebuildProgress(attr string) (bool, uint32) {
+	rebuildProgress.RLock()
+	p, ok := rebuildProgress.m[attr]
+	rebuildProgress.RUnlock()
+	if !ok {
+		return false, 0
+	}
+	done, total := atomic.LoadUint64(&p.done), atomic.LoadUint64(&p.total)
+	if total == 0 {
+		return true, 0
+	}
+	percent := done * 100 / total
+	if percent > 99 {
+		percent = 99
+	}
+	return true, uint32(percent)
+}
+
 // Index rebuilding logic here.
 type rebuild struct {
 	prefix  []byte
 	startTs uint64
 	cache   map[string]*List
+	// attr is the predicate whose index is rebuilt, for RebuildProgress. The progress isn't
+	// tracked if it's empty.
+	attr string
 
 	// The posting list passed here is the on disk version. It is not coming
 	// from the LRU cache.
@@ -470,10 +566,38 @@ func (r *rebuild) storeList(list *List) bool {
 	return true
 }
 
+// countKeys returns the number of keys the rebuild goes through.
+func (r *rebuild) countKeys(t *badger.Txn) uint64 {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	opts.Prefix = r.prefix
+	it := t.NewIterator(opts)
+	defer it.Close()
+
+	var count uint64
+	for it.Rewind(); it.Valid(); it.Next() {
+		count++
+	}
+	return count
+}
+
 func (r *rebuild) Run(ctx context.Context) error {
 	t := pstore.NewTransactionAt(r.startTs, false)
 	defer t.Discard()
 
+	p := &progress{}
+	if r.attr != "" {
+		rebuildProgress.Lock()
+		rebuildProgress.m[r.attr] = p
+		rebuildProgress.Unlock()
+		defer func() {
+			rebuildProgress.Lock()
+			delete(rebuildProgress.m, r.attr)
+			rebuildProgress.Unlock()
+		}()
+		atomic.StoreUint64(&p.total, r.countKeys(t))
+	}
+
 	glog.V(1).Infof("Rebuild: Starting process. StartTs=%d. Prefix=\n%s\n",
 		r.startTs, hex.Dump(r.prefix))
 	opts := badger.DefaultIteratorOptions
@@ -534,6 +658,7 @@ func (r *rebuild) Run(ctx context.Context) error {
 		if err := r.fn(pk.Uid, l, txn); err != nil {
 			return err
 		}
+		atomic.AddUint64(&p.done, 1)
 	}
 	glog.V(1).Infof("Rebuild: Iteration done. Now commiting at ts=%d\n", r.startTs)
 
@@ -580,7 +705,7 @@ func RebuildIndex(ctx context.Context, attr string, startTs uint64) error {
 	x.AssertTruef(schema.State().IsIndexed(attr), "Attr %s not indexed", attr)
 
 	pk := x.ParsedKey{Attr: attr}
-	builder := rebuild{prefix: pk.DataPrefix(), startTs: startTs}
+	builder := rebuild{prefix: pk.DataPrefix(), startTs: startTs, attr: attr}
 	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
 		edge := pb.DirectedEdge{Attr: attr, Entity: uid}
 		return pl.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
@@ -631,7 +756,7 @@ func RebuildCountIndex(ctx context.Context, attr string, startTs uint64) error {
 
 	// Create the forward index.
 	pk := x.ParsedKey{Attr: attr}
-	builder := rebuild{prefix: pk.DataPrefix(), startTs: startTs}
+	builder := rebuild{prefix: pk.DataPrefix(), startTs: startTs, attr: attr}
 	builder.fn = fn
 	if err := builder.Run(ctx); err != nil {
 		return err
@@ -639,7 +764,7 @@ func RebuildCountIndex(ctx context.Context, attr string, startTs uint64) error {
 
 	// Create the reverse index.
 	reverse = true
-	builder = rebuild{prefix: pk.ReversePrefix(), startTs: startTs}
+	builder = rebuild{prefix: pk.ReversePrefix(), startTs: startTs, attr: attr}
 	builder.fn = fn
 	return builder.Run(ctx)
 }
@@ -654,7 +779,7 @@ func RebuildReverseEdges(ctx context.Context, attr string, startTs uint64) error
 	x.AssertTruef(schema.State().IsReversed(attr), "Attr %s doesn't have reverse", attr)
 
 	pk := x.ParsedKey{Attr: attr}
-	builder := rebuild{prefix: pk.DataPrefix(), startTs: startTs}
+	builder := rebuild{prefix: pk.DataPrefix(), startTs: startTs, attr: attr}
 	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
 		edge := pb.DirectedEdge{Attr: attr, Entity: uid}
 		return pl.Iterate(txn.StartTs, 0, func(pp *pb.Posting) error {
@@ -679,6 +804,15 @@ func RebuildReverseEdges(ctx context.Context, attr string, startTs uint64) error
 	return builder.Run(ctx)
 }
 
+// EvictIndex drops the cached index posting lists of the predicate, so that they're read again
+// from disk, e.g. once its index was rebuilt while mutations were applied. The lists with
+// pending mutations are kept, and their number is returned.
+func EvictIndex(attr string) int {
+	return lcache.evict(func(key []byte) bool {
+		return compareAttrAndType(key, attr, x.ByteIndex)
+	})
+}
+
 func DeleteIndex(attr string) error {
 	lcache.clear(func(key []byte) bool {
 		return compareAttrAndType(key, attr, x.ByteIndex)
@@ -763,6 +897,27 @@ func DeleteAll() error {
 	})
 }
 
+// DeleteData deletes the data and the indexes of all the predicates, keeping their schema and
+// the type definitions.
+func DeleteData() error {
+	lcache.clear(func([]byte) bool { return true })
+	return deleteEntries(nil, func(key []byte) bool {
+		pk := x.Parse(key)
+		return pk == nil || (!pk.IsSchema() && !pk.IsTypeDef())
+	})
+}
+
+// DeletePredicateData deletes the data and the indexes of the predicate, keeping its schema.
+func DeletePredicateData(attr string) error {
+	glog.Infof("Dropping the data of predicate: [%s]", attr)
+	lcache.clear(func(key []byte) bool {
+		return x.ParseAttr(key) == attr
+	})
+	return deleteEntries(x.PredicatePrefix(attr), func(key []byte) bool {
+		return true
+	})
+}
+
 func DeletePredicate(ctx context.Context, attr string) error {
 	glog.Infof("Dropping predicate: [%s]", attr)
 	lcache.clear(func(key []byte) bool {
@@ -300,6 +300,30 @@ func TestRebuildIndex(t *testing.T) {
 	require.EqualValues(t, 91, uids2[0])
 }
 
+func TestRebuildProgress(t *testing.T) {
+	schema.ParseBytes([]byte(schemaVal), 1)
+	addEdgeToValue(t, "name2", 91, "Michonne", uint64(1), uint64(2))
+	addEdgeToValue(t, "name2", 92, "David", uint64(3), uint64(4))
+
+	inProgress, _ := RebuildProgress("name2")
+	require.False(t, inProgress)
+
+	pk := x.ParsedKey{Attr: "name2"}
+	builder := rebuild{prefix: pk.DataPrefix(), startTs: 5, attr: "name2"}
+	var percents []uint32
+	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
+		inProgress, percent := RebuildProgress("name2")
+		require.True(t, inProgress)
+		percents = append(percents, percent)
+		return nil
+	}
+	require.NoError(t, builder.Run(context.Background()))
+	require.Equal(t, []uint32{0, 50}, percents)
+
+	inProgress, _ = RebuildProgress("name2")
+	require.False(t, inProgress)
+}
+
 func TestRebuildReverseEdges(t *testing.T) {
 	schema.ParseBytes([]byte(schemaVal), 1)
 	addEdgeToUID(t, "friend", 1, 23, uint64(10), uint64(11))
@@ -346,3 +370,27 @@ func TestRebuildReverseEdges(t *testing.T) {
 	require.EqualValues(t, 2, uids0[1])
 	require.EqualValues(t, 1, uids1[0])
 }
+
+func TestDeletePredicateData(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(schemaVal), 1))
+	addEdgeToValue(t, "name2", 93, "Glenn", uint64(20), uint64(21))
+	addEdgeToValue(t, "name", 93, "Glenn", uint64(22), uint64(23))
+	{
+		txn := ps.NewTransactionAt(24, true)
+		require.NoError(t, txn.Set(x.IndexKey("name2", "glenn"), []byte("nothing")))
+		require.NoError(t, txn.CommitAt(24, nil))
+	}
+	require.Contains(t, tokensForTest("name2"), "glenn")
+
+	require.NoError(t, DeletePredicateData("name2"))
+
+	txn := ps.NewTransactionAt(25, false)
+	defer txn.Discard()
+	_, err := txn.Get(x.DataKey("name2", 93))
+	require.Equal(t, badger.ErrKeyNotFound, err)
+	_, err = txn.Get(x.IndexKey("name2", "glenn"))
+	require.Equal(t, badger.ErrKeyNotFound, err)
+	// The data of the other predicates is kept.
+	_, err = txn.Get(x.DataKey("name", 93))
+	require.NoError(t, err)
+}
@@ -108,6 +108,10 @@ func (it *PIterator) Init(pl *pb.PostingList, afterUid uint64) {
 	it.dec = &codec.Decoder{Pack: pl.Pack}
 	it.uids = it.dec.Seek(afterUid)
 	it.uidx = 0
+	// Seek returns the uids from afterUid on, afterUid included if it's in the list.
+	if afterUid > 0 && it.Valid() && it.uids[0] == afterUid {
+		it.Next()
+	}
 
 	it.plen = len(pl.Postings)
 	it.pidx = sort.Search(it.plen, func(idx int) bool {
@@ -326,10 +330,10 @@ func (l *List) addMutation(ctx context.Context, txn *Txn, t *pb.DirectedEdge) er
 	if t.Attr == "_predicate_" {
 		// Don't check for conflict.
 
-	} else if schema.State().HasUpsert(t.Attr) {
+	} else if schema.State().HasUpsert(t.Attr) || schema.State().HasUnique(t.Attr) {
 		// Consider checking to see if a email id is unique. A user adds:
 		// <uid> <email> "email@email.org", and there's a string equal tokenizer
-		// and upsert directive on the schema.
+		// and upsert or unique directive on the schema.
 		// Then keys are "<email> <uid>" and "<email> email@email.org"
 		// The first key won't conflict, because two different uids can try to
 		// get the same email id. But, the second key would. Thus, we ensure
@@ -606,6 +610,13 @@ func (l *List) IsEmpty(readTs, afterUid uint64) (bool, error) {
 
 func (l *List) length(readTs, afterUid uint64) int {
 	l.AssertRLock()
+	if afterUid == 0 && readTs >= l.minTs {
+		// Without any mutations to merge, the uids can be counted off the encoded blocks
+		// instead of decoding them all.
+		if plist, mposts := l.pickPostings(readTs); len(mposts) == 0 {
+			return codec.ExactLen(plist.Pack)
+		}
+	}
 	count := 0
 	err := l.iterate(readTs, afterUid, func(p *pb.Posting) error {
 		count++
@@ -617,7 +628,8 @@ func (l *List) length(readTs, afterUid uint64) int {
 	return count
 }
 
-// Length iterates over the mutation layer and counts number of elements.
+// Length iterates over the mutation layer and counts number of elements. Lists without any
+// pending mutations are counted off the encoded uids.
 func (l *List) Length(readTs, afterUid uint64) int {
 	l.RLock()
 	defer l.RUnlock()
@@ -799,6 +811,22 @@ func (l *List) AllUntaggedValues(readTs uint64) ([]types.Val, error) {
 	return vals, err
 }
 
+// AllUntaggedFacets returns the facets of the untagged values in the list, in the same order as
+// the values returned by AllUntaggedValues.
+func (l *List) AllUntaggedFacets(readTs uint64) ([][]*api.Facet, error) {
+	l.RLock()
+	defer l.RUnlock()
+
+	var fcs [][]*api.Facet
+	err := l.iterate(readTs, 0, func(p *pb.Posting) error {
+		if len(p.LangTag) == 0 {
+			fcs = append(fcs, p.Facets)
+		}
+		return nil
+	})
+	return fcs, err
+}
+
 func (l *List) AllValues(readTs uint64) ([]types.Val, error) {
 	l.RLock()
 	defer l.RUnlock()
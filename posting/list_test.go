@@ -450,6 +450,30 @@ func TestMillion(t *testing.T) {
 	}
 }
 
+func TestLengthAfterRollup(t *testing.T) {
+	key := x.DataKey("length", 1)
+	ol, err := getNew(key, ps)
+	require.NoError(t, err)
+
+	txn := &Txn{StartTs: 1}
+	for i := 1; i <= 1000; i++ {
+		edge := &pb.DirectedEdge{ValueId: uint64(i)}
+		addMutationHelper(t, ol, edge, Set, txn)
+	}
+	require.NoError(t, ol.CommitMutation(1, 2))
+	require.NoError(t, ol.Rollup(math.MaxUint64))
+	// Counted off the encoded uids.
+	require.EqualValues(t, 1000, ol.Length(3, 0))
+	require.EqualValues(t, 500, ol.Length(3, 500))
+
+	txn = &Txn{StartTs: 4}
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 10}, Del, txn)
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 2000}, Set, txn)
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 3000}, Set, txn)
+	require.EqualValues(t, 1001, ol.Length(4, 0))
+	require.EqualValues(t, 1000, ol.Length(3, 0))
+}
+
 // Test the various mutate, commit and abort sequences.
 func TestAddMutation_mrjn2(t *testing.T) {
 	ctx := context.Background()
@@ -110,6 +110,55 @@ func getMemUsage() int {
 	return rss * os.Getpagesize()
 }
 
+// availableMemory returns the memory available to the process in bytes: the physical memory of
+// the machine, or the memory limit of its cgroup if lower, as in a container.
+func availableMemory() (uint64, error) {
+	if runtime.GOOS != "linux" {
+		out, err := exec.Command("sysctl", "-n", "hw.memsize").Output()
+		if err != nil {
+			return 0, x.Wrapf(err, "while getting the physical memory")
+		}
+		return strconv.ParseUint(strings.TrimSpace(string(out)), 10, 64)
+	}
+
+	contents, err := ioutil.ReadFile("/proc/meminfo")
+	if err != nil {
+		return 0, x.Wrapf(err, "while reading the memory info")
+	}
+	var total uint64
+	for _, line := range strings.Split(string(contents), "\n") {
+		// The line looks like "MemTotal:       16307348 kB".
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "MemTotal:" {
+			continue
+		}
+		kbs, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, x.Wrapf(err, "while parsing the total memory")
+		}
+		total = kbs << 10
+	}
+	if total == 0 {
+		return 0, x.Errorf("Total memory missing from /proc/meminfo")
+	}
+
+	// cgroup v2 and v1 respectively. The limit of a cgroup without one is "max", or a number
+	// higher than the physical memory.
+	for _, path := range []string{"/sys/fs/cgroup/memory.max",
+		"/sys/fs/cgroup/memory/memory.limit_in_bytes"} {
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		limit, err := strconv.ParseUint(strings.TrimSpace(string(contents)), 10, 64)
+		if err == nil && limit < total {
+			total = limit
+		}
+		break
+	}
+	return total, nil
+}
+
 func periodicUpdateStats(lc *y.Closer) {
 	defer lc.Done()
 	ticker := time.NewTicker(10 * time.Second)
@@ -129,6 +178,7 @@ func periodicUpdateStats(lc *y.Closer) {
 
 			stats := lcache.Stats()
 			x.LcacheEvicts.Set(int64(stats.NumEvicts))
+			x.LcacheRejects.Set(int64(stats.NumRejects))
 			x.LcacheSize.Set(int64(stats.Size))
 			x.LcacheLen.Set(int64(stats.Length))
 
@@ -137,7 +187,12 @@ func periodicUpdateStats(lc *y.Closer) {
 			x.NumGoRoutines.Set(int64(runtime.NumGoroutine()))
 			Config.Mu.Lock()
 			mem := Config.AllottedMemory
+			fraction := Config.LruFraction
 			Config.Mu.Unlock()
+			if fraction > 0 {
+				// The size of the cache was set from the available memory at start up.
+				break
+			}
 			if setLruMemory {
 				if inUse > 0.75*mem {
 					maxSize = lcache.UpdateMaxSize(0)
@@ -209,6 +264,15 @@ func Init(ps *badger.DB) {
 	pstore = ps
 	lcache = newListCache(math.MaxUint64)
 	x.LcacheCapacity.Set(math.MaxInt64)
+	if fraction := Config.LruFraction; fraction > 0 {
+		mem, err := availableMemory()
+		if err != nil {
+			glog.Fatalf("Unable to size the LRU cache from the available memory: %v", err)
+		}
+		size := lcache.UpdateMaxSize(uint64(fraction * float64(mem)))
+		glog.Infof("LRU cache size set to %d MB, %.2f of %d MB available.",
+			size>>20, fraction, mem>>20)
+	}
 
 	closer = y.NewCloser(2)
 
@@ -220,6 +284,13 @@ func Cleanup() {
 	closer.SignalAndWait()
 }
 
+// DataEpoch changes whenever posting lists are deleted outside of transactions, like when
+// predicates get dropped or their indexes rebuilt. Results read before then can't be told apart
+// from the current ones by their read ts.
+func DataEpoch() uint64 {
+	return lcache.Clears()
+}
+
 // Get stores the List corresponding to key, if it's not there already.
 // to lru cache and returns it.
 //
@@ -234,9 +305,11 @@ func Get(key []byte) (rlist *List, err error) {
 	lp := lcache.Get(string(key))
 	if lp != nil {
 		x.LcacheHit.Add(1)
+		x.PredicateLcacheHit.Add(x.ParseAttr(key), 1)
 		return lp, nil
 	}
 	x.LcacheMiss.Add(1)
+	x.PredicateLcacheMiss.Add(x.ParseAttr(key), 1)
 
 	// Any initialization for l must be done before PutIfMissing. Once it's added
 	// to the map, any other goroutine can retrieve it.
@@ -22,13 +22,17 @@ package posting
 import (
 	"container/list"
 	"context"
+	"encoding/binary"
 	"sync"
 	"time"
 
 	"github.com/dgraph-io/dgraph/x"
 )
 
-// listCache is an LRU cache.
+// listCache is an LRU cache of posting lists, bounded by their estimated size in bytes. A list
+// missing from a full cache is only put in front of the others if it's been accessed at least as
+// often as the least recently used one, so that a scan over many lists doesn't evict the ones
+// accessed often.
 type listCache struct {
 	sync.Mutex
 
@@ -38,14 +42,19 @@ type listCache struct {
 
 	curSize uint64
 	evicts  uint64
-	ll      *list.List
-	cache   map[string]*list.Element
+	rejects uint64
+	// clears counts the calls to clear, made whenever lists get deleted along with their data.
+	clears uint64
+	ll     *list.List
+	cache  map[string]*list.Element
+	freq   *freqSketch
 }
 
 type CacheStats struct {
-	Length    int
-	Size      uint64
-	NumEvicts uint64
+	Length     int
+	Size       uint64
+	NumEvicts  uint64
+	NumRejects uint64
 }
 
 type entry struct {
@@ -61,6 +70,7 @@ func newListCache(maxSize uint64) *listCache {
 		MaxSize: maxSize,
 		ll:      list.New(),
 		cache:   make(map[string]*list.Element),
+		freq:    newFreqSketch(),
 	}
 	go lc.removeOldestLoop()
 	return lc
@@ -94,18 +104,36 @@ func (c *listCache) PutIfMissing(key string, pl *List) (res *List) {
 	e := &entry{
 		key:  key,
 		pl:   pl,
-		size: uint64(pl.EstimatedSize()),
+		size: uint64(pl.EstimatedSize()) + uint64(len(key)),
 	}
 	if e.size < 100 {
 		e.size = 100
 	}
 	c.curSize += e.size
-	ele := c.ll.PushFront(e)
+	var ele *list.Element
+	if c.curSize > c.MaxSize && c.colderThanOldest(key) {
+		// The list must be kept in the cache to receive its mutations, but it goes to the back,
+		// to be evicted before the lists accessed more often.
+		ele = c.ll.PushBack(e)
+		c.rejects++
+	} else {
+		ele = c.ll.PushFront(e)
+	}
 	c.cache[key] = ele
 
 	return e.pl
 }
 
+// colderThanOldest returns whether key was accessed less often than the least recently used list
+// in the cache.
+func (c *listCache) colderThanOldest(key string) bool {
+	ele := c.ll.Back()
+	if ele == nil {
+		return false
+	}
+	return c.freq.estimate(key) < c.freq.estimate(ele.Value.(*entry).key)
+}
+
 func (c *listCache) removeOldestLoop() {
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
@@ -146,6 +174,9 @@ func (c *listCache) removeOldest() {
 		c.ll.Remove(ele)
 		c.evicts++
 		c.curSize -= e.size
+		if attr, ok := keyAttr(e.key); ok {
+			x.PredicateLcacheEvicts.Add(attr, 1)
+		}
 		ele = prev
 	}
 }
@@ -155,10 +186,11 @@ func (c *listCache) Get(key string) (pl *List) {
 	c.Lock()
 	defer c.Unlock()
 
+	c.freq.increment(key)
 	if ele, hit := c.cache[key]; hit {
 		c.ll.MoveToFront(ele)
 		e := ele.Value.(*entry)
-		est := uint64(e.pl.EstimatedSize())
+		est := uint64(e.pl.EstimatedSize()) + uint64(len(e.key))
 		c.curSize += est - e.size
 		e.size = est
 		return e.pl
@@ -172,12 +204,25 @@ func (c *listCache) Stats() CacheStats {
 	defer c.Unlock()
 
 	return CacheStats{
-		Length:    c.ll.Len(),
-		Size:      c.curSize,
-		NumEvicts: c.evicts,
+		Length:     c.ll.Len(),
+		Size:       c.curSize,
+		NumEvicts:  c.evicts,
+		NumRejects: c.rejects,
 	}
 }
 
+// keyAttr returns the predicate of the key of a posting list, or false if key isn't one.
+func keyAttr(key string) (string, bool) {
+	if len(key) < 3 {
+		return "", false
+	}
+	sz := int(binary.BigEndian.Uint16([]byte(key[1:3])))
+	if len(key) < 3+sz {
+		return "", false
+	}
+	return key[3 : 3+sz], true
+}
+
 func (c *listCache) Each(f func(key []byte, val *List)) {
 	c.Lock()
 	defer c.Unlock()
@@ -213,6 +258,7 @@ func (c *listCache) iterate(cont func(l *List) bool) {
 func (c *listCache) clear(remove func(key []byte) bool) {
 	c.Lock()
 	defer c.Unlock()
+	c.clears++
 	for k, e := range c.cache {
 		kv := e.Value.(*entry)
 		if !remove(kv.pl.key) {
@@ -224,6 +270,36 @@ func (c *listCache) clear(remove func(key []byte) bool) {
 	}
 }
 
+// Clears returns the number of times lists were cleared from the cache.
+func (c *listCache) Clears() uint64 {
+	c.Lock()
+	defer c.Unlock()
+	return c.clears
+}
+
+// evict removes the lists matching the function from the cache, like clear, but keeps the ones
+// with pending mutations, which would be lost otherwise. It returns the number of lists kept.
+func (c *listCache) evict(remove func(key []byte) bool) int {
+	c.Lock()
+	defer c.Unlock()
+	var kept int
+	for k, e := range c.cache {
+		kv := e.Value.(*entry)
+		if !remove(kv.pl.key) {
+			continue
+		}
+		if !kv.pl.SetForDeletion() {
+			kept++
+			continue
+		}
+
+		c.ll.Remove(e)
+		delete(c.cache, k)
+		c.curSize -= kv.size
+	}
+	return kept
+}
+
 // delete removes a key from cache
 func (c *listCache) delete(key []byte) {
 	c.Lock()
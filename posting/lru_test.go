@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
 )
 
@@ -99,3 +100,52 @@ func TestLCachePutIfMissing(t *testing.T) {
 	lcache.PutIfMissing("1", l2)
 	require.Equal(t, l, lcache.Get("1"))
 }
+
+func TestLCacheAdmission(t *testing.T) {
+	lcache := newListCache(500)
+
+	for i := 0; i < 5; i++ {
+		key := fmt.Sprintf("hot%d", i)
+		for j := 0; j < 3; j++ {
+			require.Nil(t, lcache.Get(key))
+		}
+		lcache.PutIfMissing(key, getPosting())
+	}
+
+	// The cold list is accessed less often than all the others, so it's evicted first even
+	// though it's the most recently used.
+	cold := string(x.DataKey("lru_admission", 1))
+	lcache.PutIfMissing(cold, getPosting())
+	lcache.removeOldest()
+	require.Equal(t, uint64(1), lcache.rejects)
+	require.Equal(t, uint64(1), lcache.evicts)
+	require.Equal(t, uint64(500), lcache.curSize)
+	require.Equal(t, "1", x.PredicateLcacheEvicts.Get("lru_admission").String())
+	require.Nil(t, lcache.Get(cold))
+	for i := 0; i < 5; i++ {
+		require.NotNil(t, lcache.Get(fmt.Sprintf("hot%d", i)))
+	}
+}
+
+func TestFreqSketch(t *testing.T) {
+	s := newFreqSketch()
+	for i := 0; i < 20; i++ {
+		s.increment("a")
+	}
+	s.increment("b")
+	require.Equal(t, uint8(maxSketchCount), s.estimate("a"))
+	require.Equal(t, uint8(1), s.estimate("b"))
+	require.Equal(t, uint8(0), s.estimate("c"))
+
+	s.reset()
+	require.Equal(t, uint8(maxSketchCount/2), s.estimate("a"))
+	require.Equal(t, uint8(0), s.estimate("b"))
+}
+
+func TestKeyAttr(t *testing.T) {
+	attr, ok := keyAttr(string(x.DataKey("name", 1)))
+	require.True(t, ok)
+	require.Equal(t, "name", attr)
+	_, ok = keyAttr("1")
+	require.False(t, ok)
+}
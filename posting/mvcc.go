@@ -114,6 +114,7 @@ func (tx *Txn) CommitToDisk(writer *x.TxnWriter, commitTs uint64) error {
 		if err := writer.SetAt([]byte(key), data, BitDeltaPosting, commitTs); err != nil {
 			return err
 		}
+		deltas.add(key, commitTs)
 	}
 	return nil
 }
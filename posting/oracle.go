@@ -88,11 +88,18 @@ type oracle struct {
 	// Used for waiting logic for transactions with startTs > maxpending so that we don't read an
 	// uncommitted transaction.
 	waiters map[uint64][]chan struct{}
+
+	// lastCommits maps the predicates to the commit ts of the last transaction which wrote to
+	// them, as known from the deltas applied so far. lastUnknownCommit is the commit ts of the
+	// last transaction whose predicates weren't sent along.
+	lastCommits       map[string]uint64
+	lastUnknownCommit uint64
 }
 
 func (o *oracle) init() {
 	o.waiters = make(map[uint64][]chan struct{})
 	o.pendingTxns = make(map[uint64]*Txn)
+	o.lastCommits = make(map[string]uint64)
 }
 
 func (o *oracle) RegisterStartTs(ts uint64) *Txn {
@@ -121,6 +128,13 @@ func (o *oracle) MinPendingStartTs() uint64 {
 	return min
 }
 
+// NumPendingTxns returns the number of transactions pending a commit or abort decision.
+func (o *oracle) NumPendingTxns() int {
+	o.RLock()
+	defer o.RUnlock()
+	return len(o.pendingTxns)
+}
+
 func (o *oracle) TxnOlderThan(dur time.Duration) (res []uint64) {
 	o.RLock()
 	defer o.RUnlock()
@@ -173,6 +187,7 @@ func (o *oracle) ProcessDelta(delta *pb.OracleDelta) {
 	defer o.Unlock()
 	for _, txn := range delta.Txns {
 		delete(o.pendingTxns, txn.StartTs)
+		o.recordCommit(txn)
 	}
 	curMax := o.MaxAssigned()
 	if delta.MaxAssigned < curMax {
@@ -192,6 +207,33 @@ func (o *oracle) ProcessDelta(delta *pb.OracleDelta) {
 	x.AssertTrue(atomic.CompareAndSwapUint64(&o.maxAssigned, curMax, delta.MaxAssigned))
 }
 
+// recordCommit must be called with the write lock held, before MaxAssigned advances past the
+// commit.
+func (o *oracle) recordCommit(txn *pb.TxnStatus) {
+	if txn.CommitTs == 0 {
+		return
+	}
+	if len(txn.Preds) == 0 {
+		o.lastUnknownCommit = x.Max(o.lastUnknownCommit, txn.CommitTs)
+		return
+	}
+	for _, pred := range txn.Preds {
+		o.lastCommits[pred] = x.Max(o.lastCommits[pred], txn.CommitTs)
+	}
+}
+
+// LastCommit returns the highest commit ts of the transactions which might have written to any
+// of the predicates, among the ones committed at or below MaxAssigned.
+func (o *oracle) LastCommit(preds []string) uint64 {
+	o.RLock()
+	defer o.RUnlock()
+	last := o.lastUnknownCommit
+	for _, pred := range preds {
+		last = x.Max(last, o.lastCommits[pred])
+	}
+	return last
+}
+
 func (o *oracle) ResetTxns() {
 	o.Lock()
 	defer o.Unlock()
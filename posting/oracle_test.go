@@ -0,0 +1,52 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func TestOracleLastCommit(t *testing.T) {
+	orc := new(oracle)
+	orc.init()
+	require.Equal(t, uint64(0), orc.LastCommit([]string{"name"}))
+
+	orc.ProcessDelta(&pb.OracleDelta{
+		Txns: []*pb.TxnStatus{
+			{StartTs: 1, CommitTs: 2, Preds: []string{"name", "age"}},
+			{StartTs: 3, CommitTs: 4, Preds: []string{"age"}},
+			// Aborted.
+			{StartTs: 5, Preds: []string{"name"}},
+		},
+		MaxAssigned: 6,
+	})
+	require.Equal(t, uint64(2), orc.LastCommit([]string{"name"}))
+	require.Equal(t, uint64(4), orc.LastCommit([]string{"name", "age"}))
+	require.Equal(t, uint64(0), orc.LastCommit([]string{"friend"}))
+
+	// The commits whose predicates aren't known might have written to any.
+	orc.ProcessDelta(&pb.OracleDelta{
+		Txns:        []*pb.TxnStatus{{StartTs: 7, CommitTs: 8}},
+		MaxAssigned: 9,
+	})
+	require.Equal(t, uint64(8), orc.LastCommit([]string{"friend"}))
+	require.Equal(t, uint64(8), orc.LastCommit(nil))
+}
@@ -0,0 +1,188 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// maxDeltaKeys bounds the number of keys whose deltas are tracked. Past it, the deltas of the
+// other keys are only rolled up by the next rollup of all the posting lists.
+const maxDeltaKeys = 1 << 20
+
+// deltaStats holds the deltas of a key committed to disk since its last rollup.
+type deltaStats struct {
+	count int
+	// first is when the first delta was committed, at firstTs. lastTs is the commit ts of the
+	// last delta.
+	first   time.Time
+	firstTs uint64
+	lastTs  uint64
+}
+
+// deltaTracker tracks the keys with deltas which weren't rolled up yet, to roll up the posting
+// lists mutated often, or long ago, before the next rollup of all of them.
+type deltaTracker struct {
+	sync.Mutex
+	keys map[string]*deltaStats
+	// overflow is set when a key wasn't tracked because there were too many of them.
+	overflow bool
+}
+
+var deltas = newDeltaTracker()
+
+func newDeltaTracker() *deltaTracker {
+	return &deltaTracker{keys: make(map[string]*deltaStats)}
+}
+
+// add records a delta of key committed at commitTs.
+func (t *deltaTracker) add(key string, commitTs uint64) {
+	t.Lock()
+	defer t.Unlock()
+	ds, ok := t.keys[key]
+	if !ok {
+		if len(t.keys) >= maxDeltaKeys {
+			t.overflow = true
+			return
+		}
+		ds = &deltaStats{first: time.Now(), firstTs: commitTs}
+		t.keys[key] = ds
+		x.RollupPendingKeys.Set(int64(len(t.keys)))
+	}
+	ds.count++
+	if commitTs > ds.lastTs {
+		ds.lastTs = commitTs
+	}
+}
+
+// due returns the keys with deltas committed at or before readTs, which have at least maxDeltas
+// of them or got the first one maxAge ago or more. They're considered rolled up at readTs.
+func (t *deltaTracker) due(readTs uint64, maxDeltas int, maxAge time.Duration) []string {
+	t.Lock()
+	defer t.Unlock()
+	var keys []string
+	now := time.Now()
+	for key, ds := range t.keys {
+		if ds.firstTs > readTs {
+			continue
+		}
+		byCount := maxDeltas > 0 && ds.count >= maxDeltas
+		byAge := maxAge > 0 && now.Sub(ds.first) >= maxAge
+		if !byCount && !byAge {
+			continue
+		}
+		keys = append(keys, key)
+		t.rolledUp(key, ds, readTs)
+	}
+	x.RollupPendingKeys.Set(int64(len(t.keys)))
+	return keys
+}
+
+// rolledUp forgets the deltas of key up to readTs. As only the last commit ts is known, the
+// deltas after readTs, if any, are counted as one.
+func (t *deltaTracker) rolledUp(key string, ds *deltaStats, readTs uint64) {
+	if ds.lastTs <= readTs {
+		delete(t.keys, key)
+		return
+	}
+	ds.count, ds.first, ds.firstTs = 1, time.Now(), ds.lastTs
+}
+
+// rolledUpAll forgets the deltas of all the keys up to readTs, once all of them were rolled up.
+func (t *deltaTracker) rolledUpAll(readTs uint64) {
+	t.Lock()
+	defer t.Unlock()
+	for key, ds := range t.keys {
+		t.rolledUp(key, ds, readTs)
+	}
+	t.overflow = false
+	x.RollupPendingKeys.Set(int64(len(t.keys)))
+}
+
+// DeltasDue returns the keys due for a rollup at readTs: the ones with at least maxDeltas deltas
+// or whose first delta was committed maxAge ago or more. Either is ignored if it's zero.
+func DeltasDue(readTs uint64, maxDeltas int, maxAge time.Duration) []string {
+	return deltas.due(readTs, maxDeltas, maxAge)
+}
+
+// DeltasOverflow returns whether some keys with deltas weren't tracked, so that they're only
+// rolled up with all the posting lists.
+func DeltasOverflow() bool {
+	deltas.Lock()
+	defer deltas.Unlock()
+	return deltas.overflow
+}
+
+// RolledUpAll records the rollup of all the posting lists at readTs.
+func RolledUpAll(readTs uint64) {
+	deltas.rolledUpAll(readTs)
+}
+
+// RollupKeys consolidates the deltas of the given keys committed at or before readTs, writing
+// back complete posting lists, and rolls up their lists in the LRU cache as well.
+func RollupKeys(keys []string, readTs uint64) error {
+	writer := x.NewTxnWriter(pstore)
+	writer.BlindWrite = true // Do overwrite keys.
+
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.AllVersions = true
+	for _, key := range keys {
+		if err := rollupKey(txn, writer, []byte(key), iterOpts); err != nil {
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if l := GetLru([]byte(key)); l != nil {
+			if err := l.Rollup(readTs); err != nil {
+				return err
+			}
+		}
+	}
+	x.RollupKeys.Add(int64(len(keys)))
+	return nil
+}
+
+func rollupKey(txn *badger.Txn, writer *x.TxnWriter, key []byte,
+	iterOpts badger.IteratorOptions) error {
+	it := txn.NewKeyIterator(key, iterOpts)
+	defer it.Close()
+	it.Seek(key)
+	// There's nothing to roll up if the last version is a complete posting list already.
+	if !it.Valid() || it.Item().UserMeta()&BitCompletePosting > 0 {
+		return nil
+	}
+	l, err := ReadPostingList(key, it)
+	if err != nil {
+		return err
+	}
+	kv, err := l.MarshalToKv()
+	if err != nil {
+		return err
+	}
+	return writer.Send(&pb.KVS{Kv: []*pb.KV{kv}})
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeltaTracker(t *testing.T) {
+	dt := newDeltaTracker()
+	for ts := uint64(1); ts <= 3; ts++ {
+		dt.add("many", ts*10)
+	}
+	dt.add("few", 10)
+	dt.add("recent", 50)
+
+	// Only the key with enough deltas is due, and its delta after the read ts is kept.
+	require.Equal(t, []string{"many"}, dt.due(25, 3, 0))
+	require.Equal(t, 1, dt.keys["many"].count)
+	require.Equal(t, uint64(30), dt.keys["many"].firstTs)
+
+	// The key with old deltas is due, but not the one committed after the read ts.
+	dt.keys["few"].first = time.Now().Add(-time.Hour)
+	dt.keys["recent"].first = time.Now().Add(-time.Hour)
+	require.Equal(t, []string{"few"}, dt.due(25, 0, time.Minute))
+	require.NotContains(t, dt.keys, "few")
+
+	dt.rolledUpAll(100)
+	require.Empty(t, dt.keys)
+}
+
+func TestRollupKeys(t *testing.T) {
+	key := x.DataKey("rollup", 1)
+	// The deltas are committed from the lists in the cache.
+	l, err := Get(key)
+	require.NoError(t, err)
+	for i, uid := range []uint64{10, 20, 30} {
+		ts := uint64(100 + 2*i)
+		addMutation(t, l, &pb.DirectedEdge{ValueId: uid, Label: "rollup"}, Set, ts, ts+1, false)
+	}
+
+	keys := DeltasDue(104, 2, 0)
+	require.Contains(t, keys, string(key))
+	require.NoError(t, RollupKeys([]string{string(key)}, 104))
+
+	txn := ps.NewTransactionAt(104, false)
+	defer txn.Discard()
+	item, err := txn.Get(key)
+	require.NoError(t, err)
+	require.NotZero(t, item.UserMeta()&BitCompletePosting)
+	require.Equal(t, uint64(103), item.Version())
+
+	l, err = getNew(key, ps)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 20}, listToArray(t, 0, l, 104))
+	require.Equal(t, []uint64{10, 20, 30}, listToArray(t, 0, l, 106))
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	farm "github.com/dgryski/go-farm"
+)
+
+const (
+	sketchDepth = 4
+	sketchWidth = 1 << 16
+	// maxSketchCount is the highest access count kept for a key. It's enough to tell the keys accessed
+	// often from the ones accessed once or twice, and keeps the counters from overflowing.
+	maxSketchCount = 15
+)
+
+// freqSketch is a count-min sketch approximating how often each key of the cache was accessed
+// recently, in a fixed amount of memory. The counts are halved every sketchWidth*10 accesses,
+// so that the keys accessed often in the past don't stay in the cache forever.
+type freqSketch struct {
+	rows      [sketchDepth][]uint8
+	additions int
+}
+
+func newFreqSketch() *freqSketch {
+	s := &freqSketch{}
+	for i := range s.rows {
+		s.rows[i] = make([]uint8, sketchWidth)
+	}
+	return s
+}
+
+// sketchIndex returns the counter for the key hashed to h in row i. The counters of all the rows
+// are derived from the one hash of the key.
+func sketchIndex(h uint64, i int) uint64 {
+	lo, hi := h&0xffffffff, h>>32
+	return (lo + uint64(i)*hi) % sketchWidth
+}
+
+// increment records an access to key.
+func (s *freqSketch) increment(key string) {
+	h := farm.Fingerprint64([]byte(key))
+	for i := range s.rows {
+		if idx := sketchIndex(h, i); s.rows[i][idx] < maxSketchCount {
+			s.rows[i][idx]++
+		}
+	}
+	s.additions++
+	if s.additions >= 10*sketchWidth {
+		s.reset()
+	}
+}
+
+// estimate returns the number of recent accesses to key. It may be higher than the actual
+// number, but never lower.
+func (s *freqSketch) estimate(key string) uint8 {
+	h := farm.Fingerprint64([]byte(key))
+	min := uint8(maxSketchCount)
+	for i := range s.rows {
+		if c := s.rows[i][sketchIndex(h, i)]; c < min {
+			min = c
+		}
+	}
+	return min
+}
+
+// reset halves all the counts.
+func (s *freqSketch) reset() {
+	for i := range s.rows {
+		for j := range s.rows[i] {
+			s.rows[i][j] >>= 1
+		}
+	}
+	s.additions = 0
+}
@@ -19,6 +19,8 @@ package query
 import (
 	"bytes"
 	"math"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -30,11 +32,33 @@ type aggregator struct {
 	name   string
 	result types.Val
 	count  int // used when we need avergae.
+	// nums are the values of the aggregators which need all of them, like stddev and median.
+	nums       []float64
+	percentile float64 // used by percentile, out of 100.
+}
+
+// newAggregator returns the aggregator of the function f, like percentile(val(x), 90).
+func newAggregator(f *Function) (aggregator, error) {
+	ag := aggregator{name: f.Name}
+	switch f.Name {
+	case "median":
+		ag.percentile = 50
+	case "percentile":
+		if len(f.Args) != 1 {
+			return ag, x.Errorf("Expected the percentile to compute in percentile")
+		}
+		p, err := strconv.ParseFloat(f.Args[0].Value, 64)
+		if err != nil {
+			return ag, x.Wrapf(err, "Invalid percentile %v", f.Args[0].Value)
+		}
+		ag.percentile = p
+	}
+	return ag, nil
 }
 
 func isUnary(f string) bool {
 	return f == "ln" || f == "exp" || f == "u-" || f == "sqrt" ||
-		f == "floor" || f == "ceil" || f == "since"
+		f == "floor" || f == "ceil" || f == "since" || types.IsDatePart(f)
 }
 
 func isBinaryBoolean(f string) bool {
@@ -177,6 +201,11 @@ func (ag *aggregator) ApplyVal(v types.Val) error {
 				return x.Errorf("Wrong type encountered for func %v", ag.name)
 			}
 			res = v
+		case "year", "month", "day", "hour":
+			var err error
+			if res, err = types.DatePart(ag.name, v); err != nil {
+				return err
+			}
 		}
 		ag.result = res
 		return nil
@@ -188,6 +217,12 @@ func (ag *aggregator) ApplyVal(v types.Val) error {
 	}
 
 	va := ag.result
+	if (ag.name == "+" || ag.name == "-") &&
+		(va.Tid == types.DateTimeID || v.Tid == types.DateTimeID) {
+		var err error
+		ag.result, err = addDateTime(ag.name, va, v)
+		return err
+	}
 	if va.Tid != types.IntID && va.Tid != types.FloatID {
 		isIntOrFloat = false
 	}
@@ -264,7 +299,37 @@ func (ag *aggregator) ApplyVal(v types.Val) error {
 	return nil
 }
 
+// addDateTime moves the datetime va by the seconds vb for + and -, or returns the seconds between
+// the datetimes va and vb for -.
+func addDateTime(f string, va, vb types.Val) (types.Val, error) {
+	switch {
+	case f == "-" && va.Tid == types.DateTimeID && vb.Tid == types.DateTimeID:
+		d := va.Value.(time.Time).Sub(vb.Value.(time.Time))
+		return types.Val{Tid: types.FloatID, Value: d.Seconds()}, nil
+	case va.Tid == types.DateTimeID && vb.Tid == types.FloatID:
+		secs := vb.Value.(float64)
+		if f == "-" {
+			secs = -secs
+		}
+		t := va.Value.(time.Time).Add(time.Duration(secs * float64(time.Second)))
+		return types.Val{Tid: types.DateTimeID, Value: t}, nil
+	case f == "+" && va.Tid == types.FloatID && vb.Tid == types.DateTimeID:
+		return addDateTime(f, vb, va)
+	}
+	return types.Val{}, x.Errorf("Wrong type encountered for func %v", f)
+}
+
 func (ag *aggregator) Apply(val types.Val) {
+	if ag.needsAllValues() {
+		// Only numbers can be aggregated by these, the other values are passed.
+		switch val.Tid {
+		case types.IntID:
+			ag.nums = append(ag.nums, float64(val.Value.(int64)))
+		case types.FloatID:
+			ag.nums = append(ag.nums, val.Value.(float64))
+		}
+		return
+	}
 	if ag.result.Value == nil {
 		ag.result = val
 		ag.count++
@@ -305,8 +370,49 @@ func (ag *aggregator) Apply(val types.Val) {
 	ag.result = res
 }
 
+func (ag *aggregator) needsAllValues() bool {
+	switch ag.name {
+	case "stddev", "variance", "median", "percentile":
+		return true
+	}
+	return false
+}
+
+// summarize computes the result of the aggregators which need all the values from them.
+func (ag *aggregator) summarize() {
+	if !ag.needsAllValues() || len(ag.nums) == 0 {
+		return
+	}
+	var res float64
+	switch ag.name {
+	case "stddev", "variance":
+		// The population variance, as the values are all there is rather than a sample.
+		var sum float64
+		for _, n := range ag.nums {
+			sum += n
+		}
+		mean := sum / float64(len(ag.nums))
+		for _, n := range ag.nums {
+			res += (n - mean) * (n - mean)
+		}
+		res /= float64(len(ag.nums))
+		if ag.name == "stddev" {
+			res = math.Sqrt(res)
+		}
+	case "median", "percentile":
+		// Interpolate linearly between the closest ranks.
+		sort.Float64s(ag.nums)
+		rank := ag.percentile / 100 * float64(len(ag.nums)-1)
+		lo := int(math.Floor(rank))
+		hi := int(math.Ceil(rank))
+		res = ag.nums[lo] + (ag.nums[hi]-ag.nums[lo])*(rank-float64(lo))
+	}
+	ag.result = types.Val{Tid: types.FloatID, Value: res}
+}
+
 func (ag *aggregator) ValueMarshalled() (*pb.TaskValue, error) {
 	data := types.ValueForType(types.BinaryID)
+	ag.summarize()
 	ag.divideByCount()
 	res := &pb.TaskValue{ValType: ag.result.Tid.Enum(), Val: x.Nilbyte}
 	if ag.result.Value == nil {
@@ -337,6 +443,7 @@ func (ag *aggregator) divideByCount() {
 }
 
 func (ag *aggregator) Value() (types.Val, error) {
+	ag.summarize()
 	if ag.result.Value == nil {
 		return ag.result, ErrEmptyVal
 	}
@@ -250,7 +250,7 @@ func processToFastJsonNoErr(t *testing.T, query string) string {
 	return res
 }
 
-func processSchemaQuery(t *testing.T, q string) []*api.SchemaNode {
+func processSchemaQuery(t *testing.T, q string) []*pb.SchemaNode {
 	res, err := gql.Parse(gql.Request{Str: q})
 	require.NoError(t, err)
 
@@ -59,7 +59,7 @@ func (grp *groupResult) aggregateChild(child *SubGraph) error {
 	}
 	if child.SrcFunc != nil && isAggregatorFn(child.SrcFunc.Name) {
 		if fieldName == "" {
-			fieldName = fmt.Sprintf("%s(%s)", child.SrcFunc.Name, child.Attr)
+			fieldName = fmt.Sprintf("%s(%s)", child.SrcFunc.Name, attrName(child.Attr))
 		}
 		finalVal, err := aggregateGroup(grp, child)
 		if err != nil {
@@ -139,8 +139,9 @@ func (d *dedup) addValue(attr string, value types.Val, uid uint64) {
 }
 
 func aggregateGroup(grp *groupResult, child *SubGraph) (types.Val, error) {
-	ag := aggregator{
-		name: child.SrcFunc.Name,
+	ag, err := newAggregator(child.SrcFunc)
+	if err != nil {
+		return types.Val{}, err
 	}
 	for _, uid := range grp.uids {
 		idx := sort.Search(len(child.SrcUIDs.Uids), func(i int) bool {
@@ -201,6 +202,16 @@ func (res *groupResults) formGroups(dedupMap dedup, cur *pb.List, groupVal []gro
 	}
 }
 
+// groupValue returns the value of a groupby attribute the uids are grouped by, which is a component
+// of it for a datetime, like month(dob).
+func groupValue(child *SubGraph, tv *pb.TaskValue) (types.Val, error) {
+	val, err := convertTo(tv)
+	if err != nil || child.Params.datePart == "" {
+		return val, err
+	}
+	return types.DatePart(child.Params.datePart, val)
+}
+
 func (sg *SubGraph) formResult(ul *pb.List) (*groupResults, error) {
 	var dedupMap dedup
 	res := new(groupResults)
@@ -212,7 +223,7 @@ func (sg *SubGraph) formResult(ul *pb.List) (*groupResults, error) {
 
 		attr := child.Params.Alias
 		if attr == "" {
-			attr = child.Attr
+			attr = attrName(child.Attr)
 		}
 		if len(child.DestUIDs.Uids) != 0 {
 			// It's a UID node.
@@ -234,7 +245,7 @@ func (sg *SubGraph) formResult(ul *pb.List) (*groupResults, error) {
 				if len(v.Values) == 0 || algo.IndexOf(ul, srcUid) < 0 {
 					continue
 				}
-				val, err := convertTo(v.Values[0])
+				val, err := groupValue(child, v.Values[0])
 				if err != nil {
 					continue
 				}
@@ -292,7 +303,7 @@ func (sg *SubGraph) fillGroupedVars(doneVars map[string]varValue, path []*SubGra
 
 		attr := child.Params.Alias
 		if attr == "" {
-			attr = child.Attr
+			attr = attrName(child.Attr)
 		}
 		if len(child.DestUIDs.Uids) != 0 {
 			// It's a UID node.
@@ -311,7 +322,7 @@ func (sg *SubGraph) fillGroupedVars(doneVars map[string]varValue, path []*SubGra
 				if len(v.Values) == 0 {
 					continue
 				}
-				val, err := convertTo(v.Values[0])
+				val, err := groupValue(child, v.Values[0])
 				if err != nil {
 					continue
 				}
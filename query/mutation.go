@@ -67,19 +67,13 @@ func expandEdges(ctx context.Context, m *pb.Mutations) ([]*pb.DirectedEdge, erro
 			sg := &SubGraph{}
 			sg.DestUIDs = &pb.List{Uids: []uint64{edge.GetEntity()}}
 			sg.ReadTs = m.StartTs
-			valMatrix, err := getNodePredicates(ctx, sg)
+			var err error
+			preds, err = getDeletePredicates(ctx, sg)
 			if err != nil {
 				return nil, err
 			}
-			if len(valMatrix) != 1 {
-				return nil, x.Errorf("Expected only one list in value matrix while deleting: %v",
-					edge.GetEntity())
-			}
-			for _, tv := range valMatrix[0].Values {
-				if len(tv.Val) > 0 {
-					preds = append(preds, string(tv.Val))
-				}
-			}
+			// Only the predicates of the namespace of the request are deleted.
+			preds = namespacePreds(x.ExtractNamespace(ctx), preds)
 		}
 
 		for _, pred := range preds {
@@ -105,6 +99,35 @@ func expandEdges(ctx context.Context, m *pb.Mutations) ([]*pb.DirectedEdge, erro
 	return edges, nil
 }
 
+// getDeletePredicates returns the predicates to delete from the node for a <uid> * * deletion.
+// Those of a node having types are the ones declared by its types, along with its types.
+func getDeletePredicates(ctx context.Context, sg *SubGraph) ([]string, error) {
+	typeNames, err := getNodeTypes(ctx, sg)
+	if err != nil {
+		return nil, err
+	}
+	if len(typeNames) > 0 {
+		preds := getTypePredicates(ctx, typeNames)
+		return append(preds, x.NamespaceAttr(x.ExtractNamespace(ctx), x.TypeAttr)), nil
+	}
+
+	valMatrix, err := getNodePredicates(ctx, sg)
+	if err != nil {
+		return nil, err
+	}
+	if len(valMatrix) != 1 {
+		return nil, x.Errorf("Expected only one list in value matrix while deleting: %v",
+			sg.DestUIDs.Uids[0])
+	}
+	var preds []string
+	for _, tv := range valMatrix[0].Values {
+		if len(tv.Val) > 0 {
+			preds = append(preds, string(tv.Val))
+		}
+	}
+	return preds, nil
+}
+
 func verifyUid(ctx context.Context, uid uint64) error {
 	if uid <= worker.MaxLeaseId() {
 		return nil
@@ -31,6 +31,7 @@ import (
 	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -53,6 +54,71 @@ func ToJson(l *Latency, sgl []*SubGraph) ([]byte, error) {
 	return sgr.toFastJSON(l)
 }
 
+// ToJsonStream writes the same JSON encoding as ToJson, but passes it to send in chunks of
+// chunkSize bytes as it's written instead of building all of it first, so that only the nodes
+// of one result at the root of a query block are held in memory at a time. A chunk is only
+// valid until send returns. The encoding is held back for as long as send blocks.
+func ToJsonStream(l *Latency, sgl []*SubGraph, chunkSize int, send func([]byte) error) error {
+	if chunkSize <= 0 {
+		return x.Errorf("Invalid chunk size: %d", chunkSize)
+	}
+	defer func() {
+		l.Json = time.Since(l.Start) - l.Parsing - l.Processing
+	}()
+
+	js := &jsonStream{chunkSize: chunkSize, send: send}
+	js.buf.WriteRune('{')
+	for _, sg := range sgl {
+		if sg.Params.Alias == "var" || sg.Params.Alias == "shortest" {
+			continue
+		}
+		if err := walkNodeUids(sg, js.add); err != nil {
+			return err
+		}
+	}
+	if js.inList {
+		js.buf.WriteRune(']')
+	}
+	js.buf.WriteRune('}')
+	return js.flush(true)
+}
+
+// jsonStream writes the encoding of the nodes at the root of the results, sending it on in
+// chunks.
+type jsonStream struct {
+	buf       bytes.Buffer
+	chunkSize int
+	send      func([]byte) error
+	// attr is the name of the list the last node was written to, if inList is set.
+	attr   string
+	inList bool
+}
+
+func (js *jsonStream) add(n *fastJsonNode) error {
+	if js.inList && js.attr == n.attr {
+		js.buf.WriteRune(',')
+	} else {
+		if js.inList {
+			js.buf.WriteString("],")
+		}
+		n.writeKey(&js.buf)
+		js.buf.WriteRune('[')
+		js.attr, js.inList = n.attr, true
+	}
+	n.encode(&js.buf)
+	return js.flush(false)
+}
+
+// flush sends the full chunks written so far, and the rest of them too if all is set.
+func (js *jsonStream) flush(all bool) error {
+	for js.buf.Len() >= js.chunkSize || (all && js.buf.Len() > 0) {
+		if err := js.send(js.buf.Next(js.chunkSize)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // outputNode is the generic output / writer for preTraverse.
 type outputNode interface {
 	AddValue(attr string, v types.Val)
@@ -292,7 +358,10 @@ func merge(parent [][]*fastJsonNode, child [][]*fastJsonNode) ([][]*fastJsonNode
 	return mergedList, nil
 }
 
-func (n *fastJsonNode) normalize() ([][]*fastJsonNode, error) {
+// normalize flattens the node into the lists of values making up each of the nodes it's
+// normalized to. The child blocks up to depth levels below it are kept as lists of normalized
+// nodes instead, for @normalize(depth: n).
+func (n *fastJsonNode) normalize(depth int) ([][]*fastJsonNode, error) {
 	cnt := 0
 	for _, a := range n.attrs {
 		if a.isChild {
@@ -306,6 +375,30 @@ func (n *fastJsonNode) normalize() ([][]*fastJsonNode, error) {
 		return [][]*fastJsonNode{n.attrs}, nil
 	}
 
+	if depth > 0 {
+		// Each node of the child blocks is normalized on its own, so the node stays one.
+		attrs := make([]*fastJsonNode, 0, len(n.attrs))
+		for _, a := range n.attrs {
+			if !a.isChild {
+				attrs = append(attrs, a)
+				continue
+			}
+			normalized, err := a.normalize(depth - 1)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range normalized {
+				if len(c) > 0 {
+					attrs = append(attrs, &fastJsonNode{attr: a.attr, isChild: true, attrs: c})
+				}
+			}
+		}
+		// The nodes of a child block have to be next to each other to be encoded as a list,
+		// in the order they were found in.
+		sort.Stable(nodeSlice(attrs))
+		return [][]*fastJsonNode{attrs}, nil
+	}
+
 	parentSlice := make([][]*fastJsonNode, 0, 5)
 	// If the parents has attrs, lets add them to the slice so that it can be
 	// merged with children later.
@@ -325,7 +418,7 @@ func (n *fastJsonNode) normalize() ([][]*fastJsonNode, error) {
 		}
 		childSlice := make([][]*fastJsonNode, 0, 5)
 		for ci < len(n.attrs) && childNode.attr == n.attrs[ci].attr {
-			normalized, err := n.attrs[ci].normalize()
+			normalized, err := n.attrs[ci].normalize(0)
 			if err != nil {
 				return nil, err
 			}
@@ -416,20 +509,55 @@ func (n *fastJsonNode) addAggregations(sg *SubGraph) error {
 }
 
 func processNodeUids(n *fastJsonNode, sg *SubGraph) error {
+	return walkNodeUids(sg, func(n1 *fastJsonNode) error {
+		n.attrs = append(n.attrs, n1)
+		return nil
+	})
+}
+
+// walkNodeUids calls fn with each of the nodes making up the results of sg at the root, in the
+// order they are encoded. The nodes are list children named after the alias of sg.
+func walkNodeUids(sg *SubGraph, fn func(n *fastJsonNode) error) error {
 	var seedNode *fastJsonNode
+	// Aggregations, counts and groupbys at the root are few nodes, so they're built in n before
+	// being passed on.
+	n := &fastJsonNode{}
+	flush := func() error {
+		for _, c := range n.attrs {
+			if err := fn(c); err != nil {
+				return err
+			}
+		}
+		n.attrs = n.attrs[:0]
+		return nil
+	}
+	add := func(n1 *fastJsonNode) error {
+		n.AddListChild(sg.Params.Alias, n1)
+		return flush()
+	}
+
 	if sg.Params.IsEmpty {
-		return n.addAggregations(sg)
+		if err := n.addAggregations(sg); err != nil {
+			return err
+		}
+		return flush()
 	}
 
 	if sg.uidMatrix == nil {
-		n.AddListChild(sg.Params.Alias, &fastJsonNode{})
-		return nil
+		return add(&fastJsonNode{})
 	}
 
 	hasChild := false
 	if sg.Params.uidCount && !(sg.Params.uidCountAlias == "" && sg.Params.Normalize) {
 		hasChild = true
 		n.addCountAtRoot(sg)
+		if err := flush(); err != nil {
+			return err
+		}
+		if len(sg.Children) == 0 && !sg.Params.isGroupBy {
+			// Only the count was asked for, there's nothing to walk the uids for.
+			return nil
+		}
 	}
 
 	if sg.Params.isGroupBy {
@@ -437,7 +565,7 @@ func processNodeUids(n *fastJsonNode, sg *SubGraph) error {
 			return x.Errorf("Expected GroupbyRes to have length > 0.")
 		}
 		n.addGroupby(sg, sg.GroupbyRes[0], sg.Params.Alias)
-		return nil
+		return flush()
 	}
 
 	lenList := len(sg.uidMatrix[0].Uids)
@@ -462,23 +590,27 @@ func processNodeUids(n *fastJsonNode, sg *SubGraph) error {
 
 		hasChild = true
 		if !sg.Params.Normalize {
-			n.AddListChild(sg.Params.Alias, n1)
+			if err := add(n1.(*fastJsonNode)); err != nil {
+				return err
+			}
 			continue
 		}
 
 		// Lets normalize the response now.
-		normalized, err := n1.(*fastJsonNode).normalize()
+		normalized, err := n1.(*fastJsonNode).normalize(sg.Params.NormalizeDepth)
 		if err != nil {
 			return err
 		}
 		for _, c := range normalized {
-			n.AddListChild(sg.Params.Alias, &fastJsonNode{attrs: c})
+			if err := add(&fastJsonNode{attrs: c}); err != nil {
+				return err
+			}
 		}
 	}
 
 	if !hasChild {
 		// So that we return an empty key if the root didn't have any children.
-		n.AddListChild(sg.Params.Alias, &fastJsonNode{})
+		return add(&fastJsonNode{})
 	}
 	return nil
 }
@@ -486,6 +618,8 @@ func processNodeUids(n *fastJsonNode, sg *SubGraph) error {
 type Extensions struct {
 	Latency *api.Latency    `json:"server_latency,omitempty"`
 	Txn     *api.TxnContext `json:"txn,omitempty"`
+	// Plan is the plan the query was run with, if it asked to explain it.
+	Plan *worker.QueryPlan `json:"plan,omitempty"`
 }
 
 func (sg *SubGraph) toFastJSON(l *Latency) ([]byte, error) {
@@ -87,7 +87,7 @@ func TestNormalizeJSONLimit(t *testing.T) {
 				types.ValueForType(types.StringID))
 		}
 	}
-	_, err := n.(*fastJsonNode).normalize()
+	_, err := n.(*fastJsonNode).normalize(0)
 	require.Error(t, err, "Couldn't evaluate @normalize directive - to many results")
 }
 
@@ -109,7 +109,7 @@ func TestNormalizeJSONUid1(t *testing.T) {
 	child3.AddValue("attr3", types.ValueForType(types.StringID))
 	child2.AddListChild("child3", child3)
 
-	normalized, err := n.(*fastJsonNode).normalize()
+	normalized, err := n.(*fastJsonNode).normalize(0)
 	require.NoError(t, err)
 	require.NotNil(t, normalized)
 	nn := (&fastJsonNode{}).New("root")
@@ -140,7 +140,7 @@ func TestNormalizeJSONUid2(t *testing.T) {
 	child3.AddValue(fmt.Sprintf("attr3"), types.ValueForType(types.StringID))
 	child2.AddListChild("child3", child3)
 
-	normalized, err := n.(*fastJsonNode).normalize()
+	normalized, err := n.(*fastJsonNode).normalize(0)
 	require.NoError(t, err)
 	require.NotNil(t, normalized)
 	nn := (&fastJsonNode{}).New("root")
@@ -152,3 +152,33 @@ func TestNormalizeJSONUid2(t *testing.T) {
 	nn.(*fastJsonNode).encode(&b)
 	require.JSONEq(t, `{"alias":[{"___attr1":"","___attr2":"","uid":"0x3","attr3":""}]}`, b.String())
 }
+
+func TestJsonStream(t *testing.T) {
+	var nodes []*fastJsonNode
+	for i, alias := range []string{"me", "me", "me", "you"} {
+		n := (&fastJsonNode{}).New(alias)
+		n.SetUID(uint64(i+1), "uid")
+		n.AddValue("name", types.Val{Tid: types.StringID, Value: fmt.Sprintf("name %d", i)})
+		n.(*fastJsonNode).attr, n.(*fastJsonNode).isChild = alias, true
+		nodes = append(nodes, n.(*fastJsonNode))
+	}
+
+	root := (&fastJsonNode{}).New("_root_").(*fastJsonNode)
+	root.attrs = nodes
+	var expected bytes.Buffer
+	root.encode(&expected)
+
+	var out bytes.Buffer
+	js := &jsonStream{chunkSize: 16, send: func(chunk []byte) error {
+		require.True(t, len(chunk) <= 16)
+		out.Write(chunk)
+		return nil
+	}}
+	js.buf.WriteRune('{')
+	for _, n := range nodes {
+		require.NoError(t, js.add(n))
+	}
+	js.buf.WriteString("]}")
+	require.NoError(t, js.flush(true))
+	require.Equal(t, expected.String(), out.String())
+}
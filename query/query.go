@@ -33,6 +33,7 @@ import (
 	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/task"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
@@ -123,11 +124,13 @@ type params struct {
 	Langs      []string
 
 	// directives.
-	Normalize    bool
-	Recurse      bool
-	RecurseArgs  gql.RecurseArgs
-	Cascade      bool
-	IgnoreReflex bool
+	Normalize      bool
+	NormalizeDepth int
+	Recurse        bool
+	RecurseArgs    gql.RecurseArgs
+	recurseUntil   *SubGraph
+	Cascade        bool
+	IgnoreReflex   bool
 
 	From           uint64
 	To             uint64
@@ -135,11 +138,13 @@ type params struct {
 	FacetOrder     string
 	FacetOrderDesc bool
 	ExploreDepth   uint64
+	MaxWeight      float64
 	isInternal     bool   // Determines if processTask has to be called or not.
 	ignoreResult   bool   // Node results are ignored.
 	Expand         string // Value is either _all_/variable-name or empty.
 	isGroupBy      bool
 	groupbyAttrs   []gql.GroupByAttr
+	datePart       string // The datetime component grouped by, for a groupby attribute.
 	uidCount       bool
 	uidCountAlias  string
 	numPaths       int
@@ -147,6 +152,7 @@ type params struct {
 	IsEmpty        bool     // Won't have any SrcUids or DestUids. Only used to get aggregated vars
 	expandAll      bool     // expand all languages
 	shortest       bool
+	orderFacets    []string // Facets fetched only to order by, not returned.
 }
 
 // Function holds the information about gql functions.
@@ -155,6 +161,7 @@ type Function struct {
 	Args       []gql.Arg // Contains the arguments of the function.
 	IsCount    bool      // gt(count(friends),0)
 	IsValueVar bool      // eq(val(s), 10)
+	DatePart   string    // le(month(dob), 6)
 }
 
 // SubGraph is the way to represent data pb.y. It contains both the
@@ -186,6 +193,10 @@ type SubGraph struct {
 	// destUIDs is a list of destination UIDs, after applying filters, pagination.
 	DestUIDs *pb.List
 	List     bool // whether predicate is of list type
+
+	// truncated holds why @recurse didn't follow this edge any further for some of the SrcUIDs,
+	// because of a loop or of the depth.
+	truncated map[uint64]string
 }
 
 func (sg *SubGraph) recurse(set func(sg *SubGraph)) {
@@ -215,6 +226,7 @@ func (sg *SubGraph) createSrcFunction(gf *gql.Function) {
 		Args:       append(gf.Args[:0:0], gf.Args...),
 		IsCount:    gf.IsCount,
 		IsValueVar: gf.IsValueVar,
+		DatePart:   gf.DatePart,
 	}
 	if gf.Lang != "" {
 		sg.Params.Langs = append(sg.Params.Langs, gf.Lang)
@@ -282,7 +294,7 @@ func (sg *SubGraph) isSimilar(ssg *SubGraph) bool {
 }
 
 func (sg *SubGraph) fieldName() string {
-	fieldName := sg.Attr
+	fieldName := attrName(sg.Attr)
 	if sg.Params.Alias != "" {
 		fieldName = sg.Params.Alias
 	}
@@ -297,7 +309,7 @@ func addCount(pc *SubGraph, count uint64, dst outputNode) {
 	c.Value = int64(count)
 	fieldName := pc.Params.Alias
 	if fieldName == "" {
-		fieldName = fmt.Sprintf("count(%s)", pc.Attr)
+		fieldName = fmt.Sprintf("count(%s)", attrName(pc.Attr))
 	}
 	dst.AddValue(fieldName, c)
 }
@@ -307,7 +319,7 @@ func aggWithVarFieldName(pc *SubGraph) string {
 		return pc.Params.Alias
 	}
 	fieldName := fmt.Sprintf("val(%v)", pc.Params.Var)
-	if len(pc.Params.NeedsVar) > 0 {
+	if len(pc.Params.NeedsVar) > 0 && pc.MathExp == nil {
 		fieldName = fmt.Sprintf("val(%v)", pc.Params.NeedsVar[0].Name)
 		if pc.SrcFunc != nil {
 			fieldName = fmt.Sprintf("%s(%v)", pc.SrcFunc.Name, fieldName)
@@ -339,11 +351,28 @@ func addCheckPwd(pc *SubGraph, vals []*pb.TaskValue, dst outputNode) {
 
 	fieldName := pc.Params.Alias
 	if fieldName == "" {
-		fieldName = fmt.Sprintf("checkpwd(%s)", pc.Attr)
+		fieldName = fmt.Sprintf("checkpwd(%s)", attrName(pc.Attr))
 	}
 	dst.AddValue(fieldName, c)
 }
 
+// addScore adds the score of a node computed by a scoring function like bm25, if it has one.
+func addScore(pc *SubGraph, vals []*pb.TaskValue, dst outputNode) error {
+	if len(vals) == 0 {
+		return nil
+	}
+	v, err := convertWithBestEffort(vals[0], pc.Attr)
+	if err != nil {
+		return err
+	}
+	fieldName := pc.Params.Alias
+	if fieldName == "" {
+		fieldName = fmt.Sprintf("%s(%s)", pc.SrcFunc.Name, attrName(pc.Attr))
+	}
+	dst.AddValue(fieldName, v)
+	return nil
+}
+
 func alreadySeen(parentIds []uint64, uid uint64) bool {
 	for _, id := range parentIds {
 		if id == uid {
@@ -360,6 +389,51 @@ func facetName(fieldName string, f *api.Facet) string {
 	return fieldName + FacetDelimeter + f.Key
 }
 
+// hasValueFacets returns whether every value of the value edge has facets of its own, which is
+// the case for lists of untagged values. Otherwise, the values share the facets of the one value
+// picked by the languages.
+func (sg *SubGraph) hasValueFacets() bool {
+	return sg.List && len(sg.Params.Langs) == 0 && !sg.Params.expandAll
+}
+
+// addValueFacets adds the facets of a value edge to dst. The facets of a list of values are added
+// as maps from the index of every value to its facet.
+func (sg *SubGraph) addValueFacets(fieldName string, fl *pb.FacetsList, dst outputNode) error {
+	if !sg.hasValueFacets() {
+		for _, f := range fl.FacetsList[0].Facets {
+			fVal, err := facets.ValFor(f)
+			if err != nil {
+				return err
+			}
+			dst.AddValue(facetName(fieldName, f), fVal)
+		}
+		return nil
+	}
+
+	var names []string
+	nodes := make(map[string]outputNode)
+	for i, fs := range fl.FacetsList {
+		for _, f := range fs.Facets {
+			fVal, err := facets.ValFor(f)
+			if err != nil {
+				return err
+			}
+			name := facetName(fieldName, f)
+			node, ok := nodes[name]
+			if !ok {
+				node = dst.New(name)
+				nodes[name] = node
+				names = append(names, name)
+			}
+			node.AddValue(strconv.Itoa(i), fVal)
+		}
+	}
+	for _, name := range names {
+		dst.AddMapChild(name, nodes[name], false)
+	}
+	return nil
+}
+
 // This method gets the values and children for a subprotos.
 func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 	if sg.Params.IgnoreReflex {
@@ -413,6 +487,10 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 			addCount(pc, uint64(pc.counts[idx]), dst)
 		} else if pc.SrcFunc != nil && pc.SrcFunc.Name == "checkpwd" {
 			addCheckPwd(pc, pc.valueMatrix[idx].Values, dst)
+		} else if pc.SrcFunc != nil && pc.SrcFunc.Name == "bm25" {
+			if err := addScore(pc, pc.valueMatrix[idx].Values, dst); err != nil {
+				return err
+			}
 		} else if idx < len(pc.uidMatrix) && len(pc.uidMatrix[idx].Uids) > 0 {
 			var fcsList []*pb.Facets
 			if pc.Params.Facet != nil {
@@ -486,14 +564,8 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 			}
 
 			if pc.Params.Facet != nil && len(pc.facetsMatrix[idx].FacetsList) > 0 {
-				// in case of Value we have only one Facets
-				for _, f := range pc.facetsMatrix[idx].FacetsList[0].Facets {
-					fVal, err := facets.ValFor(f)
-					if err != nil {
-						return err
-					}
-
-					dst.AddValue(facetName(fieldName, f), fVal)
+				if err := pc.addValueFacets(fieldName, pc.facetsMatrix[idx], dst); err != nil {
+					return err
 				}
 			}
 
@@ -507,6 +579,9 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 				if convErr != nil {
 					return convErr
 				}
+				if pred, ok := sv.Value.(string); ok && pc.Attr == "_predicate_" {
+					sv.Value = attrName(pred)
+				}
 
 				if pc.Params.expandAll && len(pc.LangTags[idx].Lang) != 0 {
 					if i >= len(pc.LangTags[idx].Lang) {
@@ -537,6 +612,10 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 		}
 	}
 
+	if reason := sg.truncatedBy(uid); reason != "" {
+		dst.AddValue("_truncated_", types.Val{Tid: types.StringID, Value: reason})
+	}
+
 	if sg.Params.IgnoreReflex && len(sg.Params.parentIds) > 0 {
 		// Lets pop the stack.
 		sg.Params.parentIds = (sg.Params.parentIds)[:len(sg.Params.parentIds)-1]
@@ -592,6 +671,9 @@ func filterCopy(sg *SubGraph, ft *gql.FilterTree) error {
 		if !isValidFuncName(ft.Func.Name) {
 			return x.Errorf("Invalid function name : %s", ft.Func.Name)
 		}
+		if ft.Func.IsLenVar {
+			return x.Errorf("len() is only allowed in the conditions of an upsert")
+		}
 
 		if isUidFnWithoutVar(ft.Func) {
 			sg.SrcFunc = &Function{Name: ft.Func.Name}
@@ -708,6 +790,9 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 				return x.Errorf("Invalid argument : %s", argk)
 			}
 		}
+		if _, ok := gchild.Args["depth"]; ok && !sg.Params.Recurse {
+			return x.Errorf("depth is only allowed on the predicates of a @recurse query")
+		}
 		if err := args.fill(gchild); err != nil {
 			return err
 		}
@@ -715,6 +800,7 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 		if len(args.Order) != 0 && len(args.FacetOrder) != 0 {
 			return x.Errorf("Cannot specify order at both args and facets")
 		}
+		args.fetchOrderFacets()
 
 		dst := &SubGraph{
 			Attr:   gchild.Attr,
@@ -729,7 +815,8 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 		}
 
 		if gchild.Func != nil &&
-			(gchild.Func.IsAggregator() || gchild.Func.IsPasswordVerifier()) {
+			(gchild.Func.IsAggregator() || gchild.Func.IsPasswordVerifier() ||
+				gchild.Func.IsScorer()) {
 			if len(gchild.Children) != 0 {
 				return x.Errorf("Node with %q cant have child attr", gchild.Func.Name)
 			}
@@ -785,13 +872,20 @@ func (args *params) fill(gq *gql.GraphQuery) error {
 		args.AfterUID = uint64(after)
 	}
 
-	if v, ok := gq.Args["depth"]; ok && (args.Alias == "shortest") {
+	if v, ok := gq.Args["depth"]; ok {
 		from, err := strconv.ParseUint(v, 0, 64)
 		if err != nil {
 			return err
 		}
 		args.ExploreDepth = from
 	}
+	if v, ok := gq.Args["maxweight"]; ok && args.Alias == "shortest" {
+		maxWeight, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return err
+		}
+		args.MaxWeight = maxWeight
+	}
 	if v, ok := gq.Args["numpaths"]; ok && args.Alias == "shortest" {
 		numPaths, err := strconv.ParseUint(v, 0, 64)
 		if err != nil {
@@ -836,7 +930,8 @@ func ToSubGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 	return sg, err
 }
 
-func isDebug(ctx context.Context) bool {
+// IsDebug returns whether the query run with ctx returns the uids of the nodes it reads.
+func IsDebug(ctx context.Context) bool {
 	var debug bool
 	// gRPC client passes information about debug as metadata.
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
@@ -864,23 +959,24 @@ func newGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 	// For the root, the name to be used in result is stored in Alias, not Attr.
 	// The attr at root (if present) would stand for the source functions attr.
 	args := params{
-		Alias:         gq.Alias,
-		Cascade:       gq.Cascade,
-		GetUid:        isDebug(ctx),
-		IgnoreReflex:  gq.IgnoreReflex,
-		IsEmpty:       gq.IsEmpty,
-		Langs:         gq.Langs,
-		NeedsVar:      append(gq.NeedsVar[:0:0], gq.NeedsVar...),
-		Normalize:     gq.Normalize,
-		Order:         gq.Order,
-		ParentVars:    make(map[string]varValue),
-		Recurse:       gq.Recurse,
-		RecurseArgs:   gq.RecurseArgs,
-		Var:           gq.Var,
-		groupbyAttrs:  gq.GroupbyAttrs,
-		isGroupBy:     gq.IsGroupby,
-		uidCount:      gq.UidCount,
-		uidCountAlias: gq.UidCountAlias,
+		Alias:          gq.Alias,
+		Cascade:        gq.Cascade,
+		GetUid:         IsDebug(ctx),
+		IgnoreReflex:   gq.IgnoreReflex,
+		IsEmpty:        gq.IsEmpty,
+		Langs:          gq.Langs,
+		NeedsVar:       append(gq.NeedsVar[:0:0], gq.NeedsVar...),
+		Normalize:      gq.Normalize,
+		NormalizeDepth: gq.NormalizeDepth,
+		Order:          gq.Order,
+		ParentVars:     make(map[string]varValue),
+		Recurse:        gq.Recurse,
+		RecurseArgs:    gq.RecurseArgs,
+		Var:            gq.Var,
+		groupbyAttrs:   gq.GroupbyAttrs,
+		isGroupBy:      gq.IsGroupby,
+		uidCount:       gq.UidCount,
+		uidCountAlias:  gq.UidCountAlias,
 	}
 
 	for argk := range gq.Args {
@@ -902,6 +998,9 @@ func newGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 		if !isValidFuncName(gq.Func.Name) {
 			return nil, x.Errorf("Invalid function name : %s", gq.Func.Name)
 		}
+		if gq.Func.IsLenVar {
+			return nil, x.Errorf("len() is only allowed in the conditions of an upsert")
+		}
 		sg.createSrcFunction(gq.Func)
 	}
 
@@ -919,6 +1018,13 @@ func newGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 		}
 		sg.Filters = append(sg.Filters, sgf)
 	}
+	if gq.RecurseArgs.Until != nil {
+		until := &SubGraph{}
+		if err := filterCopy(until, gq.RecurseArgs.Until); err != nil {
+			return nil, err
+		}
+		sg.Params.recurseUntil = until
+	}
 	if gq.FacetsFilter != nil {
 		facetsFilter, err := toFacetsFilter(gq.FacetsFilter)
 		if err != nil {
@@ -970,6 +1076,7 @@ func createTaskQuery(sg *SubGraph) (*pb.Query, error) {
 		srcFunc = &pb.SrcFunction{}
 		srcFunc.Name = sg.SrcFunc.Name
 		srcFunc.IsCount = sg.SrcFunc.IsCount
+		srcFunc.DatePart = sg.SrcFunc.DatePart
 		for _, arg := range sg.SrcFunc.Args {
 			srcFunc.Args = append(srcFunc.Args, arg.Value)
 			if arg.IsValueVar {
@@ -1003,6 +1110,21 @@ type varValue struct {
 	strList []*pb.ValueList
 }
 
+// uidList returns the uids of the variable, which are the ones with a value for a value variable.
+func (v varValue) uidList() *pb.List {
+	if v.Uids != nil {
+		return v.Uids
+	}
+	uids := make([]uint64, 0, len(v.Vals))
+	for k := range v.Vals {
+		uids = append(uids, k)
+	}
+	sort.Slice(uids, func(i, j int) bool {
+		return uids[i] < uids[j]
+	})
+	return &pb.List{Uids: uids}
+}
+
 func evalLevelAgg(doneVars map[string]varValue, sg, parent *SubGraph) (map[uint64]types.Val, error) {
 	var mp map[uint64]types.Val
 
@@ -1010,19 +1132,22 @@ func evalLevelAgg(doneVars map[string]varValue, sg, parent *SubGraph) (map[uint6
 		return nil, ErrWrongAgg
 	}
 
-	needsVar := sg.Params.NeedsVar[0].Name
+	needsVar, vals, err := sg.aggregatedVals(doneVars)
+	if err != nil {
+		return nil, err
+	}
 	if parent.Params.IsEmpty {
 		// The aggregated value doesn't really belong to a uid, we put it in uidToVal map
 		// corresponding to uid 0 to avoid defining another field in SubGraph.
-		vals := doneVars[needsVar].Vals
 		if len(vals) == 0 {
 			mp = make(map[uint64]types.Val)
 			mp[0] = types.Val{Tid: types.FloatID, Value: 0.0}
 			return mp, nil
 		}
 
-		ag := aggregator{
-			name: sg.SrcFunc.Name,
+		ag, err := newAggregator(sg.SrcFunc)
+		if err != nil {
+			return nil, err
 		}
 		for _, val := range vals {
 			ag.Apply(val)
@@ -1059,12 +1184,12 @@ func evalLevelAgg(doneVars map[string]varValue, sg, parent *SubGraph) (map[uint6
 		return nil, x.Errorf("Invalid variable aggregation. Check the levels.")
 	}
 
-	vals := doneVars[needsVar].Vals
 	mp = make(map[uint64]types.Val)
 	// Go over the sibling node and aggregate.
 	for i, list := range relSG.uidMatrix {
-		ag := aggregator{
-			name: sg.SrcFunc.Name,
+		ag, err := newAggregator(sg.SrcFunc)
+		if err != nil {
+			return nil, err
 		}
 		for _, uid := range list.Uids {
 			if val, ok := vals[uid]; ok {
@@ -1082,6 +1207,59 @@ func evalLevelAgg(doneVars map[string]varValue, sg, parent *SubGraph) (map[uint6
 	return mp, nil
 }
 
+// aggregatedVals returns the values aggregated by sg, and the variable whose level they're at. The
+// variables of a math expression, like in sum(cond(a > 5, a, 0)), are brought to the level of the
+// deepest one before it's evaluated.
+func (sg *SubGraph) aggregatedVals(doneVars map[string]varValue) (
+	string, map[uint64]types.Val, error) {
+	needsVar := sg.Params.NeedsVar[0].Name
+	if sg.MathExp == nil {
+		return needsVar, doneVars[needsVar].Vals, nil
+	}
+
+	for _, v := range sg.Params.NeedsVar {
+		if len(doneVars[v.Name].path) > len(doneVars[needsVar].path) {
+			needsVar = v.Name
+		}
+	}
+	if len(doneVars[needsVar].Vals) == 0 {
+		return needsVar, nil, nil
+	}
+	if err := sg.transformVars(doneVars, doneVars[needsVar].path); err != nil {
+		return "", nil, err
+	}
+	if err := evalMathTree(sg.MathExp); err != nil {
+		return "", nil, err
+	}
+	// Conditions like cond(a > 5, a, 0) mix the ints of the variables with the float constants.
+	floatIfMixed(sg.MathExp.Val)
+	return needsVar, sg.MathExp.Val, nil
+}
+
+// floatIfMixed converts the int values of vals to floats if there are floats too, so that they can
+// be aggregated together.
+func floatIfMixed(vals map[uint64]types.Val) {
+	var isInt, isFloat bool
+	for _, v := range vals {
+		if v.Tid == types.FloatID {
+			isFloat = true
+		}
+		if v.Tid == types.IntID {
+			isInt = true
+		}
+	}
+	if !isInt || !isFloat {
+		return
+	}
+	for k, v := range vals {
+		if v.Tid == types.IntID {
+			v.Tid = types.FloatID
+			v.Value = float64(v.Value.(int64))
+		}
+		vals[k] = v
+	}
+}
+
 func (mt *mathTree) extractVarNodes() []*mathTree {
 	var nodeList []*mathTree
 	for _, ch := range mt.Child {
@@ -1203,24 +1381,7 @@ func (sg *SubGraph) valueVarAggregation(doneVars map[string]varValue, path []*Su
 		}
 		if len(sg.MathExp.Val) != 0 {
 			it := doneVars[sg.Params.Var]
-			var isInt, isFloat bool
-			for _, v := range sg.MathExp.Val {
-				if v.Tid == types.FloatID {
-					isFloat = true
-				}
-				if v.Tid == types.IntID {
-					isInt = true
-				}
-			}
-			if isInt && isFloat {
-				for k, v := range sg.MathExp.Val {
-					if v.Tid == types.IntID {
-						v.Tid = types.FloatID
-						v.Value = float64(v.Value.(int64))
-					}
-					sg.MathExp.Val[k] = v
-				}
-			}
+			floatIfMixed(sg.MathExp.Val)
 
 			it.Vals = sg.MathExp.Val
 			// The path of math node is the path of max var node used in it.
@@ -1556,12 +1717,21 @@ func (sg *SubGraph) recursiveFillVars(doneVars map[string]varValue) error {
 			return err
 		}
 	}
+	if until := sg.Params.recurseUntil; until != nil {
+		if err = until.recursiveFillVars(doneVars); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (sg *SubGraph) fillVars(mp map[string]varValue) error {
 	var lists []*pb.List
 	for _, v := range sg.Params.NeedsVar {
+		if sg.isUidVarArg(v.Name) {
+			// The uids are arguments of the function, replaced by replaceVarInFunc below.
+			continue
+		}
 		if l, ok := mp[v.Name]; ok {
 			if (v.Typ == gql.ANY_VAR || v.Typ == gql.LIST_VAR) && l.strList != nil {
 				// TODO: If we support value vars for list type then this needn't be true
@@ -1574,20 +1744,13 @@ func (sg *SubGraph) fillVars(mp map[string]varValue) error {
 				sg.Params.uidToVal = l.Vals
 			} else if (v.Typ == gql.ANY_VAR || v.Typ == gql.UID_VAR) && len(l.Vals) != 0 {
 				// Derive the UID list from value var.
-				uids := make([]uint64, 0, len(l.Vals))
-				for k := range l.Vals {
-					uids = append(uids, k)
-				}
-				sort.Slice(uids, func(i, j int) bool {
-					return uids[i] < uids[j]
-				})
-				lists = append(lists, &pb.List{Uids: uids})
+				lists = append(lists, l.uidList())
 			} else if len(l.Vals) != 0 || l.Uids != nil {
 				return x.Errorf("Wrong variable type encountered for var(%v) %v.", v.Name, v.Typ)
 			}
 		}
 	}
-	if err := sg.replaceVarInFunc(); err != nil {
+	if err := sg.replaceVarInFunc(mp); err != nil {
 		return err
 	}
 	lists = append(lists, sg.DestUIDs)
@@ -1595,15 +1758,35 @@ func (sg *SubGraph) fillVars(mp map[string]varValue) error {
 	return nil
 }
 
+// isUidVarArg returns whether the variable is given to the function of sg as the uids of an
+// argument, like a in uid_in(friend, uid(a)).
+func (sg *SubGraph) isUidVarArg(name string) bool {
+	if sg.SrcFunc == nil {
+		return false
+	}
+	for _, arg := range sg.SrcFunc.Args {
+		if arg.IsUidVar && arg.Value == name {
+			return true
+		}
+	}
+	return false
+}
+
 // eq(score,val(myscore)), we disallow vars in facets filter so we don't need to worry about
-// that as of now.
-func (sg *SubGraph) replaceVarInFunc() error {
+// that as of now. uid_in(friend, uid(a)) gets the uids of a as arguments.
+func (sg *SubGraph) replaceVarInFunc(mp map[string]varValue) error {
 	if sg.SrcFunc == nil {
 		return nil
 	}
 	var args []gql.Arg
 	// Iterate over the args and replace value args with their values
 	for _, arg := range sg.SrcFunc.Args {
+		if arg.IsUidVar {
+			for _, uid := range mp[arg.Value].uidList().Uids {
+				args = append(args, gql.Arg{Value: fmt.Sprintf("%#x", uid)})
+			}
+			continue
+		}
 		if !arg.IsValueVar {
 			args = append(args, arg)
 			continue
@@ -1683,6 +1866,38 @@ func (sg *SubGraph) appendDummyValues() {
 	}
 }
 
+// attrName returns the name of the predicate the attribute is stored under in its namespace,
+// which the results use.
+func attrName(attr string) string {
+	_, name := x.ParseNamespaceAttr(attr)
+	return name
+}
+
+// namespacePreds returns the predicates of the namespace among the ones given.
+func namespacePreds(ns uint64, preds []string) []string {
+	out := preds[:0]
+	for _, pred := range preds {
+		if pns, _ := x.ParseNamespaceAttr(pred); pns == ns {
+			out = append(out, pred)
+		}
+	}
+	return out
+}
+
+// filterNamespaceValues drops the predicates of the other namespaces from the lists of
+// predicates of the nodes, as read from _predicate_.
+func filterNamespaceValues(ns uint64, vls []*pb.ValueList) {
+	for _, vl := range vls {
+		vals := vl.Values[:0]
+		for _, v := range vl.Values {
+			if pns, _ := x.ParseNamespaceAttr(string(v.Val)); pns == ns {
+				vals = append(vals, v)
+			}
+		}
+		vl.Values = vals
+	}
+}
+
 func uniquePreds(vl []*pb.ValueList) []string {
 	predMap := make(map[string]struct{})
 
@@ -1749,6 +1964,15 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 		// It could be expand(_all_), expand(_forward_), expand(_reverse_) or expand(val(x)).
 		case "_all_":
 			span.Annotate(nil, "expand(_all_)")
+			// The predicates of nodes having types are the ones declared by their types.
+			typeNames, err := getNodeTypes(ctx, sg)
+			if err != nil {
+				return out, err
+			}
+			if len(typeNames) > 0 {
+				preds = getTypePredicates(ctx, typeNames)
+				break
+			}
 			// Get the predicate list for expansion.
 			child.ExpandPreds, err = getNodePredicates(ctx, sg)
 			if err != nil {
@@ -1780,6 +2004,8 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 			// We already have the predicates populated from the var.
 			preds = uniquePreds(child.ExpandPreds)
 		}
+		// Only the predicates of the namespace of the request are expanded.
+		preds = namespacePreds(x.ExtractNamespace(ctx), preds)
 
 		for _, pred := range preds {
 			temp := &SubGraph{
@@ -1851,7 +2077,12 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		rch <- nil
 		return
 	}
+	// The query might have timed out or been cancelled while the parent was being processed.
 	var err error
+	if err = ctx.Err(); err != nil {
+		rch <- err
+		return
+	}
 	if parent == nil && sg.SrcFunc != nil && sg.SrcFunc.Name == "uid" {
 		// I'm root and I'm using some variable that has been populated.
 		// Retain the actual order in uidMatrix. But sort the destUids.
@@ -1909,11 +2140,23 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 
 			sg.uidMatrix = result.UidMatrix
 			sg.valueMatrix = result.ValueMatrix
+			if sg.Attr == "_predicate_" {
+				filterNamespaceValues(x.ExtractNamespace(ctx), sg.valueMatrix)
+			}
 			sg.facetsMatrix = result.FacetMatrix
 			sg.counts = result.Counts
 			sg.LangTags = result.LangMatrix
 			sg.List = result.List
 
+			if len(sg.Params.FacetOrder) > 0 && len(sg.valueMatrix) > 0 && sg.hasValueFacets() {
+				// The values of a value edge have to be ordered here, as there are no uids to
+				// order once the uids of the children are merged below.
+				if err = sg.sortValuesUsingFacet(); err != nil {
+					rch <- err
+					return
+				}
+			}
+
 			if sg.Params.DoCount {
 				if len(sg.Filters) == 0 {
 					// If there is a filter, we need to do more work to get the actual count.
@@ -1953,6 +2196,11 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		return
 	}
 
+	if err = ctx.Err(); err != nil {
+		rch <- err
+		return
+	}
+
 	// Run filters if any.
 	if len(sg.Filters) > 0 {
 		// Run all filters in parallel.
@@ -1993,9 +2241,13 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		for _, filter := range sg.Filters {
 			lists = append(lists, filter.DestUIDs)
 		}
+		numUidsIn := len(sg.DestUIDs.Uids)
+		op := "intersect"
 		if sg.FilterOp == "or" {
+			op = "merge"
 			sg.DestUIDs = algo.MergeSorted(lists)
 		} else if sg.FilterOp == "not" {
+			op = "difference"
 			x.AssertTrue(len(sg.Filters) == 1)
 			sg.DestUIDs = algo.Difference(sg.DestUIDs, sg.Filters[0].DestUIDs)
 		} else if sg.FilterOp == "and" {
@@ -2010,6 +2262,16 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			lists = append(lists, sg.DestUIDs)
 			sg.DestUIDs = algo.IntersectSorted(lists)
 		}
+		if plan := worker.QueryPlanFrom(ctx); plan != nil {
+			plan.AddFilter(&worker.FilterPlan{
+				Attr:       sg.Attr,
+				Alias:      sg.Params.Alias,
+				Op:         op,
+				NumFilters: len(sg.Filters),
+				NumUidsIn:  numUidsIn,
+				NumUids:    len(sg.DestUIDs.Uids),
+			})
+		}
 	}
 
 	if len(sg.Params.Order) == 0 && len(sg.Params.FacetOrder) == 0 {
@@ -2064,18 +2326,28 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		// Add the attrs required by groupby nodes
 		for _, it := range sg.Params.groupbyAttrs {
 			// TODO - Throw error if Attr is of list type.
+			alias := it.Alias
+			if alias == "" && it.DatePart != "" {
+				alias = fmt.Sprintf("%s(%s)", it.DatePart, it.Attr)
+			}
 			sg.Children = append(sg.Children, &SubGraph{
 				Attr:   it.Attr,
 				ReadTs: sg.ReadTs,
 				Params: params{
-					Alias:        it.Alias,
+					Alias:        alias,
 					ignoreResult: true,
 					Langs:        it.Langs,
+					datePart:     it.DatePart,
 				},
 			})
 		}
 	}
 
+	if err = ctx.Err(); err != nil {
+		rch <- err
+		return
+	}
+
 	childChan := make(chan error, len(sg.Children))
 	for i := 0; i < len(sg.Children); i++ {
 		child := sg.Children[i]
@@ -2135,6 +2407,11 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 	if len(sg.Params.FacetOrder) != 0 {
 		return sg.sortAndPaginateUsingFacet(ctx)
 	}
+	for _, o := range sg.Params.Order {
+		if o.Facet || o.Distance != "" {
+			return sg.sortAndPaginateInQuery(ctx)
+		}
+	}
 
 	for _, it := range sg.Params.NeedsVar {
 		// TODO(pawan) - Return error if user uses var order with predicates.
@@ -2204,6 +2481,218 @@ func (sg *SubGraph) updateDestUids() {
 	algo.ApplyFilter(sg.DestUIDs, func(uid uint64, idx int) bool { return included[idx] })
 }
 
+// sortValuesUsingFacet orders every list of values of a value edge by their facet FacetOrder.
+func (sg *SubGraph) sortValuesUsingFacet() error {
+	if sg.facetsMatrix == nil {
+		return nil
+	}
+	orderby := sg.Params.FacetOrder
+	for i, vl := range sg.valueMatrix {
+		fl := sg.facetsMatrix[i]
+		if len(vl.Values) < 2 || len(fl.FacetsList) != len(vl.Values) {
+			continue
+		}
+		// Sort the indexes of the values along with their facets, then reorder the values.
+		idx := &pb.List{Uids: make([]uint64, len(vl.Values))}
+		values := make([][]types.Val, len(vl.Values))
+		for j, f := range fl.FacetsList {
+			idx.Uids[j] = uint64(j)
+			fVal, err := facetValue(f, orderby)
+			if err != nil {
+				return err
+			}
+			values[j] = []types.Val{fVal}
+		}
+		if err := types.SortWithFacet(values, idx, fl.FacetsList,
+			[]bool{sg.Params.FacetOrderDesc}); err != nil {
+			return err
+		}
+		sorted := make([]*pb.TaskValue, 0, len(vl.Values))
+		for _, j := range idx.Uids {
+			sorted = append(sorted, vl.Values[j])
+		}
+		vl.Values = sorted
+	}
+	return nil
+}
+
+// facetValue returns the value of the facet key in fs, with a nil value if there's none, which is
+// ordered last.
+func facetValue(fs *pb.Facets, key string) (types.Val, error) {
+	for _, f := range fs.Facets {
+		if f.Key == key {
+			return facets.ValFor(f)
+		}
+	}
+	return types.Val{Value: nil}, nil
+}
+
+// fetchOrderFacets makes sure the facets of the edge ordered by with orderasc: facet(key) are
+// fetched, keeping track of the ones not asked for with @facets to drop them once ordered.
+func (p *params) fetchOrderFacets() {
+	var keys []string
+	for _, o := range p.Order {
+		if o.Facet {
+			keys = append(keys, o.Attr)
+		}
+	}
+	if len(keys) == 0 || (p.Facet != nil && p.Facet.AllKeys) {
+		return
+	}
+
+	fp := &pb.FacetParams{}
+	if p.Facet != nil {
+		fp.Param = append(fp.Param, p.Facet.Param...)
+	}
+	for _, key := range keys {
+		found := false
+		for _, param := range fp.Param {
+			found = found || param.Key == key
+		}
+		if !found {
+			fp.Param = append(fp.Param, &pb.FacetParam{Key: key})
+			p.orderFacets = append(p.orderFacets, key)
+		}
+	}
+	// The keys have to be sorted, as expected by facets.CopyFacets.
+	sort.Slice(fp.Param, func(i, j int) bool { return fp.Param[i].Key < fp.Param[j].Key })
+	p.Facet = fp
+}
+
+// orderValues fetches the values of the predicate ordered by in o for the destination uids.
+func (sg *SubGraph) orderValues(ctx context.Context, o *pb.Order) (map[uint64]types.Val, error) {
+	q := &pb.Query{
+		Attr:    o.Attr,
+		Langs:   o.Langs,
+		UidList: sg.DestUIDs,
+		ReadTs:  sg.ReadTs,
+	}
+	result, err := worker.ProcessTaskOverNetwork(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if o.Distance != "" {
+		return distanceValues(sg.DestUIDs, result.ValueMatrix, o)
+	}
+	vals := make(map[uint64]types.Val)
+	for i, uid := range sg.DestUIDs.Uids {
+		if i >= len(result.ValueMatrix) || len(result.ValueMatrix[i].Values) == 0 {
+			continue
+		}
+		tv := result.ValueMatrix[i].Values[0]
+		if len(tv.Val) == 0 {
+			continue
+		}
+		v, err := convertWithBestEffort(tv, o.Attr)
+		if err != nil {
+			return nil, err
+		}
+		vals[uid] = v
+	}
+	return vals, nil
+}
+
+// distanceValues returns the geodesic distances from the point of o to the geometries of the uids,
+// the shortest one for a list of geometries.
+func distanceValues(uids *pb.List, vm []*pb.ValueList, o *pb.Order) (map[uint64]types.Val, error) {
+	p, err := types.ParseGeoPoint(o.Distance)
+	if err != nil {
+		return nil, err
+	}
+	vals := make(map[uint64]types.Val)
+	for i, uid := range uids.Uids {
+		if i >= len(vm) {
+			break
+		}
+		for _, tv := range vm[i].Values {
+			d, ok := types.GeoDistance(tv, p)
+			if !ok {
+				continue
+			}
+			if v, seen := vals[uid]; !seen || d < v.Value.(float64) {
+				vals[uid] = types.Val{Tid: types.FloatID, Value: d}
+			}
+		}
+	}
+	return vals, nil
+}
+
+// sortAndPaginateInQuery orders the uids by the sort keys of the arguments, some of which are
+// facets of the edge or distances to a point, which can't be ordered by the workers. The ties are
+// kept in the order of the uids.
+func (sg *SubGraph) sortAndPaginateInQuery(ctx context.Context) error {
+	order := sg.Params.Order
+	desc := make([]bool, len(order))
+	predVals := make([]map[uint64]types.Val, len(order))
+	for k, o := range order {
+		desc[k] = o.Desc
+		if o.Facet {
+			if sg.facetsMatrix == nil {
+				return nil
+			}
+			continue
+		}
+		vals, err := sg.orderValues(ctx, o)
+		if err != nil {
+			return err
+		}
+		predVals[k] = vals
+	}
+
+	for i, ul := range sg.uidMatrix {
+		var fl []*pb.Facets
+		if sg.facetsMatrix != nil {
+			fl = sg.facetsMatrix[i].FacetsList
+		}
+		values := make([][]types.Val, len(ul.Uids))
+		for j, uid := range ul.Uids {
+			values[j] = make([]types.Val, len(order))
+			for k, o := range order {
+				if !o.Facet {
+					values[j][k] = predVals[k][uid]
+					continue
+				}
+				fVal, err := facetValue(fl[j], o.Attr)
+				if err != nil {
+					return err
+				}
+				values[j][k] = fVal
+			}
+		}
+		if err := types.SortWithFacet(values, ul, fl, desc); err != nil {
+			return err
+		}
+	}
+
+	if sg.Params.Count != 0 || sg.Params.Offset != 0 {
+		for i := 0; i < len(sg.uidMatrix); i++ {
+			start, end := x.PageRange(sg.Params.Count, sg.Params.Offset, len(sg.uidMatrix[i].Uids))
+			sg.uidMatrix[i].Uids = sg.uidMatrix[i].Uids[start:end]
+			if sg.facetsMatrix != nil {
+				sg.facetsMatrix[i].FacetsList = sg.facetsMatrix[i].FacetsList[start:end]
+			}
+		}
+	}
+
+	// Drop the facets which were only fetched to order by.
+	for _, key := range sg.Params.orderFacets {
+		for _, fl := range sg.facetsMatrix {
+			for _, fs := range fl.FacetsList {
+				out := fs.Facets[:0]
+				for _, f := range fs.Facets {
+					if f.Key != key {
+						out = append(out, f)
+					}
+				}
+				fs.Facets = out
+			}
+		}
+	}
+
+	sg.updateDestUids()
+	return nil
+}
+
 func (sg *SubGraph) sortAndPaginateUsingFacet(ctx context.Context) error {
 	if sg.facetsMatrix == nil {
 		return nil
@@ -2308,7 +2797,8 @@ func (sg *SubGraph) sortAndPaginateUsingVar(ctx context.Context) error {
 // isValidArg checks if arg passed is valid keyword.
 func isValidArg(a string) bool {
 	switch a {
-	case "numpaths", "from", "to", "orderasc", "orderdesc", "first", "offset", "after", "depth":
+	case "numpaths", "from", "to", "orderasc", "orderdesc", "first", "offset", "after", "depth",
+		"maxweight":
 		return true
 	}
 	return false
@@ -2317,7 +2807,7 @@ func isValidArg(a string) bool {
 // isValidFuncName checks if fn passed is valid keyword.
 func isValidFuncName(f string) bool {
 	switch f {
-	case "anyofterms", "allofterms", "val", "regexp", "anyoftext", "alloftext",
+	case "anyofterms", "allofterms", "val", "regexp", "match", "anyoftext", "alloftext",
 		"has", "uid", "uid_in", "anyof", "allof":
 		return true
 	}
@@ -2334,7 +2824,7 @@ func isInequalityFn(f string) bool {
 
 func isAggregatorFn(f string) bool {
 	switch f {
-	case "min", "max", "sum", "avg":
+	case "min", "max", "sum", "avg", "stddev", "variance", "median", "percentile":
 		return true
 	}
 	return false
@@ -2361,6 +2851,49 @@ func getNodePredicates(ctx context.Context, sg *SubGraph) ([]*pb.ValueList, erro
 	return result.ValueMatrix, nil
 }
 
+// getNodeTypes returns the names of the types of the nodes, as read from dgraph.type.
+func getNodeTypes(ctx context.Context, sg *SubGraph) ([]string, error) {
+	temp := &SubGraph{
+		Attr:    x.NamespaceAttr(x.ExtractNamespace(ctx), x.TypeAttr),
+		SrcUIDs: sg.DestUIDs,
+		ReadTs:  sg.ReadTs,
+	}
+	taskQuery, err := createTaskQuery(temp)
+	if err != nil {
+		return nil, err
+	}
+	result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
+	if err != nil {
+		return nil, err
+	}
+	return uniquePreds(result.ValueMatrix), nil
+}
+
+// getTypePredicates returns the predicates declared by the types, in the order they're declared.
+// Every server holds all the types, so they're resolved locally. Types which aren't declared in
+// the namespace of the request are ignored.
+func getTypePredicates(ctx context.Context, typeNames []string) []string {
+	ns := x.ExtractNamespace(ctx)
+	sort.Strings(typeNames)
+
+	var preds []string
+	seen := make(map[string]struct{})
+	for _, name := range typeNames {
+		typ, ok := schema.State().GetType(x.NamespaceAttr(ns, name))
+		if !ok {
+			continue
+		}
+		for _, field := range typ.Fields {
+			if _, ok := seen[field]; ok {
+				continue
+			}
+			seen[field] = struct{}{}
+			preds = append(preds, field)
+		}
+	}
+	return preds
+}
+
 func GetAllPredicates(subGraphs []*SubGraph) []string {
 	predicatesMap := make(map[string]struct{})
 	for _, sg := range subGraphs {
@@ -2555,6 +3088,30 @@ func (req *QueryRequest) ProcessQuery(ctx context.Context) (err error) {
 	return nil
 }
 
+// Uids returns the uids in the variable of the given name, once the query has been processed.
+// For a value variable, they're the uids it has values for.
+func (req *QueryRequest) Uids(name string) []uint64 {
+	v, ok := req.vars[name]
+	if !ok {
+		return nil
+	}
+	if v.Uids != nil {
+		return v.Uids.Uids
+	}
+	uids := make([]uint64, 0, len(v.Vals))
+	for uid := range v.Vals {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	return uids
+}
+
+// Vals returns the values of the value variable of the given name by uid, once the query has
+// been processed.
+func (req *QueryRequest) Vals(name string) map[uint64]types.Val {
+	return req.vars[name].Vals
+}
+
 var MutationNotAllowedErr = x.Errorf("Mutations are forbidden on this server.")
 
 type InvalidRequestError struct {
@@ -2576,7 +3133,16 @@ func (e *InternalError) Error() string {
 // TODO: This looks unnecessary.
 type ExecuteResult struct {
 	Subgraphs  []*SubGraph
-	SchemaNode []*api.SchemaNode
+	SchemaNode []*pb.SchemaNode
+	// SchemaVersion is the version the schema was read at, to be passed as since_version to
+	// only get the schema changed since.
+	SchemaVersion uint64
+	// SchemaFailedGroups are the groups whose schema couldn't be read, for a best effort
+	// schema query.
+	SchemaFailedGroups []uint32
+	// Types are the object types declared, returned along with the schema of all the
+	// predicates or when the schema query names types.
+	Types []*pb.TypeUpdate
 }
 
 func (qr *QueryRequest) Process(ctx context.Context) (er ExecuteResult, err error) {
@@ -2586,8 +3152,31 @@ func (qr *QueryRequest) Process(ctx context.Context) (er ExecuteResult, err erro
 	}
 	er.Subgraphs = qr.Subgraphs
 
-	if qr.GqlQuery.Schema != nil {
-		if er.SchemaNode, err = worker.GetSchemaOverNetwork(ctx, qr.GqlQuery.Schema); err != nil {
+	if s := qr.GqlQuery.Schema; s != nil {
+		noPreds := len(s.Predicates) == 0 && len(s.PredicatePatterns) == 0
+		if len(s.TypeNames) > 0 || noPreds {
+			if er.Types, err = worker.GetTypesOverNetwork(ctx, s); err != nil {
+				return er, x.Wrapf(&InternalError{err: err}, "error while fetching types")
+			}
+		}
+		if len(s.TypeNames) > 0 && noPreds {
+			// Only the types were asked for.
+			return er, nil
+		}
+		var result *pb.SchemaResult
+		result, err = worker.GetSchemaResultOverNetwork(ctx, s)
+		if result != nil {
+			er.SchemaNode, er.SchemaVersion = result.Schema, result.Version
+		}
+		if groupsErr, ok := err.(*worker.SchemaGroupsError); ok {
+			// Best effort: return the schema of the groups which replied, along with the ones
+			// which didn't.
+			glog.Warningf("Returning partial schema: %v", groupsErr)
+			otrace.FromContext(ctx).Annotate(nil, groupsErr.Error())
+			er.SchemaFailedGroups = groupsErr.Groups()
+			err = nil
+		}
+		if err != nil {
 			return er, x.Wrapf(&InternalError{err: err}, "error while fetching schema")
 		}
 	}
@@ -1696,6 +1696,7 @@ func TestMain(m *testing.M) {
 	walStore, err := badger.Open(kvOpt)
 	x.Check(err)
 
+	worker.Config.WALDir = dir2
 	worker.StartRaftNodes(walStore, false)
 	// Load schema after nodes have started
 
@@ -42,7 +42,7 @@ func TestSchemaBlock2(t *testing.T) {
 		}
 	`
 	actual := processSchemaQuery(t, query)
-	expected := []*api.SchemaNode{
+	expected := []*pb.SchemaNode{
 		{Predicate: "name",
 			Type:      "string",
 			Index:     true,
@@ -62,7 +62,7 @@ func TestSchemaBlock3(t *testing.T) {
 		}
 	`
 	actual := processSchemaQuery(t, query)
-	expected := []*api.SchemaNode{{Predicate: "age",
+	expected := []*pb.SchemaNode{{Predicate: "age",
 		Type:      "int",
 		Index:     true,
 		Tokenizer: []string{"int"},
@@ -80,7 +80,7 @@ func TestSchemaBlock4(t *testing.T) {
 		}
 	`
 	actual := processSchemaQuery(t, query)
-	expected := []*api.SchemaNode{
+	expected := []*pb.SchemaNode{
 		{Predicate: "genre",
 			Type:    "uid",
 			Reverse: true}, {Predicate: "age",
@@ -96,7 +96,7 @@ func TestSchemaBlock5(t *testing.T) {
 		}
 	`
 	actual := processSchemaQuery(t, query)
-	expected := []*api.SchemaNode{
+	expected := []*pb.SchemaNode{
 		{Predicate: "name",
 			Type:      "string",
 			Index:     true,
@@ -107,6 +107,43 @@ func TestSchemaBlock5(t *testing.T) {
 	checkSchemaNodes(t, expected, actual)
 }
 
+func TestSchemaForQuery(t *testing.T) {
+	query := `
+		{
+			me(func: anyofterms(name, "Michonne")) @filter(gt(age, 10)) {
+				~friend {
+					name
+				}
+			}
+		}
+	`
+	actual, err := SchemaForQuery(context.Background(), gql.Request{Str: query})
+	require.NoError(t, err)
+	expected := []*pb.SchemaNode{
+		{Predicate: "name",
+			Type:      "string",
+			Index:     true,
+			Tokenizer: []string{"term", "exact", "trigram"},
+			Count:     true,
+			Lang:      true,
+		},
+		{Predicate: "age",
+			Type:      "int",
+			Index:     true,
+			Tokenizer: []string{"int"},
+		},
+		{Predicate: "friend",
+			Type:    "uid",
+			Reverse: true,
+			Count:   true,
+		}}
+	checkSchemaNodes(t, expected, actual)
+
+	_, err = SchemaForQuery(context.Background(), gql.Request{Str: "{ me(func: "})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "while parsing query to fetch its schema")
+}
+
 // Duplicate implemention as in cmd/dgraph/main_test.go
 // TODO: Change the implementation in cmd/dgraph to test for network failure
 type raftServer struct {
@@ -1245,6 +1282,63 @@ func TestUidInFunctionAtRoot(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestUidInFunctionWithVar(t *testing.T) {
+
+	query := `
+	{
+		var(func: uid(23)) {
+			r as uid
+		}
+
+		me(func: uid(1, 23, 24)) @filter(uid_in(friend, uid(r))) {
+			name
+		}
+	}`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne"}]}}`, js)
+}
+
+func TestUidInFunctionWithEmptyVar(t *testing.T) {
+
+	query := `
+	{
+		var(func: eq(name, "No such name")) {
+			r as uid
+		}
+
+		me(func: uid(1, 23, 24)) @filter(uid_in(friend, uid(r))) {
+			name
+		}
+	}`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": []}}`, js)
+}
+
+func TestFilterWithAggregatedVar(t *testing.T) {
+
+	query := `
+	{
+		var(func: uid(1)) {
+			friend {
+				a as age
+			}
+		}
+
+		var() {
+			maxAge as max(val(a))
+		}
+
+		me(func: uid(1)) {
+			friend @filter(eq(age, val(maxAge))) {
+				name
+				age
+			}
+		}
+	}`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"friend":[{"name":"Andrea","age":19}]}]}}`, js)
+}
+
 func TestBinaryJSON(t *testing.T) {
 
 	query := `
@@ -1466,6 +1560,62 @@ func TestAggregateRootError(t *testing.T) {
 	require.Contains(t, err.Error(), "Only aggregated variables allowed within empty block.")
 }
 
+func TestAggregateRootSpread(t *testing.T) {
+
+	query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				variance(val(a))
+				stddev(val(a))
+				median(val(a))
+				percentile(val(a), 90)
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"variance(val(a))":100.666667},{"stddev(val(a))":10.033278},{"median(val(a))":19.000000},{"percentile(val(a))":34.200000}]}}`, js)
+}
+
+func TestAggregateRootCond(t *testing.T) {
+
+	query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				adults: sum(cond(a >= 18, a, 0))
+				numAdults: sum(cond(a >= 18, 1, 0))
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"adults":57.000000},{"numAdults":2.000000}]}}`, js)
+}
+
+func TestAggregatePercentileError(t *testing.T) {
+
+	query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				percentile(val(a), 120)
+			}
+		}
+	`
+	_, err := processToFastJson(t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected a percentile between 0 and 100")
+}
+
 func TestFilterLang(t *testing.T) {
 	// This tests the fix for #1334. While getting uids for filter, we fetch data keys when number
 	// of uids is less than number of tokens. Lang tag was not passed correctly while fetching these
@@ -25,7 +25,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/stretchr/testify/require"
@@ -742,6 +741,33 @@ func TestCountReverseFilter(t *testing.T) {
 		js)
 }
 
+func TestCountReverseEqFilter(t *testing.T) {
+	query := `
+		{
+			me(func: anyofterms(name, "Glenn Michonne Rick")) @filter(eq(count(~friend), 2)) {
+				name
+				count(~friend)
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Glenn Rhee","count(~friend)":2}]}}`,
+		js)
+}
+
+func TestCountUidAtRootWithFilter(t *testing.T) {
+	query := `
+		{
+			me(func: anyofterms(name, "Glenn Michonne Rick")) @filter(eq(count(~friend), 2)) {
+				count(uid)
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"count":1}]}}`, js)
+}
+
 func TestCountReverse(t *testing.T) {
 
 	query := `
@@ -1540,6 +1566,65 @@ func TestNearGeneratorErrorMissDist(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestNearestGenerator(t *testing.T) {
+	query := `{
+		me(func: nearest(loc, [3.0, 1.0], 2)) {
+			name
+		}
+	}`
+
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Rick Grimes"},{"name":"Andrea"}]}}`, js)
+}
+
+func TestNearestFilter(t *testing.T) {
+	query := `{
+		me(func: uid(1, 24, 25, 31)) @filter(nearest(loc, [3.0, 1.0], 2)) {
+			name
+		}
+	}`
+
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Glenn Rhee"},{"name":"Andrea"}]}}`, js)
+}
+
+func TestNearestGeneratorError(t *testing.T) {
+	query := `{
+		me(func: nearest(loc, [3.0, 1.0], 0)) {
+			name
+		}
+	}`
+
+	_, err := processToFastJson(t, query)
+	require.Error(t, err)
+}
+
+func TestOrderDistance(t *testing.T) {
+	query := `{
+		me(func: nearest(loc, [3.0, 1.0], 4), orderasc: distance(loc, [3.0, 1.0])) {
+			name
+		}
+	}`
+
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Rick Grimes"},{"name":"Andrea"},
+		{"name":"Glenn Rhee"},{"name":"Michonne"}]}}`, js)
+}
+
+func TestOrderDistanceInArgs(t *testing.T) {
+	query := `{
+		me(func: uid(1)) {
+			friend(orderdesc: distance(loc, [3.0, 1.0])) @filter(has(loc)) {
+				name
+			}
+		}
+	}`
+
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"friend":[{"name":"Daryl Dixon"},
+		{"name":"Glenn Rhee"},{"name":"Andrea"},{"name":"Rick Grimes"}]}]}}`, js)
+}
+
 func TestWithinGeneratorError(t *testing.T) {
 
 	query := `{
@@ -1659,6 +1744,32 @@ func TestNormalizeDirective(t *testing.T) {
 		js)
 }
 
+func TestNormalizeDirectiveDepth(t *testing.T) {
+	query := `
+		{
+			me(func: uid(0x01)) @normalize(depth: 1) {
+				mn: name
+				gender
+				friend {
+					n: name
+					d: dob
+					friend {
+						fn : name
+					}
+				}
+				son {
+					sn: name
+				}
+			}
+		}
+	`
+
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"mn":"Michonne","friend":[{"d":"1910-01-02T00:00:00Z","fn":"Michonne","n":"Rick Grimes"},{"d":"1909-05-05T00:00:00Z","n":"Glenn Rhee"},{"d":"1909-01-10T00:00:00Z","n":"Daryl Dixon"},{"d":"1901-01-15T00:00:00Z","fn":"Glenn Rhee","n":"Andrea"}],"son":[{"sn":"Andre"},{"sn":"Helmut"}]}]}}`,
+		js)
+}
+
 func TestNearPoint(t *testing.T) {
 
 	query := `{
@@ -2188,7 +2299,7 @@ func TestLangDotInFunction(t *testing.T) {
 		js)
 }
 
-func checkSchemaNodes(t *testing.T, expected []*api.SchemaNode, actual []*api.SchemaNode) {
+func checkSchemaNodes(t *testing.T, expected []*pb.SchemaNode, actual []*pb.SchemaNode) {
 	sort.Slice(expected, func(i, j int) bool {
 		return expected[i].Predicate >= expected[j].Predicate
 	})
@@ -19,6 +19,7 @@ package query
 import (
 	"context"
 	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/dgraph-io/dgraph/gql"
@@ -162,6 +163,89 @@ func TestRecurseQueryLimitDepth2(t *testing.T) {
 		`{"data": {"me":[{"uid":"0x1","friend":[{"uid":"0x17","name":"Rick Grimes"},{"uid":"0x18","name":"Glenn Rhee"},{"uid":"0x19","name":"Daryl Dixon"},{"uid":"0x1f","name":"Andrea"},{"uid":"0x65"}],"name":"Michonne"}]}}`, js)
 }
 
+func TestRecurseQueryPredicateDepth(t *testing.T) {
+
+	query := `
+		{
+			me(func: uid(0x01)) @recurse {
+				friend (depth: 2)
+				name
+			}
+		}`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Michonne", "friend":[{"name":"Rick Grimes"},{"name":"Glenn Rhee"},{"name":"Daryl Dixon"},{"name":"Andrea"}]}]}}`, js)
+}
+
+func TestRecursePredicateDepthError(t *testing.T) {
+
+	query := `
+		{
+			me(func: uid(0x01)) {
+				friend (depth: 2) {
+					name
+				}
+			}
+		}`
+
+	ctx := defaultContext()
+	_, err := processToFastJsonCtxVars(t, query, ctx, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "depth is only allowed on the predicates of a @recurse query")
+}
+
+func TestRecurseQueryUntil(t *testing.T) {
+
+	query := `
+		{
+			me(func: uid(0x01)) @recurse(until: eq(name, "Andrea")) {
+				friend
+				name
+			}
+		}`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Michonne", "friend":[{"name":"Rick Grimes", "friend":[{"name":"Michonne"}]},{"name":"Glenn Rhee"},{"name":"Daryl Dixon"},{"name":"Andrea"}]}]}}`, js)
+}
+
+func TestRecurseQueryReportLoop(t *testing.T) {
+
+	query := `
+		{
+			me(func: uid(0x01)) @recurse(report: true) {
+				friend
+				name
+			}
+		}`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Michonne", "friend":[{"name":"Rick Grimes", "friend":[{"name":"Michonne", "_truncated_":"loop"}]},{"name":"Glenn Rhee"},{"name":"Daryl Dixon"},{"name":"Andrea", "friend":[{"name":"Glenn Rhee"}]}]}]}}`, js)
+}
+
+func TestRecurseQueryReportDepth(t *testing.T) {
+
+	expected := `{"data": {"me":[{"name":"Michonne", "friend":[{"name":"Rick Grimes", "_truncated_":"depth"},{"name":"Glenn Rhee"},{"name":"Daryl Dixon"},{"name":"Andrea", "_truncated_":"depth"}]}]}}`
+	query := `
+		{
+			me(func: uid(0x01)) @recurse(depth: 2, report: true) {
+				friend
+				name
+			}
+		}`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, expected, js)
+
+	query = `
+		{
+			me(func: uid(0x01)) @recurse(report: true) {
+				friend (depth: 2)
+				name
+			}
+		}`
+	js = processToFastJsonNoErr(t, query)
+	require.JSONEq(t, expected, js)
+}
+
 func TestRecurseVariable(t *testing.T) {
 
 	query := `
@@ -338,6 +422,21 @@ func TestKShortestPathWeighted1(t *testing.T) {
 		js)
 }
 
+func TestKShortestPathWeighted_MaxWeight(t *testing.T) {
+
+	query := `
+		{
+			shortest(from: 1, to:1003, numpaths: 3, maxweight: 1.6) {
+				path @facets(weight)
+			}
+		}`
+	// The third path weighs 1.8.
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data":{"_path_":[{"uid":"0x1","path":[{"uid":"0x1f","path":[{"uid":"0x3e8","path":[{"uid":"0x3e9","path":[{"uid":"0x3ea","path":[{"uid":"0x3eb","path|weight":0.600000}],"path|weight":0.100000}],"path|weight":0.100000}],"path|weight":0.100000}],"path|weight":0.100000}]},{"uid":"0x1","path":[{"uid":"0x1f","path":[{"uid":"0x3e8","path":[{"uid":"0x3ea","path":[{"uid":"0x3eb","path|weight":0.600000}],"path|weight":0.700000}],"path|weight":0.100000}],"path|weight":0.100000}]}]}}`,
+		js)
+}
+
 func TestTwoShortestPath(t *testing.T) {
 
 	query := `
@@ -465,6 +564,19 @@ func TestShortestPathWeights(t *testing.T) {
 		js)
 }
 
+func TestShortestPathWeights_MaxWeight(t *testing.T) {
+
+	query := `
+		{
+			shortest(from:1, to:1002, maxweight: 0.3) {
+				path @facets(weight)
+			}
+		}`
+	// The shortest path weighs 0.4.
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {}}`, js)
+}
+
 func TestShortestPath2(t *testing.T) {
 
 	query := `
@@ -1124,6 +1236,63 @@ func TestCheckPasswordIncorrect(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne","checkpwd(password)":false}]}}`, js)
 }
 
+func TestBM25Score(t *testing.T) {
+	query := `
+		{
+			me(func: uid(0x01)) {
+				friend @filter(uid(24)) {
+					bm25(alias, "john alice")
+				}
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	var res struct {
+		Data struct {
+			Me []struct {
+				Friend []map[string]float64 `json:"friend"`
+			} `json:"me"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(js), &res))
+	require.Equal(t, 1, len(res.Data.Me))
+	require.Equal(t, 1, len(res.Data.Me[0].Friend))
+	// Both terms are in 2 of the 5 values of alias, all of them 2 terms long, so each of them
+	// scores its idf.
+	require.InDelta(t, 2*math.Log(1+3.5/2.5), res.Data.Me[0].Friend[0]["bm25(alias)"], 1e-9)
+}
+
+func TestBM25OrderByScore(t *testing.T) {
+	query := `
+		{
+			var(func: uid(0x01)) {
+				friend {
+					s as bm25(alias, "john alice")
+				}
+			}
+
+			me(func: uid(s), orderdesc: val(s)) {
+				alias
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"alias":"John Alice"},{"alias":"Zambo Alice"},
+		{"alias":"John Oliver"}]}}`, js)
+}
+
+func TestBM25NotIndexed(t *testing.T) {
+	query := `
+		{
+			me(func: uid(0x01)) {
+				bm25(name, "Michonne")
+			}
+		}
+	`
+	_, err := processToFastJson(t, query)
+	require.Error(t, err)
+}
+
 // ensure, that old and deprecated form is not allowed
 func TestCheckPasswordParseError(t *testing.T) {
 	query := `
@@ -1778,3 +1947,68 @@ func TestFilterRegex16(t *testing.T) {
 		`{"data": {"me":[{"name@ru":"Артём Ткаченко"}]}}`,
 		js)
 }
+
+func TestFilterDatePart(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @filter(eq(month(dob), 1)) {
+					name
+				}
+				late: friend @filter(ge(day(dob), 10)) {
+					name
+				}
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"name":"Rick Grimes"},{"name":"Daryl Dixon"},{"name":"Andrea"}],"late":[{"name":"Daryl Dixon"},{"name":"Andrea"}]}]}}`,
+		js)
+}
+
+func TestFilterDatePartAtRoot(t *testing.T) {
+	query := `
+		{
+			me(func: eq(month(dob), 1)) {
+				name
+			}
+		}
+	`
+	_, err := processToFastJson(t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "can only be used in a filter")
+}
+
+func TestGroupByDatePart(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(month(dob)) {
+					count(uid)
+				}
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"@groupby":[{"month(dob)":5,"count":1},{"month(dob)":1,"count":3}]}]}]}}`,
+		js)
+}
+
+func TestMathDatePart(t *testing.T) {
+	query := `
+		{
+			me(func: uid(23, 24)) {
+				name
+				d as dob
+				year: math(year(d))
+				nextWeek: math(d + 604800)
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Rick Grimes","dob":"1910-01-02T00:00:00Z","year":1910,"nextWeek":"1910-01-09T00:00:00Z"},{"name":"Glenn Rhee","dob":"1909-05-05T00:00:00Z","year":1909,"nextWeek":"1909-05-12T00:00:00Z"}]}}`,
+		js)
+}
@@ -130,6 +130,44 @@ func TestOrderFacets(t *testing.T) {
 		js)
 }
 
+func TestOrderFacetsInArgs(t *testing.T) {
+	populateGraphWithFacets(t)
+	defer teardownGraphWithFacets(t)
+	// The facet ordered by isn't returned unless asked for.
+	query := `
+		{
+			me(func: uid(1)) {
+				friend (orderdesc: facet(since)) {
+					name
+				}
+			}
+		}
+	`
+
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data":{"me":[{"friend":[{"name":"Daryl Dixon"},{"name":"Rick Grimes"},`+
+			`{"name":"Andrea"},{"name":"Glenn Rhee"}]}]}}`,
+		js)
+
+	// Rick Grimes and Andrea are friends since the same date, so they're ordered by name.
+	query = `
+		{
+			me(func: uid(1)) {
+				friend (orderdesc: facet(since), orderasc: name, first: 3) {
+					name
+				}
+			}
+		}
+	`
+
+	js = processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data":{"me":[{"friend":[{"name":"Daryl Dixon"},{"name":"Andrea"},`+
+			`{"name":"Rick Grimes"}]}]}}`,
+		js)
+}
+
 func TestOrderdescFacets(t *testing.T) {
 	populateGraphWithFacets(t)
 	defer teardownGraphWithFacets(t)
@@ -804,10 +842,9 @@ func TestFacetsFilterAllofAndanyofterms(t *testing.T) {
 		js)
 }
 
-func TestFacetsFilterAtValueFail(t *testing.T) {
+func TestFacetsFilterAtValue(t *testing.T) {
 	populateGraphWithFacets(t)
 	defer teardownGraphWithFacets(t)
-	// facet filtering is not supported at value level.
 	query := `
 	{
 		me(func: uid(1)) {
@@ -818,8 +855,48 @@ func TestFacetsFilterAtValueFail(t *testing.T) {
 	}
 `
 
-	_, err := processToFastJson(t, query)
-	require.Error(t, err)
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data":{"me":[{"friend":[{"name":"Rick Grimes"},{"name":"Glenn Rhee"}]}]}}`,
+		js)
+}
+
+func TestFacetsFilterAndOrderAtListValue(t *testing.T) {
+	populateGraphWithFacets(t)
+	defer teardownGraphWithFacets(t)
+	addEdgeToValue(t, "occupations", 1, "doctor", map[string]string{"since": "2010"})
+	addEdgeToValue(t, "occupations", 1, "lawyer", map[string]string{"since": "2004"})
+	addEdgeToValue(t, "occupations", 1, "pilot", nil)
+	defer func() {
+		delEdgeToLangValue(t, "occupations", 1, "doctor", "")
+		delEdgeToLangValue(t, "occupations", 1, "lawyer", "")
+		delEdgeToLangValue(t, "occupations", 1, "pilot", "")
+	}()
+
+	query := `
+	{
+		me(func: uid(1)) {
+			occupations @facets(orderasc: since)
+		}
+	}
+`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data":{"me":[{"occupations|since":{"0":2004,"1":2010},`+
+			`"occupations":["lawyer","doctor","pilot"]}]}}`,
+		js)
+
+	query = `
+	{
+		me(func: uid(1)) {
+			occupations @facets(lt(since, 2005)) @facets(since)
+		}
+	}
+`
+	js = processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data":{"me":[{"occupations|since":{"0":2004},"occupations":["lawyer"]}]}}`,
+		js)
 }
 
 func TestFacetsFilterAndRetrieval(t *testing.T) {
@@ -23,6 +23,7 @@ import (
 	"math"
 
 	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -30,6 +31,9 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 	// Note: Key format is - "attr|fromUID|toUID"
 	reachMap := make(map[string]struct{})
 	allowLoop := start.Params.RecurseArgs.AllowLoop
+	report := start.Params.RecurseArgs.Report
+	// The nodes matching the until filter, whose edges aren't followed.
+	stopped := make(map[uint64]struct{})
 	var numEdges uint64
 	var exec []*SubGraph
 	var err error
@@ -50,6 +54,9 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 	case <-ctx.Done():
 		return ctx.Err()
 	}
+	if err = start.stopAt(ctx, start.DestUIDs, stopped); err != nil {
+		return err
+	}
 
 	// Add children back and expand if necessary
 	if exec, err = expandChildren(ctx, start, startChildren); err != nil {
@@ -64,6 +71,22 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 		}
 		depth++
 
+		// Edges with a depth of their own aren't followed any deeper than that.
+		var run, cut []*SubGraph
+		for _, sg := range exec {
+			if sg.Params.ExploreDepth > 0 && depth >= sg.Params.ExploreDepth {
+				cut = append(cut, sg)
+				continue
+			}
+			run = append(run, sg)
+		}
+		if report {
+			if err = markDepthTruncated(ctx, cut, reachMap, stopped); err != nil {
+				return err
+			}
+		}
+		exec = run
+
 		rrch := make(chan error, len(exec))
 		for _, sg := range exec {
 			go ProcessGraph(ctx, sg, dummy, rrch)
@@ -89,6 +112,7 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 			return recurseErr
 		}
 
+		var dest []*pb.List
 		for _, sg := range exec {
 			if len(sg.Filters) > 0 {
 				// We need to do this in case we had some filters.
@@ -96,15 +120,22 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 			}
 
 			for mIdx, fromUID := range sg.SrcUIDs.Uids {
+				if _, ok := stopped[fromUID]; ok {
+					sg.uidMatrix[mIdx] = &pb.List{}
+					continue
+				}
 				if allowLoop {
 					for _, ul := range sg.uidMatrix {
 						numEdges += uint64(len(ul.Uids))
 					}
 				} else {
 					algo.ApplyFilter(sg.uidMatrix[mIdx], func(uid uint64, i int) bool {
-						key := fmt.Sprintf("%s|%d|%d", sg.Attr, fromUID, uid)
+						key := recurseKey(sg.Attr, fromUID, uid)
 						_, seen := reachMap[key] // Combine fromUID here.
 						if seen {
+							if report {
+								sg.markTruncated(fromUID, "loop")
+							}
 							return false
 						} else {
 							// Mark this edge as taken. We'd disallow this edge later.
@@ -114,6 +145,10 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 						}
 					})
 				}
+				if report && depth >= maxDepth && len(sg.uidMatrix[mIdx].Uids) > 0 {
+					// The nodes at the other end of these edges won't be expanded.
+					sg.markTruncated(fromUID, "depth")
+				}
 			}
 			if len(sg.Params.Order) > 0 || len(sg.Params.FacetOrder) > 0 {
 				// Can't use merge sort if the UIDs are not sorted.
@@ -121,6 +156,10 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 			} else {
 				sg.DestUIDs = algo.MergeSorted(sg.uidMatrix)
 			}
+			dest = append(dest, sg.DestUIDs)
+		}
+		if err = start.stopAt(ctx, algo.MergeSorted(dest), stopped); err != nil {
+			return err
 		}
 
 		// modify the exec and attach child nodes.
@@ -148,6 +187,108 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 	}
 }
 
+func recurseKey(attr string, fromUID, toUID uint64) string {
+	return fmt.Sprintf("%s|%d|%d", attr, fromUID, toUID)
+}
+
+// stopAt adds the uids matching the until filter of @recurse to stopped.
+func (start *SubGraph) stopAt(ctx context.Context, uids *pb.List,
+	stopped map[uint64]struct{}) error {
+	if start.Params.recurseUntil == nil || len(uids.GetUids()) == 0 {
+		return nil
+	}
+	until := new(SubGraph)
+	until.copyFiltersRecurse(start.Params.recurseUntil)
+	// The filter is run the same way as the filters at the root, on a node without any attribute.
+	sg := &SubGraph{
+		Params:  params{ParentVars: start.Params.ParentVars},
+		SrcUIDs: uids,
+		Filters: []*SubGraph{until},
+	}
+	rch := make(chan error, 1)
+	ProcessGraph(ctx, sg, start, rch)
+	if err := <-rch; err != nil {
+		return err
+	}
+	for _, uid := range sg.DestUIDs.Uids {
+		stopped[uid] = struct{}{}
+	}
+	return nil
+}
+
+// markDepthTruncated looks one level further down the edges which aren't followed because of
+// their own depth, and marks the nodes having any of them. If all of them were already taken,
+// the node is marked as a loop instead.
+func markDepthTruncated(ctx context.Context, exec []*SubGraph, reachMap map[string]struct{},
+	stopped map[uint64]struct{}) error {
+	if len(exec) == 0 {
+		return nil
+	}
+
+	dummy := &SubGraph{}
+	probes := make([]*SubGraph, len(exec))
+	rch := make(chan error, len(exec))
+	for i, sg := range exec {
+		probes[i] = new(SubGraph)
+		probes[i].copyFiltersRecurse(sg)
+		go ProcessGraph(ctx, probes[i], dummy, rch)
+	}
+	var probeErr error
+	for range exec {
+		if err := <-rch; err != nil && probeErr == nil {
+			probeErr = err
+		}
+	}
+	if probeErr != nil {
+		return probeErr
+	}
+
+	for i, sg := range exec {
+		probe := probes[i]
+		if len(probe.Filters) > 0 {
+			probe.updateUidMatrix()
+		}
+		for mIdx, fromUID := range sg.SrcUIDs.Uids {
+			if _, ok := stopped[fromUID]; ok || mIdx >= len(probe.uidMatrix) {
+				continue
+			}
+			reason := ""
+			for _, uid := range probe.uidMatrix[mIdx].Uids {
+				if _, seen := reachMap[recurseKey(sg.Attr, fromUID, uid)]; !seen {
+					reason = "depth"
+					break
+				}
+				reason = "loop"
+			}
+			if reason != "" {
+				sg.markTruncated(fromUID, reason)
+			}
+		}
+	}
+	return nil
+}
+
+// markTruncated records why the edges of uid weren't followed any further, unless it's known
+// already.
+func (sg *SubGraph) markTruncated(uid uint64, reason string) {
+	if sg.truncated == nil {
+		sg.truncated = make(map[uint64]string)
+	}
+	if _, ok := sg.truncated[uid]; !ok {
+		sg.truncated[uid] = reason
+	}
+}
+
+// truncatedBy returns why @recurse didn't follow the edges of uid any further, if it didn't.
+func (sg *SubGraph) truncatedBy(uid uint64) string {
+	for _, pc := range sg.Children {
+		if reason, ok := pc.truncated[uid]; ok {
+			return reason
+		}
+	}
+	return ""
+}
+
 // expandChildren adds child nodes to a SubGraph with no children, expanding them if necessary.
 func expandChildren(ctx context.Context, sg *SubGraph, children []*SubGraph) ([]*SubGraph, error) {
 	if len(sg.Children) > 0 {
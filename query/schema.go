@@ -0,0 +1,100 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// planningFields are the schema fields used to plan the execution of a query.
+var planningFields = []string{"type", "index", "tokenizer", "reverse", "count", "list", "lang"}
+
+// SchemaForQuery parses the query and returns the schema of just the predicates it refers
+// to, with the fields needed to plan its execution.
+func SchemaForQuery(ctx context.Context, req gql.Request) ([]*pb.SchemaNode, error) {
+	res, err := gql.Parse(req)
+	if err != nil {
+		return nil, x.Wrapf(err, "while parsing query to fetch its schema")
+	}
+	preds := QueryPredicates(res.Query)
+	if len(preds) == 0 {
+		// An empty list of predicates would get us the whole schema.
+		return []*pb.SchemaNode{}, nil
+	}
+	return worker.GetSchemaOverNetwork(ctx, &pb.SchemaRequest{
+		Predicates: preds,
+		Fields:     planningFields,
+	})
+}
+
+// QueryPredicates returns the predicates referred to by the given query blocks, be it as
+// children, in functions, filters, sorting or grouping.
+func QueryPredicates(gqs []*gql.GraphQuery) []string {
+	seen := make(map[string]struct{})
+	var preds []string
+	add := func(attr string) {
+		attr = strings.TrimPrefix(attr, "~")
+		if attr == "" || attr == "uid" || attr == "val" {
+			return
+		}
+		if _, ok := seen[attr]; !ok {
+			seen[attr] = struct{}{}
+			preds = append(preds, attr)
+		}
+	}
+	var addFilter func(ft *gql.FilterTree)
+	addFilter = func(ft *gql.FilterTree) {
+		if ft == nil {
+			return
+		}
+		if ft.Func != nil {
+			add(ft.Func.Attr)
+		}
+		for _, child := range ft.Child {
+			addFilter(child)
+		}
+	}
+	var walk func(gq *gql.GraphQuery)
+	walk = func(gq *gql.GraphQuery) {
+		if !gq.IsInternal && gq.Expand == "" {
+			add(gq.Attr)
+		}
+		if gq.Func != nil {
+			add(gq.Func.Attr)
+		}
+		addFilter(gq.Filter)
+		for _, order := range gq.Order {
+			add(order.Attr)
+		}
+		for _, attr := range gq.GroupbyAttrs {
+			add(attr.Attr)
+		}
+		for _, child := range gq.Children {
+			walk(child)
+		}
+	}
+	for _, gq := range gqs {
+		walk(gq)
+	}
+	return preds
+}
@@ -285,6 +285,10 @@ func KShortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	if maxHops == 0 {
 		maxHops = int(math.MaxInt32)
 	}
+	maxWeight := sg.Params.MaxWeight
+	if maxWeight <= 0 {
+		maxWeight = math.MaxFloat64
+	}
 	next := make(chan bool, 2)
 	//cycles := 0
 	expandErr := make(chan error, 2)
@@ -341,6 +345,10 @@ func KShortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 		neighbours := adjacencyMap[item.uid]
 		for toUid, info := range neighbours {
 			cost := info.cost
+			if item.cost+cost > maxWeight {
+				// The paths through this edge are all too heavy.
+				continue
+			}
 			curPath := pathPool.Get().([]pathInfo)
 			if cap(curPath) < len(item.path.route)+1 {
 				// We can't use it due to insufficient capacity. Put it back.
@@ -443,6 +451,10 @@ func ShortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	if maxHops == 0 {
 		maxHops = int(math.MaxInt32)
 	}
+	maxWeight := sg.Params.MaxWeight
+	if maxWeight <= 0 {
+		maxWeight = math.MaxFloat64
+	}
 	next := make(chan bool, 2)
 	expandErr := make(chan error, 2)
 	adjacencyMap := make(map[uint64]map[uint64]mapItem)
@@ -494,6 +506,10 @@ func ShortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 				neighbours := adjacencyMap[item.uid]
 				for toUid, info := range neighbours {
 					cost := info.cost
+					if item.cost+cost > maxWeight {
+						// The paths through this edge are all too heavy.
+						continue
+					}
 					d, ok := dist[toUid]
 					if ok && d.cost <= item.cost+cost {
 						continue
@@ -0,0 +1,514 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package raftwal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/coreos/etcd/raft"
+	pb "github.com/coreos/etcd/raft/raftpb"
+	"github.com/golang/glog"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	// segmentSize is the size a segment is rotated at.
+	segmentSize = 64 << 20
+	segmentExt  = ".wal"
+
+	recordEntry     byte = 1
+	recordHardState byte = 2
+
+	// The header of a record is its type, the length and the CRC of its payload, and the index
+	// and term of the entry, if it's one.
+	headerSize = 1 + 4 + 4 + 8 + 8
+
+	// maxRecordSize bounds the length read from the header of a record, so that a corrupted one
+	// doesn't make us allocate an arbitrary amount of memory.
+	maxRecordSize = 1 << 30
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// segment is a file of the log.
+type segment struct {
+	f    *os.File
+	seq  uint64
+	size int64
+}
+
+// entryPos locates the payload of an entry in the log.
+type entryPos struct {
+	index  uint64
+	term   uint64
+	seg    *segment
+	offset int64
+	size   int64
+}
+
+// entryLog is the append-only log of the Raft entries and hard states, split into segments of
+// about segmentSize. The records are only ever appended, except for the entries overwritten by
+// a new leader, which are truncated from the end of the log. The segments holding only entries
+// compacted into a snapshot are deleted. Each segment starts with the hard state at the time
+// it was created, so that it's never lost with the segments before it.
+//
+// The index and term of all the entries are kept in memory, their data is read from the
+// segments when needed.
+type entryLog struct {
+	sync.RWMutex
+	dir      string
+	segments []*segment
+	entries  []entryPos // Contiguous, sorted by index.
+	hs       pb.HardState
+
+	// The records appended to the last segment since the last sync.
+	buf []byte
+}
+
+func segmentPath(dir string, seq uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%016x%s", seq, segmentExt))
+}
+
+// openLog opens the log in dir, creating it if needed. A record only partially written at the
+// end of the log, by a crash while it was appended, is truncated.
+func openLog(dir string) (*entryLog, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var seqs []uint64
+	for _, info := range infos {
+		name := info.Name()
+		if !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		var seq uint64
+		if _, err := fmt.Sscanf(strings.TrimSuffix(name, segmentExt), "%x", &seq); err != nil {
+			return nil, x.Wrapf(err, "While parsing the name of WAL segment %s", name)
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	l := &entryLog{dir: dir}
+	for i, seq := range seqs {
+		f, err := os.OpenFile(segmentPath(dir, seq), os.O_RDWR, 0600)
+		if err != nil {
+			return nil, err
+		}
+		s := &segment{f: f, seq: seq}
+		l.segments = append(l.segments, s)
+		if err := l.load(s, i == len(seqs)-1); err != nil {
+			l.close()
+			return nil, x.Wrapf(err, "While reading WAL segment %s", f.Name())
+		}
+	}
+	return l, nil
+}
+
+// load reads the records of the segment. A torn record is only allowed at the end of the last
+// segment, where it's truncated.
+func (l *entryLog) load(s *segment, last bool) error {
+	r := bufio.NewReaderSize(s.f, 1<<20)
+	header := make([]byte, headerSize)
+	var offset int64
+	for {
+		typ, index, term, payload, err := readRecord(r, header)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if !last {
+				return err
+			}
+			glog.Warningf("Truncating WAL segment %s at offset %d: %v", s.f.Name(), offset, err)
+			if err := s.f.Truncate(offset); err != nil {
+				return err
+			}
+			break
+		}
+		switch typ {
+		case recordEntry:
+			if n := len(l.entries); n > 0 && index > l.entries[n-1].index+1 {
+				return fmt.Errorf("Entry %d found after entry %d", index, l.entries[n-1].index)
+			}
+			l.truncateIndex(index)
+			l.entries = append(l.entries, entryPos{
+				index:  index,
+				term:   term,
+				seg:    s,
+				offset: offset + headerSize,
+				size:   int64(len(payload)),
+			})
+		case recordHardState:
+			if err := l.hs.Unmarshal(payload); err != nil {
+				return err
+			}
+		}
+		offset += headerSize + int64(len(payload))
+	}
+	s.size = offset
+	return nil
+}
+
+func readRecord(r io.Reader, header []byte) (
+	typ byte, index, term uint64, payload []byte, err error) {
+	if _, err = io.ReadFull(r, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = fmt.Errorf("Torn record header")
+		}
+		return
+	}
+	typ = header[0]
+	if typ != recordEntry && typ != recordHardState {
+		err = fmt.Errorf("Invalid record type: %d", typ)
+		return
+	}
+	length := binary.BigEndian.Uint32(header[1:5])
+	crc := binary.BigEndian.Uint32(header[5:9])
+	index = binary.BigEndian.Uint64(header[9:17])
+	term = binary.BigEndian.Uint64(header[17:25])
+	if length > maxRecordSize {
+		err = fmt.Errorf("Invalid record length: %d", length)
+		return
+	}
+	payload = make([]byte, length)
+	if _, err = io.ReadFull(r, payload); err != nil {
+		err = fmt.Errorf("Torn record: %v", err)
+		return
+	}
+	if crc32.Checksum(payload, crcTable) != crc {
+		err = fmt.Errorf("Checksum mismatch of the record of entry %d", index)
+	}
+	return
+}
+
+// truncateIndex drops the entries from index on from memory.
+func (l *entryLog) truncateIndex(index uint64) {
+	if len(l.entries) == 0 || index > l.entries[len(l.entries)-1].index {
+		return
+	}
+	if index <= l.entries[0].index {
+		l.entries = l.entries[:0]
+		return
+	}
+	l.entries = l.entries[:index-l.entries[0].index]
+}
+
+func (l *entryLog) close() error {
+	var rerr error
+	for _, s := range l.segments {
+		if err := s.f.Close(); err != nil && rerr == nil {
+			rerr = err
+		}
+	}
+	return rerr
+}
+
+// firstIndex returns the index of the first entry, or errNotFound if the log is empty.
+func (l *entryLog) firstIndex() (uint64, error) {
+	l.RLock()
+	defer l.RUnlock()
+	if len(l.entries) == 0 {
+		return 0, errNotFound
+	}
+	return l.entries[0].index, nil
+}
+
+// lastIndex returns the index of the last entry, or errNotFound if the log is empty.
+func (l *entryLog) lastIndex() (uint64, error) {
+	l.RLock()
+	defer l.RUnlock()
+	if len(l.entries) == 0 {
+		return 0, errNotFound
+	}
+	return l.entries[len(l.entries)-1].index, nil
+}
+
+func (l *entryLog) numEntries() int {
+	l.RLock()
+	defer l.RUnlock()
+	return len(l.entries)
+}
+
+func (l *entryLog) hardState() pb.HardState {
+	l.RLock()
+	defer l.RUnlock()
+	return l.hs
+}
+
+// pos returns the position of the entry, if it's in the log.
+func (l *entryLog) pos(index uint64) (entryPos, bool) {
+	if len(l.entries) == 0 || index < l.entries[0].index ||
+		index > l.entries[len(l.entries)-1].index {
+		return entryPos{}, false
+	}
+	return l.entries[index-l.entries[0].index], true
+}
+
+// term returns the term of the entry, if it's in the log.
+func (l *entryLog) term(index uint64) (uint64, bool) {
+	l.RLock()
+	defer l.RUnlock()
+	p, ok := l.pos(index)
+	return p.term, ok
+}
+
+// readEntries returns the entries in [lo, hi), up to maxSize bytes but at least one.
+func (l *entryLog) readEntries(lo, hi, maxSize uint64) ([]pb.Entry, error) {
+	l.RLock()
+	defer l.RUnlock()
+	if len(l.entries) > 0 && lo < l.entries[0].index {
+		lo = l.entries[0].index
+	}
+	var es []pb.Entry
+	var size uint64
+	for index := lo; index < hi; index++ {
+		p, ok := l.pos(index)
+		if !ok {
+			break
+		}
+		size += uint64(p.size)
+		if size > maxSize && len(es) > 0 {
+			break
+		}
+		data := make([]byte, p.size)
+		if _, err := p.seg.f.ReadAt(data, p.offset); err != nil {
+			return nil, x.Wrapf(err, "While reading entry %d", index)
+		}
+		var e pb.Entry
+		if err := e.Unmarshal(data); err != nil {
+			return nil, x.Wrapf(err, "While reading entry %d", index)
+		}
+		es = append(es, e)
+	}
+	return es, nil
+}
+
+// save appends the entries and the hard state, if it's not empty, and syncs them to disk. The
+// entries already in the log from the index of the first one on are overwritten.
+func (l *entryLog) save(hs pb.HardState, es []pb.Entry) error {
+	l.Lock()
+	defer l.Unlock()
+	return l.write(hs, es)
+}
+
+func (l *entryLog) write(hs pb.HardState, es []pb.Entry) error {
+	if len(es) > 0 && len(l.entries) > 0 && es[0].Index <= l.entries[len(l.entries)-1].index {
+		if err := l.truncate(es[0].Index); err != nil {
+			return err
+		}
+		if raft.IsEmptyHardState(hs) {
+			// The last hard state might have been truncated along with the entries.
+			hs = l.hs
+		}
+	}
+	for _, e := range es {
+		data, err := e.Marshal()
+		if err != nil {
+			return x.Wrapf(err, "wal.Append: While marshal entry")
+		}
+		if err := l.append(recordEntry, e.Index, e.Term, data); err != nil {
+			return err
+		}
+	}
+	if !raft.IsEmptyHardState(hs) {
+		data, err := hs.Marshal()
+		if err != nil {
+			return x.Wrapf(err, "wal.Store: While marshal hardstate")
+		}
+		if err := l.append(recordHardState, 0, 0, data); err != nil {
+			return err
+		}
+		l.hs = hs
+	}
+	return l.sync()
+}
+
+// append adds the record to the buffer of the last segment, rotating it if it's full.
+func (l *entryLog) append(typ byte, index, term uint64, data []byte) error {
+	last := l.lastSegment()
+	if last == nil || last.size+int64(len(l.buf)) >= segmentSize {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+		last = l.lastSegment()
+	}
+	if typ == recordEntry {
+		l.entries = append(l.entries, entryPos{
+			index:  index,
+			term:   term,
+			seg:    last,
+			offset: last.size + int64(len(l.buf)) + headerSize,
+			size:   int64(len(data)),
+		})
+	}
+	var header [headerSize]byte
+	header[0] = typ
+	binary.BigEndian.PutUint32(header[1:5], uint32(len(data)))
+	binary.BigEndian.PutUint32(header[5:9], crc32.Checksum(data, crcTable))
+	binary.BigEndian.PutUint64(header[9:17], index)
+	binary.BigEndian.PutUint64(header[17:25], term)
+	l.buf = append(append(l.buf, header[:]...), data...)
+	return nil
+}
+
+func (l *entryLog) lastSegment() *segment {
+	if len(l.segments) == 0 {
+		return nil
+	}
+	return l.segments[len(l.segments)-1]
+}
+
+// sync writes the buffered records to the last segment and syncs it to disk.
+func (l *entryLog) sync() error {
+	last := l.lastSegment()
+	if last == nil || len(l.buf) == 0 {
+		return nil
+	}
+	if _, err := last.f.WriteAt(l.buf, last.size); err != nil {
+		return err
+	}
+	last.size += int64(len(l.buf))
+	l.buf = l.buf[:0]
+	return last.f.Sync()
+}
+
+// rotate starts a new segment, beginning with the current hard state.
+func (l *entryLog) rotate() error {
+	if err := l.sync(); err != nil {
+		return err
+	}
+	var seq uint64
+	if last := l.lastSegment(); last != nil {
+		seq = last.seq + 1
+	}
+	f, err := os.OpenFile(segmentPath(l.dir, seq), os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if err := syncDir(l.dir); err != nil {
+		f.Close()
+		return err
+	}
+	l.segments = append(l.segments, &segment{f: f, seq: seq})
+	if raft.IsEmptyHardState(l.hs) {
+		return nil
+	}
+	data, err := l.hs.Marshal()
+	if err != nil {
+		return err
+	}
+	return l.append(recordHardState, 0, 0, data)
+}
+
+// truncate removes the entries from index on, along with anything written after them.
+func (l *entryLog) truncate(index uint64) error {
+	if err := l.sync(); err != nil {
+		return err
+	}
+	if len(l.entries) > 0 && index < l.entries[0].index {
+		index = l.entries[0].index
+	}
+	p, ok := l.pos(index)
+	if !ok {
+		return nil
+	}
+	for len(l.segments) > 0 && l.lastSegment() != p.seg {
+		if err := l.removeSegment(len(l.segments) - 1); err != nil {
+			return err
+		}
+	}
+	p.seg.size = p.offset - headerSize
+	if err := p.seg.f.Truncate(p.seg.size); err != nil {
+		return err
+	}
+	l.truncateIndex(index)
+	return p.seg.f.Sync()
+}
+
+// compact drops the entries before until, deleting the segments holding none of the entries
+// left.
+func (l *entryLog) compact(until uint64) error {
+	l.Lock()
+	defer l.Unlock()
+	p, ok := l.pos(until)
+	if !ok {
+		return nil
+	}
+	l.entries = l.entries[until-l.entries[0].index:]
+	for len(l.segments) > 0 && l.segments[0] != p.seg {
+		if err := l.removeSegment(0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reset replaces all the entries of the log with es, keeping the hard state.
+func (l *entryLog) reset(es []pb.Entry) error {
+	l.Lock()
+	defer l.Unlock()
+	l.buf = l.buf[:0]
+	for len(l.segments) > 0 {
+		if err := l.removeSegment(len(l.segments) - 1); err != nil {
+			return err
+		}
+	}
+	l.entries = l.entries[:0]
+	// Start a new segment, holding the hard state even if there are no entries.
+	if err := l.rotate(); err != nil {
+		return err
+	}
+	return l.write(pb.HardState{}, es)
+}
+
+func (l *entryLog) removeSegment(i int) error {
+	s := l.segments[i]
+	l.segments = append(l.segments[:i], l.segments[i+1:]...)
+	if err := s.f.Close(); err != nil {
+		return err
+	}
+	return os.Remove(s.f.Name())
+}
+
+// syncDir syncs the directory, so that the files created in it are found after a crash.
+func syncDir(dir string) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = f.Sync()
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -17,10 +17,10 @@
 package raftwal
 
 import (
-	"bytes"
 	"encoding/binary"
 	"errors"
-	"math"
+	"os"
+	"path/filepath"
 	"sync"
 
 	"github.com/coreos/etcd/raft"
@@ -34,27 +34,13 @@ import (
 
 type localCache struct {
 	sync.RWMutex
-	firstIndex uint64
-	snap       pb.Snapshot
-}
-
-func (c *localCache) setFirst(first uint64) {
-	c.Lock()
-	defer c.Unlock()
-	c.firstIndex = first
-}
-
-func (c *localCache) first() uint64 {
-	c.RLock()
-	defer c.RUnlock()
-	return c.firstIndex
+	snap pb.Snapshot
 }
 
 func (c *localCache) setSnapshot(s pb.Snapshot) {
 	c.Lock()
 	defer c.Unlock()
 	c.snap = s
-	c.firstIndex = 0 // Reset firstIndex.
 }
 
 func (c *localCache) snapshot() pb.Snapshot {
@@ -63,8 +49,11 @@ func (c *localCache) snapshot() pb.Snapshot {
 	return c.snap
 }
 
+// DiskStorage keeps the Raft entries and hard state in an append-only log in the WAL dir, and
+// the snapshot and the Raft id in Badger.
 type DiskStorage struct {
 	db   *badger.DB
+	log  *entryLog
 	id   uint64
 	gid  uint32
 	elog trace.EventLog
@@ -72,14 +61,20 @@ type DiskStorage struct {
 	cache localCache
 }
 
-func Init(db *badger.DB, id uint64, gid uint32) *DiskStorage {
+// LogDir is the directory of the log of the entries, in the WAL dir.
+const LogDir = "raftlog"
+
+func Init(db *badger.DB, dir string, id uint64, gid uint32) *DiskStorage {
 	w := &DiskStorage{db: db, id: id, gid: gid}
 	x.Check(w.StoreRaftId(id))
 	w.elog = trace.NewEventLog("Badger", "RaftStorage")
+	x.Checkf(w.openLog(dir), "Error while opening the Raft log")
 
 	snap, err := w.Snapshot()
 	x.Check(err)
 	if !raft.IsEmptySnap(snap) {
+		// The log might still hold entries before the snapshot, found in the same segment.
+		x.Check(w.deleteUntil(snap.Metadata.Index))
 		return w
 	}
 
@@ -93,6 +88,149 @@ func Init(db *badger.DB, id uint64, gid uint32) *DiskStorage {
 	return w
 }
 
+// openLog opens the log of the entries, moving the entries and the hard state stored in Badger
+// by the previous versions into it first.
+func (w *DiskStorage) openLog(dir string) error {
+	path := filepath.Join(dir, LogDir)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if err := w.migrate(path); err != nil {
+			return x.Wrapf(err, "While moving the Raft entries out of Badger")
+		}
+	} else if err != nil {
+		return err
+	}
+	var err error
+	if w.log, err = openLog(path); err != nil {
+		return err
+	}
+	// The log now holds all the entries, drop the copies left in Badger.
+	batch := w.db.NewWriteBatch()
+	defer batch.Cancel()
+	if err := w.deleteFrom(batch, 0); err != nil {
+		return err
+	}
+	if err := batch.Delete(w.hardStateKey()); err != nil {
+		return err
+	}
+	return batch.Flush()
+}
+
+// migrate writes the entries and the hard state stored in Badger to a new log at path. The log
+// is built aside and renamed once complete, so that a crash never leaves it half written.
+func (w *DiskStorage) migrate(path string) error {
+	tmp := path + ".tmp"
+	if err := os.RemoveAll(tmp); err != nil {
+		return err
+	}
+	l, err := openLog(tmp)
+	if err != nil {
+		return err
+	}
+	var hs pb.HardState
+	var count int
+	err = w.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(w.hardStateKey())
+		if err == nil {
+			err = item.Value(func(val []byte) error {
+				return hs.Unmarshal(val)
+			})
+		}
+		if err != nil && err != badger.ErrKeyNotFound {
+			return err
+		}
+
+		opt := badger.DefaultIteratorOptions
+		opt.Prefix = w.entryPrefix()
+		itr := txn.NewIterator(opt)
+		defer itr.Close()
+
+		var es []pb.Entry
+		for itr.Seek(w.entryKey(0)); itr.Valid(); itr.Next() {
+			var e pb.Entry
+			if err := itr.Item().Value(func(val []byte) error {
+				return e.Unmarshal(val)
+			}); err != nil {
+				return err
+			}
+			es = append(es, e)
+			count++
+			if len(es) == 1000 {
+				if err := l.save(pb.HardState{}, es); err != nil {
+					return err
+				}
+				es = es[:0]
+			}
+		}
+		return l.save(hs, es)
+	})
+	if cerr := l.close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+	if count > 0 {
+		glog.Infof("Moved %d Raft entries of group %d out of Badger into %s", count, w.gid, path)
+	}
+	return syncDir(filepath.Dir(path))
+}
+
+// Upgrade moves the entries and the hard state stored in Badger by the previous versions into the
+// log in the WAL dir, as Init does, without starting the Raft node stored in Badger.
+func Upgrade(db *badger.DB, dir string) error {
+	id, err := RaftId(db)
+	if err != nil {
+		return err
+	}
+	gid, err := storedGroup(db, id)
+	if err != nil {
+		return err
+	}
+	w := &DiskStorage{db: db, id: id, gid: gid}
+	if err := w.openLog(dir); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// storedGroup returns the group of the Raft node stored in Badger, which follows its id in the
+// keys of its entries, hard state and snapshot.
+func storedGroup(db *badger.DB, id uint64) (uint32, error) {
+	var gid uint32
+	err := db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchValues = false
+		opt.Prefix = make([]byte, 8)
+		binary.BigEndian.PutUint64(opt.Prefix, id)
+		itr := txn.NewIterator(opt)
+		defer itr.Close()
+
+		for itr.Seek(opt.Prefix); itr.Valid(); itr.Next() {
+			key := itr.Item().Key()
+			switch len(key) {
+			case 14:
+				// The hard state and the snapshot.
+				gid = binary.BigEndian.Uint32(key[10:14])
+				return nil
+			case 20:
+				// The entries.
+				gid = binary.BigEndian.Uint32(key[8:12])
+				return nil
+			}
+		}
+		return nil
+	})
+	return gid, err
+}
+
+// Close closes the log of the entries.
+func (w *DiskStorage) Close() error {
+	return w.log.close()
+}
+
 var idKey = []byte("raftid")
 
 func RaftId(db *badger.DB) (uint64, error) {
@@ -137,11 +275,6 @@ func (w *DiskStorage) entryKey(idx uint64) []byte {
 	return b
 }
 
-func (w *DiskStorage) parseIndex(key []byte) uint64 {
-	x.AssertTrue(len(key) == 20)
-	return binary.BigEndian.Uint64(key[12:20])
-}
-
 func (w *DiskStorage) entryPrefix() []byte {
 	b := make([]byte, 12)
 	binary.BigEndian.PutUint64(b[0:8], w.id)
@@ -171,109 +304,41 @@ func (w *DiskStorage) Term(idx uint64) (uint64, error) {
 	if idx < first-1 {
 		return 0, raft.ErrCompacted
 	}
-
-	var e pb.Entry
-	if _, err := w.seekEntry(&e, idx, false); err == errNotFound {
+	term, ok := w.log.term(idx)
+	if !ok {
 		return 0, raft.ErrUnavailable
-	} else if err != nil {
-		return 0, err
-	}
-	if idx < e.Index {
-		return 0, raft.ErrCompacted
 	}
-	return e.Term, nil
+	return term, nil
 }
 
 var errNotFound = errors.New("Unable to find raft entry")
 
-func (w *DiskStorage) seekEntry(e *pb.Entry, seekTo uint64, reverse bool) (uint64, error) {
-	var index uint64
-	err := w.db.View(func(txn *badger.Txn) error {
-		opt := badger.DefaultIteratorOptions
-		opt.PrefetchValues = false
-		opt.Prefix = w.entryPrefix()
-		opt.Reverse = reverse
-		itr := txn.NewIterator(opt)
-		defer itr.Close()
-
-		itr.Seek(w.entryKey(seekTo))
-		if !itr.Valid() {
-			return errNotFound
-		}
-		item := itr.Item()
-		index = w.parseIndex(item.Key())
-		if e == nil {
-			return nil
-		}
-		return item.Value(func(val []byte) error {
-			return e.Unmarshal(val)
-		})
-	})
-	return index, err
-}
-
 // FirstIndex returns the index of the first log entry that is
 // possibly available via Entries (older entries have been incorporated
 // into the latest Snapshot).
 func (w *DiskStorage) FirstIndex() (uint64, error) {
-	snap := w.cache.snapshot()
-	if !raft.IsEmptySnap(snap) {
-		return snap.Metadata.Index + 1, nil
-	}
-	if first := w.cache.first(); first > 0 {
-		return first, nil
-	}
-	index, err := w.seekEntry(nil, 0, false)
-	if err == nil {
-		glog.V(2).Infof("Setting first index: %d", index+1)
-		w.cache.setFirst(index + 1)
-	} else if glog.V(2) {
-		glog.Errorf("While seekEntry. Error: %v", err)
-	}
+	index, err := w.log.firstIndex()
 	return index + 1, err
 }
 
 // LastIndex returns the index of the last entry in the log.
 func (w *DiskStorage) LastIndex() (uint64, error) {
-	return w.seekEntry(nil, math.MaxUint64, true)
+	return w.log.lastIndex()
 }
 
 // Delete all entries from [0, until), i.e. excluding until.
 // Keep the entry at the snapshot index, for simplification of logic.
 // It is the application's responsibility to not attempt to deleteUntil an index
 // greater than raftLog.applied.
-func (w *DiskStorage) deleteUntil(batch *badger.WriteBatch, until uint64) error {
-	var keys []string
-	err := w.db.View(func(txn *badger.Txn) error {
-		opt := badger.DefaultIteratorOptions
-		opt.PrefetchValues = false
-		opt.Prefix = w.entryPrefix()
-		itr := txn.NewIterator(opt)
-		defer itr.Close()
-
-		start := w.entryKey(0)
-		first := true
-		var index uint64
-		for itr.Seek(start); itr.Valid(); itr.Next() {
-			item := itr.Item()
-			index = w.parseIndex(item.Key())
-			if first {
-				first = false
-				if until <= index {
-					return raft.ErrCompacted
-				}
-			}
-			if index >= until {
-				break
-			}
-			keys = append(keys, string(item.Key()))
-		}
-		return nil
-	})
+func (w *DiskStorage) deleteUntil(until uint64) error {
+	first, err := w.log.firstIndex()
 	if err != nil {
 		return err
 	}
-	return w.deleteKeys(batch, keys)
+	if until <= first {
+		return raft.ErrCompacted
+	}
+	return w.log.compact(until)
 }
 
 // Snapshot returns the most recent snapshot.
@@ -301,8 +366,9 @@ func (w *DiskStorage) Snapshot() (snap pb.Snapshot, rerr error) {
 
 // setSnapshot would store the snapshot. We can delete all the entries up until the snapshot
 // index. But, keep the raft entry at the snapshot index, to make it easier to build the logic; like
-// the dummy entry in MemoryStorage.
-func (w *DiskStorage) setSnapshot(batch *badger.WriteBatch, s pb.Snapshot) error {
+// the dummy entry in MemoryStorage. The entries after it are kept only if the log matches the
+// snapshot.
+func (w *DiskStorage) setSnapshot(s pb.Snapshot) error {
 	if raft.IsEmptySnap(s) {
 		return nil
 	}
@@ -310,57 +376,23 @@ func (w *DiskStorage) setSnapshot(batch *badger.WriteBatch, s pb.Snapshot) error
 	if err != nil {
 		return x.Wrapf(err, "wal.Store: While marshal snapshot")
 	}
-	if err := batch.Set(w.snapshotKey(), data, 0); err != nil {
+	if err := w.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(w.snapshotKey(), data)
+	}); err != nil {
 		return err
 	}
-
-	e := pb.Entry{Term: s.Metadata.Term, Index: s.Metadata.Index}
-	data, err = e.Marshal()
-	if err != nil {
-		return err
-	}
-	if err := batch.Set(w.entryKey(e.Index), data, 0); err != nil {
-		return err
-	}
-
 	// Cache it.
 	w.cache.setSnapshot(s)
-	return nil
-}
 
-// SetHardState saves the current HardState.
-func (w *DiskStorage) setHardState(batch *badger.WriteBatch, st pb.HardState) error {
-	if raft.IsEmptyHardState(st) {
-		return nil
-	}
-	data, err := st.Marshal()
-	if err != nil {
-		return x.Wrapf(err, "wal.Store: While marshal hardstate")
+	if term, ok := w.log.term(s.Metadata.Index); ok && term == s.Metadata.Term {
+		return w.log.compact(s.Metadata.Index)
 	}
-	return batch.Set(w.hardStateKey(), data, 0)
+	return w.reset([]pb.Entry{{Term: s.Metadata.Term, Index: s.Metadata.Index}})
 }
 
-// reset resets the entries. Used for testing.
+// reset resets the entries.
 func (w *DiskStorage) reset(es []pb.Entry) error {
-	// Clean out the state.
-	batch := w.db.NewWriteBatch()
-	defer batch.Cancel()
-
-	if err := w.deleteFrom(batch, 0); err != nil {
-		return err
-	}
-
-	for _, e := range es {
-		data, err := e.Marshal()
-		if err != nil {
-			return x.Wrapf(err, "wal.Store: While marshal entry")
-		}
-		k := w.entryKey(e.Index)
-		if err := batch.Set(k, data, 0); err != nil {
-			return err
-		}
-	}
-	return batch.Flush()
+	return w.log.reset(es)
 }
 
 func (w *DiskStorage) deleteKeys(batch *badger.WriteBatch, keys []string) error {
@@ -376,7 +408,7 @@ func (w *DiskStorage) deleteKeys(batch *badger.WriteBatch, keys []string) error
 	return nil
 }
 
-// Delete entries in the range of index [from, inf).
+// Delete the entries stored in Badger in the range of index [from, inf).
 func (w *DiskStorage) deleteFrom(batch *badger.WriteBatch, from uint64) error {
 	var keys []string
 	err := w.db.View(func(txn *badger.Txn) error {
@@ -402,19 +434,7 @@ func (w *DiskStorage) deleteFrom(batch *badger.WriteBatch, from uint64) error {
 func (w *DiskStorage) HardState() (hd pb.HardState, rerr error) {
 	w.elog.Printf("HardState")
 	defer w.elog.Printf("Done")
-	err := w.db.View(func(txn *badger.Txn) error {
-		item, err := txn.Get(w.hardStateKey())
-		if err != nil {
-			return err
-		}
-		return item.Value(func(val []byte) error {
-			return hd.Unmarshal(val)
-		})
-	})
-	if err == badger.ErrKeyNotFound {
-		return hd, nil
-	}
-	return hd, err
+	return w.log.hardState(), nil
 }
 
 // InitialState returns the saved HardState and ConfState information.
@@ -434,75 +454,11 @@ func (w *DiskStorage) InitialState() (hs pb.HardState, cs pb.ConfState, err erro
 }
 
 func (w *DiskStorage) NumEntries() (int, error) {
-	var count int
-	err := w.db.View(func(txn *badger.Txn) error {
-		opt := badger.DefaultIteratorOptions
-		opt.PrefetchValues = false
-		opt.Prefix = w.entryPrefix()
-		itr := txn.NewIterator(opt)
-		defer itr.Close()
-
-		start := w.entryKey(0)
-		for itr.Seek(start); itr.Valid(); itr.Next() {
-			count++
-		}
-		return nil
-	})
-	return count, err
+	return w.log.numEntries(), nil
 }
 
 func (w *DiskStorage) allEntries(lo, hi, maxSize uint64) (es []pb.Entry, rerr error) {
-	err := w.db.View(func(txn *badger.Txn) error {
-		if hi-lo == 1 { // We only need one entry.
-			item, err := txn.Get(w.entryKey(lo))
-			if err != nil {
-				return err
-			}
-			return item.Value(func(val []byte) error {
-				var e pb.Entry
-				if err = e.Unmarshal(val); err != nil {
-					return err
-				}
-				es = append(es, e)
-				return nil
-			})
-		}
-
-		iopt := badger.DefaultIteratorOptions
-		iopt.Prefix = w.entryPrefix()
-		itr := txn.NewIterator(iopt)
-		defer itr.Close()
-
-		start := w.entryKey(lo)
-		end := w.entryKey(hi) // Not included in results.
-
-		var size, lastIndex uint64
-		first := true
-		for itr.Seek(start); itr.Valid(); itr.Next() {
-			item := itr.Item()
-			var e pb.Entry
-			if err := item.Value(func(val []byte) error {
-				return e.Unmarshal(val)
-			}); err != nil {
-				return err
-			}
-			// If this Assert does not fail, then we can safely remove that strange append fix
-			// below.
-			x.AssertTrue(e.Index > lastIndex && e.Index >= lo)
-			lastIndex = e.Index
-			if bytes.Compare(item.Key(), end) >= 0 {
-				break
-			}
-			size += uint64(e.Size())
-			if size > maxSize && !first {
-				break
-			}
-			es = append(es, e)
-			first = false
-		}
-		return nil
-	})
-	return es, err
+	return w.log.readEntries(lo, hi, maxSize)
 }
 
 // Entries returns a slice of log entries in the range [lo,hi).
@@ -541,91 +497,72 @@ func (w *DiskStorage) CreateSnapshot(i uint64, cs *pb.ConfState, data []byte) er
 		return raft.ErrSnapOutOfDate
 	}
 
-	var e pb.Entry
-	if _, err := w.seekEntry(&e, i, false); err != nil {
-		return err
-	}
-	if e.Index != i {
+	term, ok := w.log.term(i)
+	if !ok {
 		return errNotFound
 	}
 
 	var snap pb.Snapshot
 	snap.Metadata.Index = i
-	snap.Metadata.Term = e.Term
+	snap.Metadata.Term = term
 	x.AssertTrue(cs != nil)
 	snap.Metadata.ConfState = *cs
 	snap.Data = data
 
-	batch := w.db.NewWriteBatch()
-	defer batch.Cancel()
-	if err := w.setSnapshot(batch, snap); err != nil {
-		return err
-	}
-	if err := w.deleteUntil(batch, snap.Metadata.Index); err != nil {
-		return err
-	}
-	return batch.Flush()
+	return w.setSnapshot(snap)
 }
 
 // Save would write Entries, HardState and Snapshot to persistent storage in order, i.e. Entries
-// first, then HardState and Snapshot if they are not empty. If persistent storage supports atomic
-// writes then all of them can be written together. Note that when writing an Entry with Index i,
-// any previously-persisted entries with Index >= i must be discarded.
+// first, then HardState and Snapshot if they are not empty. Note that when writing an Entry with
+// Index i, any previously-persisted entries with Index >= i must be discarded.
+//
+// The snapshot is written first here, as it decides which of the entries already in the log are
+// kept. The entries and the HardState are then appended to the log, and synced to disk at once.
 func (w *DiskStorage) Save(h pb.HardState, es []pb.Entry, snap pb.Snapshot) error {
-	batch := w.db.NewWriteBatch()
-	defer batch.Cancel()
-
-	if err := w.addEntries(batch, es); err != nil {
+	if err := w.setSnapshot(snap); err != nil {
 		return err
 	}
-	if err := w.setHardState(batch, h); err != nil {
+	es, err := w.uncompacted(es)
+	if err != nil {
 		return err
 	}
-	if err := w.setSnapshot(batch, snap); err != nil {
+	return w.log.save(h, es)
+}
+
+// Append the new entries to storage.
+func (w *DiskStorage) addEntries(entries []pb.Entry) error {
+	entries, err := w.uncompacted(entries)
+	if err != nil {
 		return err
 	}
-	return batch.Flush()
+	return w.log.save(pb.HardState{}, entries)
 }
 
-// Append the new entries to storage.
-func (w *DiskStorage) addEntries(batch *badger.WriteBatch, entries []pb.Entry) error {
+// uncompacted drops the entries already compacted from the new entries.
+func (w *DiskStorage) uncompacted(entries []pb.Entry) ([]pb.Entry, error) {
 	if len(entries) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	first, err := w.FirstIndex()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	firste := entries[0].Index
 	if firste+uint64(len(entries))-1 < first {
 		// All of these entries have already been compacted.
-		return nil
+		return nil, nil
 	}
 	if first > firste {
 		// Truncate compacted entries
 		entries = entries[first-firste:]
+		firste = first
 	}
 
 	last, err := w.LastIndex()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	x.AssertTruef(firste <= last+1, "firste: %d. last: %d", firste, last)
-
-	for _, e := range entries {
-		k := w.entryKey(e.Index)
-		data, err := e.Marshal()
-		if err != nil {
-			return x.Wrapf(err, "wal.Append: While marshal entry")
-		}
-		if err := batch.Set(k, data, 0); err != nil {
-			return err
-		}
-	}
-	laste := entries[len(entries)-1].Index
-	if laste < last {
-		return w.deleteFrom(batch, laste+1)
-	}
-	return nil
+	return entries, nil
 }
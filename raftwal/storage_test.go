@@ -36,6 +36,7 @@ import (
 	"io/ioutil"
 	"math"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -60,7 +61,7 @@ func TestStorageTerm(t *testing.T) {
 
 	db, err := openBadger(dir)
 	require.NoError(t, err)
-	ds := Init(db, 0, 0)
+	ds := Init(db, dir, 0, 0)
 
 	ents := []pb.Entry{{Index: 3, Term: 3}, {Index: 4, Term: 4}, {Index: 5, Term: 5}}
 	tests := []struct {
@@ -110,7 +111,7 @@ func TestStorageEntries(t *testing.T) {
 
 	db, err := openBadger(dir)
 	require.NoError(t, err)
-	ds := Init(db, 0, 0)
+	ds := Init(db, dir, 0, 0)
 
 	ents := []pb.Entry{{Index: 3, Term: 3}, {Index: 4, Term: 4}, {Index: 5, Term: 5}, {Index: 6, Term: 6}}
 	tests := []struct {
@@ -155,7 +156,7 @@ func TestStorageLastIndex(t *testing.T) {
 
 	db, err := openBadger(dir)
 	require.NoError(t, err)
-	ds := Init(db, 0, 0)
+	ds := Init(db, dir, 0, 0)
 
 	ents := []pb.Entry{{Index: 3, Term: 3}, {Index: 4, Term: 4}, {Index: 5, Term: 5}}
 	require.NoError(t, ds.reset(ents))
@@ -185,7 +186,7 @@ func TestStorageFirstIndex(t *testing.T) {
 
 	db, err := openBadger(dir)
 	require.NoError(t, err)
-	ds := Init(db, 0, 0)
+	ds := Init(db, dir, 0, 0)
 
 	ents := []pb.Entry{{Index: 3, Term: 3}, {Index: 4, Term: 4}, {Index: 5, Term: 5}}
 	require.NoError(t, ds.reset(ents))
@@ -198,10 +199,7 @@ func TestStorageFirstIndex(t *testing.T) {
 		t.Errorf("first = %d, want %d", first, 4)
 	}
 
-	batch := db.NewWriteBatch()
-	require.NoError(t, ds.deleteUntil(batch, 4))
-	require.NoError(t, batch.Flush())
-	ds.cache.firstIndex = 0
+	require.NoError(t, ds.deleteUntil(4))
 	first, err = ds.FirstIndex()
 	if err != nil {
 		t.Errorf("err = %v, want nil", err)
@@ -218,7 +216,7 @@ func TestStorageCompact(t *testing.T) {
 
 	db, err := openBadger(dir)
 	require.NoError(t, err)
-	ds := Init(db, 0, 0)
+	ds := Init(db, dir, 0, 0)
 
 	ents := []pb.Entry{{Index: 3, Term: 3}, {Index: 4, Term: 4}, {Index: 5, Term: 5}}
 	require.NoError(t, ds.reset(ents))
@@ -238,13 +236,10 @@ func TestStorageCompact(t *testing.T) {
 	}
 
 	for i, tt := range tests {
-		batch := db.NewWriteBatch()
-		err := ds.deleteUntil(batch, tt.i)
-		require.NoError(t, batch.Flush())
+		err := ds.deleteUntil(tt.i)
 		if err != tt.werr {
 			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
 		}
-		ds.cache.firstIndex = 0
 		index, err := ds.FirstIndex()
 		require.NoError(t, err)
 		// Do the minus one here to get the index of the snapshot.
@@ -267,7 +262,7 @@ func TestStorageCreateSnapshot(t *testing.T) {
 
 	db, err := openBadger(dir)
 	require.NoError(t, err)
-	ds := Init(db, 0, 0)
+	ds := Init(db, dir, 0, 0)
 
 	ents := []pb.Entry{{Index: 3, Term: 3}, {Index: 4, Term: 4}, {Index: 5, Term: 5}}
 	cs := &pb.ConfState{Nodes: []uint64{1, 2, 3}}
@@ -304,7 +299,7 @@ func TestStorageAppend(t *testing.T) {
 
 	db, err := openBadger(dir)
 	require.NoError(t, err)
-	ds := Init(db, 0, 0)
+	ds := Init(db, dir, 0, 0)
 
 	ents := []pb.Entry{{Index: 3, Term: 3}, {Index: 4, Term: 4}, {Index: 5, Term: 5}}
 	tests := []struct {
@@ -350,12 +345,10 @@ func TestStorageAppend(t *testing.T) {
 
 	for i, tt := range tests {
 		require.NoError(t, ds.reset(ents))
-		batch := db.NewWriteBatch()
-		err := ds.addEntries(batch, tt.entries)
+		err := ds.addEntries(tt.entries)
 		if err != tt.werr {
 			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
 		}
-		require.NoError(t, batch.Flush())
 		all, err := ds.allEntries(0, math.MaxUint64, math.MaxUint64)
 		require.NoError(t, err)
 		if !reflect.DeepEqual(all, tt.wentries) {
@@ -363,3 +356,132 @@ func TestStorageAppend(t *testing.T) {
 		}
 	}
 }
+
+func TestStorageReopen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := openBadger(dir)
+	require.NoError(t, err)
+	ds := Init(db, dir, 1, 1)
+
+	hs := pb.HardState{Term: 2, Vote: 1, Commit: 4}
+	ents := []pb.Entry{{Index: 1, Term: 1}, {Index: 2, Term: 1}, {Index: 3, Term: 2},
+		{Index: 4, Term: 2, Data: []byte("data")}}
+	require.NoError(t, ds.Save(hs, ents, pb.Snapshot{}))
+	// Overwrite the last entry, as done by a new leader.
+	require.NoError(t, ds.Save(pb.HardState{}, []pb.Entry{{Index: 4, Term: 3}}, pb.Snapshot{}))
+	require.NoError(t, ds.addEntries([]pb.Entry{{Index: 5, Term: 3}}))
+	cs := &pb.ConfState{Nodes: []uint64{1}}
+	require.NoError(t, ds.CreateSnapshot(2, cs, nil))
+	require.NoError(t, ds.Close())
+
+	// Tear the record of the last entry, as if we crashed while it was appended.
+	path := segmentPath(filepath.Join(dir, LogDir), 0)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-1))
+
+	ds = Init(db, dir, 1, 1)
+	defer ds.Close()
+	first, err := ds.FirstIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), first)
+	last, err := ds.LastIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), last)
+	rhs, err := ds.HardState()
+	require.NoError(t, err)
+	require.Equal(t, hs, rhs)
+	snap, err := ds.Snapshot()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), snap.Metadata.Index)
+
+	// The entries are appended where the torn one was.
+	require.NoError(t, ds.addEntries([]pb.Entry{{Index: 5, Term: 4}}))
+	all, err := ds.allEntries(0, math.MaxUint64, math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, []pb.Entry{{Index: 2, Term: 1}, {Index: 3, Term: 2}, {Index: 4, Term: 3},
+		{Index: 5, Term: 4}}, all)
+}
+
+func TestStorageMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := openBadger(dir)
+	require.NoError(t, err)
+
+	// Write the entries and the hard state the way the previous versions did, in Badger.
+	w := &DiskStorage{db: db, id: 1, gid: 1}
+	hs := pb.HardState{Term: 2, Vote: 1, Commit: 3}
+	ents := []pb.Entry{{Index: 0, Term: 0}, {Index: 1, Term: 1}, {Index: 2, Term: 2},
+		{Index: 3, Term: 2, Data: []byte("data")}}
+	require.NoError(t, db.Update(func(txn *badger.Txn) error {
+		for _, e := range ents {
+			data, err := e.Marshal()
+			require.NoError(t, err)
+			require.NoError(t, txn.Set(w.entryKey(e.Index), data))
+		}
+		data, err := hs.Marshal()
+		require.NoError(t, err)
+		return txn.Set(w.hardStateKey(), data)
+	}))
+
+	ds := Init(db, dir, 1, 1)
+	defer ds.Close()
+	all, err := ds.allEntries(0, math.MaxUint64, math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, ents, all)
+	rhs, err := ds.HardState()
+	require.NoError(t, err)
+	require.Equal(t, hs, rhs)
+
+	// Nothing is left in Badger.
+	require.NoError(t, db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.Prefix = w.entryPrefix()
+		itr := txn.NewIterator(opt)
+		defer itr.Close()
+		itr.Rewind()
+		require.False(t, itr.Valid())
+		_, err := txn.Get(w.hardStateKey())
+		require.Equal(t, badger.ErrKeyNotFound, err)
+		return nil
+	}))
+}
+
+func TestStorageUpgrade(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := openBadger(dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Write the entries the way the previous versions did, for a node of group 2.
+	w := &DiskStorage{db: db, id: 1, gid: 2}
+	require.NoError(t, w.StoreRaftId(1))
+	ents := []pb.Entry{{Index: 0, Term: 0}, {Index: 1, Term: 1}, {Index: 2, Term: 1}}
+	require.NoError(t, db.Update(func(txn *badger.Txn) error {
+		for _, e := range ents {
+			data, err := e.Marshal()
+			require.NoError(t, err)
+			require.NoError(t, txn.Set(w.entryKey(e.Index), data))
+		}
+		return nil
+	}))
+
+	require.NoError(t, Upgrade(db, dir))
+	_, err = os.Stat(filepath.Join(dir, LogDir))
+	require.NoError(t, err)
+
+	ds := Init(db, dir, 1, 2)
+	defer ds.Close()
+	all, err := ds.allEntries(0, math.MaxUint64, math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, ents, all)
+}
@@ -34,14 +34,17 @@ func ParseBytes(s []byte, gid uint32) (rerr error) {
 		reset()
 	}
 	pstate.DeleteAll()
-	updates, err := Parse(string(s))
+	result, err := ParseWithTypes(string(s))
 	if err != nil {
 		return err
 	}
 
-	for _, update := range updates {
+	for _, update := range result.Schemas {
 		State().Set(update.Predicate, *update)
 	}
+	for _, typ := range result.Types {
+		State().SetType(typ.TypeName, *typ)
+	}
 	State().Set("_predicate_", pb.SchemaUpdate{
 		ValueType: pb.Posting_STRING,
 		List:      true,
@@ -72,6 +75,8 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 		schema.Count = true
 	case "upsert":
 		schema.Upsert = true
+	case "unique":
+		schema.Unique = true
 	case "lang":
 		if t != types.StringID || schema.List {
 			return x.Errorf("@lang directive can only be specified for string type."+
@@ -299,32 +304,111 @@ func resolveTokenizers(updates []*pb.SchemaUpdate) error {
 	return nil
 }
 
-// Parse parses a schema string and returns the schema representation for it.
-func Parse(s string) ([]*pb.SchemaUpdate, error) {
-	var schemas []*pb.SchemaUpdate
+// ParsedSchema holds the predicates and the object types declared by a schema.
+type ParsedSchema struct {
+	Schemas []*pb.SchemaUpdate
+	Types   []*pb.TypeUpdate
+}
+
+// parseTypeDeclaration parses the declaration of an object type, which lists the predicates
+// a node of the type is expected to have, e.g. type Person { name age friend }.
+func parseTypeDeclaration(it *lex.ItemIterator) (*pb.TypeUpdate, error) {
+	// The type keyword was already consumed, the name of the type comes next.
+	it.Next()
+	name := it.Item()
+	if name.Typ != itemText {
+		return nil, x.Errorf("Missing name of the type")
+	}
+	it.Next()
+	if it.Item().Typ != itemLeftCurl {
+		return nil, x.Errorf("Expected { after the name of type %s", name.Val)
+	}
+
+	typ := &pb.TypeUpdate{TypeName: name.Val}
+	seen := make(map[string]struct{})
+	for it.Next() {
+		item := it.Item()
+		switch item.Typ {
+		case itemRightCurl:
+			if len(typ.Fields) == 0 {
+				return nil, x.Errorf("Type %s doesn't declare any field", typ.TypeName)
+			}
+			return typ, nil
+
+		case itemText:
+			if strings.Contains(item.Val, "@") {
+				return nil, x.Errorf("Invalid '@' in field %s of type %s", item.Val,
+					typ.TypeName)
+			}
+			if _, ok := seen[item.Val]; ok {
+				return nil, x.Errorf("Field %s declared more than once in type %s", item.Val,
+					typ.TypeName)
+			}
+			seen[item.Val] = struct{}{}
+			typ.Fields = append(typ.Fields, item.Val)
+
+		case itemNewLine, itemComma:
+			// Fields can be separated by new lines, commas or spaces.
+
+		case lex.ItemError:
+			return nil, x.Errorf(item.Val)
+
+		default:
+			return nil, x.Errorf("Unexpected token: %v while parsing type %s", item,
+				typ.TypeName)
+		}
+	}
+	return nil, x.Errorf("Unclosed { while parsing type %s", typ.TypeName)
+}
+
+// isTypeDeclaration returns whether the item starts the declaration of an object type, rather
+// than the schema of a predicate named type.
+func isTypeDeclaration(item lex.Item, it *lex.ItemIterator) bool {
+	if item.Typ != itemText || item.Val != "type" {
+		return false
+	}
+	next, err := it.Peek(2)
+	return err == nil && next[0].Typ == itemText && next[1].Typ == itemLeftCurl
+}
+
+// ParseWithTypes parses the schema, which can declare object types along with the predicates.
+func ParseWithTypes(s string) (*ParsedSchema, error) {
+	var result ParsedSchema
 	l := lex.Lexer{Input: s}
 	l.Run(lexText)
 	it := l.NewIterator()
 	for it.Next() {
 		item := it.Item()
-		switch item.Typ {
-		case lex.ItemEOF:
-			if err := resolveTokenizers(schemas); err != nil {
+		switch {
+		case item.Typ == lex.ItemEOF:
+			if err := resolveTokenizers(result.Schemas); err != nil {
 				return nil, x.Wrapf(err, "failed to enrich schema")
 			}
-			return schemas, nil
+			return &result, nil
 
-		case itemText:
+		case isTypeDeclaration(item, it):
+			typ, err := parseTypeDeclaration(it)
+			if err != nil {
+				return nil, err
+			}
+			for _, prev := range result.Types {
+				if prev.TypeName == typ.TypeName {
+					return nil, x.Errorf("Type %s declared more than once", typ.TypeName)
+				}
+			}
+			result.Types = append(result.Types, typ)
+
+		case item.Typ == itemText:
 			schema, err := parseScalarPair(it, item.Val)
 			if err != nil {
 				return nil, err
 			}
-			schemas = append(schemas, schema)
+			result.Schemas = append(result.Schemas, schema)
 
-		case lex.ItemError:
+		case item.Typ == lex.ItemError:
 			return nil, x.Errorf(item.Val)
 
-		case itemNewLine:
+		case item.Typ == itemNewLine:
 			// pass empty line
 
 		default:
@@ -333,3 +417,13 @@ func Parse(s string) ([]*pb.SchemaUpdate, error) {
 	}
 	return nil, x.Errorf("Shouldn't reach here")
 }
+
+// Parse parses the schema of the predicates. The object types it declares, if any, are
+// ignored; ParseWithTypes returns them.
+func Parse(s string) ([]*pb.SchemaUpdate, error) {
+	result, err := ParseWithTypes(s)
+	if err != nil {
+		return nil, err
+	}
+	return result.Schemas, nil
+}
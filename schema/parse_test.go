@@ -376,3 +376,67 @@ func TestParseUpsert(t *testing.T) {
 	`)
 	require.NoError(t, err)
 }
+
+func TestParseUnique(t *testing.T) {
+	reset()
+	result, err := Parse("email: string @index(exact) @unique .")
+	require.NoError(t, err)
+	require.True(t, result[0].Unique)
+}
+
+func TestParseTypes(t *testing.T) {
+	reset()
+	result, err := ParseWithTypes(`
+		name: string @index(exact) .
+		type: string .
+		type Person {
+			name
+			age, friend
+		}
+		type Animal { name owner }
+	`)
+	require.NoError(t, err)
+	require.Len(t, result.Schemas, 2)
+	require.Equal(t, "type", result.Schemas[1].Predicate)
+	require.Equal(t, []*pb.TypeUpdate{
+		{TypeName: "Person", Fields: []string{"name", "age", "friend"}},
+		{TypeName: "Animal", Fields: []string{"name", "owner"}},
+	}, result.Types)
+
+	// Parse only returns the schema of the predicates.
+	updates, err := Parse(`type Person { name }`)
+	require.NoError(t, err)
+	require.Len(t, updates, 0)
+}
+
+func TestParseTypesError(t *testing.T) {
+	reset()
+	for _, s := range []string{
+		`type Person { }`,
+		`type Person { name name }`,
+		`type Person { name`,
+		`type Person { name: string . }`,
+		`type Person { name@en }`,
+		`type Person { name } type Person { age }`,
+	} {
+		_, err := ParseWithTypes(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestLoadTypesFromDb(t *testing.T) {
+	reset()
+	txn := ps.NewTransactionAt(1, true)
+	typ := pb.TypeUpdate{TypeName: "Person", Fields: []string{"name", "age"}}
+	data, err := typ.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, txn.Set(x.TypeDefKey("Person"), data))
+	require.NoError(t, txn.CommitAt(1, nil))
+
+	require.NoError(t, LoadFromDb())
+	loaded, ok := State().GetType("Person")
+	require.True(t, ok)
+	require.Equal(t, typ, loaded)
+	_, ok = State().Get("Person")
+	require.False(t, ok)
+}
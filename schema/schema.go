@@ -36,16 +36,52 @@ var (
 	pstore *badger.DB
 )
 
+const (
+	// watchBufferSize is the number of changed predicates that can be queued for a watcher
+	// before it's considered to be lagging behind.
+	watchBufferSize = 1000
+	// maxChanges is the number of schema changes remembered to serve ChangesSince.
+	maxChanges = 10000
+	// changeBits is the number of low bits of a version telling apart the changes made by the
+	// same Raft proposal, the index of which makes up the high bits.
+	changeBits = 16
+)
+
 func (s *state) init() {
 	s.predicate = make(map[string]*pb.SchemaUpdate)
+	s.types = make(map[string]*pb.TypeUpdate)
 	s.elog = trace.NewEventLog("Dgraph", "Schema")
+	s.watchers = make(map[uint64]chan string)
 }
 
 type state struct {
 	sync.RWMutex
 	// Map containing predicate to type information.
 	predicate map[string]*pb.SchemaUpdate
-	elog      trace.EventLog
+	// Map containing the object types declared, by name.
+	types map[string]*pb.TypeUpdate
+	elog  trace.EventLog
+
+	// watchers are notified of the predicates whose schema is set or deleted.
+	watchMu     sync.Mutex
+	watchers    map[uint64]chan string
+	nextWatchId uint64
+
+	// version is the version of the last change made to the schema, and changes the most
+	// recent changes in the order they were made. forgotten is the version of the most
+	// recent change dropped from changes. index is the Raft index of the proposal being
+	// applied, see Applying.
+	version   uint64
+	index     uint64
+	changes   []change
+	forgotten uint64
+}
+
+// change records the schema a predicate had before it was changed.
+type change struct {
+	version uint64
+	pred    string
+	prev    *pb.SchemaUpdate // nil if the predicate had no schema.
 }
 
 // SateFor returns the schema for given group
@@ -61,9 +97,12 @@ func (s *state) DeleteAll() {
 		// We set schema for _predicate_, hence it shouldn't be deleted.
 		_, isInitialPred := x.InitialPreds[pred]
 		if !isInitialPred {
+			s.recordChange(pred)
 			delete(s.predicate, pred)
+			s.notify(pred)
 		}
 	}
+	s.types = make(map[string]*pb.TypeUpdate)
 }
 
 // Delete updates the schema in memory and disk
@@ -72,7 +111,9 @@ func (s *state) Delete(attr string) error {
 	defer s.Unlock()
 
 	glog.Infof("Deleting schema for predicate: [%s]", attr)
+	s.recordChange(attr)
 	delete(s.predicate, attr)
+	s.notify(attr)
 	txn := pstore.NewTransactionAt(1, true)
 	if err := txn.Delete(x.SchemaKey(attr)); err != nil {
 		return err
@@ -96,8 +137,123 @@ func logUpdate(schema pb.SchemaUpdate, pred string) string {
 func (s *state) Set(pred string, schema pb.SchemaUpdate) {
 	s.Lock()
 	defer s.Unlock()
+	s.recordChange(pred)
 	s.predicate[pred] = &schema
 	s.elog.Printf(logUpdate(schema, pred))
+	s.notify(pred)
+}
+
+// Applying sets the Raft index of the proposal being applied by the group. The versions of the
+// schema changes it makes are derived from it, so that they're the same on every server of the
+// group. The changes made before the first proposal applied since the server started, e.g. when
+// loading the schema from disk, aren't remembered as such.
+func (s *state) Applying(index uint64) {
+	s.Lock()
+	defer s.Unlock()
+	if s.index == 0 && index<<changeBits > s.forgotten {
+		s.forgotten = index<<changeBits - 1
+	}
+	s.index = index
+}
+
+// recordChange must be called with the write lock held, before the schema of the predicate
+// gets changed. The first change made by a proposal gets the Raft index of the proposal as
+// version, shifted by changeBits, and the next ones follow in the order they are made. Versions
+// are kept strictly increasing.
+func (s *state) recordChange(pred string) {
+	s.version++
+	if base := s.index << changeBits; base > s.version {
+		s.version = base
+	}
+	if len(s.changes) == maxChanges {
+		oldest := s.changes[0]
+		s.forgotten = oldest.version
+		s.changes = s.changes[1:]
+	}
+	s.changes = append(s.changes, change{
+		version: s.version,
+		pred:    pred,
+		prev:    s.predicate[pred],
+	})
+}
+
+// ChangeCount returns how many times the schema of the predicate was altered, as stored with
+// its schema.
+func (s *state) ChangeCount(pred string) uint64 {
+	s.RLock()
+	defer s.RUnlock()
+	if schema, ok := s.predicate[pred]; ok {
+		return schema.AlterCount
+	}
+	return 0
+}
+
+// Version returns the version of the last change made to the schema.
+func (s *state) Version() uint64 {
+	s.RLock()
+	defer s.RUnlock()
+	return s.version
+}
+
+// ChangesSince returns the predicates whose schema changed at or after the given version,
+// mapped to the schema they had before the first of those changes, or nil if they didn't
+// have any. ok is false if some of the changes made since are no longer remembered.
+func (s *state) ChangesSince(version uint64) (prev map[string]*pb.SchemaUpdate, ok bool) {
+	s.RLock()
+	defer s.RUnlock()
+	prev = make(map[string]*pb.SchemaUpdate)
+	for i := len(s.changes) - 1; i >= 0 && s.changes[i].version >= version; i-- {
+		// Going from the most recent change backwards, so the oldest one is kept.
+		prev[s.changes[i].pred] = s.changes[i].prev
+	}
+	return prev, version > s.forgotten
+}
+
+// Watch returns the predicates currently present in the schema, along with a channel on
+// which the name of every predicate whose schema is set or deleted afterwards is sent. The
+// list and the registration are done under the same read lock, so no change can happen in
+// between and be missed. The channel is closed if the watcher falls too far behind, after
+// which the caller must start over with a new Watch. cancel must be called once the caller
+// is no longer interested in the changes.
+func (s *state) Watch() (preds []string, changes <-chan string, cancel func()) {
+	s.RLock()
+	defer s.RUnlock()
+	for pred := range s.predicate {
+		preds = append(preds, pred)
+	}
+
+	ch := make(chan string, watchBufferSize)
+	s.watchMu.Lock()
+	id := s.nextWatchId
+	s.nextWatchId++
+	s.watchers[id] = ch
+	s.watchMu.Unlock()
+
+	cancel = func() {
+		s.watchMu.Lock()
+		defer s.watchMu.Unlock()
+		if ch, ok := s.watchers[id]; ok {
+			delete(s.watchers, id)
+			close(ch)
+		}
+	}
+	return preds, ch, cancel
+}
+
+// notify must be called with the write lock held, so that watchers get the changes in the
+// order they were applied.
+func (s *state) notify(pred string) {
+	s.watchMu.Lock()
+	defer s.watchMu.Unlock()
+	for id, ch := range s.watchers {
+		select {
+		case ch <- pred:
+		default:
+			glog.Warningf("Schema watcher %d is lagging behind. Closing it.", id)
+			delete(s.watchers, id)
+			close(ch)
+		}
+	}
 }
 
 // Get gets the schema for given predicate
@@ -111,6 +267,37 @@ func (s *state) Get(pred string) (pb.SchemaUpdate, bool) {
 	return *schema, true
 }
 
+// SetType sets the declaration of the object type in memory. Like the schema of predicates,
+// type declarations must flow through the update function to get synced to db.
+func (s *state) SetType(typeName string, typ pb.TypeUpdate) {
+	s.Lock()
+	defer s.Unlock()
+	s.types[typeName] = &typ
+	s.elog.Printf("Setting type %s: %v", typeName, typ.Fields)
+}
+
+// GetType returns the declaration of the object type.
+func (s *state) GetType(typeName string) (pb.TypeUpdate, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	typ, has := s.types[typeName]
+	if !has {
+		return pb.TypeUpdate{}, false
+	}
+	return *typ, true
+}
+
+// Types returns the names of the object types declared.
+func (s *state) Types() []string {
+	s.RLock()
+	defer s.RUnlock()
+	var out []string
+	for k := range s.types {
+		out = append(out, k)
+	}
+	return out
+}
+
 // TypeOf returns the schema type of predicate
 func (s *state) TypeOf(pred string) (types.TypeID, error) {
 	s.RLock()
@@ -170,6 +357,21 @@ func (s *state) Tokenizer(pred string) []tok.Tokenizer {
 	return tokenizers
 }
 
+// CheckTokenizers returns an error if the schema uses a tokenizer which isn't loaded on this
+// server, e.g. a custom tokenizer whose plugin wasn't given in --custom_tokenizers. Every
+// server applying or loading a schema checks it, since the server parsing the schema may have
+// plugins the others don't.
+func CheckTokenizers(s *pb.SchemaUpdate) error {
+	for _, name := range s.Tokenizer {
+		if _, found := tok.GetTokenizer(name); !found {
+			return x.Errorf("Tokenizer %s of predicate %s isn't loaded on this server."+
+				" Custom tokenizers have to be loaded on every server with --custom_tokenizers.",
+				name, s.Predicate)
+		}
+	}
+	return nil
+}
+
 // TokenizerNames returns the tokenizer names for given predicate
 func (s *state) TokenizerNames(pred string) []string {
 	var names []string
@@ -219,6 +421,16 @@ func (s *state) HasUpsert(pred string) bool {
 	return false
 }
 
+// HasUnique returns whether no two uids can have the same value of the predicate.
+func (s *state) HasUnique(pred string) bool {
+	s.RLock()
+	defer s.RUnlock()
+	if schema, ok := s.predicate[pred]; ok {
+		return schema.Unique
+	}
+	return false
+}
+
 func (s *state) HasLang(pred string) bool {
 	s.RLock()
 	defer s.RUnlock()
@@ -234,18 +446,37 @@ func Init(ps *badger.DB) {
 }
 
 func Load(predicate string) error {
+	_, err := load(predicate)
+	return err
+}
+
+// Reload reads the schema of the predicate from disk, replacing the one held in memory. Unlike
+// Load, the schema held in memory is dropped if there's none on disk.
+func Reload(predicate string) error {
+	found, err := load(predicate)
+	if err != nil || found {
+		return err
+	}
+	if _, ok := State().Get(predicate); !ok {
+		return nil
+	}
+	return State().Delete(predicate)
+}
+
+// load reads the schema of the predicate from disk into memory, returning whether there was one.
+func load(predicate string) (bool, error) {
 	if len(predicate) == 0 {
-		return x.Errorf("Empty predicate")
+		return false, x.Errorf("Empty predicate")
 	}
 	key := x.SchemaKey(predicate)
 	txn := pstore.NewTransactionAt(1, false)
 	defer txn.Discard()
 	item, err := txn.Get(key)
 	if err == badger.ErrKeyNotFound {
-		return nil
+		return false, nil
 	}
 	if err != nil {
-		return err
+		return false, err
 	}
 	var s pb.SchemaUpdate
 	err = item.Value(func(val []byte) error {
@@ -253,12 +484,15 @@ func Load(predicate string) error {
 		return nil
 	})
 	if err != nil {
-		return err
+		return false, err
+	}
+	if err := CheckTokenizers(&s); err != nil {
+		return false, err
 	}
 	State().Set(predicate, s)
 	State().elog.Printf(logUpdate(s, predicate))
 	glog.Infoln(logUpdate(s, predicate))
-	return nil
+	return true, nil
 }
 
 // LoadFromDb reads schema information from db and stores it in memory
@@ -286,6 +520,9 @@ func LoadFromDb() error {
 				s = pb.SchemaUpdate{Predicate: attr, ValueType: pb.Posting_DEFAULT}
 			}
 			x.Checkf(s.Unmarshal(val), "Error while loading schema from db")
+			if err := CheckTokenizers(&s); err != nil {
+				return err
+			}
 			State().Set(attr, s)
 			return nil
 		})
@@ -293,6 +530,34 @@ func LoadFromDb() error {
 			return err
 		}
 	}
+	return loadTypesFromDb(txn)
+}
+
+// loadTypesFromDb reads the declarations of the object types from db into memory.
+func loadTypesFromDb(txn *badger.Txn) error {
+	prefix := x.TypeDefPrefix()
+	itr := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer itr.Close()
+
+	for itr.Seek(prefix); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		key := item.Key()
+		if !bytes.HasPrefix(key, prefix) {
+			break
+		}
+		pk := x.Parse(key)
+		if pk == nil {
+			continue
+		}
+		var typ pb.TypeUpdate
+		err := item.Value(func(val []byte) error {
+			return typ.Unmarshal(val)
+		})
+		if err != nil {
+			return x.Wrapf(err, "while loading type %s from db", pk.Attr)
+		}
+		State().SetType(pk.Attr, typ)
+	}
 	return nil
 }
 
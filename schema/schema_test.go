@@ -0,0 +1,133 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestWatch(t *testing.T) {
+	reset()
+	State().Set("name", pb.SchemaUpdate{ValueType: pb.Posting_STRING})
+
+	preds, changes, cancel := State().Watch()
+	defer cancel()
+	require.Equal(t, []string{"name"}, preds)
+
+	State().Set("age", pb.SchemaUpdate{ValueType: pb.Posting_INT})
+	require.NoError(t, State().Delete("name"))
+	require.Equal(t, "age", <-changes)
+	require.Equal(t, "name", <-changes)
+}
+
+func TestWatchLagging(t *testing.T) {
+	reset()
+	_, changes, cancel := State().Watch()
+	defer cancel()
+
+	for i := 0; i <= watchBufferSize; i++ {
+		State().Set("name", pb.SchemaUpdate{ValueType: pb.Posting_STRING})
+	}
+	for i := 0; i < watchBufferSize; i++ {
+		<-changes
+	}
+	_, ok := <-changes
+	require.False(t, ok)
+}
+
+func TestChangesSince(t *testing.T) {
+	reset()
+	State().Set("name", pb.SchemaUpdate{ValueType: pb.Posting_STRING})
+	version := State().Version()
+
+	State().Set("age", pb.SchemaUpdate{ValueType: pb.Posting_INT})
+	State().Set("name", pb.SchemaUpdate{ValueType: pb.Posting_STRING, Tokenizer: []string{"term"}})
+	State().Set("name", pb.SchemaUpdate{ValueType: pb.Posting_STRING, Count: true})
+	require.True(t, State().Version() > version)
+
+	prev, ok := State().ChangesSince(version + 1)
+	require.True(t, ok)
+	require.Len(t, prev, 2)
+	require.Nil(t, prev["age"])
+	require.Equal(t, &pb.SchemaUpdate{ValueType: pb.Posting_STRING}, prev["name"])
+
+	prev, ok = State().ChangesSince(State().Version() + 1)
+	require.True(t, ok)
+	require.Empty(t, prev)
+}
+
+func TestVersionFromIndex(t *testing.T) {
+	reset()
+	// Loaded from disk before any proposal is applied.
+	State().Set("name", pb.SchemaUpdate{ValueType: pb.Posting_STRING})
+	loaded := State().Version()
+
+	State().Applying(7)
+	State().Set("age", pb.SchemaUpdate{ValueType: pb.Posting_INT})
+	State().Set("name", pb.SchemaUpdate{ValueType: pb.Posting_STRING, Count: true})
+	require.Equal(t, uint64(7<<changeBits+1), State().Version())
+	State().Applying(9)
+	State().Set("age", pb.SchemaUpdate{ValueType: pb.Posting_FLOAT})
+	require.Equal(t, uint64(9<<changeBits), State().Version())
+
+	prev, ok := State().ChangesSince(7<<changeBits + 1)
+	require.True(t, ok)
+	require.Equal(t, map[string]*pb.SchemaUpdate{
+		"age":  {ValueType: pb.Posting_INT},
+		"name": {ValueType: pb.Posting_STRING},
+	}, prev)
+	// The changes made before the first proposal applied aren't known to be all there.
+	_, ok = State().ChangesSince(loaded)
+	require.False(t, ok)
+}
+
+func TestChangeCount(t *testing.T) {
+	reset()
+	State().Set("name", pb.SchemaUpdate{ValueType: pb.Posting_STRING, AlterCount: 2})
+	State().Set("age", pb.SchemaUpdate{ValueType: pb.Posting_INT})
+	require.Equal(t, uint64(2), State().ChangeCount("name"))
+	require.Zero(t, State().ChangeCount("age"))
+	require.Zero(t, State().ChangeCount("friend"))
+}
+
+func TestReload(t *testing.T) {
+	reset()
+	// Only held in memory, so reloading it drops it.
+	State().Set("stale", pb.SchemaUpdate{ValueType: pb.Posting_STRING})
+	require.NoError(t, Reload("stale"))
+	_, ok := State().Get("stale")
+	require.False(t, ok)
+	require.NoError(t, Reload("stale"))
+
+	update := pb.SchemaUpdate{Predicate: "fresh", ValueType: pb.Posting_INT}
+	data, err := update.Marshal()
+	require.NoError(t, err)
+	txn := ps.NewTransactionAt(1, true)
+	require.NoError(t, txn.Set(x.SchemaKey("fresh"), data))
+	require.NoError(t, txn.CommitAt(1, nil))
+
+	State().Set("fresh", pb.SchemaUpdate{ValueType: pb.Posting_STRING})
+	require.NoError(t, Reload("fresh"))
+	got, ok := State().Get("fresh")
+	require.True(t, ok)
+	require.Equal(t, pb.Posting_INT, got.ValueType)
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// tmpSuffix is appended to the name of the files being written, they're renamed once complete.
+const tmpSuffix = ".tmp"
+
+// fileStorage stores the files under a directory of a local or shared filesystem.
+type fileStorage struct {
+	dir string
+}
+
+func newFileStorage(dir string) (*fileStorage, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, x.Errorf("The path %q does not exist or it is inaccessible.", dir)
+	}
+	return &fileStorage{dir: dir}, nil
+}
+
+type fileWriter struct {
+	fd   *os.File
+	path string
+}
+
+func (w *fileWriter) Write(b []byte) (int, error) {
+	return w.fd.Write(b)
+}
+
+func (w *fileWriter) Close() error {
+	if err := w.fd.Sync(); err != nil {
+		x.Ignore(w.fd.Close())
+		return err
+	}
+	if err := w.fd.Close(); err != nil {
+		return err
+	}
+	return os.Rename(w.fd.Name(), w.path)
+}
+
+func (s *fileStorage) Create(path string) (io.WriteCloser, error) {
+	fpath := filepath.Join(s.dir, path)
+	if err := os.MkdirAll(filepath.Dir(fpath), 0700); err != nil {
+		return nil, err
+	}
+	fd, err := os.Create(fpath + tmpSuffix)
+	if err != nil {
+		return nil, err
+	}
+	return &fileWriter{fd: fd, path: fpath}, nil
+}
+
+func (s *fileStorage) Open(path string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(s.dir, path))
+}
+
+func (s *fileStorage) List(prefix string) ([]string, error) {
+	var paths []string
+	err := filepath.Walk(s.dir, func(fpath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasSuffix(fpath, tmpSuffix) {
+			return nil
+		}
+		rel, err := filepath.Rel(s.dir, fpath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if strings.HasPrefix(rel, prefix) {
+			paths = append(paths, rel)
+		}
+		return nil
+	})
+	return paths, err
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+	minio "github.com/minio/minio-go"
+)
+
+const (
+	s3DefaultEndpoint = "s3.amazonaws.com"
+	s3AccelerateHost  = "s3-accelerate"
+	gsEndpoint        = "storage.googleapis.com"
+)
+
+// envKeys are the environment variables holding the access and secret keys of each store.
+var envKeys = map[string][2]string{
+	"s3":    {"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY"},
+	"minio": {"MINIO_ACCESS_KEY", "MINIO_SECRET_KEY"},
+	"gs":    {"GS_ACCESS_KEY_ID", "GS_SECRET_ACCESS_KEY"},
+}
+
+// remoteStorage stores the files as the objects of a bucket in an S3 compatible store. The
+// client retries failed requests, and uploads large files in parts.
+type remoteStorage struct {
+	mc     *minio.Client
+	bucket string
+	prefix string
+}
+
+func isS3Endpoint(host string) bool {
+	return strings.HasPrefix(host, "s3") && strings.HasSuffix(host, ".amazonaws.com")
+}
+
+// parseRemote splits the URI into the endpoint of the store, the bucket and the path within it.
+func parseRemote(uri *url.URL) (endpoint, bucket, prefix string, err error) {
+	parts := strings.Split(strings.Trim(uri.Path, "/"), "/")
+	switch {
+	case uri.Scheme == "gs":
+		endpoint, bucket = gsEndpoint, uri.Host
+	case uri.Scheme == "s3" && uri.Host == "":
+		// s3:///bucket/path
+		endpoint, bucket, parts = s3DefaultEndpoint, parts[0], parts[1:]
+	case uri.Scheme == "s3" && !strings.Contains(uri.Host, "."):
+		// s3://bucket/path
+		endpoint, bucket = s3DefaultEndpoint, uri.Host
+	default:
+		endpoint, bucket, parts = uri.Host, parts[0], parts[1:]
+	}
+	if len(endpoint) == 0 || len(bucket) == 0 {
+		return "", "", "", x.Errorf("The bucket of %q is invalid", uri.String())
+	}
+	return endpoint, bucket, path.Join(parts...), nil
+}
+
+func newRemoteStorage(uri *url.URL) (*remoteStorage, error) {
+	endpoint, bucket, prefix, err := parseRemote(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	accessKey, secretKey := Config.AccessKey, Config.SecretKey
+	if accessKey == "" || secretKey == "" {
+		keys := envKeys[uri.Scheme]
+		accessKey, secretKey = os.Getenv(keys[0]), os.Getenv(keys[1])
+	}
+	if accessKey == "" || secretKey == "" {
+		keys := envKeys[uri.Scheme]
+		return nil, x.Errorf("Env vars %s and %s not set.", keys[0], keys[1])
+	}
+
+	// secure by default
+	secure := uri.Query().Get("secure") != "false"
+	glog.V(2).Infof("Using bucket %s at %s, path: %s", bucket, endpoint, prefix)
+
+	mc, err := minio.New(endpoint, accessKey, secretKey, secure)
+	if err != nil {
+		return nil, err
+	}
+	// S3 transfer acceleration support.
+	if strings.Contains(endpoint, s3AccelerateHost) {
+		mc.SetS3TransferAccelerate(endpoint)
+	}
+
+	found, err := mc.BucketExists(bucket)
+	if err != nil {
+		return nil, x.Errorf("Error while looking for bucket: %s at host: %s. Error: %v",
+			bucket, endpoint, err)
+	}
+	if !found {
+		return nil, x.Errorf("Bucket %s not found at host: %s.", bucket, endpoint)
+	}
+	return &remoteStorage{mc: mc, bucket: bucket, prefix: prefix}, nil
+}
+
+// remoteWriter streams the writes to the upload of the object, through a pipe.
+type remoteWriter struct {
+	pwriter *io.PipeWriter
+	cerr    chan error
+}
+
+func (w *remoteWriter) Write(b []byte) (int, error) {
+	return w.pwriter.Write(b)
+}
+
+func (w *remoteWriter) Close() error {
+	// we are done writing, send EOF.
+	if err := w.pwriter.Close(); err != nil {
+		return err
+	}
+	return <-w.cerr
+}
+
+func (s *remoteStorage) Create(name string) (io.WriteCloser, error) {
+	object := path.Join(s.prefix, name)
+	preader, pwriter := io.Pipe()
+	w := &remoteWriter{pwriter: pwriter, cerr: make(chan error, 1)}
+	go func() {
+		// The size is unknown, so the object is uploaded in parts as they're written. A write
+		// to the pipe blocks until it's read, the writes go at the pace of the upload.
+		n, err := s.mc.PutObject(s.bucket, object, preader, -1, minio.PutObjectOptions{})
+		glog.V(2).Infof("Sent %d bytes to %s/%s. Error: %v", n, s.bucket, object, err)
+		// This makes the writes fail as well.
+		preader.CloseWithError(err)
+		w.cerr <- err
+	}()
+	return w, nil
+}
+
+func (s *remoteStorage) Open(name string) (io.ReadCloser, error) {
+	return s.mc.GetObject(s.bucket, path.Join(s.prefix, name), minio.GetObjectOptions{})
+}
+
+func (s *remoteStorage) List(prefix string) ([]string, error) {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	var paths []string
+	objectPrefix := path.Join(s.prefix, prefix)
+	if len(s.prefix) > 0 && len(prefix) == 0 {
+		// only list the objects within the folder
+		objectPrefix += "/"
+	}
+	for object := range s.mc.ListObjectsV2(s.bucket, objectPrefix, true, doneCh) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		name := strings.TrimPrefix(strings.TrimPrefix(object.Key, s.prefix), "/")
+		paths = append(paths, name)
+	}
+	return paths, nil
+}
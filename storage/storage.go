@@ -0,0 +1,74 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package storage writes and reads the files of exports and backups at their destination, which
+// can be a local or shared filesystem, or a bucket of an object store.
+package storage
+
+import (
+	"io"
+	"net/url"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Options holds the credentials used to access the object stores. The environment variables
+// of the provider are used for the ones left empty.
+type Options struct {
+	AccessKey string
+	SecretKey string
+}
+
+var Config Options
+
+// Storage is a destination files are written to and read from, by their path relative to it.
+type Storage interface {
+	// Create returns a writer of the file at the path. The file is only complete, and listed,
+	// once the writer has been closed without error.
+	Create(path string) (io.WriteCloser, error)
+	// Open returns a reader of the file at the path.
+	Open(path string) (io.ReadCloser, error)
+	// List returns the paths of all the files under the prefix.
+	List(prefix string) ([]string, error)
+}
+
+// New returns the storage of the destination, given as a URI:
+//   /path or file:///path             local or shared filesystem
+//   s3://bucket/path                  Amazon S3
+//   s3://endpoint/bucket/path         S3 region or accelerated endpoint, or any S3 compatible store
+//   minio://endpoint/bucket/path      MinIO
+//   gs://bucket/path                  Google Cloud Storage, through its S3 compatible API
+//
+// Object stores are reached over TLS unless the URI has the secure=false argument.
+func New(destination string) (Storage, error) {
+	uri, err := url.Parse(destination)
+	if err != nil {
+		return nil, err
+	}
+	switch uri.Scheme {
+	case "", "file":
+		return newFileStorage(uri.Path)
+	case "s3", "minio", "gs":
+		return newRemoteStorage(uri)
+	case "http", "https":
+		// Backups used to take the address of S3 buckets as is.
+		if isS3Endpoint(uri.Host) {
+			uri.Scheme = "s3"
+			return newRemoteStorage(uri)
+		}
+	}
+	return nil, x.Errorf("Unable to handle destination: %v", destination)
+}
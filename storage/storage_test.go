@@ -0,0 +1,99 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"io/ioutil"
+	"net/url"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStorage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "storage")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	st, err := New("file://" + dir)
+	require.NoError(t, err)
+
+	w, err := st.Create("dgraph.1/g01.rdf.gz")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("data"))
+	require.NoError(t, err)
+
+	// the file isn't listed until it's complete
+	paths, err := st.List("")
+	require.NoError(t, err)
+	require.Empty(t, paths)
+	require.NoError(t, w.Close())
+
+	w, err = st.Create("dgraph.2/g01.rdf.gz")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	paths, err = st.List("")
+	require.NoError(t, err)
+	sort.Strings(paths)
+	require.Equal(t, []string{"dgraph.1/g01.rdf.gz", "dgraph.2/g01.rdf.gz"}, paths)
+	paths, err = st.List("dgraph.2/")
+	require.NoError(t, err)
+	require.Equal(t, []string{"dgraph.2/g01.rdf.gz"}, paths)
+
+	r, err := st.Open("dgraph.1/g01.rdf.gz")
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, []byte("data"), data)
+
+	_, err = New(dir + "/missing")
+	require.Error(t, err)
+	_, err = New("ftp://host/dir")
+	require.Error(t, err)
+}
+
+func TestParseRemote(t *testing.T) {
+	tests := []struct {
+		uri                      string
+		endpoint, bucket, prefix string
+	}{
+		{"s3://dgraph/backups", "s3.amazonaws.com", "dgraph", "backups"},
+		{"s3:///dgraph/backups/daily", "s3.amazonaws.com", "dgraph", "backups/daily"},
+		{"s3://s3.us-west-2.amazonaws.com/dgraph", "s3.us-west-2.amazonaws.com", "dgraph", ""},
+		{"minio://localhost:9000/dgraph/exports?secure=false", "localhost:9000", "dgraph",
+			"exports"},
+		{"gs://dgraph/exports/", "storage.googleapis.com", "dgraph", "exports"},
+	}
+	for _, tc := range tests {
+		uri, err := url.Parse(tc.uri)
+		require.NoError(t, err)
+		endpoint, bucket, prefix, err := parseRemote(uri)
+		require.NoError(t, err, tc.uri)
+		require.Equal(t, tc.endpoint, endpoint, tc.uri)
+		require.Equal(t, tc.bucket, bucket, tc.uri)
+		require.Equal(t, tc.prefix, prefix, tc.uri)
+	}
+
+	uri, err := url.Parse("minio://localhost:9000/")
+	require.NoError(t, err)
+	_, _, _, err = parseRemote(uri)
+	require.Error(t, err)
+}
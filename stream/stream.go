@@ -43,6 +43,10 @@ type Lists struct {
 	DB            *badger.DB
 	ChooseKeyFunc func(item *badger.Item) bool
 	ItemToKVFunc  func(key []byte, itr *badger.Iterator) (*pb.KV, error)
+
+	// ItemToKVsFunc is used instead of ItemToKVFunc if set, for keys which are converted to
+	// more than one key-value, e.g. one per version.
+	ItemToKVsFunc func(key []byte, itr *badger.Iterator) ([]*pb.KV, error)
 }
 
 // keyRange is [start, end), including start, excluding end. Do ensure that the start,
@@ -153,12 +157,12 @@ func (sl *Lists) produceKVs(ctx context.Context, ts uint64,
 				continue
 			}
 
-			// Now convert to key value.
-			kv, err := sl.ItemToKVFunc(item.KeyCopy(nil), it)
+			// Now convert to key values.
+			list, err := sl.itemToKVs(item.KeyCopy(nil), it)
 			if err != nil {
 				return err
 			}
-			if kv != nil {
+			for _, kv := range list {
 				kvs.Kv = append(kvs.Kv, kv)
 				size += kv.Size()
 			}
@@ -190,6 +194,17 @@ func (sl *Lists) produceKVs(ctx context.Context, ts uint64,
 	}
 }
 
+func (sl *Lists) itemToKVs(key []byte, itr *badger.Iterator) ([]*pb.KV, error) {
+	if sl.ItemToKVsFunc != nil {
+		return sl.ItemToKVsFunc(key, itr)
+	}
+	kv, err := sl.ItemToKVFunc(key, itr)
+	if err != nil || kv == nil {
+		return nil, err
+	}
+	return []*pb.KV{kv}, nil
+}
+
 func (sl *Lists) streamKVs(ctx context.Context, logPrefix string, kvChan chan *pb.KVS) error {
 	var count int
 	var bytesSent uint64
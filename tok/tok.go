@@ -21,6 +21,7 @@ import (
 	"plugin"
 	"time"
 
+	"github.com/blevesearch/bleve/analysis"
 	farm "github.com/dgryski/go-farm"
 	"github.com/golang/glog"
 	geom "github.com/twpayne/go-geom"
@@ -255,19 +256,23 @@ func (t FullTextTokenizer) Tokens(v interface{}) ([]string, error) {
 	if !ok || str == "" {
 		return []string{}, nil
 	}
+	// return the unique terms.
+	return uniqueTerms(t.analyze(str)), nil
+}
+func (t FullTextTokenizer) Identifier() byte { return 0x8 }
+func (t FullTextTokenizer) IsSortable() bool { return false }
+func (t FullTextTokenizer) IsLossy() bool    { return true }
+
+// analyze returns the terms of str, with the duplicates.
+func (t FullTextTokenizer) analyze(str string) analysis.TokenStream {
 	lang := langBase(t.lang)
 	// pass 1 - lowercase and normalize input
 	tokens := fulltextAnalyzer.Analyze([]byte(str))
 	// pass 2 - filter stop words
 	tokens = filterStopwords(lang, tokens)
 	// pass 3 - filter stems
-	tokens = filterStemmers(lang, tokens)
-	// finally, return the terms.
-	return uniqueTerms(tokens), nil
+	return filterStemmers(lang, tokens)
 }
-func (t FullTextTokenizer) Identifier() byte { return 0x8 }
-func (t FullTextTokenizer) IsSortable() bool { return false }
-func (t FullTextTokenizer) IsLossy() bool    { return true }
 
 func encodeInt(val int64) string {
 	buf := make([]byte, 9)
@@ -193,6 +193,30 @@ func TestGetFullTextTokens(t *testing.T) {
 	require.Equal(t, expected, tokens)
 }
 
+func TestGetFullTextTermCounts(t *testing.T) {
+	val := "Our chief weapon is surprise...surprise and fear...fear and surprise...." +
+		"Our two weapons are fear and surprise...and ruthless efficiency.... " +
+		"Our three weapons are fear, surprise, and ruthless efficiency..."
+	counts, length := GetFullTextTermCounts(val, "en")
+	require.Equal(t, 19, length)
+
+	id := FullTextTokenizer{}.Identifier()
+	require.Equal(t, map[string]int{
+		encodeToken("chief", id):    1,
+		encodeToken("weapon", id):   3,
+		encodeToken("surpris", id):  5,
+		encodeToken("fear", id):     4,
+		encodeToken("ruthless", id): 2,
+		encodeToken("effici", id):   2,
+		encodeToken("two", id):      1,
+		encodeToken("three", id):    1,
+	}, counts)
+
+	counts, length = GetFullTextTermCounts("", "en")
+	require.Empty(t, counts)
+	require.Equal(t, 0, length)
+}
+
 func TestGetFullTextTokens1(t *testing.T) {
 	tokens, err := GetFullTextTokens([]string{"Quick brown fox"}, "en")
 	require.NoError(t, err)
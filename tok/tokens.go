@@ -46,3 +46,22 @@ func GetFullTextTokens(funcArgs []string, lang string) ([]string, error) {
 	}
 	return BuildTokens(funcArgs[0], FullTextTokenizer{lang: lang})
 }
+
+// FullTextDocsToken is the token of the full-text index listing all the uids with an indexed
+// value, which no term can collide with as they're never empty.
+var FullTextDocsToken = encodeToken("", FullTextTokenizer{}.Identifier())
+
+// GetFullTextTermCounts returns the number of occurrences in str of each of its full-text tokens,
+// along with the length of str in terms.
+func GetFullTextTermCounts(str, lang string) (map[string]int, int) {
+	if str == "" {
+		return nil, 0
+	}
+	t := FullTextTokenizer{lang: lang}
+	terms := t.analyze(str)
+	counts := make(map[string]int, len(terms))
+	for i := range terms {
+		counts[encodeToken(string(terms[i].Term), t.Identifier())]++
+	}
+	return counts, len(terms)
+}
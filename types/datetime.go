@@ -0,0 +1,58 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"time"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// IsDatePart returns whether name is one of the functions extracting a component of a datetime,
+// like month(dob).
+func IsDatePart(name string) bool {
+	switch name {
+	case "year", "month", "day", "hour":
+		return true
+	}
+	return false
+}
+
+// DatePart returns the component of the datetime v named by part as an int, in the time zone the
+// datetime was given in.
+func DatePart(part string, v Val) (Val, error) {
+	t, ok := v.Value.(time.Time)
+	if v.Tid != DateTimeID || !ok {
+		return Val{}, x.Errorf("Function %s expects a datetime, but got a value of type %s",
+			part, v.Tid.Name())
+	}
+
+	var n int
+	switch part {
+	case "year":
+		n = t.Year()
+	case "month":
+		n = int(t.Month())
+	case "day":
+		n = t.Day()
+	case "hour":
+		n = t.Hour()
+	default:
+		return Val{}, x.Errorf("Unknown datetime component %s", part)
+	}
+	return Val{Tid: IntID, Value: int64(n)}, nil
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatePart(t *testing.T) {
+	zone := time.FixedZone("", 5*3600)
+	v := Val{Tid: DateTimeID, Value: time.Date(2018, 10, 31, 22, 15, 0, 0, zone)}
+	tests := []struct {
+		part string
+		out  int64
+	}{
+		{"year", 2018},
+		{"month", 10},
+		{"day", 31},
+		{"hour", 22},
+	}
+	for _, tc := range tests {
+		part, err := DatePart(tc.part, v)
+		require.NoError(t, err)
+		require.Equal(t, Val{Tid: IntID, Value: tc.out}, part)
+	}
+
+	_, err := DatePart("month", Val{Tid: IntID, Value: int64(2018)})
+	require.Error(t, err)
+	_, err = DatePart("week", v)
+	require.Error(t, err)
+}
@@ -18,9 +18,11 @@ package types
 
 import (
 	"bytes"
+	"math"
 	"strconv"
 	"strings"
 
+	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
 	"github.com/twpayne/go-geom"
 
@@ -52,7 +54,7 @@ type GeoQueryData struct {
 // IsGeoFunc returns if a function is of geo type.
 func IsGeoFunc(str string) bool {
 	switch str {
-	case "near", "contains", "within", "intersects":
+	case "near", "nearest", "contains", "within", "intersects":
 		return true
 	}
 
@@ -422,3 +424,95 @@ func MatchGeo(value *pb.TaskValue, q *GeoQueryData) bool {
 	g := gc.Value.(geom.T)
 	return q.MatchesFilter(g)
 }
+
+// GeoPoint is a point on the earth the geodesic distances to the geometries are measured from.
+type GeoPoint struct {
+	g  *geom.Point
+	pt s2.Point
+}
+
+// ParseGeoPoint parses a point given as [lon, lat] to a geo function.
+func ParseGeoPoint(s string) (*GeoPoint, error) {
+	g, err := convertToGeom(s)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := g.(*geom.Point)
+	if !ok {
+		return nil, x.Errorf("Expected a point, but got a geometry of type %T", g)
+	}
+	return &GeoPoint{g: p, pt: pointFromPoint(p)}, nil
+}
+
+// CapTokens returns the index tokens of the geometries which may be within radius metres of the
+// point. Once the radius reaches half the circumference of the earth, it's covered whole.
+func (p *GeoPoint) CapTokens(radius float64) []string {
+	c := s2.FullCap()
+	if radius < math.Pi*EarthRadiusMeters {
+		c = s2.CapFromCenterAngle(p.pt, EarthAngle(radius))
+	}
+	cover := indexCellsForCap(c)
+	return parentCoverTokens(getParentCells(cover, MinCellLevel), cover)
+}
+
+// Distance returns the geodesic distance in metres from the point to the closest point of g,
+// which is 0 if g contains it.
+func (p *GeoPoint) Distance(g geom.T) (float64, error) {
+	switch v := g.(type) {
+	case *geom.Point:
+		return float64(EarthDistance(p.pt.Distance(pointFromPoint(v)))), nil
+	case *geom.Polygon:
+		l, err := loopFromPolygon(v)
+		if err != nil {
+			return 0, err
+		}
+		return float64(EarthDistance(p.angleToLoop(l))), nil
+	case *geom.MultiPolygon:
+		min := s1.Angle(math.Inf(1))
+		for i := 0; i < v.NumPolygons(); i++ {
+			l, err := loopFromPolygon(v.Polygon(i))
+			if err != nil {
+				return 0, err
+			}
+			if a := p.angleToLoop(l); a < min {
+				min = a
+			}
+		}
+		return float64(EarthDistance(min)), nil
+	default:
+		return 0, x.Errorf("Cannot measure the distance to a geometry of type %T", v)
+	}
+}
+
+func (p *GeoPoint) angleToLoop(l *s2.Loop) s1.Angle {
+	if l.ContainsPoint(p.pt) {
+		return 0
+	}
+	min := s1.Angle(math.Inf(1))
+	for i := 0; i < l.NumEdges(); i++ {
+		e := l.Edge(i)
+		if a := s2.DistanceFromSegment(p.pt, e.V0, e.V1); a < min {
+			min = a
+		}
+	}
+	return min
+}
+
+// GeoDistance returns the geodesic distance in metres from the point to the geometry stored in
+// value, and false if it isn't one.
+func GeoDistance(value *pb.TaskValue, p *GeoPoint) (float64, bool) {
+	if len(value.Val) == 0 || TypeID(value.ValType) != GeoID {
+		return 0, false
+	}
+	src := ValueForType(BinaryID)
+	src.Value = value.Val
+	gc, err := Convert(src, GeoID)
+	if err != nil {
+		return 0, false
+	}
+	d, err := p.Distance(gc.Value.(geom.T))
+	if err != nil {
+		return 0, false
+	}
+	return d, true
+}
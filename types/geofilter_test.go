@@ -18,6 +18,7 @@ package types
 
 import (
 	"encoding/binary"
+	"math"
 	"strings"
 	"testing"
 
@@ -396,3 +397,59 @@ func TestMatchesFilterNearPoint(t *testing.T) {
 	})
 	require.True(t, qd.MatchesFilter(poly))
 }
+
+func TestGeoPointDistance(t *testing.T) {
+	p, err := ParseGeoPoint("[-122.082506, 37.4249518]")
+	require.NoError(t, err)
+
+	// Same point
+	d, err := p.Distance(geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.082506, 37.4249518}))
+	require.NoError(t, err)
+	require.InDelta(t, 0, d, 0.01)
+
+	// A degree of longitude away, along the parallel.
+	d, err = p.Distance(geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-123.082506, 37.4249518}))
+	require.NoError(t, err)
+	require.InDelta(t, 88300, d, 200)
+
+	// Containing polygon
+	poly := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122, 37}, {-123, 37}, {-123, 38}, {-122, 38}, {-122, 37}},
+	})
+	d, err = p.Distance(poly)
+	require.NoError(t, err)
+	require.Equal(t, 0.0, d)
+
+	// The polygon is measured from its closest edge, at a longitude of -122.
+	poly = geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-121, 37}, {-122, 37}, {-122, 38}, {-121, 38}, {-121, 37}},
+	})
+	d, err = p.Distance(poly)
+	require.NoError(t, err)
+	require.InDelta(t, 7290, d, 100)
+
+	_, err = ParseGeoPoint("[[-122, 37], [-123, 37], [-123, 38], [-122, 37]]")
+	require.Error(t, err)
+}
+
+func TestGeoPointCapTokens(t *testing.T) {
+	p, err := ParseGeoPoint("[-122.082506, 37.4249518]")
+	require.NoError(t, err)
+
+	// The tokens of a point within the radius are among the tokens of the cap.
+	toks := make(map[string]bool)
+	for _, tok := range p.CapTokens(1000) {
+		toks[tok] = true
+	}
+	close := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.080668, 37.426753})
+	ptoks, err := IndexGeoTokens(close)
+	require.NoError(t, err)
+	found := false
+	for _, tok := range ptoks {
+		found = found || toks[tok]
+	}
+	require.True(t, found)
+
+	// Past half the circumference of the earth, every cell is covered at the lowest level.
+	require.Equal(t, 6*(1<<(2*MinCellLevel)), len(p.CapTokens(math.Pi*EarthRadiusMeters))/2)
+}
@@ -79,7 +79,7 @@ func (s byValue) Less(i, j int) bool {
 	return false
 }
 
-// Sort sorts the given array in-place.
+// SortWithFacet sorts the given array in-place, along with the uids and the facets.
 func SortWithFacet(v [][]Val, ul *pb.List, l []*pb.Facets, desc []bool) error {
 	if len(v) == 0 || len(v[0]) == 0 {
 		return nil
@@ -95,7 +95,9 @@ func SortWithFacet(v [][]Val, ul *pb.List, l []*pb.Facets, desc []bool) error {
 	var toBeSorted sort.Interface
 	b := sortBase{v, desc, ul, l}
 	toBeSorted = byValue{b}
-	sort.Sort(toBeSorted)
+	// The sort is stable, so that the ties on all the values are kept in their original order,
+	// which is usually the order of the uids.
+	sort.Stable(toBeSorted)
 	return nil
 }
 
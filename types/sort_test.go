@@ -122,6 +122,20 @@ func TestSortIntAndFloat(t *testing.T) {
 
 }
 
+func TestSortMultipleKeysStable(t *testing.T) {
+	list := [][]Val{
+		{{Tid: StringID, Value: "b"}, {Tid: IntID, Value: int64(1)}},
+		{{Tid: StringID, Value: "a"}, {Tid: IntID, Value: int64(1)}},
+		{{Tid: StringID, Value: "b"}, {Tid: IntID, Value: int64(2)}},
+		{{Tid: StringID, Value: "a"}, {Tid: IntID, Value: int64(1)}},
+		{{Tid: StringID, Value: "b"}, {Tid: IntID, Value: int64(1)}},
+	}
+	ul := getUIDList(5)
+	require.NoError(t, Sort(list, ul, []bool{false, true}))
+	// The ties on both keys keep the order of the uids.
+	require.EqualValues(t, []uint64{200, 400, 300, 100, 500}, ul.Uids)
+}
+
 func TestEqual(t *testing.T) {
 	require.True(t, equal(Val{Tid: IntID, Value: int64(3)}, Val{Tid: IntID, Value: int64(3)}),
 		"equal should return true for two equal values")
@@ -29,7 +29,7 @@ func CouldApplyAggregatorOn(agrtr string, typ types.TypeID) bool {
 			typ == types.DateTimeID ||
 			typ == types.StringID ||
 			typ == types.DefaultID)
-	case "sum", "avg":
+	case "sum", "avg", "stddev", "variance", "median", "percentile":
 		return (typ == types.IntID ||
 			typ == types.FloatID)
 	default:
@@ -88,8 +88,10 @@ func backupGroup(ctx context.Context, in pb.BackupRequest) error {
 	return nil
 }
 
-// BackupOverNetwork handles a request coming from an HTTP client.
-func BackupOverNetwork(pctx context.Context, target string) error {
+// BackupOverNetwork handles a request coming from an HTTP client. Unless forceFull is set, the
+// backup is an incremental one if there are previous backups at the target, holding the changes
+// since the latest of them.
+func BackupOverNetwork(pctx context.Context, target string, forceFull bool) error {
 	ctx, cancel := context.WithCancel(pctx)
 	defer cancel()
 
@@ -106,11 +108,20 @@ func BackupOverNetwork(pctx context.Context, target string) error {
 		return err
 	}
 
+	var sinceTs uint64
+	if !forceFull {
+		if sinceTs, err = backup.LastReadTs(target); err != nil {
+			glog.Errorf("Unable to read the previous backups at %s: %s", target, err)
+			return err
+		}
+	}
+
 	gids := groups().KnownGroups()
 	req := pb.BackupRequest{
-		ReadTs: ts.ReadOnly,
-		Target: target,
-		UnixTs: time.Now().UTC().Format("20060102.1504"),
+		ReadTs:  ts.ReadOnly,
+		Target:  target,
+		UnixTs:  time.Now().UTC().Format("20060102.150405"),
+		SinceTs: sinceTs,
 	}
 	glog.Infof("Created backup request: %+v. Groups=%v\n", req, gids)
 
@@ -119,9 +130,9 @@ func BackupOverNetwork(pctx context.Context, target string) error {
 	errCh := make(chan error, 1)
 	for _, gid := range gids {
 		req.GroupId = gid
-		go func() {
+		go func(req pb.BackupRequest) {
 			errCh <- backupGroup(ctx, req)
-		}()
+		}(req)
 	}
 
 	for i := 0; i < len(gids); i++ {
@@ -132,6 +143,12 @@ func BackupOverNetwork(pctx context.Context, target string) error {
 		}
 	}
 	req.GroupId = 0
+	// The manifest is only written once all the groups are backed up, the next backup
+	// won't be based on an incomplete one.
+	if err := backup.WriteManifest(&req, gids, MaxLeaseId()); err != nil {
+		glog.Errorf("Unable to write the backup manifest: %v", err)
+		return err
+	}
 	glog.Infof("Backup for req: %+v. OK.\n", req)
 	return nil
 }
@@ -0,0 +1,179 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"math"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/types/facets"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// bm25(attr, "terms") scores the full-text value of each of the source uids against the terms
+// with Okapi BM25. The postings of the full-text index carry the number of occurrences tf of their
+// term in the value and the length dl of the value in terms, and every uid with a value is listed
+// under tok.FullTextDocsToken. The average length of the values is taken over the ones matching
+// any of the terms, so that only the posting lists of the terms are read.
+
+const (
+	// bm25K1 bounds how much the score of a term grows with its number of occurrences.
+	bm25K1 = 1.2
+	// bm25B is how much the score of a term is normalized by the length of the value.
+	bm25B = 0.75
+)
+
+// termFreq is the number of occurrences of a term in a value, and the length of the value.
+type termFreq struct {
+	tf, dl float64
+}
+
+func handleBM25Function(ctx context.Context, arg funcArgs) error {
+	q := arg.q
+	numDocs, err := countFullTextDocs(arg)
+	if err != nil {
+		return err
+	}
+
+	// The lengths of all the values matching any of the terms, and the frequencies of each term
+	// in the values of the source uids.
+	lengths := make(map[uint64]float64)
+	freqs := make([]map[uint64]termFreq, len(arg.srcFn.tokens))
+	docFreqs := make([]int, len(arg.srcFn.tokens))
+	for i, token := range arg.srcFn.tokens {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		pl, err := posting.Get(x.IndexKey(q.Attr, token))
+		if err != nil {
+			return err
+		}
+		freqs[i] = make(map[uint64]termFreq)
+		err = pl.Iterate(q.ReadTs, 0, func(p *pb.Posting) error {
+			docFreqs[i]++
+			f, err := termFreqFor(p.Facets)
+			if err != nil {
+				return err
+			}
+			if f.dl > 0 {
+				lengths[p.Uid] = f.dl
+			}
+			if algo.IndexOf(q.UidList, p.Uid) >= 0 {
+				freqs[i][p.Uid] = f
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	avgLength := 1.0
+	if len(lengths) > 0 {
+		var sum float64
+		for _, l := range lengths {
+			sum += l
+		}
+		avgLength = sum / float64(len(lengths))
+	}
+	// The values indexed before the lengths were stored aren't listed under FullTextDocsToken.
+	if numDocs < len(lengths) {
+		numDocs = len(lengths)
+	}
+
+	scores := make(map[uint64]float64)
+	for i, fs := range freqs {
+		df := float64(docFreqs[i])
+		idf := math.Log(1 + (float64(numDocs)-df+0.5)/(df+0.5))
+		for uid, f := range fs {
+			dl := f.dl
+			if dl == 0 {
+				dl = avgLength
+			}
+			norm := bm25K1 * (1 - bm25B + bm25B*dl/avgLength)
+			scores[uid] += idf * f.tf * (bm25K1 + 1) / (f.tf + norm)
+		}
+	}
+
+	for _, uid := range q.UidList.Uids {
+		vl := &pb.ValueList{}
+		if score, ok := scores[uid]; ok {
+			tv, err := floatTaskValue(score)
+			if err != nil {
+				return err
+			}
+			vl.Values = append(vl.Values, tv)
+		}
+		arg.out.ValueMatrix = append(arg.out.ValueMatrix, vl)
+		// Add an empty UID list to make later processing consistent
+		arg.out.UidMatrix = append(arg.out.UidMatrix, &emptyUIDList)
+	}
+	return nil
+}
+
+// countFullTextDocs returns the number of uids with a value in the full-text index.
+func countFullTextDocs(arg funcArgs) (int, error) {
+	pl, err := posting.Get(x.IndexKey(arg.q.Attr, tok.FullTextDocsToken))
+	if err != nil {
+		return 0, err
+	}
+	n := pl.Length(arg.q.ReadTs, 0)
+	if n == -1 {
+		return 0, posting.ErrTsTooOld
+	}
+	return n, nil
+}
+
+// termFreqFor reads the term frequency from the facets of a full-text index posting. The postings
+// indexed before they were stored count as a single occurrence in a value of unknown length.
+func termFreqFor(fcs []*api.Facet) (termFreq, error) {
+	f := termFreq{tf: 1}
+	for _, fc := range fcs {
+		if fc.Key != "tf" && fc.Key != "dl" {
+			continue
+		}
+		v, err := facets.ValFor(fc)
+		if err != nil {
+			return f, err
+		}
+		n, ok := v.Value.(int64)
+		if !ok {
+			continue
+		}
+		if fc.Key == "tf" {
+			f.tf = float64(n)
+		} else {
+			f.dl = float64(n)
+		}
+	}
+	return f, nil
+}
+
+func floatTaskValue(f float64) (*pb.TaskValue, error) {
+	data := types.ValueForType(types.BinaryID)
+	if err := types.Marshal(types.Val{Tid: types.FloatID, Value: f}, &data); err != nil {
+		return nil, err
+	}
+	return &pb.TaskValue{ValType: types.FloatID.Enum(), Val: data.Value.([]byte)}, nil
+}
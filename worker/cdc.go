@@ -0,0 +1,236 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+)
+
+// cdcEvent is a change to an edge made by a committed transaction, published by the change
+// data capture.
+type cdcEvent struct {
+	CommitTs  uint64 `json:"commit_ts"`
+	StartTs   uint64 `json:"start_ts"`
+	Group     uint32 `json:"group"`
+	Namespace uint64 `json:"namespace,omitempty"`
+	Op        string `json:"op"` // "set" or "del".
+	Uid       string `json:"uid"`
+	Predicate string `json:"predicate"`
+	// Exactly one of ObjectUid and Value is set. Deleting all the values of the predicate of
+	// the uid has the value "*".
+	ObjectUid string `json:"object_uid,omitempty"`
+	Value     string `json:"value,omitempty"`
+	ValueType string `json:"value_type,omitempty"`
+	Lang      string `json:"lang,omitempty"`
+}
+
+// cdcSink is where the events are published to.
+type cdcSink interface {
+	// publish writes the events, all of which have the given commit ts, to the sink.
+	publish(events []*cdcEvent, commitTs uint64) error
+	// checkpoint returns the commit ts of the last events published.
+	checkpoint() uint64
+}
+
+// changeCapture keeps the edges mutated by the pending transactions of the group, and
+// publishes them in the order of their commit ts once they're committed.
+type changeCapture struct {
+	sync.Mutex
+	sink    cdcSink
+	pending map[uint64][]*pb.DirectedEdge
+}
+
+var cdc changeCapture
+
+// cdcEnabled returns whether the change data capture is turned on, opening its sink if needed.
+func cdcEnabled() bool {
+	if Config.CDCFile == "" {
+		return false
+	}
+	cdc.Lock()
+	defer cdc.Unlock()
+	if cdc.sink == nil {
+		sink, err := openCDCFileSink(Config.CDCFile)
+		x.Checkf(err, "While opening the change data capture file %s", Config.CDCFile)
+		cdc.sink = sink
+		cdc.pending = make(map[uint64][]*pb.DirectedEdge)
+	}
+	return true
+}
+
+// cdcRecord keeps the edges applied by the transaction started at startTs until it's committed
+// or aborted.
+func cdcRecord(startTs uint64, edges []*pb.DirectedEdge) {
+	if !cdcEnabled() {
+		return
+	}
+	cdc.Lock()
+	defer cdc.Unlock()
+	cdc.pending[startTs] = append(cdc.pending[startTs], edges...)
+}
+
+// cdcPublish publishes the edges of the transactions committed by delta, and drops the ones of
+// the transactions it aborts. The transactions published before the checkpoint of the sink,
+// replayed from the raft log after a restart, are skipped.
+func cdcPublish(gid uint32, delta *pb.OracleDelta) {
+	if !cdcEnabled() {
+		return
+	}
+	cdc.Lock()
+	defer cdc.Unlock()
+
+	txns := make([]*pb.TxnStatus, 0, len(delta.Txns))
+	for _, status := range delta.Txns {
+		if status.CommitTs == 0 {
+			delete(cdc.pending, status.StartTs)
+			continue
+		}
+		txns = append(txns, status)
+	}
+	sort.Slice(txns, func(i, j int) bool { return txns[i].CommitTs < txns[j].CommitTs })
+
+	for _, status := range txns {
+		edges, ok := cdc.pending[status.StartTs]
+		if !ok {
+			continue
+		}
+		delete(cdc.pending, status.StartTs)
+		if status.CommitTs <= cdc.sink.checkpoint() {
+			continue
+		}
+		events := make([]*cdcEvent, 0, len(edges))
+		for _, edge := range edges {
+			events = append(events, toCDCEvent(edge, gid, status.StartTs, status.CommitTs))
+		}
+		err := x.RetryUntilSuccess(Config.MaxRetries, 10*time.Millisecond, func() error {
+			return cdc.sink.publish(events, status.CommitTs)
+		})
+		if err != nil {
+			glog.Errorf("Unable to publish the changes of txn %d -> %d: %v",
+				status.StartTs, status.CommitTs, err)
+		}
+	}
+}
+
+func toCDCEvent(edge *pb.DirectedEdge, gid uint32, startTs, commitTs uint64) *cdcEvent {
+	ns, attr := x.ParseNamespaceAttr(edge.Attr)
+	e := &cdcEvent{
+		CommitTs:  commitTs,
+		StartTs:   startTs,
+		Group:     gid,
+		Namespace: ns,
+		Op:        "set",
+		Uid:       fmt.Sprintf("%#x", edge.Entity),
+		Predicate: attr,
+		Lang:      edge.Lang,
+	}
+	if edge.Op == pb.DirectedEdge_DEL {
+		e.Op = "del"
+	}
+	if len(edge.Value) == 0 {
+		e.ObjectUid = fmt.Sprintf("%#x", edge.ValueId)
+		return e
+	}
+
+	tid := types.TypeID(edge.ValueType)
+	src := types.ValueForType(tid)
+	src.Value = edge.Value
+	if str, err := types.Convert(src, types.StringID); err == nil {
+		e.Value = strings.TrimRight(str.Value.(string), "\x00")
+	} else {
+		e.Value = string(edge.Value)
+	}
+	if tid != types.DefaultID {
+		e.ValueType = tid.Name()
+	}
+	return e
+}
+
+// cdcFileSink writes the events to a file, one JSON object per line. The commit ts of the last
+// events written is kept in the checkpoint file next to it, so that the events aren't written
+// again after a restart.
+type cdcFileSink struct {
+	f              *os.File
+	checkpointPath string
+	lastCommitTs   uint64
+}
+
+func openCDCFileSink(path string) (*cdcFileSink, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	s := &cdcFileSink{f: f, checkpointPath: path + ".checkpoint"}
+	b, err := ioutil.ReadFile(s.checkpointPath)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, err
+	default:
+		s.lastCommitTs, err = strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64)
+		if err != nil {
+			return nil, x.Wrapf(err, "While reading the checkpoint of the change data capture")
+		}
+	}
+	return s, nil
+}
+
+func (s *cdcFileSink) publish(events []*cdcEvent, commitTs uint64) error {
+	var buf []byte
+	for _, e := range events {
+		b, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		buf = append(append(buf, b...), '\n')
+	}
+	if _, err := s.f.Write(buf); err != nil {
+		return err
+	}
+	if err := s.f.Sync(); err != nil {
+		return err
+	}
+
+	// The checkpoint is replaced at once, so it's never found half written.
+	tmp := s.checkpointPath + ".tmp"
+	data := []byte(strconv.FormatUint(commitTs, 10))
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, s.checkpointPath); err != nil {
+		return err
+	}
+	s.lastCommitTs = commitTs
+	return nil
+}
+
+func (s *cdcFileSink) checkpoint() uint64 {
+	return s.lastCommitTs
+}
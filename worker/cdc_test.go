@@ -0,0 +1,104 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func readCDCEvents(t *testing.T, path string) []cdcEvent {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var events []cdcEvent
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e cdcEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		events = append(events, e)
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
+
+func TestCDCPublish(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cdc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "changes.json")
+	Config.CDCFile = path
+	// Zero retries would never try to publish.
+	Config.MaxRetries = 1
+	defer func() {
+		Config.CDCFile, Config.MaxRetries = "", 0
+		cdc.sink, cdc.pending = nil, nil
+	}()
+
+	cdcRecord(10, []*pb.DirectedEdge{
+		{Entity: 1, Attr: "name", Value: []byte("alice"), Op: pb.DirectedEdge_SET},
+	})
+	cdcRecord(11, []*pb.DirectedEdge{
+		{Entity: 2, Attr: x.NamespaceAttr(3, "friend"), ValueId: 1, Op: pb.DirectedEdge_SET},
+	})
+	cdcRecord(12, []*pb.DirectedEdge{
+		{Entity: 1, Attr: "name", Value: []byte("bob"), Op: pb.DirectedEdge_SET},
+	})
+	// 12 is aborted, and 11 was committed after 10.
+	cdcPublish(1, &pb.OracleDelta{Txns: []*pb.TxnStatus{
+		{StartTs: 11, CommitTs: 14},
+		{StartTs: 12},
+		{StartTs: 10, CommitTs: 13},
+	}})
+
+	events := readCDCEvents(t, path)
+	require.Equal(t, []cdcEvent{
+		{CommitTs: 13, StartTs: 10, Group: 1, Op: "set", Uid: "0x1", Predicate: "name",
+			Value: "alice"},
+		{CommitTs: 14, StartTs: 11, Group: 1, Namespace: 3, Op: "set", Uid: "0x2",
+			Predicate: "friend", ObjectUid: "0x1"},
+	}, events)
+	require.Empty(t, cdc.pending)
+
+	// After a restart, the transactions replayed up to the checkpoint aren't published again.
+	cdc.sink, cdc.pending = nil, nil
+	cdcRecord(11, []*pb.DirectedEdge{
+		{Entity: 2, Attr: x.NamespaceAttr(3, "friend"), ValueId: 1, Op: pb.DirectedEdge_SET},
+	})
+	cdcRecord(15, []*pb.DirectedEdge{
+		{Entity: 2, Attr: "name", Value: []byte("carol"), Op: pb.DirectedEdge_DEL},
+	})
+	cdcPublish(1, &pb.OracleDelta{Txns: []*pb.TxnStatus{
+		{StartTs: 11, CommitTs: 14},
+		{StartTs: 15, CommitTs: 16},
+	}})
+	events = readCDCEvents(t, path)
+	require.Len(t, events, 3)
+	require.Equal(t, cdcEvent{CommitTs: 16, StartTs: 15, Group: 1, Op: "del", Uid: "0x2",
+		Predicate: "name", Value: "carol"}, events[2])
+}
@@ -15,7 +15,10 @@
  */
 package worker
 
-import "net"
+import (
+	"net"
+	"time"
+)
 
 type IPRange struct {
 	Lower, Upper net.IP
@@ -23,6 +26,7 @@ type IPRange struct {
 
 type Options struct {
 	ExportPath          string
+	WALDir              string
 	NumPendingProposals int
 	// TODO: Get rid of this here.
 	Tracing             float64
@@ -32,6 +36,38 @@ type Options struct {
 	ExpandEdge          bool
 	WhiteListedIPRanges []IPRange
 	MaxRetries          int
+	// SchemaRefresh allows the schema held in memory to be reloaded from disk through the
+	// RefreshSchema RPC.
+	SchemaRefresh bool
+	// SchemaFanout is the number of groups asked for their schema at the same time by a schema
+	// query. All of them are asked at once if it's zero.
+	SchemaFanout int
+	// SchemaTimeout bounds the time taken by another group to return its schema, unless the
+	// request already has a deadline. There's no bound if it's zero.
+	SchemaTimeout time.Duration
+	// SchemaRetries is the number of times a schema query failing with a transient error is
+	// retried, waiting SchemaRetryBackoff at first and twice as long every time after.
+	SchemaRetries      int
+	SchemaRetryBackoff time.Duration
+	// BackgroundIndexing builds the index added to an existing predicate in the background,
+	// without blocking the mutations applied meanwhile.
+	BackgroundIndexing bool
+	// LearnerOf is the group this server joins as a learner, a read-only replica which never
+	// votes nor becomes the leader. The server joins as a regular member if it's zero.
+	LearnerOf uint32
+	// CDCFile is the file the changes made by the transactions committed in the group are
+	// published to. There's no change data capture if it's empty.
+	CDCFile string
+	// InternalTLSDir holds the certificates used for mutual TLS with the other Alphas and the
+	// Zeros. The traffic between the nodes isn't encrypted if it's empty.
+	InternalTLSDir string
+	// Besides the rollup of all the posting lists every 5 minutes, a posting list is rolled up
+	// on its own once it has RollupDeltas deltas, or its first delta is RollupAge old, and all
+	// of them are once the store grew by RollupDiskGrowthMB since the last rollup. Each of
+	// them is disabled if it's zero.
+	RollupDeltas       int
+	RollupAge          time.Duration
+	RollupDiskGrowthMB int64
 }
 
 var Config Options
@@ -0,0 +1,196 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// maxViolations is the number of constraint violations reported for a predicate.
+const maxViolations = 10
+
+// constraintChecker collects the violations of the constraints declared by the schema of a
+// predicate, found while sampling its data and index.
+type constraintChecker struct {
+	attr       string
+	schema     pb.SchemaUpdate
+	readTs     uint64
+	samples    int
+	violations []string
+}
+
+func (c *constraintChecker) report(format string, args ...interface{}) {
+	if len(c.violations) < maxViolations {
+		c.violations = append(c.violations, fmt.Sprintf(format, args...))
+	}
+}
+
+// done returns whether sampling should stop, either because the budget ran out or because
+// enough violations were found.
+func (c *constraintChecker) done() bool {
+	return c.samples >= maxValueSamples || len(c.violations) >= maxViolations
+}
+
+// constraintViolations checks whether a sample of the data of the predicate satisfies its
+// schema: that the values are of its type, that there's at most one value per uid and language
+// unless it's a list, and that no two uids share a value if it has @unique. Only the first
+// maxValueSamples values and index entries are checked, in which case sampled is true.
+func constraintViolations(attr string) (violations []string, sampled bool, rerr error) {
+	su, ok := schema.State().Get(attr)
+	if !ok {
+		return nil, false, nil
+	}
+	c := &constraintChecker{
+		attr:   attr,
+		schema: su,
+		readTs: posting.Oracle().MaxAssigned(),
+	}
+	if err := c.checkData(); err != nil {
+		return nil, false, err
+	}
+	if err := c.checkUniqueness(); err != nil {
+		return nil, false, err
+	}
+	return c.violations, c.samples >= maxValueSamples, nil
+}
+
+// iterate calls fn with the key and the posting list of every key with the given prefix, until
+// the checker is done.
+func (c *constraintChecker) iterate(prefix []byte,
+	fn func(pk *x.ParsedKey, pl *posting.List) error) error {
+	txn := pstore.NewTransactionAt(c.readTs, false)
+	defer txn.Discard()
+
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.PrefetchValues = false
+	iterOpt.AllVersions = true
+	iterOpt.Prefix = prefix
+	itr := txn.NewIterator(iterOpt)
+	defer itr.Close()
+
+	var prevKey []byte
+	for itr.Seek(prefix); itr.ValidForPrefix(prefix) && !c.done(); {
+		item := itr.Item()
+		if bytes.Equal(item.Key(), prevKey) {
+			itr.Next()
+			continue
+		}
+		prevKey = append(prevKey[:0], item.Key()...)
+
+		// Parse the key upfront, otherwise ReadPostingList would advance the iterator.
+		pk := x.Parse(item.Key())
+		if pk == nil {
+			itr.Next()
+			continue
+		}
+		pl, err := posting.ReadPostingList(item.KeyCopy(nil), itr)
+		if err != nil {
+			return err
+		}
+		if err := fn(pk, pl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compatibleTypes returns whether a value stored with the given type satisfies the schema type.
+func compatibleTypes(stored, declared types.TypeID) bool {
+	isString := func(t types.TypeID) bool {
+		return t == types.StringID || t == types.DefaultID
+	}
+	return stored == declared || (isString(stored) && isString(declared))
+}
+
+func (c *constraintChecker) checkData() error {
+	typ := types.TypeID(c.schema.ValueType)
+	pk := x.ParsedKey{Attr: c.attr}
+	return c.iterate(pk.DataPrefix(), func(pk *x.ParsedKey, pl *posting.List) error {
+		var untagged int
+		err := pl.Iterate(c.readTs, 0, func(p *pb.Posting) error {
+			c.samples++
+			isEdge := p.PostingType == pb.Posting_REF
+			switch {
+			case typ == types.UidID && !isEdge:
+				c.report("Uid %#x has a value for a predicate of type uid", pk.Uid)
+			case typ != types.UidID && isEdge:
+				c.report("Uid %#x has an edge for a predicate of type %s", pk.Uid, typ.Name())
+			case !isEdge && !compatibleTypes(types.TypeID(p.ValType), typ):
+				c.report("Uid %#x has a value of type %s for a predicate of type %s", pk.Uid,
+					types.TypeID(p.ValType).Name(), typ.Name())
+			}
+			if p.PostingType == pb.Posting_VALUE {
+				untagged++
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if !c.schema.List && untagged > 1 {
+			c.report("Uid %#x has %d values for a predicate which isn't a list", pk.Uid, untagged)
+		}
+		return nil
+	})
+}
+
+// checkUniqueness looks for the index entries pointing to more than one uid, among the ones
+// made by tokenizers which keep the whole value. Two uids sharing such an entry share a value,
+// which @unique is meant to prevent.
+func (c *constraintChecker) checkUniqueness() error {
+	if !c.schema.Unique || !schema.State().IsIndexed(c.attr) {
+		return nil
+	}
+	identifiers := make(map[byte]string)
+	for _, t := range schema.State().Tokenizer(c.attr) {
+		if !t.IsLossy() {
+			identifiers[t.Identifier()] = t.Name()
+		}
+	}
+	if len(identifiers) == 0 {
+		return nil
+	}
+
+	pk := x.ParsedKey{Attr: c.attr}
+	return c.iterate(pk.IndexPrefix(), func(pk *x.ParsedKey, pl *posting.List) error {
+		c.samples++
+		if len(pk.Term) == 0 {
+			return nil
+		}
+		name, ok := identifiers[pk.Term[0]]
+		if !ok {
+			return nil
+		}
+		uids, err := pl.Uids(posting.ListOptions{ReadTs: c.readTs})
+		if err != nil {
+			return err
+		}
+		if len(uids.Uids) > 1 {
+			c.report("Uids %#x share a value of @unique predicate in its %s index",
+				uids.Uids, name)
+		}
+		return nil
+	})
+}
@@ -45,21 +45,27 @@ import (
 	"github.com/golang/glog"
 	"golang.org/x/net/context"
 	"golang.org/x/net/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type node struct {
 	*conn.Node
 
 	// Fields which are never changed after init.
-	applyCh  chan []*pb.Proposal
-	rollupCh chan uint64 // Channel to run posting list rollups.
-	ctx      context.Context
-	gid      uint32
-	closer   *y.Closer
+	applyCh     chan []*pb.Proposal
+	rollupCh    chan uint64   // Channel to run posting list rollups.
+	rollupNowCh chan struct{} // Channel to roll up all the posting lists right away.
+	ctx         context.Context
+	gid         uint32
+	closer      *y.Closer
 
 	lastCommitTs uint64 // Only used to ensure that our commit Ts is monotonically increasing.
 
 	streaming int32 // Used to avoid calculating snapshot
+	// dropIndex is the index of the last proposal changing the data without writing new versions.
+	// The followers whose snapshot precedes it can't be sent only the keys changed since.
+	dropIndex uint64
 
 	canCampaign bool
 	elog        trace.EventLog
@@ -72,9 +78,10 @@ func newNode(store *raftwal.DiskStorage, gid uint32, id uint64, myAddr string) *
 	glog.Infof("Node ID: %v with GroupID: %v\n", id, gid)
 
 	rc := &pb.RaftContext{
-		Addr:  myAddr,
-		Group: gid,
-		Id:    id,
+		Addr:      myAddr,
+		Group:     gid,
+		Id:        id,
+		IsLearner: Config.LearnerOf != 0,
 	}
 	m := conn.NewNode(rc, store)
 
@@ -85,10 +92,11 @@ func newNode(store *raftwal.DiskStorage, gid uint32, id uint64, myAddr string) *
 		// We need a generous size for applyCh, because raft.Tick happens every
 		// 10ms. If we restrict the size here, then Raft goes into a loop trying
 		// to maintain quorum health.
-		applyCh:  make(chan []*pb.Proposal, 1000),
-		rollupCh: make(chan uint64, 3),
-		elog:     trace.NewEventLog("Dgraph", "ApplyCh"),
-		closer:   y.NewCloser(3), // Matches CLOSER:1
+		applyCh:     make(chan []*pb.Proposal, 1000),
+		rollupCh:    make(chan uint64, 3),
+		rollupNowCh: make(chan struct{}, 1),
+		elog:        trace.NewEventLog("Dgraph", "ApplyCh"),
+		closer:      y.NewCloser(3), // Matches CLOSER:1
 	}
 	return n
 }
@@ -162,17 +170,62 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) error
 	span := otrace.FromContext(ctx)
 
 	if proposal.Mutations.DropAll {
+		// The indexes being built would be written after everything was deleted.
+		if err := waitForIndexes(ctx); err != nil {
+			return err
+		}
 		// Ensures nothing get written to disk due to commit proposals.
 		posting.Oracle().ResetTxns()
 		schema.State().DeleteAll()
 		return posting.DeleteAll()
 	}
+	if proposal.Mutations.DropData {
+		// Unlike DropAll, the schema and the types are kept, and so are the tablets in Zero.
+		if err := waitForIndexes(ctx); err != nil {
+			return err
+		}
+		posting.Oracle().ResetTxns()
+		return posting.DeleteData()
+	}
+	if preds := proposal.Mutations.DropDataPreds; len(preds) > 0 {
+		if err := waitForIndexes(ctx, preds...); err != nil {
+			return err
+		}
+		for _, attr := range preds {
+			if tablet := groups().Tablet(attr); tablet != nil && tablet.ReadOnly {
+				return errPredicateMoving
+			}
+			if err := detectPendingTxns(attr); err != nil {
+				span.Annotatef(nil, "Found pending transactions. Retry later.")
+				return err
+			}
+		}
+		for _, attr := range preds {
+			span.Annotatef(nil, "Deleting the data of predicate: %s", attr)
+			if err := posting.DeletePredicateData(attr); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 
 	if proposal.Mutations.StartTs == 0 {
 		return errors.New("StartTs must be provided.")
 	}
 	startTs := proposal.Mutations.StartTs
 
+	if len(proposal.Mutations.Types) > 0 {
+		span.Annotatef(nil, "Applying types")
+		for _, t := range proposal.Mutations.Types {
+			if err := updateType(t.TypeName, *t); err != nil {
+				return err
+			}
+		}
+		if len(proposal.Mutations.Schema) == 0 {
+			return nil
+		}
+	}
+
 	if len(proposal.Mutations.Schema) > 0 {
 		span.Annotatef(nil, "Applying schema")
 		for _, supdate := range proposal.Mutations.Schema {
@@ -232,6 +285,9 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) error
 	total := len(proposal.Mutations.Edges)
 	x.ActiveMutations.Add(int64(total))
 	defer x.ActiveMutations.Add(-int64(total))
+	for _, edge := range proposal.Mutations.Edges {
+		x.PredicateMutationEdges.Add(edge.Attr, 1)
+	}
 
 	for attr, storageType := range schemaMap {
 		if _, err := schema.State().TypeOf(attr); err != nil {
@@ -267,28 +323,64 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) error
 	if retries > 0 {
 		span.Annotatef(nil, "retries=true num=%d", retries)
 	}
+	cdcRecord(m.StartTs, m.Edges)
 	return nil
 }
 
+// recordProposalErrors counts a failure to apply the mutations against every predicate they
+// touch. Conflicts, predicate moves and values rejected by @unique happen as part of normal
+// operation, so they aren't counted.
+func recordProposalErrors(m *pb.Mutations, err error) {
+	if err == dy.ErrConflict || err == errPredicateMoving ||
+		status.Code(err) == codes.AlreadyExists {
+		return
+	}
+	preds := make(map[string]struct{})
+	for _, supdate := range m.Schema {
+		preds[supdate.Predicate] = struct{}{}
+	}
+	for _, edge := range m.Edges {
+		preds[edge.Attr] = struct{}{}
+	}
+	for attr := range preds {
+		pstats.recordProposalError(attr)
+	}
+}
+
 func (n *node) applyCommitted(proposal *pb.Proposal) error {
 	ctx := n.Ctx(proposal.Key)
 	span := otrace.FromContext(ctx)
 	span.Annotatef(nil, "node.applyCommitted Node id: %d. Group id: %d. Got proposal key: %s",
 		n.Id, n.gid, proposal.Key)
+	// The versions of the schema changes made by the proposal are derived from its index.
+	schema.State().Applying(proposal.Index)
 
 	if proposal.Mutations != nil {
+		if dropsData(proposal.Mutations) {
+			n.markDrop(proposal.Index)
+		}
 		// syncmarks for this shouldn't be marked done until it's comitted.
 		span.Annotate(nil, "Applying mutations")
 		if err := n.applyMutations(ctx, proposal); err != nil {
 			span.Annotatef(nil, "While applying mutations: %v", err)
+			recordProposalErrors(proposal.Mutations, err)
+			if proposal.Mutations.Ludicrous {
+				// Nobody else would abort the transaction.
+				_ = n.commitLudicrous(proposal.Mutations.StartTs, 0)
+			}
 			return err
 		}
 		span.Annotate(nil, "Done")
+		if proposal.Mutations.Ludicrous {
+			return n.commitLudicrous(proposal.Mutations.StartTs, proposal.Mutations.StartTs)
+		}
 		return nil
 	}
 
 	switch {
 	case len(proposal.Kv) > 0:
+		// The keys of a predicate moved in keep their versions.
+		n.markDrop(proposal.Index)
 		return populateKeyValues(ctx, proposal.Kv)
 
 	case proposal.State != nil:
@@ -299,6 +391,7 @@ func (n *node) applyCommitted(proposal *pb.Proposal) error {
 		return nil
 
 	case len(proposal.CleanPredicate) > 0:
+		n.markDrop(proposal.Index)
 		n.elog.Printf("Cleaning predicate: %s", proposal.CleanPredicate)
 		return posting.DeletePredicate(ctx, proposal.CleanPredicate)
 
@@ -340,33 +433,105 @@ func (n *node) applyCommitted(proposal *pb.Proposal) error {
 	return nil
 }
 
+// The triggers of the rollups, as labeled in the dgraph_rollups_total metric. All of them roll up
+// all the posting lists, but rollupDeltas, which only rolls up the ones with many or old deltas.
+const (
+	rollupPeriodic = "periodic"
+	rollupDisk     = "disk"
+	rollupManual   = "manual"
+	rollupDeltas   = "deltas"
+)
+
+// storeSize returns the size of the LSM tree and the value log of the store.
+func storeSize() int64 {
+	lsm, vlog := pstore.Size()
+	return lsm + vlog
+}
+
 func (n *node) processRollups() {
 	defer n.closer.Done()                   // CLOSER:1
 	tick := time.NewTicker(5 * time.Minute) // Rolling up once every 5 minutes seems alright.
 	defer tick.Stop()
+	// The deltas and the size of the store are checked more often, to roll up the posting lists
+	// mutated the most before the next rollup.
+	check := time.NewTicker(10 * time.Second)
+	defer check.Stop()
 
+	// All the rollups happen at the read ts of the last snapshot.
 	var readTs, last uint64
+	lastSize := storeSize()
+	rollupAll := func(trigger string) {
+		if readTs == 0 {
+			glog.Infof("Skipping the %s rollup, as no snapshot was taken yet.", trigger)
+			return
+		}
+		if err := n.rollupLists(readTs); err != nil {
+			// If we encounter error here, we don't need to do anything about
+			// it. Just let the user know.
+			glog.Errorf("Error while rolling up lists at %d: %v\n", readTs, err)
+			return
+		}
+		last = readTs // Update last only if we succeeded.
+		lastSize = storeSize()
+		posting.RolledUpAll(readTs)
+		x.Rollups.Add(trigger, 1)
+		glog.Infof("List rollup at Ts %d: OK.\n", readTs)
+	}
+
 	for {
 		select {
 		case <-n.closer.HasBeenClosed():
 			return
 		case readTs = <-n.rollupCh:
+		case <-n.rollupNowCh:
+			rollupAll(rollupManual)
 		case <-tick.C:
 			if readTs <= last {
-				break // Break out of the select case.
+				break
 			}
-			if err := n.rollupLists(readTs); err != nil {
-				// If we encounter error here, we don't need to do anything about
-				// it. Just let the user know.
-				glog.Errorf("Error while rolling up lists at %d: %v\n", readTs, err)
-			} else {
-				last = readTs // Update last only if we succeeded.
-				glog.Infof("List rollup at Ts %d: OK.\n", readTs)
+			rollupAll(rollupPeriodic)
+		case <-check.C:
+			if readTs == 0 {
+				break
+			}
+			// The deltas of the keys not tracked are only rolled up along with all the others.
+			growth := Config.RollupDiskGrowthMB << 20
+			if readTs > last &&
+				((growth > 0 && storeSize()-lastSize >= growth) || posting.DeltasOverflow()) {
+				rollupAll(rollupDisk)
+				break
+			}
+			keys := withoutPendingIndexes(
+				posting.DeltasDue(readTs, Config.RollupDeltas, Config.RollupAge))
+			if len(keys) == 0 {
+				break
+			}
+			if err := posting.RollupKeys(keys, readTs); err != nil {
+				glog.Errorf("Error while rolling up %d lists at %d: %v\n", len(keys), readTs, err)
+				break
 			}
+			x.Rollups.Add(rollupDeltas, 1)
+			glog.V(2).Infof("Rolled up %d lists with many or old deltas at Ts %d.", len(keys),
+				readTs)
 		}
 	}
 }
 
+// TriggerRollup rolls up all the posting lists of this server in the background, at the read
+// ts of the last snapshot.
+func TriggerRollup() error {
+	g := groups()
+	if g == nil || g.Node == nil {
+		return x.Errorf("This server hasn't joined a group yet")
+	}
+	select {
+	case g.Node.rollupNowCh <- struct{}{}:
+		return nil
+	default:
+		return x.Errorf("A rollup is already pending")
+	}
+}
+
 func (n *node) processApplyCh() {
 	defer n.closer.Done() // CLOSER:1
 
@@ -435,6 +600,19 @@ func (n *node) processApplyCh() {
 }
 
 func (n *node) commitOrAbort(pkey string, delta *pb.OracleDelta) error {
+	for _, status := range delta.Txns {
+		if status.CommitTs > 0 && status.CommitTs < n.lastCommitTs {
+			glog.Errorf("Lastcommit %d > current %d. This would cause some commits to be lost.",
+				n.lastCommitTs, status.CommitTs)
+		}
+		n.lastCommitTs = status.CommitTs
+	}
+	return n.applyTxnStatus(delta)
+}
+
+// applyTxnStatus writes the mutations of the committed transactions to disk and memory, and drops
+// the ones of the aborted transactions.
+func (n *node) applyTxnStatus(delta *pb.OracleDelta) error {
 	// First let's commit all mutations to disk.
 	writer := x.NewTxnWriter(pstore)
 	toDisk := func(start, commit uint64) {
@@ -453,12 +631,7 @@ func (n *node) commitOrAbort(pkey string, delta *pb.OracleDelta) error {
 	}
 
 	for _, status := range delta.Txns {
-		if status.CommitTs > 0 && status.CommitTs < n.lastCommitTs {
-			glog.Errorf("Lastcommit %d > current %d. This would cause some commits to be lost.",
-				n.lastCommitTs, status.CommitTs)
-		}
 		toDisk(status.StartTs, status.CommitTs)
-		n.lastCommitTs = status.CommitTs
 	}
 	if err := writer.Flush(); err != nil {
 		x.Errorf("Error while flushing to disk: %v", err)
@@ -485,9 +658,18 @@ func (n *node) commitOrAbort(pkey string, delta *pb.OracleDelta) error {
 	}
 	// Now advance Oracle(), so we can service waiting reads.
 	posting.Oracle().ProcessDelta(delta)
+	cdcPublish(n.gid, delta)
 	return nil
 }
 
+// commitLudicrous commits the transaction of ludicrous mutations at commitTs, or aborts it if
+// commitTs is zero, without involving Zero.
+func (n *node) commitLudicrous(startTs, commitTs uint64) error {
+	return n.applyTxnStatus(&pb.OracleDelta{
+		Txns: []*pb.TxnStatus{{StartTs: startTs, CommitTs: commitTs}},
+	})
+}
+
 func (n *node) applyAllMarks(ctx context.Context) {
 	// Get index of last committed.
 	lastIndex := n.Applied.LastIndex()
@@ -562,11 +744,15 @@ func (n *node) retrieveSnapshot(snap pb.Snapshot) error {
 	if _, err := n.populateSnapshot(snap, pstore, pool); err != nil {
 		return fmt.Errorf("Cannot retrieve snapshot from peer, error: %v\n", err)
 	}
+	// The drops before the snapshot weren't applied by this node.
+	n.markDrop(snap.DropIndex)
 	// Populate shard stores the streamed data directly into db, so we need to refresh
 	// schema for current group id
 	if err := schema.LoadFromDb(); err != nil {
 		return fmt.Errorf("Error while initilizating schema: %+v\n", err)
 	}
+	// The indexes the peer was building in the background are built here too.
+	n.resumeIndexBuilds()
 	groups().triggerMembershipSync()
 	return nil
 }
@@ -811,10 +997,15 @@ func (n *node) rollupLists(readTs uint64) error {
 	sl := stream.Lists{Stream: writer, DB: pstore}
 	sl.ChooseKeyFunc = func(item *badger.Item) bool {
 		pk := x.Parse(item.Key())
-		if pk.IsSchema() {
+		if pk.IsSchema() || pk.IsTypeDef() {
 			// Skip if schema.
 			return false
 		}
+		if pk.IsIndex() && indexPending(pk.Attr) {
+			// A rollup would hide the posting lists written at an older timestamp by the index
+			// being built in the background.
+			return false
+		}
 		// Return true if we don't find the BitCompletePosting bit.
 		return item.UserMeta()&posting.BitCompletePosting == 0
 	}
@@ -845,11 +1036,28 @@ func (n *node) rollupLists(readTs uint64) error {
 	}
 	glog.Infoln("Rollup in LRU cache done.")
 
-	// We can now discard all invalid versions of keys below this ts.
-	pstore.SetDiscardTs(readTs)
+	// We can now discard all invalid versions of keys below this ts, except for the ones the
+	// indexes being built in the background are written at.
+	discardTs := readTs
+	if ts := minPendingIndexTs(); ts > 0 && ts <= discardTs {
+		discardTs = ts - 1
+	}
+	pstore.SetDiscardTs(discardTs)
 	return nil
 }
 
+// withoutPendingIndexes returns the keys but those of the indexes being built in the background,
+// which mustn't be rolled up until they're built.
+func withoutPendingIndexes(keys []string) []string {
+	out := keys[:0]
+	for _, key := range keys {
+		if pk := x.Parse([]byte(key)); pk == nil || !pk.IsIndex() || !indexPending(pk.Attr) {
+			out = append(out, key)
+		}
+	}
+	return out
+}
+
 var errNoConnection = errors.New("No connection exists")
 
 func (n *node) blockingAbort(req *pb.TxnTimestamps) error {
@@ -939,7 +1147,6 @@ func (n *node) calculateSnapshot(discardN int) (*pb.Snapshot, error) {
 		span.Annotate(nil, "Skipping calculateSnapshot due to streaming")
 		return nil, nil
 	}
-
 	first, err := n.Store.FirstIndex()
 	if err != nil {
 		span.Annotatef(nil, "Error: %v", err)
@@ -1019,9 +1226,10 @@ func (n *node) calculateSnapshot(discardN int) (*pb.Snapshot, error) {
 	}
 
 	snap := &pb.Snapshot{
-		Context: n.RaftContext,
-		Index:   snapshotIdx,
-		ReadTs:  maxCommitTs,
+		Context:   n.RaftContext,
+		Index:     snapshotIdx,
+		ReadTs:    maxCommitTs,
+		DropIndex: atomic.LoadUint64(&n.dropIndex),
 	}
 	span.Annotatef(nil, "Got snapshot: %+v", snap)
 	return snap, nil
@@ -1102,11 +1310,18 @@ func (n *node) InitAndStartNode() {
 			// zero-member Raft group.
 			n.SetConfState(&sp.Metadata.ConfState)
 
+			// The drops after the snapshot are marked again as the Raft logs are replayed.
+			var snap pb.Snapshot
+			x.Check(snap.Unmarshal(sp.Data))
+			n.markDrop(snap.DropIndex)
+
 			members := groups().members(n.gid)
-			for _, id := range sp.Metadata.ConfState.Nodes {
-				m, ok := members[id]
-				if ok {
-					n.Connect(id, m.Addr)
+			for _, ids := range [][]uint64{sp.Metadata.ConfState.Nodes,
+				sp.Metadata.ConfState.Learners} {
+				for _, id := range ids {
+					if m, ok := members[id]; ok {
+						n.Connect(id, m.Addr)
+					}
 				}
 			}
 		}
@@ -1125,6 +1340,8 @@ func (n *node) InitAndStartNode() {
 			n.retryUntilSuccess(n.joinPeers, time.Second)
 			n.SetRaft(raft.StartNode(n.Cfg, nil))
 		} else {
+			// Zero only assigns learners to groups which already have members.
+			x.AssertTruef(!n.RaftContext.IsLearner, "Learner can't start group %d", n.gid)
 			peers := []raft.Peer{{ID: n.Id}}
 			n.SetRaft(raft.StartNode(n.Cfg, peers))
 			// Trigger election, so this node can become the leader of this single-node cluster.
@@ -61,7 +61,7 @@ func TestCalculateSnapshot(t *testing.T) {
 
 	db, err := openBadger(dir)
 	require.NoError(t, err)
-	ds := raftwal.Init(db, 0, 0)
+	ds := raftwal.Init(db, dir, 0, 0)
 
 	n := newNode(ds, 1, 1, "")
 	var entries []raftpb.Entry
@@ -124,3 +124,19 @@ func TestCalculateSnapshot(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, snap)
 }
+
+func TestDropsData(t *testing.T) {
+	require.True(t, dropsData(&pb.Mutations{DropAll: true}))
+	require.True(t, dropsData(&pb.Mutations{DropData: true}))
+	require.True(t, dropsData(&pb.Mutations{DropDataPreds: []string{"name"}}))
+	require.True(t, dropsData(&pb.Mutations{Schema: []*pb.SchemaUpdate{{Predicate: "name"}}}))
+	require.True(t, dropsData(&pb.Mutations{Edges: []*pb.DirectedEdge{
+		{Attr: "name", Value: []byte(x.Star), Op: pb.DirectedEdge_DEL}}}))
+	require.False(t, dropsData(&pb.Mutations{Edges: []*pb.DirectedEdge{
+		{Entity: 1, Attr: "name", Value: []byte(x.Star), Op: pb.DirectedEdge_DEL}}}))
+
+	n := &node{}
+	n.markDrop(5)
+	n.markDrop(3)
+	require.Equal(t, uint64(5), n.dropIndex)
+}
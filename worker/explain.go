@@ -0,0 +1,151 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"golang.org/x/net/context"
+)
+
+// QueryPlan collects the plan of a query asking to explain it: how each of its tasks was run,
+// and how the results of its filters were combined.
+type QueryPlan struct {
+	mu      sync.Mutex
+	Tasks   []*pb.TaskPlan `json:"tasks"`
+	Filters []*FilterPlan  `json:"filters,omitempty"`
+}
+
+// FilterPlan describes how the results of the filters of a block were combined with the uids
+// of the block.
+type FilterPlan struct {
+	Attr       string `json:"attr,omitempty"`
+	Alias      string `json:"alias,omitempty"`
+	Op         string `json:"op"` // "intersect", "merge" or "difference".
+	NumFilters int    `json:"num_filters"`
+	NumUidsIn  int    `json:"num_uids_in"`
+	NumUids    int    `json:"num_uids"`
+}
+
+type queryPlanKey struct{}
+
+// WithQueryPlan returns a context making the query run with it collect its plan in the
+// returned QueryPlan.
+func WithQueryPlan(ctx context.Context) (context.Context, *QueryPlan) {
+	plan := &QueryPlan{}
+	return context.WithValue(ctx, queryPlanKey{}, plan), plan
+}
+
+// QueryPlanFrom returns the QueryPlan the query run with ctx collects its plan in, or nil if
+// the query doesn't explain its plan.
+func QueryPlanFrom(ctx context.Context) *QueryPlan {
+	plan, _ := ctx.Value(queryPlanKey{}).(*QueryPlan)
+	return plan
+}
+
+func (p *QueryPlan) addTask(tp *pb.TaskPlan) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.Tasks = append(p.Tasks, tp)
+}
+
+// addResult records the plan of the task returned with its result, by the server of group gid
+// if not local, for the task started at start.
+func (p *QueryPlan) addResult(reply *pb.Result, gid uint32, local bool, start time.Time) {
+	if reply.Plan == nil {
+		// The server running the task doesn't explain its plans.
+		return
+	}
+	reply.Plan.GroupId = gid
+	reply.Plan.Local = local
+	reply.Plan.TotalNs = uint64(time.Since(start))
+	p.addTask(reply.Plan)
+}
+
+// AddFilter records how the results of the filters of a block were combined.
+func (p *QueryPlan) AddFilter(fp *FilterPlan) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.Filters = append(p.Filters, fp)
+}
+
+// planTask describes how the task of q is run with srcFn, before it's run.
+func planTask(q *pb.Query, srcFn *functionContext, needsValPostings bool) *pb.TaskPlan {
+	tp := &pb.TaskPlan{Attr: q.Attr, Reverse: q.Reverse}
+	if q.SrcFunc != nil {
+		tp.Func = q.SrcFunc.Name
+	}
+	if q.UidList != nil {
+		tp.NumSrcUids = uint64(len(q.UidList.Uids))
+	}
+
+	switch {
+	case needsValPostings:
+		// The values of the source uids are read, and compared if there is a function.
+		tp.Strategy = "values"
+	case srcFn.fnType == NotAFunction:
+		tp.Strategy = "uids"
+	case srcFn.fnType == HasFn && srcFn.isFuncAtRoot:
+		// Every posting list of the predicate is read.
+		tp.Strategy = "scan"
+	case srcFn.fnType == CompareScalarFn && (srcFn.isFuncAtRoot || srcFn.isCountIndexed):
+		tp.Strategy = "count_index"
+	case needsIndex(srcFn.fnType) || srcFn.fnType == CustomIndexFn:
+		tp.Strategy = "index"
+		tp.Tokenizers = schema.State().TokenizerNames(q.Attr)
+		if len(srcFn.tokens) > 1 {
+			tp.Combine = "merge"
+			if srcFn.intersectDest {
+				tp.Combine = "intersect"
+			}
+		}
+	default:
+		// The posting lists of the source uids are read.
+		tp.Strategy = "postings"
+	}
+	tp.Intersect = srcFn.fnType != NotAFunction && q.UidList != nil && len(q.UidList.Uids) > 0
+
+	switch {
+	case srcFn.fnType == RegexFn, srcFn.fnType == MatchFn:
+		tp.Checks = append(tp.Checks, "match_values")
+	case srcFn.fnType == CompareAttrFn && len(srcFn.tokens) > 0:
+		tp.Checks = append(tp.Checks, "compare_values")
+	case srcFn.geoQuery != nil:
+		tp.Checks = append(tp.Checks, "check_geometries")
+	case srcFn.fnType == NearestFn:
+		tp.Checks = append(tp.Checks, "rank_distances")
+	case srcFn.fnType == BM25Fn:
+		tp.Checks = append(tp.Checks, "score_terms")
+	}
+	if needsStringFiltering(srcFn, q.Langs, q.Attr) {
+		tp.Checks = append(tp.Checks, "filter_languages")
+	}
+	return tp
+}
+
+// countResults sets the number of uids and values found by the task in its plan.
+func countResults(tp *pb.TaskPlan, out *pb.Result) {
+	for _, l := range out.UidMatrix {
+		tp.NumUids += uint64(len(l.Uids))
+	}
+	for _, vl := range out.ValueMatrix {
+		tp.NumValues += uint64(len(vl.Values))
+	}
+}
@@ -20,20 +20,25 @@ import (
 	"bufio"
 	"bytes"
 	"compress/gzip"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/golang/glog"
+	geom "github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/geojson"
 	"golang.org/x/net/context"
 
 	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/storage"
 	"github.com/dgraph-io/dgraph/stream"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
@@ -139,6 +144,98 @@ func toRDF(pl *posting.List, prefix string, readTs uint64) (*pb.KV, error) {
 	return kv, err
 }
 
+// toJSON returns the postings of pl as JSON objects, one per posting and line, in the format of
+// the JSON mutations. The facets of an edge are set on the object it points to, and the ones of
+// a value on the object holding it.
+func toJSON(pl *posting.List, uid uint64, attr string, readTs uint64) (*pb.KV, error) {
+	var buf bytes.Buffer
+	subject := fmt.Sprintf("_:uid%x", uid)
+
+	err := pl.Iterate(readTs, 0, func(p *pb.Posting) error {
+		obj := map[string]interface{}{"uid": subject}
+		if p.PostingType == pb.Posting_REF {
+			object := map[string]interface{}{"uid": fmt.Sprintf("_:uid%x", p.Uid)}
+			addJSONFacets(object, attr, p.Facets)
+			obj[attr] = object
+
+		} else {
+			val, err := toJSONValue(p)
+			if err != nil {
+				glog.Errorf("While converting value of %s to JSON. Err=%v. Ignoring.\n",
+					attr, err)
+				return nil
+			}
+			pred := attr
+			if p.PostingType == pb.Posting_VALUE_LANG {
+				pred = attr + "@" + string(p.LangTag)
+			}
+			obj[pred] = val
+			addJSONFacets(obj, pred, p.Facets)
+		}
+
+		b, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if buf.Len() > 0 {
+			buf.WriteString(",\n")
+		}
+		buf.Write(b)
+		return nil
+	})
+	kv := &pb.KV{
+		Val:     buf.Bytes(),
+		Version: 1, // Data value.
+	}
+	return kv, err
+}
+
+// toJSONValue returns the value of the posting as the JSON value read back into the same type.
+func toJSONValue(p *pb.Posting) (interface{}, error) {
+	vID := types.TypeID(p.ValType)
+	src := types.ValueForType(vID)
+	src.Value = p.Value
+
+	switch vID {
+	case types.IntID, types.FloatID, types.BoolID:
+		val, err := types.Convert(src, vID)
+		if err != nil {
+			return nil, err
+		}
+		return val.Value, nil
+	case types.GeoID:
+		val, err := types.Convert(src, types.GeoID)
+		if err != nil {
+			return nil, err
+		}
+		b, err := geojson.Marshal(val.Value.(geom.T))
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(b), nil
+	default:
+		str, err := types.Convert(src, types.StringID)
+		if err != nil {
+			return nil, err
+		}
+		// trim null character at end
+		return strings.TrimRight(str.Value.(string), "\x00"), nil
+	}
+}
+
+// addJSONFacets sets the facets of the edge of pred on obj, under the keys pred|facet.
+func addJSONFacets(obj map[string]interface{}, pred string, fcs []*api.Facet) {
+	for _, f := range fcs {
+		fVal, err := facets.ValFor(f)
+		if err != nil {
+			glog.Errorf("Error getting value from facet %#v:%v", f, err)
+			continue
+		}
+		// Same as query.FacetDelimeter, which can't be imported here.
+		obj[pred+"|"+f.Key] = fVal.Value
+	}
+}
+
 func toSchema(attr string, update pb.SchemaUpdate) (*pb.KV, error) {
 	// bytes.Buffer never returns error for any of the writes. So, we don't need to check them.
 	var buf bytes.Buffer
@@ -173,6 +270,9 @@ func toSchema(attr string, update pb.SchemaUpdate) (*pb.KV, error) {
 	if update.Upsert {
 		buf.WriteString(" @upsert")
 	}
+	if update.Unique {
+		buf.WriteString(" @unique")
+	}
 	buf.WriteString(" . \n")
 	kv := &pb.KV{
 		Val:     buf.Bytes(),
@@ -181,20 +281,38 @@ func toSchema(attr string, update pb.SchemaUpdate) (*pb.KV, error) {
 	return kv, nil
 }
 
+func toType(typeName string, update pb.TypeUpdate) (*pb.KV, error) {
+	var buf bytes.Buffer
+	buf.WriteString("type ")
+	buf.WriteString(typeName)
+	buf.WriteString(" {\n")
+	for _, field := range update.Fields {
+		buf.WriteString("  ")
+		buf.WriteString(field)
+		buf.WriteByte('\n')
+	}
+	buf.WriteString("}\n")
+	kv := &pb.KV{
+		Val:     buf.Bytes(),
+		Version: 2, // Schema value
+	}
+	return kv, nil
+}
+
 type fileWriter struct {
-	fd *os.File
+	w  io.WriteCloser
 	bw *bufio.Writer
 	gw *gzip.Writer
 }
 
-func (writer *fileWriter) open(fpath string) error {
+func (writer *fileWriter) open(st storage.Storage, name string) error {
 	var err error
-	writer.fd, err = os.Create(fpath)
+	writer.w, err = st.Create(name)
 	if err != nil {
 		return err
 	}
 
-	writer.bw = bufio.NewWriterSize(writer.fd, 1e6)
+	writer.bw = bufio.NewWriterSize(writer.w, 1e6)
 	writer.gw, err = gzip.NewWriterLevel(writer.bw, gzip.BestCompression)
 	return err
 }
@@ -209,15 +327,15 @@ func (writer *fileWriter) Close() error {
 	if err := writer.bw.Flush(); err != nil {
 		return err
 	}
-	if err := writer.fd.Sync(); err != nil {
-		return err
-	}
-	return writer.fd.Close()
+	return writer.w.Close()
 }
 
 type writerMux struct {
 	data   *fileWriter
 	schema *fileWriter
+	// The data is written as a JSON array of objects, instead of RDF.
+	json     bool
+	numItems int
 }
 
 func (mux *writerMux) Send(kvs *pb.KVS) error {
@@ -226,6 +344,19 @@ func (mux *writerMux) Send(kvs *pb.KVS) error {
 		switch kv.Version {
 		case 1: // data
 			writer = mux.data
+			if len(kv.Val) == 0 {
+				continue
+			}
+			if mux.json {
+				sep := ",\n"
+				if mux.numItems == 0 {
+					sep = "[\n"
+				}
+				if _, err := writer.gw.Write([]byte(sep)); err != nil {
+					return err
+				}
+				mux.numItems++
+			}
 		case 2: // schema
 			writer = mux.schema
 		default:
@@ -239,12 +370,32 @@ func (mux *writerMux) Send(kvs *pb.KVS) error {
 	return nil
 }
 
-// export creates a export of data by exporting it as an RDF gzip.
+// finish ends the JSON array of the data.
+func (mux *writerMux) finish() error {
+	if !mux.json {
+		return nil
+	}
+	end := "\n]\n"
+	if mux.numItems == 0 {
+		end = "[]\n"
+	}
+	_, err := mux.data.gw.Write([]byte(end))
+	return err
+}
+
+// export creates a export of data by exporting it as an RDF or JSON gzip.
 func export(ctx context.Context, in *pb.ExportRequest) error {
 	if in.GroupId != groups().groupId() {
 		return x.Errorf("Export request group mismatch. Mine: %d. Requested: %d\n",
 			groups().groupId(), in.GroupId)
 	}
+	format := in.Format
+	if format == "" {
+		format = "rdf"
+	}
+	if format != "rdf" && format != "json" {
+		return x.Errorf("Invalid export format: %q. Must be rdf or json.", in.Format)
+	}
 	glog.Infof("Export requested at %d.", in.ReadTs)
 
 	// Let's wait for this server to catch up to all the updates until this ts.
@@ -253,49 +404,59 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 	}
 	glog.Infof("Running export for group %d at timestamp %d.", in.GroupId, in.ReadTs)
 
-	uts := time.Unix(in.UnixTs, 0)
-	bdir := path.Join(Config.ExportPath, fmt.Sprintf(
-		"dgraph.r%d.u%s", in.ReadTs, uts.UTC().Format("0102.1504")))
-
-	if err := os.MkdirAll(bdir, 0700); err != nil {
+	destination := in.Destination
+	if len(destination) == 0 {
+		destination = Config.ExportPath
+		if err := os.MkdirAll(destination, 0700); err != nil {
+			return err
+		}
+	}
+	st, err := storage.New(destination)
+	if err != nil {
 		return err
 	}
-	path := func(suffix string) (string, error) {
-		return filepath.Abs(path.Join(bdir, fmt.Sprintf("g%02d.%s", in.GroupId, suffix)))
+
+	uts := time.Unix(in.UnixTs, 0)
+	bdir := fmt.Sprintf("dgraph.r%d.u%s", in.ReadTs, uts.UTC().Format("0102.1504"))
+	path := func(suffix string) string {
+		return path.Join(bdir, fmt.Sprintf("g%02d.%s", in.GroupId, suffix))
 	}
 
 	// Open data file now.
-	dataPath, err := path("rdf.gz")
-	if err != nil {
-		return err
-	}
-	glog.Infof("Exporting data for group: %d at %s\n", in.GroupId, dataPath)
+	dataPath := path(format + ".gz")
+	glog.Infof("Exporting data for group: %d at %s in %s\n", in.GroupId, dataPath, destination)
 	dataWriter := &fileWriter{}
-	if err := dataWriter.open(dataPath); err != nil {
+	if err := dataWriter.open(st, dataPath); err != nil {
 		return err
 	}
 
 	// Open schema file now.
-	schemaPath, err := path("schema.gz")
-	if err != nil {
-		return err
-	}
-	glog.Infof("Exporting schema for group: %d at %s\n", in.GroupId, schemaPath)
+	schemaPath := path("schema.gz")
+	glog.Infof("Exporting schema for group: %d at %s in %s\n", in.GroupId, schemaPath,
+		destination)
 	schemaWriter := &fileWriter{}
-	if err := schemaWriter.open(schemaPath); err != nil {
+	if err := schemaWriter.open(st, schemaPath); err != nil {
 		return err
 	}
 
-	mux := writerMux{data: dataWriter, schema: schemaWriter}
+	mux := writerMux{data: dataWriter, schema: schemaWriter, json: format == "json"}
 	sl := stream.Lists{Stream: &mux, DB: pstore}
 	sl.ChooseKeyFunc = func(item *badger.Item) bool {
 		pk := x.Parse(item.Key())
 		if pk.Attr == "_predicate_" {
 			return false
 		}
+		if pk.IsTypeDef() {
+			// Every group holds all the types, only the first one exports them.
+			ns, _ := x.ParseNamespaceAttr(pk.Attr)
+			return in.GroupId == 1 && (in.AllNamespaces || ns == in.Namespace)
+		}
 		if !groups().ServesTablet(pk.Attr) {
 			return false
 		}
+		if ns, _ := x.ParseNamespaceAttr(pk.Attr); !in.AllNamespaces && ns != in.Namespace {
+			return false
+		}
 		// We need to ensure that schema keys are separately identifiable, so they can be
 		// written to a different file.
 		return pk.IsData() || pk.IsSchema()
@@ -303,6 +464,12 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 	sl.ItemToKVFunc = func(key []byte, itr *badger.Iterator) (*pb.KV, error) {
 		item := itr.Item()
 		pk := x.Parse(item.Key())
+		// The predicates of a single namespace are exported under their name in it, so that
+		// they can be loaded into any namespace.
+		attr := pk.Attr
+		if !in.AllNamespaces {
+			_, attr = x.ParseNamespaceAttr(attr)
+		}
 
 		switch {
 		case pk.IsSchema():
@@ -316,14 +483,33 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 				glog.Errorf("Unable to unmarshal schema: %+v. Err=%v\n", pk, err)
 				return nil, nil
 			}
-			return toSchema(pk.Attr, update)
+			return toSchema(attr, update)
+
+		case pk.IsTypeDef():
+			var update pb.TypeUpdate
+			err := item.Value(func(val []byte) error {
+				return update.Unmarshal(val)
+			})
+			if err != nil {
+				glog.Errorf("Unable to unmarshal type: %+v. Err=%v\n", pk, err)
+				return nil, nil
+			}
+			if !in.AllNamespaces {
+				for i, field := range update.Fields {
+					_, update.Fields[i] = x.ParseNamespaceAttr(field)
+				}
+			}
+			return toType(attr, update)
 
 		case pk.IsData():
-			prefix := fmt.Sprintf("<_:uid%x> <%s> ", pk.Uid, pk.Attr)
 			pl, err := posting.ReadPostingList(key, itr)
 			if err != nil {
 				return nil, err
 			}
+			if mux.json {
+				return toJSON(pl, pk.Uid, attr, in.ReadTs)
+			}
+			prefix := fmt.Sprintf("<_:uid%x> <%s> ", pk.Uid, attr)
 			return toRDF(pl, prefix, in.ReadTs)
 
 		default:
@@ -336,6 +522,9 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 	if err := sl.Orchestrate(ctx, "Export", in.ReadTs); err != nil {
 		return err
 	}
+	if err := mux.finish(); err != nil {
+		return err
+	}
 	if err := mux.data.Close(); err != nil {
 		return err
 	}
@@ -384,7 +573,12 @@ func handleExportOverNetwork(ctx context.Context, in *pb.ExportRequest) error {
 	return err
 }
 
-func ExportOverNetwork(ctx context.Context) error {
+// ExportOverNetwork exports all the groups to the destination, or to the export directory of
+// the alphas if it's empty. See storage.New for the destinations supported. Only the predicates
+// of the namespace are exported, unless allNamespaces is set. The data is written in the
+// format, "rdf" or "json".
+func ExportOverNetwork(ctx context.Context, destination string, ns uint64,
+	allNamespaces bool, format string) error {
 	// If we haven't even had a single membership update, don't run export.
 	if err := x.HealthCheck(); err != nil {
 		glog.Errorf("Rejecting export request due to health check error: %v\n", err)
@@ -407,9 +601,13 @@ func ExportOverNetwork(ctx context.Context) error {
 	for _, gid := range gids {
 		go func(group uint32) {
 			req := &pb.ExportRequest{
-				GroupId: group,
-				ReadTs:  readTs,
-				UnixTs:  time.Now().Unix(),
+				GroupId:       group,
+				ReadTs:        readTs,
+				UnixTs:        time.Now().Unix(),
+				Destination:   destination,
+				Namespace:     ns,
+				AllNamespaces: allNamespaces,
+				Format:        format,
 			}
 			ch <- handleExportOverNetwork(ctx, req)
 		}(gid)
@@ -20,6 +20,7 @@ import (
 	"bufio"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"io/ioutil"
 	"math"
 	"os"
@@ -233,6 +234,66 @@ func TestExport(t *testing.T) {
 	require.Equal(t, 1, count)
 }
 
+func TestExportJSON(t *testing.T) {
+	initTestExport(t, "name:string @index .")
+	bdir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+	defer os.RemoveAll(bdir)
+
+	Config.ExportPath = bdir
+	readTs := timestamp()
+	posting.Oracle().ProcessDelta(&pb.OracleDelta{MaxAssigned: readTs})
+	err = export(context.Background(),
+		&pb.ExportRequest{ReadTs: readTs, GroupId: 1, Format: "json"})
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(bdir, "*", "*.json.gz"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files), "files=%v", files)
+
+	f, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	require.NoError(t, err)
+
+	var objs []map[string]interface{}
+	require.NoError(t, json.NewDecoder(r).Decode(&objs))
+	// Same as the RDF export, 4 friend edges and 4 names.
+	require.Equal(t, 8, len(objs))
+	for _, obj := range objs {
+		switch {
+		case obj["name"] != nil:
+			switch obj["uid"] {
+			case "_:uid1":
+				require.Equal(t, "pho\ton", obj["name"])
+			case "_:uid3":
+				require.Equal(t, "First Line\nSecondLine", obj["name"])
+			case "_:uid5":
+				require.Equal(t, "", obj["name"])
+			default:
+				t.Errorf("Unexpected object: %v", obj)
+			}
+		case obj["name@en"] != nil:
+			require.Equal(t, map[string]interface{}{"uid": "_:uid2", "name@en": "pho\ton"}, obj)
+		case obj["friend"] != nil:
+			friend := obj["friend"].(map[string]interface{})
+			require.Equal(t, "_:uid5", friend["uid"])
+			if obj["uid"] == "_:uid4" {
+				require.Equal(t, 33.0, friend["friend|age"])
+				require.Equal(t, true, friend["friend|close"])
+				require.Equal(t, "football", friend["friend|game"])
+				require.Equal(t, "roses are red\nviolets are blue", friend["friend|poem"])
+				require.Contains(t, friend["friend|since"], "2005-05-02T15:04:05")
+			} else {
+				require.Equal(t, 1, len(friend))
+			}
+		default:
+			t.Errorf("Unexpected object: %v", obj)
+		}
+	}
+}
+
 type skv struct {
 	attr   string
 	schema pb.SchemaUpdate
@@ -281,6 +342,19 @@ func TestToSchema(t *testing.T) {
 	}
 }
 
+func TestToType(t *testing.T) {
+	kv, err := toType("Person", pb.TypeUpdate{Fields: []string{"name", "friend"}})
+	require.NoError(t, err)
+	require.Equal(t, "type Person {\n  name\n  friend\n}\n", string(kv.Val))
+	require.Equal(t, uint64(2), kv.Version)
+
+	// The exported type can be loaded back.
+	result, err := schema.ParseWithTypes(string(kv.Val))
+	require.NoError(t, err)
+	require.Equal(t, []*pb.TypeUpdate{{TypeName: "Person", Fields: []string{"name", "friend"}}},
+		result.Types)
+}
+
 // func generateBenchValues() []kv {
 // 	byteInt := make([]byte, 4)
 // 	binary.LittleEndian.PutUint32(byteInt, 123)
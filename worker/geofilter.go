@@ -0,0 +1,173 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// At the root, nearest(attr, [lon, lat], k) looks up the geometries in the index within a radius
+// of the point, growing it until at least k of them are known to be within it, so that no
+// geometry outside of it can be any nearer. The candidates are then ranked by their exact
+// distance to the point. As a filter, the source uids are ranked right away.
+
+const (
+	// nearestStartRadius is the radius in metres of the first lookup around the point.
+	nearestStartRadius = 1000.0
+	// nearestRadiusGrowth is the factor the radius is grown by between two lookups.
+	nearestRadiusGrowth = 4
+)
+
+// nearestMaxRadius is half the circumference of the earth, at which it's covered whole.
+var nearestMaxRadius = math.Pi * types.EarthRadiusMeters
+
+func handleNearestFunction(ctx context.Context, arg funcArgs) error {
+	q := arg.q
+	dists := make(map[uint64]float64)
+	if q.UidList != nil {
+		if err := geoDistances(ctx, arg, q.UidList.Uids, dists); err != nil {
+			return err
+		}
+		arg.out.UidMatrix = append(arg.out.UidMatrix, nearestUids(dists, arg.srcFn.numNearest))
+		return nil
+	}
+
+	for radius := nearestStartRadius; ; radius *= nearestRadiusGrowth {
+		uids, err := uidsForGeoTokens(arg, arg.srcFn.geoPoint.CapTokens(radius))
+		if err != nil {
+			return err
+		}
+		// The distances of the geometries found by a smaller radius are known already.
+		fresh := uids.Uids[:0]
+		for _, uid := range uids.Uids {
+			if _, ok := dists[uid]; !ok {
+				fresh = append(fresh, uid)
+			}
+		}
+		if err := geoDistances(ctx, arg, fresh, dists); err != nil {
+			return err
+		}
+
+		within := 0
+		for _, d := range dists {
+			if d <= radius {
+				within++
+			}
+		}
+		if within >= arg.srcFn.numNearest || radius >= nearestMaxRadius {
+			break
+		}
+	}
+	arg.out.UidMatrix = append(arg.out.UidMatrix, nearestUids(dists, arg.srcFn.numNearest))
+	return nil
+}
+
+// uidsForGeoTokens returns the uids found in the geo index of the predicate for any of the tokens.
+func uidsForGeoTokens(arg funcArgs, tokens []string) (*pb.List, error) {
+	tok.EncodeGeoTokens(tokens)
+	opts := posting.ListOptions{ReadTs: arg.q.ReadTs}
+	uidMatrix := make([]*pb.List, 0, len(tokens))
+	for _, t := range tokens {
+		pl, err := posting.Get(x.IndexKey(arg.q.Attr, t))
+		if err != nil {
+			return nil, err
+		}
+		uids, err := pl.Uids(opts)
+		if err != nil {
+			return nil, err
+		}
+		uidMatrix = append(uidMatrix, uids)
+	}
+	return algo.MergeSorted(uidMatrix), nil
+}
+
+// geoDistances adds the distance from the point to the geometry of each of the uids to dists,
+// the shortest one for a list of geometries. The uids without any geometry are left out.
+func geoDistances(ctx context.Context, arg funcArgs, uids []uint64,
+	dists map[uint64]float64) error {
+	attr := arg.q.Attr
+	isList := schema.State().IsList(attr)
+	for i, uid := range uids {
+		if i%100 == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		pl, err := posting.Get(x.DataKey(attr, uid))
+		if err != nil {
+			return err
+		}
+
+		var vals []types.Val
+		if isList {
+			vals, err = pl.AllValues(arg.q.ReadTs)
+		} else {
+			var val types.Val
+			val, err = pl.Value(arg.q.ReadTs)
+			vals = append(vals, val)
+		}
+		if err == posting.ErrNoValue {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		for _, val := range vals {
+			tv := &pb.TaskValue{ValType: val.Tid.Enum(), Val: val.Value.([]byte)}
+			d, ok := types.GeoDistance(tv, arg.srcFn.geoPoint)
+			if !ok {
+				continue
+			}
+			if prev, seen := dists[uid]; !seen || d < prev {
+				dists[uid] = d
+			}
+		}
+	}
+	return nil
+}
+
+// nearestUids returns the sorted list of the k uids nearest to the point, the ties being broken
+// by the uids.
+func nearestUids(dists map[uint64]float64, k int) *pb.List {
+	uids := make([]uint64, 0, len(dists))
+	for uid := range dists {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool {
+		di, dj := dists[uids[i]], dists[uids[j]]
+		if di != dj {
+			return di < dj
+		}
+		return uids[i] < uids[j]
+	})
+	if len(uids) > k {
+		uids = uids[:k]
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	return &pb.List{Uids: uids}
+}
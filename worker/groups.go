@@ -17,6 +17,7 @@
 package worker
 
 import (
+	"expvar"
 	"fmt"
 	"io"
 	"math"
@@ -92,6 +93,10 @@ func StartRaftNodes(walStore *badger.DB, bindall bool) {
 
 	// Connect with Zero leader and figure out what group we should belong to.
 	m := &pb.Member{Id: Config.RaftId, Addr: Config.MyAddr}
+	if Config.LearnerOf != 0 {
+		m.GroupId = Config.LearnerOf
+		m.Learner = true
+	}
 	var connState *pb.ConnectionState
 	var err error
 	for { // Keep on retrying. See: https://github.com/dgraph-io/dgraph/issues/2289
@@ -121,10 +126,13 @@ func StartRaftNodes(walStore *badger.DB, bindall bool) {
 	gr.delPred = make(chan struct{}, 1)
 
 	// Initialize DiskStorage and pass it along.
-	store := raftwal.Init(walStore, Config.RaftId, gid)
+	store := raftwal.Init(walStore, Config.WALDir, Config.RaftId, gid)
 	gr.Node = newNode(store, gid, Config.RaftId, Config.MyAddr)
 
 	x.Checkf(schema.LoadFromDb(), "Error while initializing schema")
+	// The indexes being built in the background when the server stopped are built before the
+	// Raft logs are replayed.
+	gr.Node.resumeIndexBuilds()
 	raftServer.Node = gr.Node.Node
 	gr.Node.InitAndStartNode()
 	x.UpdateHealthStatus(true)
@@ -139,6 +147,16 @@ func StartRaftNodes(walStore *badger.DB, bindall bool) {
 }
 
 func (g *groupi) proposeInitialSchema() {
+	// propose the schema for dgraph.type, which holds the types of a node. Unlike _predicate_,
+	// it's needed whether or not edges get expanded.
+	g.upsertSchema(&pb.SchemaUpdate{
+		Predicate: x.TypeAttr,
+		ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact"},
+		List:      true,
+	})
+
 	// propose the schema for _predicate_
 	if !Config.ExpandEdge {
 		return
@@ -222,7 +240,8 @@ func (g *groupi) calculateTabletSizes() map[string]*pb.Tablet {
 		item := itr.Item()
 
 		pk := x.Parse(item.Key())
-		if pk == nil {
+		if pk == nil || pk.IsTypeDef() {
+			// Types aren't tablets, every group holds all of them.
 			itr.Next()
 			continue
 		}
@@ -250,6 +269,50 @@ func (g *groupi) calculateTabletSizes() map[string]*pb.Tablet {
 	return tablets
 }
 
+// recordTabletSizes replaces the sizes of the tablets of the group exported in the metrics, so
+// that the tablets moved away from the group are dropped.
+func recordTabletSizes(gid uint32, tablets map[string]*pb.Tablet) {
+	sizes := new(expvar.Map).Init()
+	for attr, tablet := range tablets {
+		size := new(expvar.Int)
+		size.Set(tablet.Space)
+		sizes.Set(attr, size)
+	}
+	x.TabletSize.Set(fmt.Sprint(gid), sizes)
+}
+
+// KnownNamespace returns whether the namespace is in the catalog kept by Zero. The default
+// namespace always is.
+func KnownNamespace(ns uint64) bool {
+	if ns == x.DefaultNamespace {
+		return true
+	}
+	g := groups()
+	g.RLock()
+	defer g.RUnlock()
+	if g.state == nil {
+		return false
+	}
+	_, ok := g.state.Namespaces[ns]
+	return ok
+}
+
+// Namespaces returns the ids of the namespaces in the catalog kept by Zero, including the
+// default namespace.
+func Namespaces() []uint64 {
+	g := groups()
+	g.RLock()
+	defer g.RUnlock()
+	ids := []uint64{x.DefaultNamespace}
+	if g.state == nil {
+		return ids
+	}
+	for id := range g.state.Namespaces {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 func MaxLeaseId() uint64 {
 	g := groups()
 	g.RLock()
@@ -359,6 +422,14 @@ func (g *groupi) ServesTablet(key string) bool {
 	return false
 }
 
+// KnownTablet returns the tablet of the predicate if this server knows about it already, unlike
+// Tablet which asks Zero to serve it otherwise. Do not modify the returned Tablet.
+func (g *groupi) KnownTablet(key string) *pb.Tablet {
+	g.RLock()
+	defer g.RUnlock()
+	return g.tablets[key]
+}
+
 // Do not modify the returned Tablet
 func (g *groupi) Tablet(key string) *pb.Tablet {
 	// TODO: Remove all this later, create a membership state and apply it
@@ -405,27 +476,20 @@ func (g *groupi) HasMeInState() bool {
 	return has
 }
 
-// Returns 0, 1, or 2 valid server addrs.
-func (g *groupi) AnyTwoServers(gid uint32) []string {
-	g.RLock()
-	defer g.RUnlock()
-
-	if g.state == nil {
-		return []string{}
-	}
-	group, has := g.state.Groups[gid]
-	if !has {
-		return []string{}
-	}
-	var res []string
-	for _, m := range group.Members {
-		// map iteration gives us members in no particular order.
-		res = append(res, m.Addr)
-		if len(res) >= 2 {
-			break
+// Replicas returns the connections to the members of the group which aren't learners, in no
+// particular order, so that the queries are spread across them.
+func (g *groupi) Replicas(gid uint32) []*conn.Pool {
+	var pools []*conn.Pool
+	for _, m := range g.members(gid) {
+		if m.Learner {
+			// Learners are left to the queries sent to them directly.
+			continue
+		}
+		if pl, err := conn.Get().Get(m.Addr); err == nil {
+			pools = append(pools, pl)
 		}
 	}
-	return res
+	return pools
 }
 
 func (g *groupi) members(gid uint32) map[uint64]*pb.Member {
@@ -458,6 +522,23 @@ func (g *groupi) AnyServer(gid uint32) *conn.Pool {
 	return nil
 }
 
+// Servers returns the connections to the members of the group, with the leader first.
+func (g *groupi) Servers(gid uint32) []*conn.Pool {
+	var pools []*conn.Pool
+	for _, m := range g.members(gid) {
+		pl, err := conn.Get().Get(m.Addr)
+		if err != nil {
+			continue
+		}
+		if m.Leader {
+			pools = append([]*conn.Pool{pl}, pools...)
+		} else {
+			pools = append(pools, pl)
+		}
+	}
+	return pools
+}
+
 func (g *groupi) MyPeer() (uint64, bool) {
 	members := g.members(g.groupId())
 	if members != nil {
@@ -487,6 +568,17 @@ func (g *groupi) Leader(gid uint32) *conn.Pool {
 	return nil
 }
 
+// LeaderAddr returns the address of the leader of the group, or an empty string if the group
+// has no known leader.
+func (g *groupi) LeaderAddr(gid uint32) string {
+	for _, m := range g.members(gid) {
+		if m.Leader {
+			return m.Addr
+		}
+	}
+	return ""
+}
+
 func (g *groupi) KnownGroups() (gids []uint32) {
 	g.RLock()
 	defer g.RUnlock()
@@ -499,6 +591,22 @@ func (g *groupi) KnownGroups() (gids []uint32) {
 	return
 }
 
+// KnownPredicates returns the predicates served by all the groups, as known from the
+// membership state.
+func (g *groupi) KnownPredicates() (preds []string) {
+	g.RLock()
+	defer g.RUnlock()
+	if g.state == nil {
+		return
+	}
+	for _, group := range g.state.Groups {
+		for pred := range group.Tablets {
+			preds = append(preds, pred)
+		}
+	}
+	return
+}
+
 func (g *groupi) triggerMembershipSync() {
 	// It's ok if we miss the trigger, periodic membership sync runs every minute.
 	select {
@@ -567,6 +675,7 @@ func (g *groupi) doSendMembership(tablets map[string]*pb.Tablet) error {
 		Addr:       Config.MyAddr,
 		Leader:     leader,
 		LastUpdate: uint64(time.Now().Unix()),
+		Learner:    Config.LearnerOf != 0,
 	}
 	group := &pb.Group{
 		Members: make(map[uint64]*pb.Member),
@@ -626,6 +735,9 @@ func (g *groupi) sendMembershipUpdates() {
 		case <-g.closer.HasBeenClosed():
 			return
 		case <-fastTicker.C:
+			pending := new(expvar.Int)
+			pending.Set(int64(posting.Oracle().NumPendingTxns()))
+			x.PendingTxns.Set(fmt.Sprint(g.groupId()), pending)
 			if time.Since(lastSent) > 10*time.Second {
 				// On start of node if it becomes a leader, we would send tablets size for sure.
 				g.triggerMembershipSync()
@@ -645,6 +757,7 @@ func (g *groupi) sendMembershipUpdates() {
 				break // breaks select case, not for loop.
 			}
 			tablets := g.calculateTabletSizes()
+			recordTabletSizes(g.groupId(), tablets)
 			g.RLock()
 			for attr := range g.tablets {
 				if tablets[attr] == nil {
@@ -806,7 +919,7 @@ func (g *groupi) cleanupTablets() {
 
 				// TODO: Investiage out of bounds.
 				pk := x.Parse(item.Key())
-				if pk == nil {
+				if pk == nil || pk.IsTypeDef() {
 					itr.Next()
 					continue
 				}
@@ -0,0 +1,117 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"sort"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Health returns the health of this server and of the groups it knows about. The details of the
+// server are left out until it has joined the cluster.
+func Health() *pb.HealthInfo {
+	info := &pb.HealthInfo{Healthy: true, Addr: Config.MyAddr}
+	if err := x.HealthCheck(); err != nil {
+		info.Healthy, info.Error = false, err.Error()
+	}
+	if pstore != nil {
+		info.LsmSize, info.VlogSize = pstore.Size()
+	}
+
+	g := groups()
+	if g == nil {
+		return info
+	}
+	info.GroupId = g.groupId()
+	if n := g.Node; n != nil {
+		info.RaftId = n.Id
+		info.Leader = n.AmLeader()
+		info.AppliedIndex = n.Applied.DoneUntil()
+		if snap, err := n.Store.Snapshot(); err == nil {
+			info.SnapshotIndex = snap.Metadata.Index
+		}
+		if info.AppliedIndex > info.SnapshotIndex {
+			info.SnapshotLag = info.AppliedIndex - info.SnapshotIndex
+		}
+	}
+	info.MaxAssigned = posting.Oracle().MaxAssigned()
+
+	g.RLock()
+	defer g.RUnlock()
+	if g.state != nil {
+		info.ZeroMaxTxnTs = g.state.MaxTxnTs
+		info.ZeroMaxLeaseId = g.state.MaxLeaseId
+		info.Groups = groupsHealth(g.state)
+	}
+	return info
+}
+
+// groupsHealth returns the members, the leader, the tablets being moved and the size of every
+// group in the membership state, sorted by group id.
+func groupsHealth(state *pb.MembershipState) []*pb.GroupHealth {
+	ghs := make([]*pb.GroupHealth, 0, len(state.Groups))
+	for gid, group := range state.Groups {
+		gh := &pb.GroupHealth{GroupId: gid, SnapshotTs: group.SnapshotTs}
+		for _, m := range group.Members {
+			member := *m
+			gh.Members = append(gh.Members, &member)
+			if m.Leader {
+				gh.LeaderId = m.Id
+			}
+		}
+		sort.Slice(gh.Members, func(i, j int) bool {
+			return gh.Members[i].Id < gh.Members[j].Id
+		})
+		for pred, tablet := range group.Tablets {
+			gh.Space += tablet.Space
+			// A tablet is read-only while it's moved to another group.
+			if tablet.ReadOnly {
+				gh.MovingPredicates = append(gh.MovingPredicates, pred)
+			}
+		}
+		sort.Strings(gh.MovingPredicates)
+		ghs = append(ghs, gh)
+	}
+	sort.Slice(ghs, func(i, j int) bool { return ghs[i].GroupId < ghs[j].GroupId })
+	return ghs
+}
+
+// hasMembershipState returns whether this server got the membership state from Zero, and so
+// knows which servers to ask for the data of every group.
+func hasMembershipState() bool {
+	g := groups()
+	if g == nil {
+		return false
+	}
+	g.RLock()
+	defer g.RUnlock()
+	return g.state != nil
+}
+
+// Health returns the health of this server to another one.
+func (w *grpcWorker) Health(ctx context.Context, _ *api.Payload) (*pb.HealthInfo, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return Health(), nil
+}
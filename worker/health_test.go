@@ -0,0 +1,62 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupsHealth(t *testing.T) {
+	state := &pb.MembershipState{Groups: map[uint32]*pb.Group{
+		2: {
+			Members: map[uint64]*pb.Member{
+				3: {Id: 3, GroupId: 2, Addr: "alpha3:7080"},
+			},
+		},
+		1: {
+			Members: map[uint64]*pb.Member{
+				2: {Id: 2, GroupId: 1, Addr: "alpha2:7080", Leader: true},
+				1: {Id: 1, GroupId: 1, Addr: "alpha1:7080"},
+			},
+			Tablets: map[string]*pb.Tablet{
+				"name":   {GroupId: 1, Predicate: "name", Space: 100},
+				"friend": {GroupId: 1, Predicate: "friend", Space: 50, ReadOnly: true},
+				"age":    {GroupId: 1, Predicate: "age", Space: 10, ReadOnly: true},
+			},
+			SnapshotTs: 42,
+		},
+	}}
+
+	ghs := groupsHealth(state)
+	require.Len(t, ghs, 2)
+	g1, g2 := ghs[0], ghs[1]
+	require.Equal(t, uint32(1), g1.GroupId)
+	require.Equal(t, uint64(2), g1.LeaderId)
+	require.Equal(t, uint64(42), g1.SnapshotTs)
+	require.Equal(t, int64(160), g1.Space)
+	require.Equal(t, []string{"age", "friend"}, g1.MovingPredicates)
+	require.Len(t, g1.Members, 2)
+	require.Equal(t, uint64(1), g1.Members[0].Id)
+	require.Equal(t, uint64(2), g1.Members[1].Id)
+
+	require.Equal(t, uint32(2), g2.GroupId)
+	require.Equal(t, uint64(0), g2.LeaderId)
+	require.Empty(t, g2.MovingPredicates)
+}
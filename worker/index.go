@@ -17,14 +17,206 @@
 package worker
 
 import (
+	"sync"
+	"time"
+
 	"golang.org/x/net/context"
 
 	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
 )
 
+// pendingIndexes holds the predicates whose index is being built in the background, see
+// Config.BackgroundIndexing.
+var pendingIndexes = struct {
+	sync.RWMutex
+	m map[string]pendingIndex
+}{m: make(map[string]pendingIndex)}
+
+// pendingIndex is an index being built in the background at startTs. done is closed once it's
+// built.
+type pendingIndex struct {
+	done    chan struct{}
+	startTs uint64
+}
+
+func errIndexPending(attr string) error {
+	return x.Errorf("Index of predicate %s is still being built, please retry later", attr)
+}
+
+// indexPending returns whether the index of the predicate is being built in the background.
+func indexPending(attr string) bool {
+	return pendingIndexTs(attr) > 0
+}
+
+// pendingIndexTs returns the timestamp the index of the predicate is being built at in the
+// background, zero if it isn't.
+func pendingIndexTs(attr string) uint64 {
+	pendingIndexes.RLock()
+	defer pendingIndexes.RUnlock()
+	return pendingIndexes.m[attr].startTs
+}
+
+// minPendingIndexTs returns the lowest timestamp the indexes being built in the background are
+// built at, zero if there are none.
+func minPendingIndexTs() uint64 {
+	pendingIndexes.RLock()
+	defer pendingIndexes.RUnlock()
+	var min uint64
+	for _, p := range pendingIndexes.m {
+		if min == 0 || p.startTs < min {
+			min = p.startTs
+		}
+	}
+	return min
+}
+
+func setIndexPending(attr string, startTs uint64) {
+	pendingIndexes.Lock()
+	defer pendingIndexes.Unlock()
+	pendingIndexes.m[attr] = pendingIndex{done: make(chan struct{}), startTs: startTs}
+}
+
+func clearIndexPending(attr string) {
+	pendingIndexes.Lock()
+	defer pendingIndexes.Unlock()
+	if p, ok := pendingIndexes.m[attr]; ok {
+		close(p.done)
+		delete(pendingIndexes.m, attr)
+	}
+}
+
+// waitForIndexes waits for the indexes being built in the background, of the given predicates
+// or of all of them if none are given. Every server of the group waits, so that the proposal
+// waiting is applied the same way by all of them, however far their own build is.
+func waitForIndexes(ctx context.Context, attrs ...string) error {
+	var pending []chan struct{}
+	pendingIndexes.RLock()
+	if len(attrs) == 0 {
+		for _, p := range pendingIndexes.m {
+			pending = append(pending, p.done)
+		}
+	}
+	for _, attr := range attrs {
+		if p, ok := pendingIndexes.m[attr]; ok {
+			pending = append(pending, p.done)
+		}
+	}
+	pendingIndexes.RUnlock()
+
+	for _, ch := range pending {
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// canIndexInBackground returns whether the schema update of a predicate only changes the
+// tokenizers of its index, or adds one, which is what is built in the background. Anything
+// else, like removing the index or changing the type, is still done while mutations wait.
+func canIndexInBackground(old, current pb.SchemaUpdate) bool {
+	return current.Directive == pb.SchemaUpdate_INDEX && old.Directive != pb.SchemaUpdate_REVERSE &&
+		old.ValueType == current.ValueType && old.List == current.List &&
+		old.Count == current.Count && needReindexing(old, current)
+}
+
+// indexInBackground deletes the index of the predicate and starts building it again with the
+// tokenizers of the update, without blocking the mutations applied after it. The index is
+// reported pending, and the functions needing it fail, until it's built. The update is written
+// to disk first, marked with the timestamp the index is built at, so that the build resumes if
+// the server restarts before it's done, or gets the data of the group from a snapshot meanwhile.
+func (n *node) indexInBackground(update pb.SchemaUpdate, startTs uint64) error {
+	attr := update.Predicate
+	building := update
+	building.IndexBuildTs = startTs
+	if err := writeSchema(attr, building); err != nil {
+		return err
+	}
+	glog.Infof("Deleting index for %s", attr)
+	if err := posting.DeleteIndex(attr); err != nil {
+		return err
+	}
+	setIndexPending(attr, startTs)
+	go n.buildIndex(update, startTs)
+	return nil
+}
+
+// resumeIndexBuilds builds again the indexes whose schema on disk is marked as being built in
+// the background. Each is built at the timestamp it was first built at, which writes the posting
+// lists written by the build cut short again, and keeps the mutations applied since.
+func (n *node) resumeIndexBuilds() {
+	for _, attr := range schema.State().Predicates() {
+		update, ok := schema.State().Get(attr)
+		if !ok || update.IndexBuildTs == 0 || indexPending(attr) {
+			continue
+		}
+		startTs := update.IndexBuildTs
+		update.IndexBuildTs = 0
+		glog.Infof("Resuming the build of the index of %s at %d", attr, startTs)
+		setIndexPending(attr, startTs)
+		go n.buildIndex(update, startTs)
+	}
+}
+
+// buildIndex builds the index of the predicate, retrying until it's built or the server stops.
+// The index is only reported built once it is, and its update written to disk without the
+// marker. If the server stops first, the marker left on disk resumes the build on restart.
+func (n *node) buildIndex(update pb.SchemaUpdate, startTs uint64) {
+	attr := update.Predicate
+	glog.Infof("Building index for %s in the background", attr)
+	for {
+		err := n.buildIndexOnce(update, startTs)
+		if err == nil {
+			break
+		}
+		if n.ctx.Err() != nil {
+			glog.Warningf("Stopped building index for %s, it'll be built on restart", attr)
+			return
+		}
+		glog.Errorf("Error while building index for %s, retrying: %v", attr, err)
+		select {
+		case <-time.After(10 * time.Second):
+		case <-n.ctx.Done():
+			glog.Warningf("Stopped building index for %s, it'll be built on restart", attr)
+			return
+		}
+	}
+	indexedWith.Lock()
+	delete(indexedWith.m, attr)
+	indexedWith.Unlock()
+	clearIndexPending(attr)
+	glog.Infof("Done building index for %s", attr)
+}
+
+// buildIndexOnce builds the index of the predicate at startTs and then writes its update to
+// disk. Rebuilding writes the same posting lists at the same timestamp, so it can be run again
+// after a failure.
+func (n *node) buildIndexOnce(update pb.SchemaUpdate, startTs uint64) error {
+	attr := update.Predicate
+	if err := posting.RebuildIndex(n.ctx, attr, startTs); err != nil {
+		return err
+	}
+	// The lists cached before the index was written don't have it. The ones with pending
+	// mutations can only be dropped once these are committed or aborted.
+	for posting.EvictIndex(attr) > 0 {
+		select {
+		case <-time.After(100 * time.Millisecond):
+		case <-n.ctx.Done():
+			return n.ctx.Err()
+		}
+	}
+	pstats.forgetMissingIndex(attr)
+	// The update was set in memory when it was applied, so it isn't changed again here, which
+	// would give it a version that differs from one server of the group to the other.
+	return writeSchema(attr, update)
+}
+
 func (n *node) rebuildOrDelIndex(ctx context.Context, attr string, rebuild bool, startTs uint64) error {
 	if schema.State().IsIndexed(attr) != rebuild {
 		return x.Errorf("Predicate %s index mismatch, rebuild %v", attr, rebuild)
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/badger"
@@ -30,19 +31,30 @@ import (
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 
 	"github.com/golang/glog"
 	otrace "go.opencensus.io/trace"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
 	errUnservedTablet  = x.Errorf("Tablet isn't being served by this instance.")
 	errPredicateMoving = x.Errorf("Predicate is being moved, please retry later")
+	errSchemaLimit     = x.Errorf("Schema limit reached")
 )
 
+// indexedWith holds the tokenizers the index of a predicate was built with, for the predicates
+// whose schema was updated but whose index hasn't been rebuilt yet.
+var indexedWith = struct {
+	sync.RWMutex
+	m map[string][]string
+}{m: make(map[string][]string)}
+
 func isStarAll(v []byte) bool {
 	return bytes.Equal(v, []byte(x.Star))
 }
@@ -73,6 +85,11 @@ func runMutation(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) e
 	if err := ValidateAndConvert(edge, &su); err != nil {
 		return err
 	}
+	if edge.Op == pb.DirectedEdge_SET && su.Unique {
+		if err := checkUnique(edge, txn); err != nil {
+			return err
+		}
+	}
 
 	t := time.Now()
 	key := x.DataKey(edge.Attr, edge.Entity)
@@ -93,6 +110,140 @@ func runMutation(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) e
 	return nil
 }
 
+// checkUnique returns an error if another uid already has the value the edge sets for its
+// @unique predicate. The index is read at the start ts of the transaction, which includes its own
+// mutations. The transactions committed concurrently setting the same value conflict on the index
+// key instead, see addMutation.
+func checkUnique(edge *pb.DirectedEdge, txn *posting.Txn) error {
+	// The index built in the background doesn't have all the values yet.
+	if indexPending(edge.Attr) {
+		return errIndexPending(edge.Attr)
+	}
+	tokenizers := schema.State().Tokenizer(edge.Attr)
+	if len(tokenizers) == 0 {
+		return x.Errorf("Index tokenizer is mandatory for @unique predicate %s", edge.Attr)
+	}
+	// A value has a single token with a tokenizer which isn't lossy. With a lossy one, all the
+	// uids with the same value share the first token, but have to be checked for the value.
+	tokenizer, lossy := tokenizers[0], true
+	for _, t := range tokenizers {
+		if !t.IsLossy() {
+			tokenizer, lossy = t, false
+			break
+		}
+	}
+	typ, err := schema.State().TypeOf(edge.Attr)
+	if err != nil {
+		return err
+	}
+	val, err := types.Convert(types.Val{Tid: types.TypeID(edge.ValueType), Value: edge.Value},
+		typ)
+	if err != nil {
+		return err
+	}
+	tokens, err := tok.BuildTokens(val.Value, tok.GetLangTokenizer(tokenizer, edge.GetLang()))
+	if err != nil || len(tokens) == 0 {
+		return err
+	}
+
+	plist, err := txn.Get(x.IndexKey(edge.Attr, tokens[0]))
+	if err != nil {
+		return err
+	}
+	uids, err := plist.Uids(posting.ListOptions{ReadTs: txn.StartTs})
+	if err != nil {
+		return err
+	}
+	for _, uid := range uids.Uids {
+		if uid == edge.Entity {
+			continue
+		}
+		if lossy {
+			same, err := hasValue(txn, x.DataKey(edge.Attr, uid), edge.Value)
+			if err != nil {
+				return err
+			}
+			if !same {
+				continue
+			}
+		}
+		return status.Errorf(codes.AlreadyExists,
+			"Value %v of @unique predicate %s is already used by uid %#x",
+			val.Value, edge.Attr, uid)
+	}
+	return nil
+}
+
+// hasValue returns whether the posting list of the key has the value.
+func hasValue(txn *posting.Txn, key []byte, value []byte) (bool, error) {
+	plist, err := txn.Get(key)
+	if err != nil {
+		return false, err
+	}
+	vals, err := plist.AllValues(txn.StartTs)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range vals {
+		if bytes.Equal(v.Value.([]byte), value) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkSharedValues returns an error if two uids share a value of the predicate at readTs, so
+// that @unique can't be added to it. All the values of the predicate are read.
+func checkSharedValues(attr string, readTs uint64) error {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	pk := x.ParsedKey{Attr: attr}
+	prefix := pk.DataPrefix()
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.PrefetchValues = false
+	iterOpt.AllVersions = true
+	iterOpt.Prefix = prefix
+	itr := txn.NewIterator(iterOpt)
+	defer itr.Close()
+
+	owners := make(map[string]uint64)
+	var prevKey []byte
+	for itr.Seek(prefix); itr.ValidForPrefix(prefix); {
+		item := itr.Item()
+		if bytes.Equal(item.Key(), prevKey) {
+			itr.Next()
+			continue
+		}
+		prevKey = append(prevKey[:0], item.Key()...)
+
+		// Parse the key upfront, otherwise ReadPostingList would advance the iterator.
+		pk := x.Parse(item.Key())
+		if pk == nil {
+			itr.Next()
+			continue
+		}
+		pl, err := posting.ReadPostingList(item.KeyCopy(nil), itr)
+		if err != nil {
+			return err
+		}
+		vals, err := pl.AllValues(readTs)
+		if err != nil {
+			return err
+		}
+		for _, v := range vals {
+			val := string(v.Value.([]byte))
+			if uid, ok := owners[val]; ok && uid != pk.Uid {
+				return status.Errorf(codes.AlreadyExists,
+					"Can't add @unique to predicate %s: uids %#x and %#x share a value",
+					attr, uid, pk.Uid)
+			}
+			owners[val] = pk.Uid
+		}
+	}
+	return nil
+}
+
 // This is serialized with mutations, called after applied watermarks catch up
 // and further mutations are blocked until this is done.
 func runSchemaMutation(ctx context.Context, update *pb.SchemaUpdate, startTs uint64) error {
@@ -108,7 +259,13 @@ func runSchemaMutation(ctx context.Context, update *pb.SchemaUpdate, startTs uin
 		}
 		return err
 	}
+	if indexPending(update.Predicate) {
+		// The schema is written to disk once the index is built, see buildIndex.
+		return nil
+	}
 
+	// The functions which failed for lack of an index might be able to run now.
+	pstats.forgetMissingIndex(update.Predicate)
 	return updateSchema(update.Predicate, *update)
 }
 
@@ -121,8 +278,49 @@ func runSchemaMutationHelper(ctx context.Context, update *pb.SchemaUpdate, start
 	if err := checkSchema(update); err != nil {
 		return err
 	}
+	// The proposal applied again after a restart finds the build of its index resumed already,
+	// see resumeIndexBuilds.
+	if startTs > 0 && pendingIndexTs(update.Predicate) == startTs {
+		return nil
+	}
+	// An index still being built in the background is built with the tokenizers of the
+	// previous update, so this one has to wait for it.
+	if err := waitForIndexes(ctx, update.Predicate); err != nil {
+		return err
+	}
 	old, ok := schema.State().Get(update.Predicate)
+	// The alter is only counted once, however many times its proposal is applied.
+	update.AlterCount, update.AlterTs = old.AlterCount, old.AlterTs
+	if startTs > old.AlterTs {
+		update.AlterCount, update.AlterTs = old.AlterCount+1, startTs
+	}
+	// The values set from now on are checked as they are mutated, the ones already stored are
+	// checked once here.
+	if update.Unique && !old.Unique {
+		if err := checkSharedValues(update.Predicate, startTs); err != nil {
+			return err
+		}
+	}
 	current := *update
+	// The index is built with the old tokenizers until it gets rebuilt below.
+	var builtWith []string
+	if old.Directive == pb.SchemaUpdate_INDEX {
+		builtWith = old.Tokenizer
+	}
+	indexedWith.Lock()
+	indexedWith.m[update.Predicate] = builtWith
+	indexedWith.Unlock()
+	defer func() {
+		// The index built in the background is reported as built with the old tokenizers
+		// until it's done.
+		if indexPending(update.Predicate) {
+			return
+		}
+		indexedWith.Lock()
+		delete(indexedWith.m, update.Predicate)
+		indexedWith.Unlock()
+	}()
+
 	// Sets only in memory, we will update it on disk only after schema mutations is successful and persisted
 	// to disk.
 	schema.State().Set(update.Predicate, current)
@@ -135,7 +333,8 @@ func runSchemaMutationHelper(ctx context.Context, update *pb.SchemaUpdate, start
 	// might remain, which is ok.
 
 	// Indexing can't be done in background as it can cause race conditons with new
-	// index mutations (old set and new del)
+	// index mutations (old set and new del), unless the index isn't rolled up and its versions
+	// aren't discarded until it's built, see indexInBackground.
 	// We need watermark for index/reverse edge addition for linearizable reads.
 	// (both applied and synced watermarks).
 	defer glog.Infof("Done schema update %+v\n", update)
@@ -168,6 +367,9 @@ func runSchemaMutationHelper(ctx context.Context, update *pb.SchemaUpdate, start
 			" without dropping it first.", current.Predicate)
 	}
 
+	if Config.BackgroundIndexing && canIndexInBackground(old, current) {
+		return n.indexInBackground(current, startTs)
+	}
 	if needReindexing(old, current) {
 		// Reindex if update.Index is true or remove index
 		if err := n.rebuildOrDelIndex(ctx, update.Predicate,
@@ -218,10 +420,32 @@ func needReindexing(old pb.SchemaUpdate, current pb.SchemaUpdate) bool {
 	return false
 }
 
+// reindexNeeded returns whether the index of the predicate was built with tokenizers other than
+// the ones in its current schema, which happens while the index is being rebuilt after the
+// tokenizers were changed.
+func reindexNeeded(attr string) bool {
+	indexedWith.RLock()
+	builtWith, ok := indexedWith.m[attr]
+	indexedWith.RUnlock()
+	if !ok {
+		return false
+	}
+	var tokenizers []string
+	if schema.State().IsIndexed(attr) {
+		tokenizers = schema.State().TokenizerNames(attr)
+	}
+	return !sameTokenizers(builtWith, tokenizers)
+}
+
 // We commit schema to disk in blocking way, should be ok because this happens
 // only during schema mutations or we see a new predicate.
 func updateSchema(attr string, s pb.SchemaUpdate) error {
 	schema.State().Set(attr, s)
+	return writeSchema(attr, s)
+}
+
+// writeSchema writes the schema of the predicate to disk, leaving the one in memory as it is.
+func writeSchema(attr string, s pb.SchemaUpdate) error {
 	txn := pstore.NewTransactionAt(1, true)
 	defer txn.Discard()
 	data, err := s.Marshal()
@@ -232,6 +456,19 @@ func updateSchema(attr string, s pb.SchemaUpdate) error {
 	return txn.CommitAt(1, nil)
 }
 
+// updateType sets the declaration of the object type in memory and on disk.
+func updateType(typeName string, t pb.TypeUpdate) error {
+	schema.State().SetType(typeName, t)
+	txn := pstore.NewTransactionAt(1, true)
+	defer txn.Discard()
+	data, err := t.Marshal()
+	x.Check(err)
+	if err := txn.SetWithMeta(x.TypeDefKey(typeName), data, posting.BitSchemaPosting); err != nil {
+		return err
+	}
+	return txn.CommitAt(1, nil)
+}
+
 func updateSchemaType(attr string, typ types.TypeID, index uint64) {
 	// Don't overwrite schema blindly, acl's might have been set even though
 	// type is not present
@@ -281,6 +518,10 @@ func checkSchema(s *pb.SchemaUpdate) error {
 		return x.Errorf("Directive must be SchemaUpdate_INDEX when a tokenizer is specified")
 	}
 
+	if err := schema.CheckTokenizers(s); err != nil {
+		return err
+	}
+
 	typ := types.TypeID(s.ValueType)
 	if typ == types.UidID && s.Directive == pb.SchemaUpdate_INDEX {
 		// index on uid type
@@ -296,6 +537,10 @@ func checkSchema(s *pb.SchemaUpdate) error {
 		return x.Errorf("Index tokenizer is mandatory for: [%s] when specifying @upsert directive",
 			s.Predicate)
 	}
+	if s.Unique && len(s.Tokenizer) == 0 {
+		return x.Errorf("Index tokenizer is mandatory for: [%s] when specifying @unique directive",
+			s.Predicate)
+	}
 
 	t, err := schema.State().TypeOf(s.Predicate)
 	if err != nil {
@@ -487,6 +732,18 @@ func populateMutationMap(src *pb.Mutations) map[uint32]*pb.Mutations {
 		}
 		mu.Schema = append(mu.Schema, schema)
 	}
+	// Every group holds the declarations of all the types, so that the predicates of a node
+	// can be resolved from its types by any of them.
+	if len(src.Types) > 0 {
+		for _, gid := range groups().KnownGroups() {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &pb.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.Types = src.Types
+		}
+	}
 	if src.DropAll {
 		for _, gid := range groups().KnownGroups() {
 			mu := mm[gid]
@@ -497,6 +754,27 @@ func populateMutationMap(src *pb.Mutations) map[uint32]*pb.Mutations {
 			mu.DropAll = true
 		}
 	}
+	// Every group known from Zero drops its data. The tablets aren't touched, so the predicates
+	// stay in the groups serving them.
+	if src.DropData {
+		for _, gid := range groups().KnownGroups() {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &pb.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.DropData = true
+		}
+	}
+	for _, attr := range src.DropDataPreds {
+		gid := groups().BelongsTo(attr)
+		mu := mm[gid]
+		if mu == nil {
+			mu = &pb.Mutations{GroupId: gid}
+			mm[gid] = mu
+		}
+		mu.DropDataPreds = append(mu.DropDataPreds, attr)
+	}
 	return mm
 }
 
@@ -531,6 +809,7 @@ func MutateOverNetwork(ctx context.Context, m *pb.Mutations) (*api.TxnContext, e
 			return tctx, errUnservedTablet
 		}
 		mu.StartTs = m.StartTs
+		mu.Ludicrous = m.Ludicrous
 		go proposeOrSend(ctx, gid, mu, resCh)
 	}
 
@@ -550,11 +829,22 @@ func MutateOverNetwork(ctx context.Context, m *pb.Mutations) (*api.TxnContext, e
 	return tctx, e
 }
 
+// isReadOnly returns whether the transaction is committed without having mutated anything, in
+// which case it has no keys to check for conflicts nor predicates to check for moves.
+func isReadOnly(tc *api.TxnContext) bool {
+	return !tc.Aborted && len(tc.Keys) == 0 && len(tc.Preds) == 0
+}
+
 // CommitOverNetwork makes a proxy call to Zero to commit or abort a transaction.
 func CommitOverNetwork(ctx context.Context, tc *api.TxnContext) (uint64, error) {
 	ctx, span := otrace.StartSpan(ctx, "worker.CommitOverNetwork")
 	defer span.End()
 
+	if isReadOnly(tc) {
+		// There's nothing to commit, so Zero needn't track the transaction.
+		span.Annotate(nil, "Read-only transaction. Nothing to commit.")
+		return 0, nil
+	}
 	pl := groups().Leader(0)
 	if pl == nil {
 		return 0, conn.ErrNoConnection
@@ -19,13 +19,19 @@ package worker
 import (
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
 )
 
 func TestConvertEdgeType(t *testing.T) {
@@ -126,6 +132,13 @@ func TestPopulateMutationMap(t *testing.T) {
 	require.NotNil(t, mu.Schema)
 }
 
+func TestIsReadOnly(t *testing.T) {
+	require.True(t, isReadOnly(&api.TxnContext{StartTs: 10}))
+	require.False(t, isReadOnly(&api.TxnContext{StartTs: 10, Aborted: true}))
+	require.False(t, isReadOnly(&api.TxnContext{StartTs: 10, Preds: []string{"name"}}))
+	require.False(t, isReadOnly(&api.TxnContext{StartTs: 10, Keys: []string{"abc"}}))
+}
+
 func TestCheckSchema(t *testing.T) {
 	posting.DeleteAll()
 	initTest(t, "name:string @index(term) .")
@@ -151,6 +164,11 @@ func TestCheckSchema(t *testing.T) {
 	s1 = &pb.SchemaUpdate{Predicate: "name", ValueType: pb.Posting_STRING}
 	require.Error(t, checkSchema(s1))
 
+	// tokenizer not loaded on this server
+	s1 = &pb.SchemaUpdate{Predicate: "name", ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"cjk"}}
+	require.Error(t, checkSchema(s1))
+
 	// int to password
 	err = schema.ParseBytes([]byte("name:int ."), 1)
 	require.NoError(t, err)
@@ -200,6 +218,76 @@ func TestCheckSchema(t *testing.T) {
 	require.NoError(t, err)
 	err = checkSchema(su[1])
 	require.NoError(t, err)
+
+	su, err = schema.Parse(`email: string @unique .`)
+	require.NoError(t, err)
+	err = checkSchema(su[0])
+	require.Error(t, err)
+	require.Equal(t, "Index tokenizer is mandatory for: [email] when specifying @unique directive",
+		err.Error())
+
+	su, err = schema.Parse(`email: string @index(hash) @unique .`)
+	require.NoError(t, err)
+	require.NoError(t, checkSchema(su[0]))
+}
+
+func TestCheckUnique(t *testing.T) {
+	posting.DeleteAll()
+	require.NoError(t, schema.ParseBytes([]byte(`
+		email: string @index(exact) @unique .
+		code: string @index(hash) @unique .
+	`), 1))
+
+	for _, attr := range []string{"email", "code"} {
+		edge := func(uid uint64, val string) *pb.DirectedEdge {
+			return &pb.DirectedEdge{Attr: attr, Entity: uid, Value: []byte(val),
+				ValueType: pb.Posting_STRING, Op: pb.DirectedEdge_SET}
+		}
+		l, err := posting.Get(x.DataKey(attr, 1))
+		require.NoError(t, err)
+		commitTransaction(t, edge(1, "a@dgraph.io"), l)
+
+		txn := posting.Oracle().RegisterStartTs(timestamp())
+		require.NoError(t, checkUnique(edge(1, "a@dgraph.io"), txn))
+		require.NoError(t, checkUnique(edge(2, "b@dgraph.io"), txn))
+		err = checkUnique(edge(2, "a@dgraph.io"), txn)
+		require.Error(t, err)
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		// The values set by the transaction itself are taken into account.
+		l, err = posting.Get(x.DataKey(attr, 2))
+		require.NoError(t, err)
+		require.NoError(t, l.AddMutationWithIndex(context.Background(), edge(2, "b@dgraph.io"),
+			txn))
+		require.Error(t, checkUnique(edge(3, "b@dgraph.io"), txn))
+	}
+
+	// The index built in the background can't be relied on until it's done.
+	setIndexPending("email", 1)
+	defer clearIndexPending("email")
+	txn := posting.Oracle().RegisterStartTs(timestamp())
+	require.Error(t, checkUnique(&pb.DirectedEdge{Attr: "email", Entity: 4,
+		Value: []byte("c@dgraph.io"), ValueType: pb.Posting_STRING, Op: pb.DirectedEdge_SET}, txn))
+}
+
+func TestCheckSharedValues(t *testing.T) {
+	posting.DeleteAll()
+	require.NoError(t, schema.ParseBytes([]byte(`login: string .`), 1))
+
+	set := func(uid uint64, val string) {
+		l, err := posting.Get(x.DataKey("login", uid))
+		require.NoError(t, err)
+		addEdge(t, &pb.DirectedEdge{Attr: "login", Entity: uid, Value: []byte(val),
+			ValueType: pb.Posting_STRING}, l)
+	}
+	set(1, "alice")
+	set(2, "bob")
+	require.NoError(t, checkSharedValues("login", timestamp()))
+
+	set(3, "alice")
+	err := checkSharedValues("login", timestamp())
+	require.Error(t, err)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
 }
 
 func TestNeedReindexing(t *testing.T) {
@@ -223,3 +311,46 @@ func TestNeedReindexing(t *testing.T) {
 	s2 = pb.SchemaUpdate{ValueType: pb.Posting_FLOAT, Directive: pb.SchemaUpdate_NONE}
 	require.True(t, needReindexing(s1, s2))
 }
+
+func TestCanIndexInBackground(t *testing.T) {
+	s1 := pb.SchemaUpdate{ValueType: pb.Posting_STRING}
+	s2 := pb.SchemaUpdate{ValueType: pb.Posting_STRING, Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"exact"}}
+	require.True(t, canIndexInBackground(s1, s2))
+
+	s1 = pb.SchemaUpdate{ValueType: pb.Posting_STRING, Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"term"}}
+	require.True(t, canIndexInBackground(s1, s2))
+	// Removing the index isn't done in the background.
+	require.False(t, canIndexInBackground(s2, pb.SchemaUpdate{ValueType: pb.Posting_STRING}))
+	require.False(t, canIndexInBackground(s2, s2))
+
+	s1 = pb.SchemaUpdate{ValueType: pb.Posting_INT}
+	require.False(t, canIndexInBackground(s1, s2))
+	s1 = pb.SchemaUpdate{ValueType: pb.Posting_STRING, List: true}
+	require.False(t, canIndexInBackground(s1, s2))
+}
+
+func TestWaitForIndexes(t *testing.T) {
+	require.False(t, indexPending("name"))
+	require.NoError(t, waitForIndexes(context.Background(), "name"))
+
+	setIndexPending("name", 5)
+	setIndexPending("age", 3)
+	require.True(t, indexPending("name"))
+	require.Equal(t, uint64(5), pendingIndexTs("name"))
+	require.Equal(t, uint64(3), minPendingIndexTs())
+	clearIndexPending("age")
+	require.Equal(t, uint64(5), minPendingIndexTs())
+	require.Error(t, errIndexPending("name"))
+	// Other predicates don't wait.
+	require.NoError(t, waitForIndexes(context.Background(), "age"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, waitForIndexes(ctx))
+
+	done := make(chan error)
+	go func() { done <- waitForIndexes(context.Background(), "name") }()
+	clearIndexPending("name")
+	require.NoError(t, <-done)
+	require.Zero(t, minPendingIndexTs())
+}
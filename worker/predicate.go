@@ -17,11 +17,14 @@
 package worker
 
 import (
+	"bytes"
 	"sync/atomic"
 
 	"github.com/coreos/etcd/raft"
 	"github.com/dgraph-io/badger"
 	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/dgraph-io/dgraph/conn"
 	"github.com/dgraph-io/dgraph/posting"
@@ -36,7 +39,25 @@ const (
 )
 
 // populateSnapshot gets data for a shard from the leader and writes it to BadgerDB on the follower.
+// If the follower has a snapshot of its own, only the keys changed since are asked for, unless the
+// leader can't tell which ones they are.
 func (n *node) populateSnapshot(snap pb.Snapshot, ps *badger.DB, pl *conn.Pool) (int, error) {
+	if prev, err := n.Snapshot(); err == nil && prev.ReadTs > 0 {
+		snap.SinceTs, snap.SinceIndex = prev.ReadTs, prev.Index
+		count, err := n.streamSnapshot(snap, ps, pl)
+		if status.Code(err) != codes.FailedPrecondition {
+			return count, err
+		}
+		glog.Infof("Unable to retrieve the keys changed since index %d: %v. Retrieving all keys.",
+			prev.Index, err)
+		snap.SinceTs, snap.SinceIndex = 0, 0
+	}
+	return n.streamSnapshot(snap, ps, pl)
+}
+
+// streamSnapshot retrieves the snapshot from the leader. Unless only the keys changed since
+// snap.SinceTs are asked for, all the data stored in ps is dropped first.
+func (n *node) streamSnapshot(snap pb.Snapshot, ps *badger.DB, pl *conn.Pool) (int, error) {
 	conn := pl.Get()
 	c := pb.NewWorkerClient(conn)
 
@@ -50,8 +71,12 @@ func (n *node) populateSnapshot(snap pb.Snapshot, ps *badger.DB, pl *conn.Pool)
 	if err := stream.Send(&snap); err != nil {
 		return 0, err
 	}
-	// Before we write anything, we should drop all the data stored in ps.
-	if err := ps.DropAll(); err != nil {
+	if snap.SinceTs > 0 {
+		// The leader refuses to send only the changed keys with the first response, before we
+		// write anything.
+		glog.Infof("Retrieving the keys changed since ts %d", snap.SinceTs)
+	} else if err := ps.DropAll(); err != nil {
+		// Before we write anything, we should drop all the data stored in ps.
 		return 0, err
 	}
 
@@ -92,6 +117,36 @@ func (n *node) populateSnapshot(snap pb.Snapshot, ps *badger.DB, pl *conn.Pool)
 	return count, nil
 }
 
+// errSinceUnavailable is returned to a follower asking for the keys changed since a snapshot
+// which doesn't include the last proposal changing the data without writing new versions.
+var errSinceUnavailable = status.Error(codes.FailedPrecondition,
+	"Data changed without new versions since the snapshot. All keys must be retrieved.")
+
+// dropsData returns whether the mutations change the data without writing new versions of the
+// keys, in which case a follower which hasn't applied them can't be caught up by sending it only
+// the keys with newer versions.
+func dropsData(m *pb.Mutations) bool {
+	if m.DropAll || m.DropData || len(m.DropDataPreds) > 0 || len(m.Schema) > 0 {
+		return true
+	}
+	for _, edge := range m.Edges {
+		if edge.Entity == 0 && bytes.Equal(edge.Value, []byte(x.Star)) {
+			return true
+		}
+	}
+	return false
+}
+
+// markDrop records the index of a proposal changing the data without writing new versions.
+func (n *node) markDrop(index uint64) {
+	for {
+		prev := atomic.LoadUint64(&n.dropIndex)
+		if index <= prev || atomic.CompareAndSwapUint64(&n.dropIndex, prev, index) {
+			return
+		}
+	}
+}
+
 func doStreamSnapshot(snap *pb.Snapshot, stream pb.Worker_StreamSnapshotServer) error {
 	// We choose not to try and match the requested snapshot from the latest snapshot at the leader.
 	// This is the job of the Raft library. At the leader end, we service whatever is asked of us.
@@ -105,11 +160,19 @@ func doStreamSnapshot(snap *pb.Snapshot, stream pb.Worker_StreamSnapshotServer)
 	// might be OK. Otherwise, we'd want to version the schemas as well. Currently, they're stored
 	// at timestamp=1.
 
+	//
+	// If the follower asks for the keys changed since its own snapshot, only the keys with a
+	// version above its read ts are sent. The schema and the types are sent in full, because
+	// they're not versioned.
+
 	var numKeys uint64
 	sl := ws.Lists{Stream: stream, DB: pstore}
-	sl.ChooseKeyFunc = func(_ *badger.Item) bool {
-		// Pick all keys.
-		return true
+	sl.ChooseKeyFunc = func(item *badger.Item) bool {
+		if item.Version() > snap.SinceTs {
+			return true
+		}
+		pk := x.Parse(item.Key())
+		return pk.IsSchema() || pk.IsTypeDef()
 	}
 	sl.ItemToKVFunc = func(key []byte, itr *badger.Iterator) (*pb.KV, error) {
 		atomic.AddUint64(&numKeys, 1)
@@ -184,6 +247,10 @@ func (w *grpcWorker) StreamSnapshot(stream pb.Worker_StreamSnapshotServer) error
 		return err
 	}
 	glog.Infof("Got StreamSnapshot request: %+v\n", snap)
+	if snap.SinceTs > 0 && snap.SinceIndex < atomic.LoadUint64(&n.dropIndex) {
+		// The follower retries asking for all keys, this isn't a failure of the snapshot.
+		return errSinceUnavailable
+	}
 	if err := doStreamSnapshot(snap, stream); err != nil {
 		glog.Errorf("While streaming snapshot: %v. Reporting failure.", err)
 		n.Raft().ReportSnapshot(snap.Context.GetId(), raft.SnapshotFailure)
@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/golang/glog"
 	"golang.org/x/net/context"
@@ -84,7 +85,32 @@ func populateKeyValues(ctx context.Context, kvs []*pb.KV) error {
 	return schema.Load(predicate)
 }
 
-func movePredicateHelper(ctx context.Context, predicate string, gid uint32) error {
+// rateLimitedStream sends the batches of a predicate being moved at no more than limit bytes per
+// second, so that the move doesn't saturate the network and the disks of both groups.
+type rateLimitedStream struct {
+	pb.Worker_ReceivePredicateClient
+	limit uint64
+	start time.Time
+	sent  uint64
+}
+
+func (s *rateLimitedStream) Send(kvs *pb.KVS) error {
+	if s.limit > 0 {
+		if s.start.IsZero() {
+			s.start = time.Now()
+		}
+		// Wait until the bytes sent so far are within the limit.
+		due := time.Duration(float64(s.sent) / float64(s.limit) * float64(time.Second))
+		if wait := due - time.Since(s.start); wait > 0 {
+			time.Sleep(wait)
+		}
+		s.sent += uint64(kvs.Size())
+	}
+	return s.Worker_ReceivePredicateClient.Send(kvs)
+}
+
+func movePredicateHelper(ctx context.Context, predicate string, gid uint32,
+	rateLimit uint64) error {
 	pl := groups().Leader(gid)
 	if pl == nil {
 		return x.Errorf("Unable to find a connection for group: %d\n", gid)
@@ -97,7 +123,11 @@ func movePredicateHelper(ctx context.Context, predicate string, gid uint32) erro
 
 	// sends all data except schema, schema key has different prefix
 	// Read the predicate keys and stream to keysCh.
-	sl := stream.Lists{Stream: s, Predicate: predicate, DB: pstore}
+	sl := stream.Lists{
+		Stream:    &rateLimitedStream{Worker_ReceivePredicateClient: s, limit: rateLimit},
+		Predicate: predicate,
+		DB:        pstore,
+	}
 	sl.ItemToKVFunc = func(key []byte, itr *badger.Iterator) (*pb.KV, error) {
 		l, err := posting.ReadPostingList(key, itr)
 		if err != nil {
@@ -253,13 +283,17 @@ func (w *grpcWorker) MovePredicate(ctx context.Context,
 	if !groups().ServesTablet(in.Predicate) {
 		return &emptyPayload, errUnservedTablet
 	}
+	// The index being built would be written after the predicate was sent.
+	if indexPending(in.Predicate) {
+		return &emptyPayload, errIndexPending(in.Predicate)
+	}
 	n := groups().Node
 	if !n.AmLeader() {
 		return &emptyPayload, errNotLeader
 	}
 
-	glog.Infof("Move predicate request for pred: [%v], src: [%v], dst: [%v]\n", in.Predicate,
-		in.SourceGroupId, in.DestGroupId)
+	glog.Infof("Move predicate request for pred: [%v], src: [%v], dst: [%v], "+
+		"rate limit: [%d bytes/s]\n", in.Predicate, in.SourceGroupId, in.DestGroupId, in.RateLimit)
 
 	// Ensures that all future mutations beyond this point are rejected.
 	if err := n.proposeAndWait(ctx, &pb.Proposal{State: in.State}); err != nil {
@@ -285,6 +319,6 @@ func (w *grpcWorker) MovePredicate(ctx context.Context,
 	// We iterate over badger, so need to flush and wait for sync watermark to catch up.
 	n.applyAllMarks(ctx)
 
-	err := movePredicateHelper(ctx, in.Predicate, in.DestGroupId)
+	err := movePredicateHelper(ctx, in.Predicate, in.DestGroupId, in.RateLimit)
 	return &emptyPayload, err
 }
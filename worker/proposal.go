@@ -132,6 +132,8 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) error
 				continue
 			} else if err := ValidateAndConvert(edge, &su); err != nil {
 				return err
+			} else if su.Unique && edge.Op == pb.DirectedEdge_SET && indexPending(edge.Attr) {
+				return errIndexPending(edge.Attr)
 			}
 		}
 		for _, schema := range proposal.Mutations.Schema {
@@ -17,113 +17,565 @@
 package worker
 
 import (
+	"expvar"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
 	otrace "go.opencensus.io/trace"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
-	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/conn"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
 )
 
 var (
 	emptySchemaResult pb.SchemaResult
+
+	// deprecatedTypes and deprecatedTokenizers map the value types and tokenizers slated
+	// for removal to a hint on how to migrate away from them.
+	deprecatedTypes = map[string]string{
+		"default": "Values of type default are stored untyped. Declare an explicit type " +
+			"for the predicate instead.",
+	}
+	deprecatedTokenizers = map[string]string{}
 )
 
 type resultErr struct {
+	gid    uint32
 	result *pb.SchemaResult
 	err    error
 }
 
+// SchemaGroupsError is returned by GetSchemaOverNetwork for a best effort request, along with
+// the schema returned by the other groups, when some groups failed to return their schema.
+type SchemaGroupsError struct {
+	Errors map[uint32]error // Error returned by each group which failed, by group id.
+}
+
+// Groups returns the ids of the groups which failed, in increasing order.
+func (e *SchemaGroupsError) Groups() []uint32 {
+	gids := make([]uint32, 0, len(e.Errors))
+	for gid := range e.Errors {
+		gids = append(gids, gid)
+	}
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+	return gids
+}
+
+func (e *SchemaGroupsError) Error() string {
+	gids := e.Groups()
+	msgs := make([]string, 0, len(gids))
+	for _, gid := range gids {
+		msgs = append(msgs, fmt.Sprintf("group %d: %v", gid, e.Errors[gid]))
+	}
+	return "Unable to fetch schema from " + strings.Join(msgs, "; ")
+}
+
 // getSchema iterates over all predicates and populates the asked fields, if list of
 // predicates is not specified, then all the predicates belonging to the group
 // are returned
 func getSchema(ctx context.Context, s *pb.SchemaRequest) (*pb.SchemaResult, error) {
 	ctx, span := otrace.StartSpan(ctx, "worker.getSchema")
 	defer span.End()
+	span.Annotate([]otrace.Attribute{
+		otrace.Int64Attribute("group", int64(s.GroupId)),
+		otrace.Int64Attribute("predicates", int64(len(s.Predicates))),
+	}, "")
+
+	if s.ExistsOnly {
+		return existingPredicates(s), nil
+	}
+
+	// The version is read before the changes, so that a change made in between is returned
+	// again rather than missed by a client asking for the changes since this version.
+	result := pb.SchemaResult{Version: schema.State().Version()}
+	key, cacheable := schemaCacheKey(s)
+	if cacheable {
+		if cached := scache.get(key, result.Version); cached != nil {
+			return cached, nil
+		}
+	}
+
+	err := iterateSchema(ctx, s, func(schemaNode *pb.SchemaNode) error {
+		result.Schema = append(result.Schema, schemaNode)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// A result is only cached if the schema didn't change while it was computed.
+	if cacheable && schema.State().Version() == result.Version {
+		scache.put(key, &result)
+	}
+	return &result, nil
+}
+
+// iterateSchema calls fn with the schema of every predicate of the request served by this
+// group, one predicate at a time.
+func iterateSchema(ctx context.Context, s *pb.SchemaRequest,
+	fn func(*pb.SchemaNode) error) error {
+	var changes map[string]*pb.SchemaUpdate
+	var allChanged bool
+	if s.SinceVersion > 0 {
+		var ok bool
+		changes, ok = schema.State().ChangesSince(s.SinceVersion)
+		// If the changes made that far back are gone, consider everything changed.
+		allChanged = !ok
+	}
 
-	var result pb.SchemaResult
 	var predicates []string
-	var fields []string
-	if len(s.Predicates) > 0 {
-		predicates = s.Predicates
-	} else {
+	switch {
+	case len(s.PredicatePatterns) > 0:
+		matched, err := matchPredicates(s.Namespace, s.PredicatePatterns,
+			schema.State().Predicates())
+		if err != nil {
+			return err
+		}
+		predicates = unionPredicates(s.Predicates, matched)
+	case len(s.Predicates) > 0:
+		predicates = unionPredicates(s.Predicates, nil)
+	default:
 		predicates = schema.State().Predicates()
 	}
-	if len(s.Fields) > 0 {
-		fields = s.Fields
-	} else {
-		fields = []string{"type", "index", "tokenizer", "reverse", "count", "list", "upsert",
-			"lang"}
+	fields := schemaFields(s)
+	var valueTypes map[types.TypeID]bool
+	if len(s.Types) > 0 {
+		valueTypes = make(map[types.TypeID]bool, len(s.Types))
+		for _, name := range s.Types {
+			typ, ok := types.TypeForName(name)
+			if !ok {
+				return x.Errorf("Unknown value type in types: %s", name)
+			}
+			valueTypes[typ] = true
+		}
+	}
+	var valuePattern *regexp.Regexp
+	if len(s.ValuePattern) > 0 {
+		var err error
+		if valuePattern, err = regexp.Compile(s.ValuePattern); err != nil {
+			return x.Wrapf(err, "while compiling value pattern")
+		}
+	}
+
+	var graphqlSchema map[string]*pb.SchemaUpdate
+	if x.HasString(fields, "graphql") {
+		graphqlSchema = make(map[string]*pb.SchemaUpdate, len(s.GraphqlSchema))
+		for _, update := range s.GraphqlSchema {
+			graphqlSchema[update.Predicate] = update
+		}
 	}
 
 	for _, attr := range predicates {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
 		// This can happen after a predicate is moved. We don't delete predicate from schema state
-		// immediately. So lets ignore this predicate.
+		// immediately. So lets ignore this predicate, unless asked to report it.
 		if !groups().ServesTablet(attr) {
+			if s.IncludeMoving {
+				if err := fn(notServedNode(attr)); err != nil {
+					return err
+				}
+			}
 			continue
 		}
-		if schemaNode := populateSchema(attr, fields); schemaNode != nil {
-			result.Schema = append(result.Schema, schemaNode)
+		if !hasNameLen(attr, s) {
+			continue
+		}
+		if s.ReversesOnly && !schema.State().IsReversed(attr) {
+			continue
+		}
+		if s.IndexedOnly && !schema.State().IsIndexed(attr) {
+			continue
+		}
+		if valueTypes != nil {
+			if typ, err := schema.State().TypeOf(attr); err != nil || !valueTypes[typ] {
+				continue
+			}
+		}
+		var missingIndexFor []string
+		if s.MissingIndexOnly {
+			if missingIndexFor = pstats.missingIndexFor(attr); len(missingIndexFor) == 0 {
+				continue
+			}
+		}
+		prev, changed := changes[attr]
+		if s.SinceVersion > 0 && !changed && !allChanged {
+			continue
+		}
+		schemaNode := populateSchema(attr, fields)
+		if schemaNode == nil {
+			continue
+		}
+		if s.SinceVersion > 0 {
+			if allChanged {
+				prev = nil
+			}
+			cur, _ := schema.State().Get(attr)
+			schemaNode.ChangedFields = changedFields(prev, &cur)
+		}
+		if s.ReversesOnly {
+			schemaNode.ReversePredicate = reversePredicate(attr)
+		}
+		schemaNode.MissingIndexFor = missingIndexFor
+		if s.ValidateConstraints {
+			var err error
+			schemaNode.Violations, schemaNode.ViolationsSampled, err = constraintViolations(attr)
+			if err != nil {
+				return err
+			}
+		}
+		if valuePattern != nil {
+			typ, _ := schema.State().TypeOf(attr)
+			var err error
+			schemaNode.MatchedValue, schemaNode.MatchedValueEstimated, err =
+				matchValuePattern(attr, typ, valuePattern)
+			if err != nil {
+				return err
+			}
+		}
+		if graphqlSchema != nil {
+			schemaNode.GraphqlMismatch = graphqlMismatch(attr, graphqlSchema[attr])
+			schemaNode.GraphqlConsistent = schemaNode.GraphqlMismatch == ""
+		}
+		if err := fn(schemaNode); err != nil {
+			return err
 		}
 	}
-	return &result, nil
+	return nil
 }
 
-// populateSchema returns the information of asked fields for given attribute
-func populateSchema(attr string, fields []string) *api.SchemaNode {
-	var schemaNode api.SchemaNode
-	var typ types.TypeID
-	var err error
-	if typ, err = schema.State().TypeOf(attr); err != nil {
-		// schema is not defined
-		return nil
+// notServedNode returns the schema of a predicate which is still in the schema state of this
+// group but isn't served by it anymore. Only its type is returned, the rest of its schema may
+// be stale.
+func notServedNode(attr string) *pb.SchemaNode {
+	node := &pb.SchemaNode{Predicate: attr, NotServed: true}
+	if typ, err := schema.State().TypeOf(attr); err == nil {
+		node.Type = typ.Name()
+	}
+	return node
+}
+
+// dropMovedDuplicates drops the predicates flagged as not served by a group if another group
+// returned them as served, i.e. once they've been moved there. The order of the nodes is kept.
+func dropMovedDuplicates(nodes []*pb.SchemaNode) []*pb.SchemaNode {
+	served := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		if !node.NotServed {
+			served[node.Predicate] = true
+		}
+	}
+	out := nodes[:0]
+	for _, node := range nodes {
+		if node.NotServed && served[node.Predicate] {
+			continue
+		}
+		out = append(out, node)
+	}
+	return out
+}
+
+// existingPredicates returns the predicates of the request served by this group which have a
+// schema, along with their type. Like addToSchemaMap, only the tablets known already are
+// looked at.
+func existingPredicates(s *pb.SchemaRequest) *pb.SchemaResult {
+	result := &pb.SchemaResult{Version: schema.State().Version()}
+	for _, attr := range s.Predicates {
+		if tablet := groups().KnownTablet(attr); tablet == nil ||
+			tablet.GroupId != groups().groupId() {
+			continue
+		}
+		if typ, err := schema.State().TypeOf(attr); err == nil {
+			result.Schema = append(result.Schema, &pb.SchemaNode{
+				Predicate: attr,
+				Type:      typ.Name(),
+			})
+		}
+	}
+	return result
+}
+
+// hasNameLen returns whether the length of the predicate name falls within the bounds given
+// in the request.
+func hasNameLen(attr string, s *pb.SchemaRequest) bool {
+	l := uint32(len(attr))
+	if l < s.MinNameLen {
+		return false
+	}
+	return s.MaxNameLen == 0 || l <= s.MaxNameLen
+}
+
+// defaultSchemaFields are returned when no fields are asked for.
+var defaultSchemaFields = []string{"type", "index", "tokenizer", "reverse", "count", "list",
+	"upsert", "lang", "unique"}
+
+// schemaFields returns the fields asked for in the request, or the default ones if none
+// were asked for.
+func schemaFields(s *pb.SchemaRequest) []string {
+	if len(s.Fields) > 0 {
+		return s.Fields
+	}
+	return defaultSchemaFields
+}
+
+// changedFields returns the schema fields which differ between the previous and the current
+// schema of a predicate. All of them have changed if there was no previous schema.
+func changedFields(prev, cur *pb.SchemaUpdate) []string {
+	if prev == nil {
+		return defaultSchemaFields
+	}
+	var fields []string
+	if prev.ValueType != cur.ValueType {
+		fields = append(fields, "type")
+	}
+	if (len(prev.Tokenizer) > 0) != (len(cur.Tokenizer) > 0) {
+		fields = append(fields, "index")
+	}
+	if !sameTokenizers(prev.Tokenizer, cur.Tokenizer) {
+		fields = append(fields, "tokenizer")
+	}
+	if (prev.Directive == pb.SchemaUpdate_REVERSE) != (cur.Directive == pb.SchemaUpdate_REVERSE) {
+		fields = append(fields, "reverse")
+	}
+	if prev.Count != cur.Count {
+		fields = append(fields, "count")
+	}
+	if prev.List != cur.List {
+		fields = append(fields, "list")
+	}
+	if prev.Upsert != cur.Upsert {
+		fields = append(fields, "upsert")
+	}
+	if prev.Lang != cur.Lang {
+		fields = append(fields, "lang")
+	}
+	if prev.Unique != cur.Unique {
+		fields = append(fields, "unique")
+	}
+	return fields
+}
+
+// sameTokenizers returns whether both lists have the same tokenizers, in any order.
+func sameTokenizers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	names := make(map[string]struct{}, len(a))
+	for _, name := range a {
+		names[name] = struct{}{}
+	}
+	for _, name := range b {
+		if _, ok := names[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// tokenizerDetail returns the kind of every tokenizer of the predicate, and whether it indexes
+// the values differently depending on their language. It's empty rather than nil if the
+// predicate has no index.
+func tokenizerDetail(attr string) []*pb.TokenizerDetail {
+	details := []*pb.TokenizerDetail{}
+	if !schema.State().IsIndexed(attr) {
+		return details
+	}
+	hasLang := schema.State().HasLang(attr)
+	for _, t := range schema.State().Tokenizer(attr) {
+		detail := &pb.TokenizerDetail{Name: t.Name(), Kind: "other"}
+		switch t.(type) {
+		case tok.TrigramTokenizer:
+			detail.Kind = "trigram"
+		case tok.FullTextTokenizer:
+			detail.Kind = "fulltext"
+			// Only the full-text tokenizer stems and drops stop words per language, see
+			// tok.GetLangTokenizer.
+			detail.LangVariants = hasLang
+		case tok.TermTokenizer:
+			detail.Kind = "term"
+		}
+		details = append(details, detail)
+	}
+	return details
+}
+
+// deprecation returns whether the predicate uses a deprecated type or tokenizer, along with
+// the hints on how to migrate away from them.
+func deprecation(attr string, typ types.TypeID) (bool, string) {
+	var notes []string
+	if note, ok := deprecatedTypes[typ.Name()]; ok {
+		notes = append(notes, note)
+	}
+	if schema.State().IsIndexed(attr) {
+		for _, name := range schema.State().TokenizerNames(attr) {
+			if note, ok := deprecatedTokenizers[name]; ok {
+				notes = append(notes, note)
+			}
+		}
+	}
+	return len(notes) > 0, strings.Join(notes, " ")
+}
+
+// reversePredicate returns the name under which the reverse edges of the predicate are queried.
+func reversePredicate(attr string) string {
+	return "~" + attr
+}
+
+// indexPredicate returns the name of the index entries made by the tokenizer for the
+// predicate, written the way the index is declared in the schema.
+func indexPredicate(attr, tokenizer string) string {
+	return attr + "@index(" + tokenizer + ")"
+}
+
+// servingRole returns whether this server answers as the primary (leader) of its group or as
+// a replica.
+func servingRole() string {
+	if groups().Node != nil && groups().Node.AmLeader() {
+		return "primary"
 	}
-	schemaNode.Predicate = attr
-	for _, field := range fields {
-		switch field {
-		case "type":
-			schemaNode.Type = typ.Name()
-		case "index":
-			schemaNode.Index = schema.State().IsIndexed(attr)
-		case "tokenizer":
-			if schema.State().IsIndexed(attr) {
-				schemaNode.Tokenizer = schema.State().TokenizerNames(attr)
-			}
-		case "reverse":
-			schemaNode.Reverse = schema.State().IsReversed(attr)
-		case "count":
-			schemaNode.Count = schema.State().HasCount(attr)
-		case "list":
-			schemaNode.List = schema.State().IsList(attr)
-		case "upsert":
-			schemaNode.Upsert = schema.State().HasUpsert(attr)
-		case "lang":
-			schemaNode.Lang = schema.State().HasLang(attr)
-		default:
-			//pass
-		}
-	}
-	return &schemaNode
+	return "replica"
+}
+
+// hasGeoIndex returns whether the predicate is indexed with the geo tokenizer, which is
+// needed by the within, contains and intersects functions.
+func hasGeoIndex(attr string, typ types.TypeID) bool {
+	if typ != types.GeoID || !schema.State().IsIndexed(attr) {
+		return false
+	}
+	for _, t := range schema.State().Tokenizer(attr) {
+		if _, ok := t.(tok.GeoTokenizer); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPredicates returns the predicates of the namespace whose name within the namespace matches
+// any of the patterns.
+func matchPredicates(ns uint64, patterns, predicates []string) ([]string, error) {
+	res := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, x.Wrapf(err, "while compiling predicate pattern %q", pattern)
+		}
+		res = append(res, re)
+	}
+	var matched []string
+	for _, pred := range predicates {
+		pns, name := x.ParseNamespaceAttr(pred)
+		if pns != ns {
+			continue
+		}
+		for _, re := range res {
+			if re.MatchString(name) {
+				matched = append(matched, pred)
+				break
+			}
+		}
+	}
+	return matched, nil
+}
+
+// unionPredicates returns the predicates of both lists, without duplicates.
+func unionPredicates(a, b []string) []string {
+	seen := make(map[string]struct{}, len(a)+len(b))
+	var union []string
+	for _, list := range [][]string{a, b} {
+		for _, pred := range list {
+			if _, ok := seen[pred]; ok {
+				continue
+			}
+			seen[pred] = struct{}{}
+			union = append(union, pred)
+		}
+	}
+	return union
 }
 
 // addToSchemaMap groups the predicates by group id, if list of predicates is
-// empty then it adds all known groups
-func addToSchemaMap(schemaMap map[uint32]*pb.SchemaRequest, schema *pb.SchemaRequest) {
-	for _, attr := range schema.Predicates {
-		gid := groups().BelongsTo(attr)
+// empty then it adds all known groups. The predicate patterns are expanded to the
+// predicates known to match them, so that only the groups serving them are asked.
+// Duplicate predicates are dropped, keeping the first one.
+func addToSchemaMap(schemaMap map[uint32]*pb.SchemaRequest, schema *pb.SchemaRequest) error {
+	excluded := make(map[uint32]struct{}, len(schema.ExcludeGroups))
+	for _, gid := range schema.ExcludeGroups {
+		excluded[gid] = struct{}{}
+	}
+	newRequest := func(gid uint32) *pb.SchemaRequest {
+		return &pb.SchemaRequest{
+			GroupId: gid,
+			// The fields are resolved here rather than by every group, so that a server with
+			// other default fields still returns the same ones.
+			Fields:              schemaFields(schema),
+			MinNameLen:          schema.MinNameLen,
+			MaxNameLen:          schema.MaxNameLen,
+			SinceVersion:        schema.SinceVersion,
+			Sort:                schema.Sort,
+			ValuePattern:        schema.ValuePattern,
+			ReversesOnly:        schema.ReversesOnly,
+			GroupByLeader:       schema.GroupByLeader,
+			ValidateConstraints: schema.ValidateConstraints,
+			MissingIndexOnly:    schema.MissingIndexOnly,
+			BestEffort:          schema.BestEffort,
+			ExistsOnly:          schema.ExistsOnly,
+			ReadFromAny:         schema.ReadFromAny,
+			Types:               schema.Types,
+			IndexedOnly:         schema.IndexedOnly,
+			IncludeMoving:       schema.IncludeMoving,
+			GraphqlSchema:       schema.GraphqlSchema,
+		}
+	}
+
+	var matched []string
+	if len(schema.PredicatePatterns) > 0 {
+		var err error
+		matched, err = matchPredicates(schema.Namespace, schema.PredicatePatterns,
+			groups().KnownPredicates())
+		if err != nil {
+			return err
+		}
+	}
+	// Every predicate is asked for once, even if it's listed more than once.
+	predicates := unionPredicates(schema.Predicates, matched)
+	for _, attr := range predicates {
+		var gid uint32
+		if schema.ExistsOnly {
+			// Checking whether a predicate exists mustn't create its tablet, nor cost a call to
+			// Zero for every unknown name, so only the tablets known already are asked.
+			tablet := groups().KnownTablet(attr)
+			if tablet == nil {
+				continue
+			}
+			gid = tablet.GroupId
+		} else {
+			gid = groups().BelongsTo(attr)
+		}
+		if _, ok := excluded[gid]; ok {
+			continue
+		}
 		s := schemaMap[gid]
 		if s == nil {
-			s = &pb.SchemaRequest{GroupId: gid}
-			s.Fields = schema.Fields
+			s = newRequest(gid)
 			schemaMap[gid] = s
 		}
 		s.Predicates = append(s.Predicates, attr)
 	}
-	if len(schema.Predicates) > 0 {
-		return
+	if len(schema.Predicates) > 0 || len(schema.PredicatePatterns) > 0 {
+		return nil
 	}
 	// TODO: Janardhan - node shouldn't serve any request until membership
 	// information is synced, should we fail health check till then ?
@@ -132,75 +584,481 @@ func addToSchemaMap(schemaMap map[uint32]*pb.SchemaRequest, schema *pb.SchemaReq
 		if gid == 0 {
 			continue
 		}
-		s := schemaMap[gid]
-		if s == nil {
-			s = &pb.SchemaRequest{GroupId: gid}
-			s.Fields = schema.Fields
-			schemaMap[gid] = s
+		if _, ok := excluded[gid]; ok {
+			continue
+		}
+		if _, ok := schemaMap[gid]; !ok {
+			schemaMap[gid] = newRequest(gid)
+		}
+	}
+	return nil
+}
+
+// schemaReadBuckets are the upper bounds (in milliseconds) of the buckets of the schema read
+// latency histogram.
+var schemaReadBuckets = []int64{1, 5, 10, 50, 100, 500, 1000, 5000}
+
+// recordSchemaRead counts a schema read served locally or forwarded to another group, and
+// adds its latency to the histogram of its source. Like Prometheus histograms, the buckets are
+// cumulative.
+func recordSchemaRead(source string, latency time.Duration) {
+	x.SchemaReads.Add(source, 1)
+	buckets, ok := x.SchemaReadLatency.Get(source).(*expvar.Map)
+	if !ok {
+		return
+	}
+	ms := int64(latency / time.Millisecond)
+	for _, le := range schemaReadBuckets {
+		if ms <= le {
+			buckets.Add(strconv.FormatInt(le, 10), 1)
+		}
+	}
+	buckets.Add("+Inf", 1)
+}
+
+// transientError marks an error which may go away by itself, e.g. while the membership
+// information is being synced after a leader change, so that the request is worth retrying.
+type transientError struct {
+	error
+}
+
+// isTransient returns whether retrying the schema request which failed with the error may help.
+func isTransient(err error) bool {
+	if _, ok := err.(transientError); ok {
+		return true
+	}
+	return err == errUnservedTablet || conn.IsRetriable(err)
+}
+
+func errNoHealthyServer(gid uint32) error {
+	return transientError{x.Errorf("No healthy server in group %d: %v", gid, conn.ErrNoConnection)}
+}
+
+// checkGroupHealth returns an error naming the group if it isn't served by this server and
+// none of its servers can be reached, so that it can be left out before asking it anything.
+func checkGroupHealth(gid uint32) error {
+	if groups().ServesGroup(gid) || len(groups().Servers(gid)) > 0 {
+		return nil
+	}
+	return errNoHealthyServer(gid)
+}
+
+// schemaReads counts the schema reads from any server, to spread them across the servers of
+// the groups.
+var schemaReads uint32
+
+// readOrder returns the servers of a group, leader first, in the order they should be asked for
+// the schema. If the schema can be read from any server, one of them is picked in turn and
+// asked first, falling back to the leader and then the others.
+func readOrder(pools []*conn.Pool, readFromAny bool) []*conn.Pool {
+	if !readFromAny || len(pools) < 2 {
+		return pools
+	}
+	first := int(atomic.AddUint32(&schemaReads, 1) % uint32(len(pools)))
+	ordered := make([]*conn.Pool, 0, len(pools))
+	ordered = append(ordered, pools[first])
+	for i, pl := range pools {
+		if i != first {
+			ordered = append(ordered, pl)
 		}
 	}
+	return ordered
 }
 
 // If the current node serves the group serve the schema or forward
-// to relevant node
-// TODO: Janardhan - if read fails try other servers serving same group
+// to relevant node. The leader is asked first, falling back to the other
+// servers of the group one after the other if it can't be reached or is
+// unavailable.
 func getSchemaOverNetwork(ctx context.Context, gid uint32, s *pb.SchemaRequest, ch chan resultErr) {
-	if groups().ServesGroup(gid) {
+	span := otrace.FromContext(ctx)
+	start := time.Now()
+	servesGroup := groups().ServesGroup(gid)
+	if servesGroup && x.HealthCheck() == nil {
+		span.Annotatef(nil, "Serving schema of group %d locally", gid)
 		schema, e := getSchema(ctx, s)
-		ch <- resultErr{result: schema, err: e}
+		recordSchemaRead("local", time.Since(start))
+		ch <- resultErr{gid: gid, result: groupSchemaResult(gid, s, schema), err: e}
 		return
 	}
+	span.Annotatef(nil, "Forwarding schema request to group %d", gid)
+	defer func() {
+		recordSchemaRead("forwarded", time.Since(start))
+	}()
 
-	pl := groups().Leader(gid)
-	if pl == nil {
-		ch <- resultErr{err: conn.ErrNoConnection}
+	pools := schemaServers(gid, s)
+	if len(pools) == 0 {
+		ch <- resultErr{gid: gid, err: errNoHealthyServer(gid)}
+		return
+	}
+	ctx, cancel, timeout := withSchemaTimeout(ctx)
+	defer cancel()
+	reply, err := conn.WithFailover(ctx, pools,
+		func(ctx context.Context, pl *conn.Pool) (interface{}, error) {
+			return pb.NewWorkerClient(pl.Get()).Schema(ctx, s)
+		})
+	if err == nil {
+		ch <- resultErr{gid: gid, result: groupSchemaResult(gid, s, reply.(*pb.SchemaResult))}
 		return
 	}
-	conn := pl.Get()
-	c := pb.NewWorkerClient(conn)
-	schema, e := c.Schema(ctx, s)
-	ch <- resultErr{result: schema, err: e}
+	glog.Warningf("Error while reading schema of group %d: %v", gid, err)
+	ch <- resultErr{gid: gid, err: schemaReadError(ctx, gid, timeout, err)}
+}
+
+// schemaServers returns the servers of the group to ask for its schema, in the order they
+// should be asked. This server is left out if it's unhealthy.
+func schemaServers(gid uint32, s *pb.SchemaRequest) []*conn.Pool {
+	pools := groups().Servers(gid)
+	if groups().ServesGroup(gid) {
+		// This server is unhealthy, so only the other servers of its group are asked.
+		others := pools[:0]
+		for _, pl := range pools {
+			if pl.Addr != Config.MyAddr {
+				others = append(others, pl)
+			}
+		}
+		pools = others
+	}
+	return readOrder(pools, s.ReadFromAny)
+}
+
+// withSchemaTimeout bounds the context by Config.SchemaTimeout, unless it already has a
+// deadline. Without one, a group which stopped responding would hold up the request forever.
+// The timeout returned is zero if the context wasn't bounded.
+func withSchemaTimeout(ctx context.Context) (context.Context, context.CancelFunc,
+	time.Duration) {
+	timeout := Config.SchemaTimeout
+	if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+		return ctx, func() {}, 0
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, timeout
+}
+
+// schemaReadError returns the error reading the schema of the group failed with, telling
+// that the read timed out if it ran past the timeout set by withSchemaTimeout.
+func schemaReadError(ctx context.Context, gid uint32, timeout time.Duration, err error) error {
+	if timeout > 0 && ctx.Err() == context.DeadlineExceeded {
+		return x.Errorf("Timed out after %v while reading schema of group %d", timeout, gid)
+	}
+	return err
+}
+
+// validateSchemaRequest checks that the arguments of the request are consistent.
+func validateSchemaRequest(s *pb.SchemaRequest) error {
+	for _, field := range s.Fields {
+		if _, ok := knownSchemaFields[field]; !ok {
+			return x.Errorf("Unknown schema field: %s", field)
+		}
+	}
+	for _, name := range s.Types {
+		if _, ok := types.TypeForName(name); !ok {
+			return x.Errorf("Unknown value type in types: %s", name)
+		}
+	}
+	if s.MaxNameLen > 0 && s.MinNameLen > s.MaxNameLen {
+		return x.Errorf("min_name_len: %d can't be greater than max_name_len: %d",
+			s.MinNameLen, s.MaxNameLen)
+	}
+	if s.GroupByLeader && s.Sort != "" {
+		return x.Errorf("group_by_leader can't be combined with sort")
+	}
+	if s.BestEffort && s.Sort != "" {
+		return x.Errorf("best_effort can't be combined with sort")
+	}
+	if len(s.ExcludeGroups) == 0 {
+		return nil
+	}
+	known := make(map[uint32]struct{})
+	for _, gid := range groups().KnownGroups() {
+		known[gid] = struct{}{}
+	}
+	for _, gid := range s.ExcludeGroups {
+		if _, ok := known[gid]; !ok || gid == 0 {
+			return x.Errorf("Unknown group id in exclude_groups: %d", gid)
+		}
+	}
+	return nil
+}
+
+// groupSchemaResult does what's common to the schema served by this server and the schema
+// returned by another group, so that every node of the aggregated result has exactly the
+// fields asked for, whichever server populated it.
+func groupSchemaResult(gid uint32, s *pb.SchemaRequest,
+	result *pb.SchemaResult) *pb.SchemaResult {
+	if result == nil {
+		return nil
+	}
+	// Existence checks only return the type, whatever the fields.
+	if !s.ExistsOnly {
+		for i, node := range result.Schema {
+			result.Schema[i] = selectSchemaFields(node, s)
+		}
+	}
+	return withLeaderAddr(gid, s, result)
+}
+
+// selectSchemaFields returns a copy of the schema node with only the fields of the request
+// set, along with what the other arguments of the request add to it.
+func selectSchemaFields(node *pb.SchemaNode, s *pb.SchemaRequest) *pb.SchemaNode {
+	out := projectSchemaNode(node, schemaFields(s))
+	if s.ReversesOnly {
+		out.ReversePredicate = node.ReversePredicate
+	}
+	out.ChangedFields = node.ChangedFields
+	out.MissingIndexFor = node.MissingIndexFor
+	out.Violations, out.ViolationsSampled = node.Violations, node.ViolationsSampled
+	out.MatchedValue, out.MatchedValueEstimated = node.MatchedValue, node.MatchedValueEstimated
+	out.NotServed = node.NotServed
+	// The field sorted by is needed to merge the schema of the groups.
+	switch s.Sort {
+	case "type":
+		out.Type = node.Type
+	case "alter_freq_desc":
+		out.AlterCount = node.AlterCount
+	}
+	return out
+}
+
+// withLeaderAddr sets the address of the leader of the group on the schema nodes of the
+// result, if the request asks for it. The address is left empty if the group has no leader.
+func withLeaderAddr(gid uint32, s *pb.SchemaRequest, result *pb.SchemaResult) *pb.SchemaResult {
+	if !s.GroupByLeader || result == nil {
+		return result
+	}
+	addr := groups().LeaderAddr(gid)
+	for _, node := range result.Schema {
+		node.LeaderAddr = addr
+	}
+	return result
+}
+
+// sortByLeader orders the schema nodes by the address of the leader serving them, keeping the
+// order of the nodes served by the same leader. Nodes without a leader are put last.
+func sortByLeader(nodes []*pb.SchemaNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		a, b := nodes[i].LeaderAddr, nodes[j].LeaderAddr
+		if a == "" || b == "" {
+			return b == "" && a != ""
+		}
+		return a < b
+	})
+}
+
+// sortTokenizers orders the tokenizers of the node by name. The list is copied, since it
+// may be shared with the schema cache.
+func sortTokenizers(node *pb.SchemaNode) {
+	if len(node.Tokenizer) < 2 {
+		return
+	}
+	tokenizers := make([]string, len(node.Tokenizer))
+	copy(tokenizers, node.Tokenizer)
+	sort.Strings(tokenizers)
+	node.Tokenizer = tokenizers
+}
+
+// sortByPredicate orders the schema nodes by predicate, so that the same schema is always
+// returned in the same order whichever group replied first.
+func sortByPredicate(nodes []*pb.SchemaNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].Predicate < nodes[j].Predicate
+	})
+	for _, node := range nodes {
+		sortTokenizers(node)
+	}
 }
 
 // GetSchemaOverNetwork checks which group should be serving the schema
 // according to fingerprint of the predicate and sends it to that instance.
-func GetSchemaOverNetwork(ctx context.Context, schema *pb.SchemaRequest) ([]*api.SchemaNode, error) {
+func GetSchemaOverNetwork(ctx context.Context, schema *pb.SchemaRequest) ([]*pb.SchemaNode, error) {
+	result, err := GetSchemaResultOverNetwork(ctx, schema)
+	if result == nil {
+		return nil, err
+	}
+	return result.Schema, err
+}
+
+// GetSchemaResultOverNetwork is like GetSchemaOverNetwork, but also returns the version the
+// schema was read at. It's the oldest of the versions the groups read their schema at, so that
+// asking for the changes since that version doesn't miss any. The version is unknown, and left
+// zero, when the schema is sorted.
+//
+// The request is retried up to Config.SchemaRetries times if it fails with a transient error,
+// waiting longer every time.
+func GetSchemaResultOverNetwork(ctx context.Context,
+	schema *pb.SchemaRequest) (*pb.SchemaResult, error) {
+	for attempt := 0; ; attempt++ {
+		result, err := getSchemaResultOverNetwork(ctx, schema)
+		if err == nil || attempt >= Config.SchemaRetries || !isTransient(err) {
+			return result, err
+		}
+		wait := schemaBackoff(attempt)
+		glog.Warningf("Retrying schema request in %v after error: %v", wait, err)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// schemaBackoff returns how long to wait before retrying a schema request for the given
+// attempt. The wait doubles every attempt, up to a limit, and is randomized between half of it
+// and all of it so that servers retrying at the same time spread out.
+func schemaBackoff(attempt int) time.Duration {
+	const maxSchemaBackoff = 10 * time.Second
+	wait := Config.SchemaRetryBackoff
+	if wait <= 0 {
+		wait = 100 * time.Millisecond
+	}
+	for i := 0; i < attempt && wait < maxSchemaBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxSchemaBackoff {
+		wait = maxSchemaBackoff
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+func getSchemaResultOverNetwork(ctx context.Context,
+	schema *pb.SchemaRequest) (*pb.SchemaResult, error) {
 	ctx, span := otrace.StartSpan(ctx, "worker.GetSchemaOverNetwork")
 	defer span.End()
 
-	if err := x.HealthCheck(); err != nil {
+	if schema.Sort != "" {
+		var schemaNodes []*pb.SchemaNode
+		err := StreamSchemaOverNetwork(ctx, schema, func(node *pb.SchemaNode) error {
+			sortTokenizers(node)
+			schemaNodes = append(schemaNodes, node)
+			if schema.Limit > 0 && len(schemaNodes) == int(schema.Limit) {
+				return errSchemaLimit
+			}
+			return nil
+		})
+		if err == errSchemaLimit {
+			err = nil
+		}
+		return &pb.SchemaResult{Schema: schemaNodes}, err
+	}
+
+	// An unhealthy server which knows the membership state still asks the other groups for their
+	// schema, and the other servers of its own group for the schema of its group. The health of
+	// every group is checked as it's asked for its schema.
+	if err := x.HealthCheck(); err != nil && !hasMembershipState() {
+		return nil, err
+	}
+	if err := validateSchemaRequest(schema); err != nil {
 		return nil, err
 	}
 
 	// Map of groupd id => Predicates for that group.
 	schemaMap := make(map[uint32]*pb.SchemaRequest)
-	addToSchemaMap(schemaMap, schema)
+	if err := addToSchemaMap(schemaMap, schema); err != nil {
+		return nil, err
+	}
+	span.Annotate([]otrace.Attribute{
+		otrace.Int64Attribute("predicates", int64(len(schema.Predicates))),
+		otrace.Int64Attribute("groups", int64(len(schemaMap))),
+	}, "")
+	for gid, s := range schemaMap {
+		span.Annotatef(nil, "Group %d: %d predicates", gid, len(s.Predicates))
+	}
 
-	results := make(chan resultErr, len(schemaMap))
-	var schemaNodes []*api.SchemaNode
+	var schemaNodes []*pb.SchemaNode
+	var version uint64
+	var versionSet bool
+	groupsErr := &SchemaGroupsError{Errors: make(map[uint32]error)}
 
+	// Every group is checked before any of them is asked, so that failing the request doesn't
+	// leave the groups already asked running.
+	ask := make(map[uint32]*pb.SchemaRequest, len(schemaMap))
 	for gid, s := range schemaMap {
 		if gid == 0 {
-			return schemaNodes, errUnservedTablet
+			if !schema.BestEffort {
+				return nil, errUnservedTablet
+			}
+			groupsErr.Errors[gid] = errUnservedTablet
+			continue
 		}
-		go getSchemaOverNetwork(ctx, gid, s, results)
+		// A group without any healthy server fails on its own, without holding up the others.
+		if err := checkGroupHealth(gid); err != nil {
+			if !schema.BestEffort {
+				return nil, err
+			}
+			groupsErr.Errors[gid] = err
+			continue
+		}
+		ask[gid] = s
+	}
+
+	// The groups still being asked are cancelled once the request fails.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// At most Config.SchemaFanout groups are asked at the same time. The results channel can
+	// hold the result of every group, so the goroutines waiting for their turn never block on it.
+	results := make(chan resultErr, len(ask))
+	fanout := Config.SchemaFanout
+	if fanout <= 0 {
+		fanout = len(ask)
+	}
+	sem := make(chan struct{}, fanout)
+	for gid, s := range ask {
+		go func(gid uint32, s *pb.SchemaRequest) {
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+				getSchemaOverNetwork(ctx, gid, s, results)
+			case <-ctx.Done():
+				results <- resultErr{gid: gid, err: ctx.Err()}
+			}
+		}(gid, s)
 	}
 
 	// wait for all the goroutines to reply back.
-	// we return if an error was returned or the parent called ctx.Done()
-	for i := 0; i < len(schemaMap); i++ {
+	// we return if an error was returned or the parent called ctx.Done(), unless the request
+	// is best effort, in which case the errors are collected along with the schema.
+	for range ask {
 		select {
 		case r := <-results:
 			if r.err != nil {
-				return nil, r.err
+				if !schema.BestEffort {
+					err := x.Wrapf(r.err, "while fetching schema of group %d", r.gid)
+					if isTransient(r.err) {
+						err = transientError{err}
+					}
+					return nil, err
+				}
+				groupsErr.Errors[r.gid] = r.err
+				continue
 			}
 			schemaNodes = append(schemaNodes, r.result.Schema...)
+			if !versionSet || r.result.Version < version {
+				version, versionSet = r.result.Version, true
+			}
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		}
 	}
+	// A cancelled request shouldn't look like a partial result.
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	sortByPredicate(schemaNodes)
+	if schema.IncludeMoving {
+		schemaNodes = dropMovedDuplicates(schemaNodes)
+	}
+	if schema.GroupByLeader {
+		sortByLeader(schemaNodes)
+	}
+	if schema.Limit > 0 && len(schemaNodes) > int(schema.Limit) {
+		schemaNodes = schemaNodes[:schema.Limit]
+	}
+	result := &pb.SchemaResult{Schema: schemaNodes, Version: version}
+	if len(groupsErr.Errors) > 0 {
+		return result, groupsErr
+	}
 
-	return schemaNodes, nil
+	return result, nil
 }
 
 // Schema is used to get schema information over the network on other instances.
@@ -210,7 +1068,117 @@ func (w *grpcWorker) Schema(ctx context.Context, s *pb.SchemaRequest) (*pb.Schem
 	}
 
 	if !groups().ServesGroup(s.GroupId) {
+		// Unavailable, so that the request goes to another server of the group.
+		return &emptySchemaResult, status.Errorf(codes.Unavailable,
+			"This server doesn't serve group id: %v", s.GroupId)
+	}
+	return getSchema(ctx, s)
+}
+
+// RefreshSchema reloads the schema of the given predicates from disk, or of all the predicates
+// if none are given, and returns it. It's meant to recover from a schema held in memory which
+// got out of sync with the one on disk, and can safely be retried.
+func (w *grpcWorker) RefreshSchema(ctx context.Context,
+	s *pb.SchemaRequest) (*pb.SchemaResult, error) {
+	if ctx.Err() != nil {
+		return &emptySchemaResult, ctx.Err()
+	}
+	if !Config.SchemaRefresh {
+		return &emptySchemaResult, x.Errorf("Schema refresh is disabled. " +
+			"Please restart the server with --schema_refresh to enable it.")
+	}
+	// The group can be left out, to refresh the schema served by this server.
+	if s.GroupId != 0 && !groups().ServesGroup(s.GroupId) {
 		return &emptySchemaResult, x.Errorf("This server doesn't serve group id: %v", s.GroupId)
 	}
+
+	if len(s.Predicates) > 0 {
+		for _, attr := range s.Predicates {
+			if err := schema.Reload(attr); err != nil {
+				return &emptySchemaResult, err
+			}
+		}
+		return getSchema(ctx, s)
+	}
+
+	// Reload the predicates held in memory, dropping the ones gone from disk, and then load the
+	// ones found on disk only.
+	for _, attr := range schema.State().Predicates() {
+		if err := schema.Reload(attr); err != nil {
+			return &emptySchemaResult, err
+		}
+	}
+	if err := schema.LoadFromDb(); err != nil {
+		return &emptySchemaResult, err
+	}
 	return getSchema(ctx, s)
 }
+
+// SnapshotAndWatch sends the schema of the predicates served by this group, followed by the
+// schema of every predicate as it gets changed. Because the snapshot is taken in the same
+// step the watch is established, a client mirroring the schema can't miss a change that
+// happens between fetching it and watching it.
+func (w *grpcWorker) SnapshotAndWatch(s *pb.SchemaRequest,
+	stream pb.Worker_SnapshotAndWatchServer) error {
+	ctx := stream.Context()
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if !groups().ServesGroup(s.GroupId) {
+		return x.Errorf("This server doesn't serve group id: %v", s.GroupId)
+	}
+
+	preds, changes, cancel := schema.State().Watch()
+	defer cancel()
+
+	asked := make(map[string]struct{}, len(s.Predicates))
+	for _, attr := range s.Predicates {
+		asked[attr] = struct{}{}
+	}
+	wants := func(attr string) bool {
+		if len(asked) == 0 {
+			return true
+		}
+		_, ok := asked[attr]
+		return ok
+	}
+	fields := schemaFields(s)
+
+	snapshot := &pb.SchemaWatchEvent{Snapshot: true}
+	for _, attr := range preds {
+		if !wants(attr) || !groups().ServesTablet(attr) {
+			continue
+		}
+		if schemaNode := populateSchema(attr, fields); schemaNode != nil {
+			snapshot.Schema = append(snapshot.Schema, schemaNode)
+		}
+	}
+	if err := stream.Send(snapshot); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case attr, ok := <-changes:
+			if !ok {
+				return x.Errorf("Schema watch fell behind the changes. Please start over.")
+			}
+			if !wants(attr) {
+				continue
+			}
+			var event pb.SchemaWatchEvent
+			if schemaNode := populateSchema(attr, fields); schemaNode == nil {
+				event.Deleted = append(event.Deleted, attr)
+			} else if groups().ServesTablet(attr) {
+				event.Schema = append(event.Schema, schemaNode)
+			} else {
+				continue
+			}
+			if err := stream.Send(&event); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// GetSchemaBatchOverNetwork returns the schema asked for by each of the requests, in the same
+// order. The requests are merged so that every group is asked once, for every predicate any of
+// the requests asked for along with every field any of them asked for. Only requests made of
+// predicates and fields can be batched.
+func GetSchemaBatchOverNetwork(ctx context.Context,
+	reqs []*pb.SchemaRequest) ([][]*pb.SchemaNode, error) {
+	merged, err := mergeSchemaRequests(reqs)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := GetSchemaOverNetwork(ctx, merged)
+	if err != nil {
+		return nil, err
+	}
+	return splitSchema(reqs, nodes), nil
+}
+
+// mergeSchemaRequests returns a request for the predicates and fields of all the requests. A
+// request without predicates asks for all of them, and so does the merged request then.
+func mergeSchemaRequests(reqs []*pb.SchemaRequest) (*pb.SchemaRequest, error) {
+	merged := &pb.SchemaRequest{}
+	var allPredicates bool
+	for i, req := range reqs {
+		// The request has nothing but predicates and fields if it has the same size as a
+		// request made of just those.
+		batchable := pb.SchemaRequest{Predicates: req.Predicates, Fields: req.Fields}
+		if req.Size() != batchable.Size() {
+			return nil, x.Errorf("Schema request %d: only predicates and fields can be batched", i)
+		}
+		if err := validateSchemaRequest(req); err != nil {
+			return nil, x.Wrapf(err, "schema request %d", i)
+		}
+		if len(req.Predicates) == 0 {
+			allPredicates = true
+		}
+		merged.Predicates = unionPredicates(merged.Predicates, req.Predicates)
+		merged.Fields = unionPredicates(merged.Fields, schemaFields(req))
+	}
+	if allPredicates {
+		merged.Predicates = nil
+	}
+	return merged, nil
+}
+
+// splitSchema returns the schema nodes asked for by each of the requests, with only the fields
+// they asked for. The requests matching nothing get an empty list rather than nil.
+func splitSchema(reqs []*pb.SchemaRequest, nodes []*pb.SchemaNode) [][]*pb.SchemaNode {
+	split := make([][]*pb.SchemaNode, len(reqs))
+	for i, req := range reqs {
+		split[i] = []*pb.SchemaNode{}
+		preds := make(map[string]bool, len(req.Predicates))
+		for _, pred := range req.Predicates {
+			preds[pred] = true
+		}
+		fields := schemaFields(req)
+		for _, node := range nodes {
+			if len(preds) > 0 && !preds[node.Predicate] {
+				continue
+			}
+			split[i] = append(split[i], projectSchemaNode(node, fields))
+		}
+	}
+	return split
+}
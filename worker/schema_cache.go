@@ -0,0 +1,122 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+// maxSchemaCacheEntries is the number of distinct schema requests whose results are cached.
+// The cache is emptied once it's full.
+const maxSchemaCacheEntries = 128
+
+// schemaCache holds the results of the schema requests served by getSchema, for the version
+// of the schema they were computed at. The whole cache is dropped once the schema changes.
+type schemaCache struct {
+	sync.Mutex
+	disabled bool
+	version  uint64
+	results  map[string]*pb.SchemaResult
+}
+
+var scache = &schemaCache{results: make(map[string]*pb.SchemaResult)}
+
+// schemaCacheKey returns the key of the request in the cache, and false if its result can't
+// be cached because it depends on more than the schema.
+func schemaCacheKey(s *pb.SchemaRequest) (string, bool) {
+	if s.SinceVersion > 0 || s.ValidateConstraints || len(s.ValuePattern) > 0 ||
+		s.MissingIndexOnly || s.GroupByLeader || s.Sort != "" || s.ExistsOnly || s.IncludeMoving {
+		return "", false
+	}
+	fields := make([]string, 0, len(schemaFields(s)))
+	for _, field := range schemaFields(s) {
+		if f, ok := knownSchemaFields[field]; !ok || !f.cacheable {
+			return "", false
+		}
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	preds := make([]string, len(s.Predicates))
+	copy(preds, s.Predicates)
+	sort.Strings(preds)
+	patterns := make([]string, len(s.PredicatePatterns))
+	copy(patterns, s.PredicatePatterns)
+	sort.Strings(patterns)
+	valueTypes := make([]string, len(s.Types))
+	copy(valueTypes, s.Types)
+	sort.Strings(valueTypes)
+
+	return fmt.Sprintf("%q|%q|%d|%q|%q|%d|%d|%t|%t", preds, patterns, s.Namespace, fields,
+		valueTypes, s.MinNameLen, s.MaxNameLen, s.ReversesOnly, s.IndexedOnly), true
+}
+
+// get returns a copy of the result cached for the key at the given version of the schema, or
+// nil if there's none. Predicates which stopped being served by this group since the result
+// was cached are left out.
+func (c *schemaCache) get(key string, version uint64) *pb.SchemaResult {
+	c.Lock()
+	defer c.Unlock()
+	if c.disabled || c.version != version {
+		return nil
+	}
+	cached, ok := c.results[key]
+	if !ok {
+		return nil
+	}
+	result := &pb.SchemaResult{Version: cached.Version}
+	for _, node := range cached.Schema {
+		if !groups().ServesTablet(node.Predicate) {
+			continue
+		}
+		// The nodes are copied, callers are free to modify the result.
+		n := *node
+		result.Schema = append(result.Schema, &n)
+	}
+	return result
+}
+
+// put caches a copy of the result, computed at its version of the schema.
+func (c *schemaCache) put(key string, result *pb.SchemaResult) {
+	c.Lock()
+	defer c.Unlock()
+	if c.disabled || result.Version < c.version {
+		return
+	}
+	if result.Version > c.version || len(c.results) >= maxSchemaCacheEntries {
+		c.version = result.Version
+		c.results = make(map[string]*pb.SchemaResult)
+	}
+	cached := &pb.SchemaResult{Version: result.Version}
+	cached.Schema = make([]*pb.SchemaNode, 0, len(result.Schema))
+	for _, node := range result.Schema {
+		n := *node
+		cached.Schema = append(cached.Schema, &n)
+	}
+	c.results[key] = cached
+}
+
+// setDisabled turns the cache off or back on, dropping whatever it holds.
+func (c *schemaCache) setDisabled(disabled bool) {
+	c.Lock()
+	defer c.Unlock()
+	c.disabled = disabled
+	c.results = make(map[string]*pb.SchemaResult)
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+// CanonicalSchema returns the schema of the whole cluster in a canonical form, along with its
+// SHA-256 hash. The same schema always has the same canonical form, whichever server it's
+// fetched from, so it can be signed once approved and later compared with the live schema.
+func CanonicalSchema(ctx context.Context) ([]byte, [sha256.Size]byte, error) {
+	nodes, err := GetSchemaOverNetwork(ctx, &pb.SchemaRequest{Fields: defaultSchemaFields})
+	if err != nil {
+		return nil, [sha256.Size]byte{}, err
+	}
+	canonical := canonicalSchema(nodes)
+	return canonical, sha256.Sum256(canonical), nil
+}
+
+// canonicalSchema writes a line per predicate, ordered by predicate. Every line has the quoted
+// predicate followed by the default schema fields, always in the same order and with the
+// tokenizers sorted. unique is only written when set, for the schemas without it to keep their
+// hash.
+func canonicalSchema(nodes []*pb.SchemaNode) []byte {
+	sorted := make([]*pb.SchemaNode, len(nodes))
+	copy(sorted, nodes)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Predicate < sorted[j].Predicate
+	})
+
+	var buf bytes.Buffer
+	for _, node := range sorted {
+		tokenizers := make([]string, len(node.Tokenizer))
+		copy(tokenizers, node.Tokenizer)
+		sort.Strings(tokenizers)
+
+		buf.WriteString(strconv.Quote(node.Predicate))
+		fmt.Fprintf(&buf, " type=%s index=%t tokenizer=[%s] reverse=%t count=%t list=%t"+
+			" upsert=%t lang=%t", node.Type, node.Index, strings.Join(tokenizers, ","),
+			node.Reverse, node.Count, node.List, node.Upsert, node.Lang)
+		if node.Unique {
+			buf.WriteString(" unique=true")
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
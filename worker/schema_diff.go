@@ -0,0 +1,130 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"reflect"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// SchemaDiff fetches the schema asked for by both requests and compares them: the predicates
+// only returned for one of them, and the predicates returned for both whose schema differs.
+// A request with a group id only gets the schema of that group, see groupScoped. Only the
+// fields asked for by both requests are compared, with tokenizers compared as sets.
+func SchemaDiff(ctx context.Context, a, b *pb.SchemaRequest) (*pb.SchemaDiffResult, error) {
+	a, err := groupScoped(a)
+	if err != nil {
+		return nil, err
+	}
+	b, err = groupScoped(b)
+	if err != nil {
+		return nil, err
+	}
+	nodesA, err := GetSchemaOverNetwork(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	nodesB, err := GetSchemaOverNetwork(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	inB := make(map[string]bool)
+	for _, field := range schemaFields(b) {
+		inB[field] = true
+	}
+	var fields []string
+	for _, field := range schemaFields(a) {
+		if inB[field] {
+			fields = append(fields, field)
+		}
+	}
+	return diffSchema(nodesA, nodesB, fields), nil
+}
+
+// groupScoped returns the request restricted to the schema of its group, by excluding all the
+// other groups, or the request as is if it has no group id.
+func groupScoped(s *pb.SchemaRequest) (*pb.SchemaRequest, error) {
+	if s.GroupId == 0 {
+		return s, nil
+	}
+	scoped := *s
+	scoped.GroupId = 0
+	scoped.ExcludeGroups = nil
+	known := false
+	for _, gid := range groups().KnownGroups() {
+		switch gid {
+		case 0:
+		case s.GroupId:
+			known = true
+		default:
+			scoped.ExcludeGroups = append(scoped.ExcludeGroups, gid)
+		}
+	}
+	if !known {
+		return nil, x.Errorf("Unknown group id: %d", s.GroupId)
+	}
+	return &scoped, nil
+}
+
+// diffSchema compares the given fields of the schema nodes of both sides, matched by predicate.
+// The result lists the predicates in the order of the nodes.
+func diffSchema(a, b []*pb.SchemaNode, fields []string) *pb.SchemaDiffResult {
+	byPred := make(map[string]*pb.SchemaNode, len(b))
+	for _, node := range b {
+		byPred[node.Predicate] = node
+	}
+	inA := make(map[string]bool, len(a))
+
+	result := &pb.SchemaDiffResult{}
+	for _, nodeA := range a {
+		inA[nodeA.Predicate] = true
+		nodeB, ok := byPred[nodeA.Predicate]
+		if !ok {
+			result.OnlyA = append(result.OnlyA, nodeA.Predicate)
+			continue
+		}
+		var differ []string
+		for _, field := range fields {
+			fieldA := projectSchemaNode(nodeA, []string{field})
+			fieldB := projectSchemaNode(nodeB, []string{field})
+			sortTokenizers(fieldA)
+			sortTokenizers(fieldB)
+			if !reflect.DeepEqual(fieldA, fieldB) {
+				differ = append(differ, field)
+			}
+		}
+		if len(differ) > 0 {
+			result.Differ = append(result.Differ, &pb.SchemaNodeDiff{
+				Predicate: nodeA.Predicate,
+				Fields:    differ,
+				A:         nodeA,
+				B:         nodeB,
+			})
+		}
+	}
+	for _, nodeB := range b {
+		if !inA[nodeB.Predicate] {
+			result.OnlyB = append(result.OnlyB, nodeB.Predicate)
+		}
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+// SchemaNodesToDQL renders the schema nodes in the schema syntax accepted by Alter, one
+// predicate per line, so that a schema fetched with GetSchemaOverNetwork can be applied again.
+// The nodes need the default schema fields to be rendered fully.
+func SchemaNodesToDQL(nodes []*pb.SchemaNode) string {
+	// bytes.Buffer never returns error for any of the writes. So, we don't need to check them.
+	var buf bytes.Buffer
+	for _, node := range nodes {
+		if strings.ContainsRune(node.Predicate, ':') {
+			buf.WriteRune('<')
+			buf.WriteString(node.Predicate)
+			buf.WriteRune('>')
+		} else {
+			buf.WriteString(node.Predicate)
+		}
+		buf.WriteString(": ")
+		// Edges to uids are lists already, which the schema can't spell out as [uid].
+		list := node.List && node.Type != "uid"
+		if list {
+			buf.WriteRune('[')
+		}
+		buf.WriteString(node.Type)
+		if list {
+			buf.WriteRune(']')
+		}
+		if node.Reverse {
+			buf.WriteString(" @reverse")
+		}
+		if node.Index && len(node.Tokenizer) > 0 {
+			buf.WriteString(" @index(")
+			buf.WriteString(strings.Join(node.Tokenizer, ", "))
+			buf.WriteByte(')')
+		}
+		if node.Count {
+			buf.WriteString(" @count")
+		}
+		if node.Lang {
+			buf.WriteString(" @lang")
+		}
+		if node.Upsert {
+			buf.WriteString(" @upsert")
+		}
+		if node.Unique {
+			buf.WriteString(" @unique")
+		}
+		buf.WriteString(" .\n")
+	}
+	return buf.String()
+}
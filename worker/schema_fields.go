@@ -0,0 +1,304 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+)
+
+// schemaField is a field a schema request can ask for.
+type schemaField struct {
+	name string
+	// populate sets the field on the schema node of the predicate attr, whose type is typ.
+	populate func(node *pb.SchemaNode, attr string, typ types.TypeID)
+	// project copies the field from the schema node src to dst.
+	project func(dst, src *pb.SchemaNode)
+	// cacheable is whether the field only depends on the schema of the predicate, so that it
+	// can't change without the version of the schema changing.
+	cacheable bool
+}
+
+// schemaFieldTable has every field a schema request can ask for. Asking for any other field
+// is an error.
+var schemaFieldTable = []schemaField{
+	{
+		name: "type",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.Type = typ.Name()
+		},
+		project:   func(dst, src *pb.SchemaNode) { dst.Type = src.Type },
+		cacheable: true,
+	},
+	{
+		name: "index",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.Index = schema.State().IsIndexed(attr)
+		},
+		project:   func(dst, src *pb.SchemaNode) { dst.Index = src.Index },
+		cacheable: true,
+	},
+	{
+		name: "tokenizer",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			if schema.State().IsIndexed(attr) {
+				node.Tokenizer = schema.State().TokenizerNames(attr)
+			}
+		},
+		project:   func(dst, src *pb.SchemaNode) { dst.Tokenizer = src.Tokenizer },
+		cacheable: true,
+	},
+	{
+		name: "reverse",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.Reverse = schema.State().IsReversed(attr)
+		},
+		project:   func(dst, src *pb.SchemaNode) { dst.Reverse = src.Reverse },
+		cacheable: true,
+	},
+	{
+		name: "count",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.Count = schema.State().HasCount(attr)
+		},
+		project:   func(dst, src *pb.SchemaNode) { dst.Count = src.Count },
+		cacheable: true,
+	},
+	{
+		name: "list",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.List = schema.State().IsList(attr)
+		},
+		project:   func(dst, src *pb.SchemaNode) { dst.List = src.List },
+		cacheable: true,
+	},
+	{
+		name: "upsert",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.Upsert = schema.State().HasUpsert(attr)
+		},
+		project:   func(dst, src *pb.SchemaNode) { dst.Upsert = src.Upsert },
+		cacheable: true,
+	},
+	{
+		name: "lang",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.Lang = schema.State().HasLang(attr)
+		},
+		project:   func(dst, src *pb.SchemaNode) { dst.Lang = src.Lang },
+		cacheable: true,
+	},
+	{
+		name: "unique",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.Unique = schema.State().HasUnique(attr)
+		},
+		project:   func(dst, src *pb.SchemaNode) { dst.Unique = src.Unique },
+		cacheable: true,
+	},
+	{
+		name: "latency",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			p50, p95, p99 := pstats.latencyPercentiles(attr)
+			node.LatencyPercentiles = &pb.LatencyPercentiles{
+				P50Ns: p50,
+				P95Ns: p95,
+				P99Ns: p99,
+			}
+		},
+		project: func(dst, src *pb.SchemaNode) { dst.LatencyPercentiles = src.LatencyPercentiles },
+	},
+	{
+		name: "deprecated",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.Deprecated, node.DeprecationNote = deprecation(attr, typ)
+		},
+		project: func(dst, src *pb.SchemaNode) {
+			dst.Deprecated, dst.DeprecationNote = src.Deprecated, src.DeprecationNote
+		},
+		cacheable: true,
+	},
+	{
+		name: "geocontainment",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.GeoContainment = hasGeoIndex(attr, typ)
+		},
+		project:   func(dst, src *pb.SchemaNode) { dst.GeoContainment = src.GeoContainment },
+		cacheable: true,
+	},
+	{
+		name: "servedby",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			// Every node is populated by the server answering for the group, whether the
+			// request was forwarded to it or not.
+			node.ServedByRole = servingRole()
+		},
+		project: func(dst, src *pb.SchemaNode) { dst.ServedByRole = src.ServedByRole },
+	},
+	{
+		name: "group",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			// The tablet is known here, getSchema only populates the tablets it serves.
+			if tablet := groups().Tablet(attr); tablet != nil {
+				node.GroupId = tablet.GroupId
+				node.TabletSize = tablet.Space
+			}
+		},
+		project: func(dst, src *pb.SchemaNode) {
+			dst.GroupId, dst.TabletSize = src.GroupId, src.TabletSize
+		},
+	},
+	{
+		name: "vlogrefs",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.VlogRefs = vlogRefs(attr)
+		},
+		project: func(dst, src *pb.SchemaNode) { dst.VlogRefs = src.VlogRefs },
+	},
+	{
+		name: "reindexneeded",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.ReindexNeeded = reindexNeeded(attr)
+		},
+		project: func(dst, src *pb.SchemaNode) { dst.ReindexNeeded = src.ReindexNeeded },
+	},
+	{
+		name: "indexbuildmem",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.EstIndexBuildMem = indexBuildMem(attr)
+		},
+		project: func(dst, src *pb.SchemaNode) { dst.EstIndexBuildMem = src.EstIndexBuildMem },
+	},
+	{
+		name: "proposalerrors",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.ProposalErrors = pstats.recentProposalErrors(attr)
+		},
+		project: func(dst, src *pb.SchemaNode) { dst.ProposalErrors = src.ProposalErrors },
+	},
+	{
+		name: "reversepredicate",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			if schema.State().IsReversed(attr) {
+				node.ReversePredicate = reversePredicate(attr)
+			}
+		},
+		project:   func(dst, src *pb.SchemaNode) { dst.ReversePredicate = src.ReversePredicate },
+		cacheable: true,
+	},
+	{
+		name: "indexpredicates",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			// Empty rather than nil, to tell that the predicate has no index.
+			node.IndexPredicates = []string{}
+			if schema.State().IsIndexed(attr) {
+				for _, name := range schema.State().TokenizerNames(attr) {
+					node.IndexPredicates = append(node.IndexPredicates,
+						indexPredicate(attr, name))
+				}
+			}
+			node.ReverseStored = schema.State().IsReversed(attr) && hasReverseEdges(attr)
+		},
+		project: func(dst, src *pb.SchemaNode) {
+			dst.IndexPredicates, dst.ReverseStored = src.IndexPredicates, src.ReverseStored
+		},
+	},
+	{
+		name: "alterfreq",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.AlterCount = schema.State().ChangeCount(attr)
+		},
+		project:   func(dst, src *pb.SchemaNode) { dst.AlterCount = src.AlterCount },
+		cacheable: true,
+	},
+	{
+		name: "tokenizerdetail",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.TokenizerDetail = tokenizerDetail(attr)
+		},
+		project:   func(dst, src *pb.SchemaNode) { dst.TokenizerDetail = src.TokenizerDetail },
+		cacheable: true,
+	},
+	{
+		name: "countindex",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.CountIndexStored = schema.State().HasCount(attr) && hasCountIndex(attr)
+		},
+		project: func(dst, src *pb.SchemaNode) { dst.CountIndexStored = src.CountIndexStored },
+	},
+	{
+		name: "indexing",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			node.Indexing, node.IndexingPercent = posting.RebuildProgress(attr)
+			node.IndexPending = indexPending(attr)
+		},
+		project: func(dst, src *pb.SchemaNode) {
+			dst.Indexing, dst.IndexingPercent = src.Indexing, src.IndexingPercent
+			dst.IndexPending = src.IndexPending
+		},
+	},
+	{
+		name: "graphql",
+		populate: func(node *pb.SchemaNode, attr string, typ types.TypeID) {
+			// Set by getSchema, which compares the predicate against the GraphQL schema of
+			// the request.
+		},
+		project: func(dst, src *pb.SchemaNode) {
+			dst.GraphqlConsistent, dst.GraphqlMismatch = src.GraphqlConsistent, src.GraphqlMismatch
+		},
+	},
+}
+
+// knownSchemaFields maps the name of every field of schemaFieldTable to it.
+var knownSchemaFields = make(map[string]*schemaField, len(schemaFieldTable))
+
+func init() {
+	for i := range schemaFieldTable {
+		knownSchemaFields[schemaFieldTable[i].name] = &schemaFieldTable[i]
+	}
+}
+
+// populateSchema returns the information of asked fields for given attribute
+func populateSchema(attr string, fields []string) *pb.SchemaNode {
+	typ, err := schema.State().TypeOf(attr)
+	if err != nil {
+		// schema is not defined
+		return nil
+	}
+	schemaNode := &pb.SchemaNode{Predicate: attr}
+	for _, name := range fields {
+		// Unknown fields are rejected by validateSchemaRequest.
+		if field, ok := knownSchemaFields[name]; ok {
+			field.populate(schemaNode, attr, typ)
+		}
+	}
+	return schemaNode
+}
+
+// projectSchemaNode returns a copy of the schema node with only the given fields set, out of
+// the fields set by populateSchema.
+func projectSchemaNode(node *pb.SchemaNode, fields []string) *pb.SchemaNode {
+	out := &pb.SchemaNode{Predicate: node.Predicate}
+	for _, name := range fields {
+		if field, ok := knownSchemaFields[name]; ok {
+			field.project(out, node)
+		}
+	}
+	return out
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+)
+
+// graphqlMismatch returns how the schema of the predicate differs from the schema want the
+// GraphQL schema expects of it, empty if it doesn't. The predicates the GraphQL schema doesn't
+// use, for which want is nil, don't differ.
+func graphqlMismatch(attr string, want *pb.SchemaUpdate) string {
+	if want == nil {
+		return ""
+	}
+	cur, ok := schema.State().Get(attr)
+	if !ok {
+		return ""
+	}
+	var diffs []string
+	if cur.ValueType != want.ValueType {
+		diffs = append(diffs, fmt.Sprintf("type is %s, GraphQL expects %s",
+			types.TypeID(cur.ValueType).Name(), types.TypeID(want.ValueType).Name()))
+	}
+	if cur.List != want.List {
+		diffs = append(diffs, fmt.Sprintf("list is %v, GraphQL expects %v", cur.List, want.List))
+	}
+	curTokenizers, wantTokenizers := indexTokenizers(&cur), indexTokenizers(want)
+	if curTokenizers != wantTokenizers {
+		diffs = append(diffs, fmt.Sprintf("index is [%s], GraphQL expects [%s]",
+			curTokenizers, wantTokenizers))
+	}
+	return strings.Join(diffs, "; ")
+}
+
+// indexTokenizers returns the tokenizers of the index of the predicate, ordered by name.
+func indexTokenizers(update *pb.SchemaUpdate) string {
+	if update.Directive != pb.SchemaUpdate_INDEX {
+		return ""
+	}
+	tokenizers := make([]string, len(update.Tokenizer))
+	copy(tokenizers, update.Tokenizer)
+	sort.Strings(tokenizers)
+	return strings.Join(tokenizers, ", ")
+}
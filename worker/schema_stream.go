@@ -0,0 +1,508 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"container/heap"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+	otrace "go.opencensus.io/trace"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// schemaStream yields schema nodes in order, returning io.EOF once they are all consumed.
+type schemaStream interface {
+	Recv() (*pb.SchemaNode, error)
+}
+
+// localSchemaStream streams schema nodes already held in memory.
+type localSchemaStream struct {
+	nodes []*pb.SchemaNode
+}
+
+func (s *localSchemaStream) Recv() (*pb.SchemaNode, error) {
+	if len(s.nodes) == 0 {
+		return nil, io.EOF
+	}
+	node := s.nodes[0]
+	s.nodes = s.nodes[1:]
+	return node, nil
+}
+
+// batchSchemaStream streams the schema nodes of the batches sent by StreamSchema to another
+// group. The stream is bounded by the timeout of withSchemaTimeout, if any.
+type batchSchemaStream struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	gid     uint32
+	req     *pb.SchemaRequest
+	timeout time.Duration
+	stream  pb.Worker_StreamSchemaClient
+	nodes   []*pb.SchemaNode
+}
+
+func (s *batchSchemaStream) Recv() (*pb.SchemaNode, error) {
+	for len(s.nodes) == 0 {
+		batch, err := s.stream.Recv()
+		if err == io.EOF {
+			s.cancel()
+			return nil, err
+		}
+		if err != nil {
+			err = schemaReadError(s.ctx, s.gid, s.timeout, err)
+			s.cancel()
+			return nil, err
+		}
+		s.nodes = groupSchemaResult(s.gid, s.req, batch).Schema
+	}
+	node := s.nodes[0]
+	s.nodes = s.nodes[1:]
+	return node, nil
+}
+
+// openSchemaBatches opens the stream of the schema of another group, asking its servers one
+// after the other like getSchemaOverNetwork. As a server which can't be reached only fails the
+// stream once it's read from, the first batch is read before moving on to the next server.
+// The nodes streamed are those of groupSchemaResult. The stream returned is nil if the group
+// sent no schema at all.
+func openSchemaBatches(ctx context.Context, gid uint32,
+	s *pb.SchemaRequest) (*batchSchemaStream, error) {
+	pools := schemaServers(gid, s)
+	if len(pools) == 0 {
+		return nil, errNoHealthyServer(gid)
+	}
+	ctx, cancel, timeout := withSchemaTimeout(ctx)
+	reply, err := conn.WithFailover(ctx, pools,
+		func(ctx context.Context, pl *conn.Pool) (interface{}, error) {
+			stream, err := pb.NewWorkerClient(pl.Get()).StreamSchema(ctx, s)
+			if err != nil {
+				return nil, err
+			}
+			batch, err := stream.Recv()
+			if err == io.EOF {
+				return (*batchSchemaStream)(nil), nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			return &batchSchemaStream{stream: stream,
+				nodes: groupSchemaResult(gid, s, batch).Schema}, nil
+		})
+	if err != nil {
+		glog.Warningf("Error while streaming schema of group %d: %v", gid, err)
+		err = schemaReadError(ctx, gid, timeout, err)
+		cancel()
+		return nil, err
+	}
+	bs := reply.(*batchSchemaStream)
+	if bs == nil {
+		cancel()
+		return nil, nil
+	}
+	bs.ctx, bs.cancel, bs.gid, bs.req, bs.timeout = ctx, cancel, gid, s, timeout
+	return bs, nil
+}
+
+// schemaLess returns the ordering of schema nodes by the given field. Nodes with the same
+// value for the field are ordered by predicate, so that the ordering is total.
+func schemaLess(field string) (func(a, b *pb.SchemaNode) bool, error) {
+	switch field {
+	case "", "predicate":
+		return func(a, b *pb.SchemaNode) bool {
+			return a.Predicate < b.Predicate
+		}, nil
+	case "type":
+		return func(a, b *pb.SchemaNode) bool {
+			if a.Type != b.Type {
+				return a.Type < b.Type
+			}
+			return a.Predicate < b.Predicate
+		}, nil
+	case "alter_freq_desc":
+		return func(a, b *pb.SchemaNode) bool {
+			if a.AlterCount != b.AlterCount {
+				return a.AlterCount > b.AlterCount
+			}
+			return a.Predicate < b.Predicate
+		}, nil
+	default:
+		return nil, x.Errorf("Invalid schema sort field: %s", field)
+	}
+}
+
+// sortedSchema returns the schema of the predicates served by this group, ordered by the
+// field given in the request. The schema of the whole group is buffered to be sorted.
+func sortedSchema(ctx context.Context, s *pb.SchemaRequest) (*pb.SchemaResult, error) {
+	less, err := schemaLess(s.Sort)
+	if err != nil {
+		return nil, err
+	}
+	result, err := getSchema(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	if s.Sort == "alter_freq_desc" {
+		// The count is needed on every node to merge them across groups.
+		for _, node := range result.Schema {
+			node.AlterCount = schema.State().ChangeCount(node.Predicate)
+		}
+	}
+	sort.Slice(result.Schema, func(i, j int) bool {
+		return less(result.Schema[i], result.Schema[j])
+	})
+	return result, nil
+}
+
+// openSchemaStream serves the sorted schema of the group if the current node serves it and is
+// healthy, or else streams it from the other servers of the group.
+func openSchemaStream(ctx context.Context, gid uint32, s *pb.SchemaRequest) (schemaStream, error) {
+	if groups().ServesGroup(gid) && x.HealthCheck() == nil {
+		result, err := sortedSchema(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+		return &localSchemaStream{nodes: groupSchemaResult(gid, s, result).Schema}, nil
+	}
+
+	stream, err := openSchemaBatches(ctx, gid, s)
+	if err != nil || stream == nil {
+		return &localSchemaStream{}, err
+	}
+	return stream, nil
+}
+
+type schemaHead struct {
+	node   *pb.SchemaNode // Next node of the stream.
+	stream schemaStream
+}
+
+type schemaHeap struct {
+	heads []schemaHead
+	less  func(a, b *pb.SchemaNode) bool
+}
+
+func (h schemaHeap) Len() int           { return len(h.heads) }
+func (h schemaHeap) Less(i, j int) bool { return h.less(h.heads[i].node, h.heads[j].node) }
+func (h schemaHeap) Swap(i, j int)      { h.heads[i], h.heads[j] = h.heads[j], h.heads[i] }
+func (h *schemaHeap) Push(x interface{}) {
+	h.heads = append(h.heads, x.(schemaHead))
+}
+
+func (h *schemaHeap) Pop() interface{} {
+	old := h.heads
+	n := len(old)
+	x := old[n-1]
+	h.heads = old[0 : n-1]
+	return x
+}
+
+// mergeSchemaStreams does a k-way merge of the sorted streams, calling send with every node in
+// order. Only the next node of every stream is held at any time.
+func mergeSchemaStreams(streams []schemaStream, less func(a, b *pb.SchemaNode) bool,
+	send func(*pb.SchemaNode) error) error {
+	h := &schemaHeap{less: less}
+	for _, stream := range streams {
+		node, err := stream.Recv()
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		h.heads = append(h.heads, schemaHead{node: node, stream: stream})
+	}
+	heap.Init(h)
+
+	for h.Len() > 0 { // While heap is not empty.
+		head := h.heads[0] // Peek at the top element in heap.
+		if err := send(head.node); err != nil {
+			return err
+		}
+		node, err := head.stream.Recv()
+		if err == io.EOF {
+			heap.Pop(h)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		h.heads[0].node = node
+		heap.Fix(h, 0) // Faster than Pop() followed by Push().
+	}
+	return nil
+}
+
+// StreamSchemaOverNetwork calls send with the schema asked for one node at a time, ordered by
+// the field given in the request. Every group buffers and sorts the schema of its own
+// predicates, which is then merged across the groups as it is streamed back.
+func StreamSchemaOverNetwork(ctx context.Context, schema *pb.SchemaRequest,
+	send func(*pb.SchemaNode) error) error {
+	ctx, span := otrace.StartSpan(ctx, "worker.StreamSchemaOverNetwork")
+	defer span.End()
+
+	// Like getSchemaResultOverNetwork, an unhealthy server streams the schema of its group from
+	// the other servers of the group.
+	if err := x.HealthCheck(); err != nil && !hasMembershipState() {
+		return err
+	}
+	if err := validateSchemaRequest(schema); err != nil {
+		return err
+	}
+	less, err := schemaLess(schema.Sort)
+	if err != nil {
+		return err
+	}
+
+	// Cancelling the context closes the streams still open if we return early.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Map of groupd id => Predicates for that group.
+	schemaMap := make(map[uint32]*pb.SchemaRequest)
+	if err := addToSchemaMap(schemaMap, schema); err != nil {
+		return err
+	}
+
+	streams := make([]schemaStream, 0, len(schemaMap))
+	for gid, s := range schemaMap {
+		if gid == 0 {
+			return errUnservedTablet
+		}
+		if err := checkGroupHealth(gid); err != nil {
+			return err
+		}
+		stream, err := openSchemaStream(ctx, gid, s)
+		if err != nil {
+			return err
+		}
+		streams = append(streams, stream)
+	}
+	return withoutMovedDuplicates(schema, send, func(send func(*pb.SchemaNode) error) error {
+		return mergeSchemaStreams(streams, less, send)
+	})
+}
+
+// withoutMovedDuplicates calls stream with send, dropping the duplicates of the predicates being
+// moved if the request includes them. Which node of such a predicate to drop is only known once
+// every group has sent its schema, so the nodes are then buffered until the stream is done.
+func withoutMovedDuplicates(s *pb.SchemaRequest, send func(*pb.SchemaNode) error,
+	stream func(send func(*pb.SchemaNode) error) error) error {
+	if !s.IncludeMoving {
+		return stream(send)
+	}
+	var nodes []*pb.SchemaNode
+	err := stream(func(node *pb.SchemaNode) error {
+		nodes = append(nodes, node)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, node := range dropMovedDuplicates(nodes) {
+		if err := send(node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// schemaBatchSize is the number of schema nodes sent in every message by StreamSchema.
+const schemaBatchSize = 1000
+
+// schemaBatches calls fn with the schema of the predicates served by this group, in batches of
+// at most schemaBatchSize nodes. The batches are sent as the predicates are gone through, unless
+// the schema is sorted, which needs the schema of the whole group.
+func schemaBatches(ctx context.Context, s *pb.SchemaRequest,
+	fn func(*pb.SchemaResult) error) error {
+	if s.ExistsOnly {
+		return fn(existingPredicates(s))
+	}
+	if s.Sort != "" {
+		result, err := sortedSchema(ctx, s)
+		if err != nil {
+			return err
+		}
+		for nodes := result.Schema; len(nodes) > 0; {
+			n := len(nodes)
+			if n > schemaBatchSize {
+				n = schemaBatchSize
+			}
+			if err := fn(&pb.SchemaResult{Schema: nodes[:n], Version: result.Version}); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		return nil
+	}
+	version := schema.State().Version()
+	batch := &pb.SchemaResult{Version: version}
+	err := iterateSchema(ctx, s, func(node *pb.SchemaNode) error {
+		batch.Schema = append(batch.Schema, node)
+		if len(batch.Schema) < schemaBatchSize {
+			return nil
+		}
+		err := fn(batch)
+		batch = &pb.SchemaResult{Version: version}
+		return err
+	})
+	if err != nil || len(batch.Schema) == 0 {
+		return err
+	}
+	return fn(batch)
+}
+
+// StreamSchema is used to stream the schema of the predicates served by this group in
+// batches, so that the schema of a large group isn't sent in a single message. The schema is
+// ordered by the field given in the request, if any.
+func (w *grpcWorker) StreamSchema(s *pb.SchemaRequest, stream pb.Worker_StreamSchemaServer) error {
+	ctx := stream.Context()
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if !groups().ServesGroup(s.GroupId) {
+		return x.Errorf("This server doesn't serve group id: %v", s.GroupId)
+	}
+	return schemaBatches(ctx, s, stream.Send)
+}
+
+// streamGroupSchema sends the batches of the schema of the group to ch, serving them if the
+// current node serves the group and is healthy, or else streaming them from the other servers
+// of the group. The last result sent has no schema, with the error which ended the stream if
+// any.
+func streamGroupSchema(ctx context.Context, gid uint32, s *pb.SchemaRequest,
+	ch chan<- resultErr) {
+	emit := func(r resultErr) error {
+		select {
+		case ch <- r:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	send := func(batch *pb.SchemaResult) error {
+		return emit(resultErr{gid: gid, result: batch})
+	}
+
+	err := func() error {
+		if groups().ServesGroup(gid) && x.HealthCheck() == nil {
+			return schemaBatches(ctx, s, func(batch *pb.SchemaResult) error {
+				return send(groupSchemaResult(gid, s, batch))
+			})
+		}
+		stream, err := openSchemaBatches(ctx, gid, s)
+		if err != nil || stream == nil {
+			return err
+		}
+		defer stream.cancel()
+		batch := &pb.SchemaResult{Schema: stream.nodes}
+		for {
+			if err := send(batch); err != nil {
+				return err
+			}
+			batch, err = stream.stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return schemaReadError(stream.ctx, gid, stream.timeout, err)
+			}
+			batch = groupSchemaResult(gid, s, batch)
+		}
+	}()
+	emit(resultErr{gid: gid, err: err})
+}
+
+// StreamSchemaBatchesOverNetwork calls send with the schema asked for one node at a time, as
+// the groups stream it back in batches. Unlike StreamSchemaOverNetwork, the nodes aren't
+// ordered, so the schema of a group is never held in memory as a whole. Requests which are best
+// effort or grouped by leader can't be streamed.
+func StreamSchemaBatchesOverNetwork(ctx context.Context, schema *pb.SchemaRequest,
+	send func(*pb.SchemaNode) error) error {
+	ctx, span := otrace.StartSpan(ctx, "worker.StreamSchemaBatchesOverNetwork")
+	defer span.End()
+
+	if schema.Sort != "" {
+		return StreamSchemaOverNetwork(ctx, schema, send)
+	}
+	// The nodes are sent as the groups stream them, before the errors of the other groups or
+	// the leaders of all the groups are known.
+	if schema.BestEffort {
+		return x.Errorf("best_effort can't be combined with streaming the schema")
+	}
+	if schema.GroupByLeader {
+		return x.Errorf("group_by_leader can't be combined with streaming the schema")
+	}
+	if err := x.HealthCheck(); err != nil && !hasMembershipState() {
+		return err
+	}
+	if err := validateSchemaRequest(schema); err != nil {
+		return err
+	}
+
+	// Cancelling the context stops the groups still streaming if we return early.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Map of groupd id => Predicates for that group.
+	schemaMap := make(map[uint32]*pb.SchemaRequest)
+	if err := addToSchemaMap(schemaMap, schema); err != nil {
+		return err
+	}
+	if _, ok := schemaMap[0]; ok {
+		return errUnservedTablet
+	}
+	for gid := range schemaMap {
+		if err := checkGroupHealth(gid); err != nil {
+			return err
+		}
+	}
+
+	batches := make(chan resultErr, len(schemaMap))
+	for gid, s := range schemaMap {
+		go streamGroupSchema(ctx, gid, s, batches)
+	}
+	return withoutMovedDuplicates(schema, send, func(send func(*pb.SchemaNode) error) error {
+		for pending := len(schemaMap); pending > 0; {
+			select {
+			case r := <-batches:
+				if r.err != nil {
+					return r.err
+				}
+				if r.result == nil {
+					pending-- // The group is done.
+					continue
+				}
+				for _, node := range r.result.Schema {
+					if err := send(node); err != nil {
+						return err
+					}
+				}
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,921 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"errors"
+	"expvar"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestDeprecation(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term, exact) .
+		age: int .
+	`), 1))
+
+	deprecatedTokenizers["term"] = "Use fulltext instead."
+	defer delete(deprecatedTokenizers, "term")
+
+	deprecated, note := deprecation("name", types.StringID)
+	require.True(t, deprecated)
+	require.Equal(t, "Use fulltext instead.", note)
+
+	deprecated, note = deprecation("age", types.IntID)
+	require.False(t, deprecated)
+	require.Empty(t, note)
+
+	deprecated, note = deprecation("age", types.DefaultID)
+	require.True(t, deprecated)
+	require.Equal(t, deprecatedTypes["default"], note)
+}
+
+func TestHasNameLen(t *testing.T) {
+	require.True(t, hasNameLen("name", &pb.SchemaRequest{}))
+	require.True(t, hasNameLen("name", &pb.SchemaRequest{MinNameLen: 4, MaxNameLen: 4}))
+	require.False(t, hasNameLen("name", &pb.SchemaRequest{MinNameLen: 5}))
+	require.False(t, hasNameLen("name", &pb.SchemaRequest{MaxNameLen: 3}))
+}
+
+func TestHasGeoIndex(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		loc: geo @index(geo) .
+		area: geo .
+	`), 1))
+
+	require.True(t, hasGeoIndex("loc", types.GeoID))
+	require.False(t, hasGeoIndex("area", types.GeoID))
+}
+
+func TestChangedFields(t *testing.T) {
+	prev := &pb.SchemaUpdate{ValueType: pb.Posting_STRING, Tokenizer: []string{"term", "exact"}}
+	cur := &pb.SchemaUpdate{ValueType: pb.Posting_STRING, Tokenizer: []string{"exact", "term"}}
+	require.Empty(t, changedFields(prev, cur))
+
+	cur = &pb.SchemaUpdate{ValueType: pb.Posting_INT, Count: true}
+	require.Equal(t, []string{"type", "index", "tokenizer", "count"}, changedFields(prev, cur))
+
+	require.Equal(t, defaultSchemaFields, changedFields(nil, cur))
+}
+
+func TestMergeSchemaStreams(t *testing.T) {
+	less, err := schemaLess("type")
+	require.NoError(t, err)
+
+	streams := []schemaStream{
+		&localSchemaStream{nodes: []*pb.SchemaNode{
+			{Predicate: "age", Type: "int"},
+			{Predicate: "name", Type: "string"},
+		}},
+		&localSchemaStream{},
+		&localSchemaStream{nodes: []*pb.SchemaNode{
+			{Predicate: "friend", Type: "uid"},
+		}},
+		&localSchemaStream{nodes: []*pb.SchemaNode{
+			{Predicate: "height", Type: "float"},
+			{Predicate: "alias", Type: "string"},
+		}},
+	}
+	var preds []string
+	require.NoError(t, mergeSchemaStreams(streams, less, func(node *pb.SchemaNode) error {
+		preds = append(preds, node.Predicate)
+		return nil
+	}))
+	require.Equal(t, []string{"height", "age", "alias", "name", "friend"}, preds)
+
+	_, err = schemaLess("index")
+	require.Error(t, err)
+}
+
+func TestReindexNeeded(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term, exact) .
+	`), 1))
+	require.False(t, reindexNeeded("name"))
+
+	indexedWith.m["name"] = []string{"exact", "term"}
+	require.False(t, reindexNeeded("name"))
+
+	indexedWith.m["name"] = []string{"exact"}
+	require.True(t, reindexNeeded("name"))
+	delete(indexedWith.m, "name")
+}
+
+func TestIndexBuildMem(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term, exact) .
+		age: int .
+	`), 1))
+
+	gr.tablets["name"].Space = 1000
+	gr.tablets["age"].Space = 1000
+	defer func() {
+		gr.tablets["name"].Space = 0
+		gr.tablets["age"].Space = 0
+	}()
+	require.Equal(t, uint64(3500), indexBuildMem("name"))
+	require.Zero(t, indexBuildMem("age"))
+}
+
+func TestLatencySweep(t *testing.T) {
+	pstats.recordLatency("name", time.Millisecond)
+	p50, _, _ := pstats.latencyPercentiles("name")
+	require.NotZero(t, p50)
+
+	// The histograms without any recent queries are dropped along with the next one created.
+	pstats.latency["name"] = x.NewHistogram(latencyWindow, maxLatencyUs, 2)
+	pstats.lastLatencySweep = time.Time{}
+	pstats.recordLatency("age", time.Millisecond)
+	require.NotContains(t, pstats.latency, "name")
+	require.Contains(t, pstats.latency, "age")
+	delete(pstats.latency, "age")
+}
+
+func TestProposalErrors(t *testing.T) {
+	require.Zero(t, pstats.recentProposalErrors("name"))
+
+	m := &pb.Mutations{
+		Edges: []*pb.DirectedEdge{{Attr: "name"}, {Attr: "name"}, {Attr: "age"}},
+	}
+	recordProposalErrors(m, errors.New("uh oh"))
+	recordProposalErrors(m, errPredicateMoving)
+	require.Equal(t, uint64(1), pstats.recentProposalErrors("name"))
+	require.Equal(t, uint64(1), pstats.recentProposalErrors("age"))
+
+	pstats.proposalErrors["name"][0] = time.Now().Add(-2 * proposalErrorWindow)
+	require.Zero(t, pstats.recentProposalErrors("name"))
+}
+
+func TestAddToSchemaMapExcludeGroups(t *testing.T) {
+	schemaMap := make(map[uint32]*pb.SchemaRequest)
+	require.NoError(t, addToSchemaMap(schemaMap, &pb.SchemaRequest{
+		Predicates:    []string{"name", "friend_not_served"},
+		ExcludeGroups: []uint32{2},
+	}))
+	require.Len(t, schemaMap, 1)
+	require.Equal(t, []string{"name"}, schemaMap[1].Predicates)
+}
+
+func TestAddToSchemaMapDuplicates(t *testing.T) {
+	schemaMap := make(map[uint32]*pb.SchemaRequest)
+	require.NoError(t, addToSchemaMap(schemaMap, &pb.SchemaRequest{
+		Predicates: []string{"name", "friend_not_served", "age", "name", "friend_not_served"},
+	}))
+	require.Len(t, schemaMap, 2)
+	require.Equal(t, []string{"name", "age"}, schemaMap[1].Predicates)
+	require.Equal(t, []string{"friend_not_served"}, schemaMap[2].Predicates)
+
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string .
+		age: int .
+	`), 1))
+	result, err := getSchema(context.Background(), schemaMap[1])
+	require.NoError(t, err)
+	require.Len(t, result.Schema, 2)
+
+	result, err = getSchema(context.Background(), &pb.SchemaRequest{
+		Predicates: []string{"age", "age"},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Schema, 1)
+}
+
+func TestSchemaLessAlterFreq(t *testing.T) {
+	less, err := schemaLess("alter_freq_desc")
+	require.NoError(t, err)
+	require.True(t, less(&pb.SchemaNode{Predicate: "b", AlterCount: 3},
+		&pb.SchemaNode{Predicate: "a", AlterCount: 1}))
+	require.True(t, less(&pb.SchemaNode{Predicate: "a", AlterCount: 1},
+		&pb.SchemaNode{Predicate: "b", AlterCount: 1}))
+}
+
+func TestGetSchemaReversesOnly(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term, exact) .
+		friend: uid @reverse .
+	`), 1))
+
+	result, err := getSchema(context.Background(), &pb.SchemaRequest{
+		Predicates:   []string{"name", "friend"},
+		Fields:       []string{"type"},
+		ReversesOnly: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Schema, 1)
+	require.Equal(t, "friend", result.Schema[0].Predicate)
+	require.Equal(t, "~friend", result.Schema[0].ReversePredicate)
+}
+
+func TestSortByLeader(t *testing.T) {
+	nodes := []*pb.SchemaNode{
+		{Predicate: "name", LeaderAddr: "alpha2:7080"},
+		{Predicate: "age", LeaderAddr: ""},
+		{Predicate: "friend", LeaderAddr: "alpha1:7080"},
+		{Predicate: "alias", LeaderAddr: "alpha2:7080"},
+	}
+	sortByLeader(nodes)
+	var preds []string
+	for _, node := range nodes {
+		preds = append(preds, node.Predicate)
+	}
+	require.Equal(t, []string{"friend", "name", "alias", "age"}, preds)
+}
+
+func TestCompatibleTypes(t *testing.T) {
+	require.True(t, compatibleTypes(types.IntID, types.IntID))
+	require.True(t, compatibleTypes(types.DefaultID, types.StringID))
+	require.True(t, compatibleTypes(types.StringID, types.DefaultID))
+	require.False(t, compatibleTypes(types.StringID, types.IntID))
+	require.False(t, compatibleTypes(types.FloatID, types.IntID))
+}
+
+func TestMissingIndexFor(t *testing.T) {
+	for i := 0; i < minMissingIndexQueries; i++ {
+		pstats.recordMissingIndex("name", "anyofterms")
+	}
+	pstats.recordMissingIndex("name", "regexp")
+	require.Equal(t, []string{"anyofterms"}, pstats.missingIndexFor("name"))
+
+	pstats.forgetMissingIndex("name")
+	require.Empty(t, pstats.missingIndexFor("name"))
+}
+
+func TestCanonicalSchema(t *testing.T) {
+	a := canonicalSchema([]*pb.SchemaNode{
+		{Predicate: "name", Type: "string", Index: true, Tokenizer: []string{"term", "exact"}},
+		{Predicate: "age", Type: "int"},
+	})
+	b := canonicalSchema([]*pb.SchemaNode{
+		{Predicate: "age", Type: "int"},
+		{Predicate: "name", Type: "string", Index: true, Tokenizer: []string{"exact", "term"}},
+	})
+	require.Equal(t, a, b)
+	require.Equal(t, `"age" type=int index=false tokenizer=[] reverse=false count=false`+
+		` list=false upsert=false lang=false`+"\n"+
+		`"name" type=string index=true tokenizer=[exact,term] reverse=false count=false`+
+		` list=false upsert=false lang=false`+"\n", string(a))
+}
+
+func TestSchemaGroupsError(t *testing.T) {
+	err := &SchemaGroupsError{Errors: map[uint32]error{
+		3: errors.New("no connection"),
+		1: context.DeadlineExceeded,
+	}}
+	require.Equal(t, "Unable to fetch schema from group 1: context deadline exceeded; "+
+		"group 3: no connection", err.Error())
+	require.Equal(t, []uint32{1, 3}, err.Groups())
+}
+
+func TestMatchPredicates(t *testing.T) {
+	preds := []string{"user.name", "user.email", "post.email", "title", "2|user.email", "2|title"}
+	matched, err := matchPredicates(0, []string{`^user\.`, `\.email$`}, preds)
+	require.NoError(t, err)
+	require.Equal(t, []string{"user.name", "user.email", "post.email"}, matched)
+
+	// Only the predicates of the namespace are matched, by their name within it.
+	matched, err = matchPredicates(2, []string{`^user\.`}, preds)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2|user.email"}, matched)
+
+	_, err = matchPredicates(0, []string{`user.(`}, preds)
+	require.Error(t, err)
+
+	require.Equal(t, []string{"title", "user.name", "user.email"},
+		unionPredicates([]string{"title", "user.name"}, []string{"user.name", "user.email"}))
+}
+
+func TestSchemaCache(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term) .
+	`), 1))
+
+	req := &pb.SchemaRequest{Predicates: []string{"name"}}
+	key, cacheable := schemaCacheKey(req)
+	require.True(t, cacheable)
+	result, err := getSchema(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, result.Schema, 1)
+	require.NotNil(t, scache.get(key, schema.State().Version()))
+
+	// The cached result isn't shared with the callers.
+	result.Schema[0].Type = "int"
+	result, err = getSchema(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, "string", result.Schema[0].Type)
+
+	// Changing the schema invalidates the cache.
+	schema.State().Set("name", pb.SchemaUpdate{ValueType: pb.Posting_INT})
+	require.Nil(t, scache.get(key, schema.State().Version()))
+	result, err = getSchema(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, "int", result.Schema[0].Type)
+
+	_, cacheable = schemaCacheKey(&pb.SchemaRequest{Fields: []string{"type", "latency"}})
+	require.False(t, cacheable)
+
+	scache.setDisabled(true)
+	defer scache.setDisabled(false)
+	_, err = getSchema(context.Background(), req)
+	require.NoError(t, err)
+	require.Nil(t, scache.get(key, schema.State().Version()))
+}
+
+func TestSortByPredicate(t *testing.T) {
+	shared := []string{"term", "exact"}
+	nodes := []*pb.SchemaNode{
+		{Predicate: "name", Tokenizer: shared},
+		{Predicate: "age"},
+	}
+	sortByPredicate(nodes)
+	require.Equal(t, "age", nodes[0].Predicate)
+	require.Equal(t, []string{"exact", "term"}, nodes[1].Tokenizer)
+	require.Equal(t, []string{"term", "exact"}, shared)
+}
+
+func TestExistingPredicates(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term) .
+	`), 1))
+
+	result := existingPredicates(&pb.SchemaRequest{
+		Predicates: []string{"name", "age", "friend_not_served", "unknown"},
+	})
+	require.Equal(t, []*pb.SchemaNode{{Predicate: "name", Type: "string"}}, result.Schema)
+
+	// The predicates without a known tablet aren't asked for, nor get a tablet.
+	schemaMap := make(map[uint32]*pb.SchemaRequest)
+	require.NoError(t, addToSchemaMap(schemaMap, &pb.SchemaRequest{
+		Predicates: []string{"name", "friend_not_served", "unknown"},
+		ExistsOnly: true,
+	}))
+	require.Len(t, schemaMap, 2)
+	require.Equal(t, []string{"name"}, schemaMap[1].Predicates)
+	require.Equal(t, []string{"friend_not_served"}, schemaMap[2].Predicates)
+	require.Nil(t, groups().KnownTablet("unknown"))
+}
+
+func TestSchemaBatches(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term) .
+		age: int .
+		friend_not_served: uid .
+	`), 1))
+
+	var batches []*pb.SchemaResult
+	err := schemaBatches(context.Background(), &pb.SchemaRequest{
+		Predicates: []string{"name", "age", "friend_not_served"},
+	}, func(batch *pb.SchemaResult) error {
+		batches = append(batches, batch)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	require.Len(t, batches[0].Schema, 2)
+
+	// The schema asked sorted comes in order.
+	batches = nil
+	err = schemaBatches(context.Background(), &pb.SchemaRequest{
+		Predicates: []string{"name", "age"},
+		Sort:       "predicate",
+	}, func(batch *pb.SchemaResult) error {
+		batches = append(batches, batch)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	require.Equal(t, "age", batches[0].Schema[0].Predicate)
+	require.Equal(t, "name", batches[0].Schema[1].Predicate)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = schemaBatches(ctx, &pb.SchemaRequest{}, func(*pb.SchemaResult) error { return nil })
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestStreamSchemaBatchesOptions(t *testing.T) {
+	send := func(*pb.SchemaNode) error { return nil }
+	err := StreamSchemaBatchesOverNetwork(context.Background(),
+		&pb.SchemaRequest{BestEffort: true}, send)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "best_effort")
+	err = StreamSchemaBatchesOverNetwork(context.Background(),
+		&pb.SchemaRequest{GroupByLeader: true}, send)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "group_by_leader")
+}
+
+func TestValidateSchemaFields(t *testing.T) {
+	var fields []string
+	for _, field := range schemaFieldTable {
+		require.NotNil(t, field.populate, field.name)
+		require.NotNil(t, field.project, field.name)
+		fields = append(fields, field.name)
+	}
+	require.Len(t, knownSchemaFields, len(schemaFieldTable))
+	require.NoError(t, validateSchemaRequest(&pb.SchemaRequest{Fields: fields}))
+	require.NoError(t, validateSchemaRequest(&pb.SchemaRequest{}))
+
+	err := validateSchemaRequest(&pb.SchemaRequest{Fields: []string{"type", "tokeniser"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "tokeniser")
+}
+
+func TestWithSchemaTimeout(t *testing.T) {
+	defer func(timeout time.Duration) { Config.SchemaTimeout = timeout }(Config.SchemaTimeout)
+	Config.SchemaTimeout = time.Millisecond
+
+	ctx, cancel, timeout := withSchemaTimeout(context.Background())
+	defer cancel()
+	require.Equal(t, time.Millisecond, timeout)
+	<-ctx.Done()
+	err := schemaReadError(ctx, 2, timeout, ctx.Err())
+	require.Contains(t, err.Error(), "Timed out after 1ms while reading schema of group 2")
+
+	// A request with a deadline of its own keeps it.
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Hour)
+	defer cancelParent()
+	ctx, cancel, timeout = withSchemaTimeout(parent)
+	defer cancel()
+	require.Zero(t, timeout)
+	require.Equal(t, parent, ctx)
+}
+
+func TestReadOrder(t *testing.T) {
+	leader, a, b := &conn.Pool{Addr: "leader"}, &conn.Pool{Addr: "a"}, &conn.Pool{Addr: "b"}
+	pools := []*conn.Pool{leader, a, b}
+	require.Equal(t, pools, readOrder(pools, false))
+
+	firsts := make(map[string]bool)
+	for i := 0; i < len(pools); i++ {
+		ordered := readOrder(pools, true)
+		require.Len(t, ordered, len(pools))
+		firsts[ordered[0].Addr] = true
+		if ordered[0] != leader {
+			// The leader is asked next if the server picked fails.
+			require.Equal(t, leader, ordered[1])
+		}
+	}
+	require.Len(t, firsts, len(pools))
+}
+
+func TestCheckGroupHealth(t *testing.T) {
+	require.NoError(t, checkGroupHealth(1))
+	err := checkGroupHealth(2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "group 2")
+}
+
+func TestIsTransient(t *testing.T) {
+	require.True(t, isTransient(errNoHealthyServer(2)))
+	require.True(t, isTransient(errUnservedTablet))
+	require.True(t, isTransient(conn.ErrNoConnection))
+	require.False(t, isTransient(errors.New("Unknown schema field: foo")))
+	require.False(t, isTransient(context.Canceled))
+}
+
+func TestSchemaBackoff(t *testing.T) {
+	defer func(d time.Duration) { Config.SchemaRetryBackoff = d }(Config.SchemaRetryBackoff)
+	Config.SchemaRetryBackoff = 100 * time.Millisecond
+	for attempt, max := range []time.Duration{100, 200, 400, 800} {
+		max *= time.Millisecond
+		wait := schemaBackoff(attempt)
+		require.True(t, wait >= max/2 && wait <= max, "attempt %d waited %v", attempt, wait)
+	}
+	require.True(t, schemaBackoff(100) <= 10*time.Second)
+}
+
+func TestRecordSchemaRead(t *testing.T) {
+	count := func(m *expvar.Map, key string) int64 {
+		if v, ok := m.Get(key).(*expvar.Int); ok {
+			return v.Value()
+		}
+		return 0
+	}
+	buckets := x.SchemaReadLatency.Get("forwarded").(*expvar.Map)
+	reads, le5, le50 := count(x.SchemaReads, "forwarded"), count(buckets, "5"), count(buckets, "50")
+
+	recordSchemaRead("forwarded", 20*time.Millisecond)
+	require.Equal(t, reads+1, count(x.SchemaReads, "forwarded"))
+	require.Equal(t, le5, count(buckets, "5"))
+	require.Equal(t, le50+1, count(buckets, "50"))
+}
+
+func TestGetSchemaSinceCurrentVersion(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term) .
+	`), 1))
+
+	version := schema.State().Version()
+	result, err := getSchema(context.Background(), &pb.SchemaRequest{
+		Predicates:   []string{"name"},
+		SinceVersion: version + 1,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.Schema)
+	require.Equal(t, version, result.Version)
+}
+
+func TestGetSchemaTypes(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term) .
+		age: int .
+		friend: uid .
+	`), 1))
+
+	result, err := getSchema(context.Background(), &pb.SchemaRequest{
+		Predicates: []string{"name", "age", "friend"},
+		Types:      []string{"int", "uid"},
+	})
+	require.NoError(t, err)
+	var preds []string
+	for _, node := range result.Schema {
+		preds = append(preds, node.Predicate)
+	}
+	sort.Strings(preds)
+	require.Equal(t, []string{"age", "friend"}, preds)
+
+	_, err = getSchema(context.Background(), &pb.SchemaRequest{Types: []string{"date"}})
+	require.Error(t, err)
+	require.Error(t, validateSchemaRequest(&pb.SchemaRequest{Types: []string{"date"}}))
+}
+
+func TestGetSchemaGraphQL(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(exact, term) .
+		name2: string @index(hash) .
+		age: [int] .
+		friend: uid .
+	`), 1))
+	expected, err := schema.Parse(`
+		name: string @index(term, exact) .
+		name2: string @index(exact) .
+		age: int .
+	`)
+	require.NoError(t, err)
+
+	result, err := getSchema(context.Background(), &pb.SchemaRequest{
+		Predicates:    []string{"name", "name2", "age", "friend"},
+		Fields:        []string{"graphql"},
+		GraphqlSchema: expected,
+	})
+	require.NoError(t, err)
+	mismatches := make(map[string]string)
+	for _, node := range result.Schema {
+		require.Equal(t, node.GraphqlMismatch == "", node.GraphqlConsistent)
+		mismatches[node.Predicate] = node.GraphqlMismatch
+	}
+	require.Equal(t, map[string]string{
+		"name":   "",
+		"name2":  "index is [hash], GraphQL expects [exact]",
+		"age":    "list is true, GraphQL expects false",
+		"friend": "",
+	}, mismatches)
+}
+
+func TestGetTypes(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string .
+		owner: uid .
+		type Person {
+			name
+		}
+		type Animal {
+			name
+			owner
+		}
+	`), 1))
+	schema.State().SetType(x.NamespaceAttr(2, "Person"), pb.TypeUpdate{
+		TypeName: x.NamespaceAttr(2, "Person"),
+		Fields:   []string{x.NamespaceAttr(2, "name")},
+	})
+
+	result, err := getTypes(context.Background(), &pb.SchemaRequest{
+		TypeNames: []string{"Person", "Plant"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*pb.TypeUpdate{{TypeName: "Person", Fields: []string{"name"}}},
+		result.Types)
+
+	// Without names, all the types of the namespace are returned.
+	result, err = getTypes(context.Background(), &pb.SchemaRequest{})
+	require.NoError(t, err)
+	var names []string
+	for _, typ := range result.Types {
+		names = append(names, typ.TypeName)
+	}
+	sort.Strings(names)
+	require.Equal(t, []string{"Animal", "Person"}, names)
+
+	result, err = getTypes(context.Background(), &pb.SchemaRequest{Namespace: 2})
+	require.NoError(t, err)
+	require.Len(t, result.Types, 1)
+	require.Equal(t, x.NamespaceAttr(2, "Person"), result.Types[0].TypeName)
+}
+
+func TestSchemaNodesToDQL(t *testing.T) {
+	nodes := []*pb.SchemaNode{
+		{Predicate: "name", Type: "string", Index: true, Tokenizer: []string{"term", "exact"},
+			Lang: true, Upsert: true},
+		{Predicate: "friend", Type: "uid", Reverse: true, Count: true},
+		{Predicate: "age", Type: "int"},
+		{Predicate: "http://example.com/alias", Type: "string", List: true},
+	}
+	dql := SchemaNodesToDQL(nodes)
+	require.Equal(t, "name: string @index(term, exact) @lang @upsert .\n"+
+		"friend: uid @reverse @count .\n"+
+		"age: int .\n"+
+		"<http://example.com/alias>: [string] .\n", dql)
+
+	updates, err := schema.Parse(dql)
+	require.NoError(t, err)
+	require.Len(t, updates, len(nodes))
+	for i, update := range updates {
+		node := nodes[i]
+		require.Equal(t, node.Predicate, update.Predicate)
+		require.Equal(t, node.Type, types.TypeID(update.ValueType).Name())
+		require.Equal(t, node.List, update.List)
+		require.Equal(t, node.Reverse, update.Directive == pb.SchemaUpdate_REVERSE)
+		require.Equal(t, node.Index, update.Directive == pb.SchemaUpdate_INDEX)
+		if node.Index {
+			require.Equal(t, node.Tokenizer, update.Tokenizer)
+		}
+		require.Equal(t, node.Count, update.Count)
+		require.Equal(t, node.Lang, update.Lang)
+		require.Equal(t, node.Upsert, update.Upsert)
+	}
+
+	require.Equal(t, "friend: uid .\n",
+		SchemaNodesToDQL([]*pb.SchemaNode{{Predicate: "friend", Type: "uid", List: true}}))
+}
+
+func TestBatchSchemaRequests(t *testing.T) {
+	reqs := []*pb.SchemaRequest{
+		{Predicates: []string{"name", "age"}, Fields: []string{"type"}},
+		{Predicates: []string{"name"}, Fields: []string{"index", "tokenizer"}},
+	}
+	merged, err := mergeSchemaRequests(reqs)
+	require.NoError(t, err)
+	require.Equal(t, []string{"name", "age"}, merged.Predicates)
+	require.Equal(t, []string{"type", "index", "tokenizer"}, merged.Fields)
+
+	nodes := []*pb.SchemaNode{
+		{Predicate: "age", Type: "int"},
+		{Predicate: "name", Type: "string", Index: true, Tokenizer: []string{"term"}},
+	}
+	split := splitSchema(reqs, nodes)
+	require.Equal(t, [][]*pb.SchemaNode{
+		{{Predicate: "age", Type: "int"}, {Predicate: "name", Type: "string"}},
+		{{Predicate: "name", Index: true, Tokenizer: []string{"term"}}},
+	}, split)
+
+	merged, err = mergeSchemaRequests(append(reqs, &pb.SchemaRequest{}))
+	require.NoError(t, err)
+	require.Empty(t, merged.Predicates)
+
+	_, err = mergeSchemaRequests(append(reqs, &pb.SchemaRequest{ReversesOnly: true}))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "request 2")
+}
+
+func TestIndexPredicates(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term, exact) .
+		age: int .
+		friend: uid @reverse .
+	`), 1))
+
+	node := populateSchema("name", []string{"indexpredicates"})
+	require.Equal(t, []string{"name@index(term)", "name@index(exact)"}, node.IndexPredicates)
+
+	node = populateSchema("age", []string{"indexpredicates"})
+	require.NotNil(t, node.IndexPredicates)
+	require.Empty(t, node.IndexPredicates)
+
+	// Declared, but no reverse edge was stored yet.
+	node = populateSchema("friend", []string{"indexpredicates"})
+	require.False(t, node.ReverseStored)
+}
+
+func TestCountIndex(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		friend: uid @count .
+		age: int .
+	`), 1))
+
+	// Declared, but no count was indexed yet.
+	node := populateSchema("friend", []string{"countindex"})
+	require.False(t, node.CountIndexStored)
+
+	node = populateSchema("age", []string{"countindex"})
+	require.False(t, node.CountIndexStored)
+}
+
+func TestTokenizerDetail(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term, fulltext, trigram, exact) @lang .
+		age: int .
+	`), 1))
+
+	node := populateSchema("name", []string{"tokenizerdetail"})
+	require.Equal(t, []*pb.TokenizerDetail{
+		{Name: "term", Kind: "term"},
+		{Name: "fulltext", Kind: "fulltext", LangVariants: true},
+		{Name: "trigram", Kind: "trigram"},
+		{Name: "exact", Kind: "other"},
+	}, node.TokenizerDetail)
+
+	node = populateSchema("age", []string{"tokenizerdetail"})
+	require.NotNil(t, node.TokenizerDetail)
+	require.Empty(t, node.TokenizerDetail)
+}
+
+func TestGetSchemaIndexedOnly(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term) .
+		age: int .
+		friend: uid @reverse .
+	`), 1))
+
+	result, err := getSchema(context.Background(), &pb.SchemaRequest{
+		Predicates:  []string{"name", "age", "friend"},
+		IndexedOnly: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Schema, 1)
+	require.Equal(t, "name", result.Schema[0].Predicate)
+
+	// Nothing is both indexed and reversed, which isn't an error.
+	result, err = getSchema(context.Background(), &pb.SchemaRequest{
+		Predicates:   []string{"name", "age", "friend"},
+		IndexedOnly:  true,
+		ReversesOnly: true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.Schema)
+}
+
+func TestGetSchemaIncludeMoving(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term) .
+		friend_not_served: uid .
+	`), 1))
+
+	req := &pb.SchemaRequest{Predicates: []string{"name", "friend_not_served"}}
+	result, err := getSchema(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, result.Schema, 1)
+	require.Equal(t, "name", result.Schema[0].Predicate)
+
+	req.IncludeMoving = true
+	result, err = getSchema(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, result.Schema, 2)
+	require.False(t, result.Schema[0].NotServed)
+	require.Equal(t, &pb.SchemaNode{Predicate: "friend_not_served", Type: "uid", NotServed: true},
+		result.Schema[1])
+
+	nodes := dropMovedDuplicates([]*pb.SchemaNode{
+		{Predicate: "age", NotServed: true},
+		{Predicate: "name"},
+		{Predicate: "name", NotServed: true},
+	})
+	require.Equal(t, []*pb.SchemaNode{{Predicate: "age", NotServed: true}, {Predicate: "name"}},
+		nodes)
+
+	// Sorted by type, the node of the group the predicate moved to comes last.
+	var sent []*pb.SchemaNode
+	err = withoutMovedDuplicates(req, func(node *pb.SchemaNode) error {
+		sent = append(sent, node)
+		return nil
+	}, func(send func(*pb.SchemaNode) error) error {
+		for _, node := range []*pb.SchemaNode{
+			{Predicate: "name", Type: "default", NotServed: true},
+			{Predicate: "name", Type: "string"},
+		} {
+			if err := send(node); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*pb.SchemaNode{{Predicate: "name", Type: "string"}}, sent)
+}
+
+func TestGroupSchemaResultFields(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(term) @lang .
+	`), 1))
+
+	schemaMap := make(map[uint32]*pb.SchemaRequest)
+	require.NoError(t, addToSchemaMap(schemaMap, &pb.SchemaRequest{
+		Predicates: []string{"name", "friend_not_served"},
+	}))
+	require.Equal(t, defaultSchemaFields, schemaMap[1].Fields)
+	require.Equal(t, defaultSchemaFields, schemaMap[2].Fields)
+
+	req := &pb.SchemaRequest{Predicates: []string{"name"}, Fields: []string{"type", "lang"}}
+	local, err := getSchema(context.Background(), req)
+	require.NoError(t, err)
+	local = groupSchemaResult(1, req, local)
+
+	// A remote server may populate more fields than asked, e.g. if its defaults differ.
+	remote := groupSchemaResult(2, req, &pb.SchemaResult{Schema: []*pb.SchemaNode{{
+		Predicate: "friend_not_served",
+		Type:      "string",
+		Index:     true,
+		Tokenizer: []string{"term"},
+		Lang:      true,
+	}}})
+
+	require.Equal(t, &pb.SchemaNode{Predicate: "name", Type: "string", Lang: true},
+		local.Schema[0])
+	require.Equal(t, &pb.SchemaNode{Predicate: "friend_not_served", Type: "string", Lang: true},
+		remote.Schema[0])
+
+	// The field sorted by is kept, to merge the schema of the groups.
+	req = &pb.SchemaRequest{Fields: []string{"lang"}, Sort: "alter_freq_desc"}
+	remote = groupSchemaResult(2, req, &pb.SchemaResult{Schema: []*pb.SchemaNode{{
+		Predicate:  "friend_not_served",
+		Type:       "string",
+		AlterCount: 3,
+	}}})
+	require.Equal(t, &pb.SchemaNode{Predicate: "friend_not_served", AlterCount: 3},
+		remote.Schema[0])
+}
+
+func TestGroupScoped(t *testing.T) {
+	req := &pb.SchemaRequest{Predicates: []string{"name"}}
+	scoped, err := groupScoped(req)
+	require.NoError(t, err)
+	require.Equal(t, req, scoped)
+
+	gr.Lock()
+	gr.state = &pb.MembershipState{Groups: map[uint32]*pb.Group{1: {}, 2: {}, 3: {}}}
+	gr.Unlock()
+	defer func() {
+		gr.Lock()
+		gr.state = nil
+		gr.Unlock()
+	}()
+	req = &pb.SchemaRequest{GroupId: 2, ExcludeGroups: []uint32{3}}
+	scoped, err = groupScoped(req)
+	require.NoError(t, err)
+	require.Zero(t, scoped.GroupId)
+	sort.Slice(scoped.ExcludeGroups, func(i, j int) bool {
+		return scoped.ExcludeGroups[i] < scoped.ExcludeGroups[j]
+	})
+	require.Equal(t, []uint32{1, 3}, scoped.ExcludeGroups)
+	require.Equal(t, []uint32{3}, req.ExcludeGroups)
+
+	_, err = groupScoped(&pb.SchemaRequest{GroupId: 4})
+	require.Error(t, err)
+}
+
+func TestDiffSchema(t *testing.T) {
+	a := []*pb.SchemaNode{
+		{Predicate: "name", Type: "string", Index: true, Tokenizer: []string{"term", "exact"}},
+		{Predicate: "age", Type: "int"},
+		{Predicate: "email", Type: "string"},
+	}
+	b := []*pb.SchemaNode{
+		{Predicate: "name", Type: "string", Index: true, Tokenizer: []string{"exact", "term"}},
+		{Predicate: "age", Type: "float", Count: true},
+		{Predicate: "friend", Type: "uid"},
+	}
+	diff := diffSchema(a, b, defaultSchemaFields)
+	require.Equal(t, []string{"email"}, diff.OnlyA)
+	require.Equal(t, []string{"friend"}, diff.OnlyB)
+	require.Len(t, diff.Differ, 1)
+	require.Equal(t, "age", diff.Differ[0].Predicate)
+	require.Equal(t, []string{"type", "count"}, diff.Differ[0].Fields)
+
+	// Fields which aren't compared don't make a difference.
+	diff = diffSchema(a, b, []string{"index"})
+	require.Empty(t, diff.Differ)
+}
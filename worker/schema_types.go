@@ -0,0 +1,158 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"sort"
+
+	otrace "go.opencensus.io/trace"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+var emptyTypesResult pb.TypesResult
+
+type typesResultErr struct {
+	gid    uint32
+	result *pb.TypesResult
+	err    error
+}
+
+// getTypes returns the declarations of the object types named by the request, or of all the
+// types of its namespace if it names none. The names are those of the types in the namespace of
+// the request, as stored.
+func getTypes(ctx context.Context, s *pb.SchemaRequest) (*pb.TypesResult, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	names := s.TypeNames
+	if len(names) == 0 {
+		for _, name := range schema.State().Types() {
+			if ns, _ := x.ParseNamespaceAttr(name); ns == s.Namespace {
+				names = append(names, name)
+			}
+		}
+	}
+	result := &pb.TypesResult{}
+	for _, name := range names {
+		if typ, ok := schema.State().GetType(name); ok {
+			result.Types = append(result.Types, &typ)
+		}
+	}
+	return result, nil
+}
+
+// getTypesOverNetwork asks the group for the types, serving them locally if this server is one
+// of the healthy servers of the group.
+func getTypesOverNetwork(ctx context.Context, gid uint32, s *pb.SchemaRequest,
+	ch chan typesResultErr) {
+	if groups().ServesGroup(gid) && x.HealthCheck() == nil {
+		result, err := getTypes(ctx, s)
+		ch <- typesResultErr{gid: gid, result: result, err: err}
+		return
+	}
+	pools := schemaServers(gid, s)
+	if len(pools) == 0 {
+		ch <- typesResultErr{gid: gid, err: errNoHealthyServer(gid)}
+		return
+	}
+	ctx, cancel, timeout := withSchemaTimeout(ctx)
+	defer cancel()
+	reply, err := conn.WithFailover(ctx, pools,
+		func(ctx context.Context, pl *conn.Pool) (interface{}, error) {
+			return pb.NewWorkerClient(pl.Get()).Types(ctx, s)
+		})
+	if err != nil {
+		ch <- typesResultErr{gid: gid, err: schemaReadError(ctx, gid, timeout, err)}
+		return
+	}
+	ch <- typesResultErr{gid: gid, result: reply.(*pb.TypesResult)}
+}
+
+// GetTypesOverNetwork returns the declarations of the object types named by the request, or of
+// all the types of its namespace if it names none, ordered by name. Every group holds all the
+// types, but they're asked for to every group like the schema, so that a type is returned as
+// soon as one group applied it. Each type is only returned once.
+func GetTypesOverNetwork(ctx context.Context, s *pb.SchemaRequest) ([]*pb.TypeUpdate, error) {
+	ctx, span := otrace.StartSpan(ctx, "worker.GetTypesOverNetwork")
+	defer span.End()
+
+	if err := x.HealthCheck(); err != nil && !hasMembershipState() {
+		return nil, err
+	}
+	gids := groups().KnownGroups()
+	// Every group is checked before any of them is asked.
+	for _, gid := range gids {
+		if err := checkGroupHealth(gid); err != nil {
+			return nil, err
+		}
+	}
+
+	// The groups still being asked are cancelled once the request fails.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan typesResultErr, len(gids))
+	for _, gid := range gids {
+		req := *s
+		req.GroupId = gid
+		go getTypesOverNetwork(ctx, gid, &req, results)
+	}
+
+	var types []*pb.TypeUpdate
+	seen := make(map[string]struct{})
+	for range gids {
+		select {
+		case r := <-results:
+			if r.err != nil {
+				return nil, x.Wrapf(r.err, "while fetching types of group %d", r.gid)
+			}
+			for _, typ := range r.result.Types {
+				if _, ok := seen[typ.TypeName]; ok {
+					continue
+				}
+				seen[typ.TypeName] = struct{}{}
+				types = append(types, typ)
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].TypeName < types[j].TypeName
+	})
+	return types, nil
+}
+
+// Types is used to get the object types declared from other instances.
+func (w *grpcWorker) Types(ctx context.Context, s *pb.SchemaRequest) (*pb.TypesResult, error) {
+	if ctx.Err() != nil {
+		return &emptyTypesResult, ctx.Err()
+	}
+	if !groups().ServesGroup(s.GroupId) {
+		// Unavailable, so that the request goes to another server of the group.
+		return &emptyTypesResult, status.Errorf(codes.Unavailable,
+			"This server doesn't serve group id: %v", s.GroupId)
+	}
+	return getTypes(ctx, s)
+}
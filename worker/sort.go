@@ -25,6 +25,8 @@ import (
 	"github.com/dgraph-io/badger"
 	otrace "go.opencensus.io/trace"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/posting"
@@ -75,7 +77,8 @@ func (w *grpcWorker) Sort(ctx context.Context, s *pb.SortMessage) (*pb.SortResul
 	gid := groups().BelongsTo(s.Order[0].Attr)
 	span.Annotatef(nil, "Sorting: Attribute: %q groupId: %v Sort", s.Order[0].Attr, gid)
 	if gid != groups().groupId() {
-		return nil, x.Errorf("attr: %q groupId: %v Request sent to wrong server.", s.Order[0].Attr, gid)
+		return nil, status.Errorf(codes.Unavailable,
+			"attr: %q groupId: %v Request sent to wrong server.", s.Order[0].Attr, gid)
 	}
 
 	var reply *pb.SortResult
@@ -164,6 +167,9 @@ func sortWithIndex(ctx context.Context, ts *pb.SortMessage) *sortresult {
 	if !schema.State().IsIndexed(order.Attr) {
 		return &sortresult{&emptySortResult, nil, x.Errorf("Attribute %s is not indexed.", order.Attr)}
 	}
+	if indexPending(order.Attr) {
+		return &sortresult{&emptySortResult, nil, errIndexPending(order.Attr)}
+	}
 
 	tokenizers := schema.State().Tokenizer(order.Attr)
 	var tokenizer tok.Tokenizer
@@ -345,6 +351,9 @@ func multiSort(ctx context.Context, r *sortresult, ts *pb.SortMessage) error {
 
 	// Values have been accumulated, now we do the multisort for each list.
 	for i, ul := range r.reply.UidMatrix {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		vals := make([][]types.Val, len(ul.Uids))
 		for j, uid := range ul.Uids {
 			idx := algo.IndexOf(dest, uid)
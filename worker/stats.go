@@ -0,0 +1,351 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	// maxValueSamples is the number of values of a predicate which are sampled to check them
+	// against the value pattern or the constraints of a schema request.
+	maxValueSamples = 1000
+
+	// Latencies are recorded in microseconds, with queries taking longer than a minute
+	// being recorded as taking a minute.
+	maxLatencyUs = int64(time.Minute / time.Microsecond)
+	// Only the queries run in the last latencyWindow are used to compute percentiles.
+	latencyWindow = time.Minute
+
+	// Only the proposals which failed to apply in the last proposalErrorWindow are counted, up
+	// to maxProposalErrors of them.
+	proposalErrorWindow = time.Minute
+	maxProposalErrors   = 10000
+
+	// A function must have failed minMissingIndexQueries times for lack of an index on a
+	// predicate, since its schema last changed, to be reported as missing one.
+	minMissingIndexQueries = 10
+)
+
+// predicateStats keeps the statistics this server maintains for every predicate it serves.
+type predicateStats struct {
+	sync.RWMutex
+	latency map[string]*x.Histogram
+	// lastLatencySweep is when the histograms without any recent queries were last dropped.
+	lastLatencySweep time.Time
+	// proposalErrors holds the times at which proposals failed to apply, oldest first.
+	proposalErrors map[string][]time.Time
+	// missingIndex counts the queries which failed for lack of an index, by predicate and
+	// function.
+	missingIndex map[string]map[string]uint64
+}
+
+var pstats = &predicateStats{
+	latency:        make(map[string]*x.Histogram),
+	proposalErrors: make(map[string][]time.Time),
+	missingIndex:   make(map[string]map[string]uint64),
+}
+
+func (ps *predicateStats) histogram(attr string) *x.Histogram {
+	ps.RLock()
+	h, ok := ps.latency[attr]
+	ps.RUnlock()
+	if ok {
+		return h
+	}
+
+	ps.Lock()
+	defer ps.Unlock()
+	if h, ok = ps.latency[attr]; !ok {
+		ps.sweepLatency()
+		h = x.NewHistogram(latencyWindow, maxLatencyUs, 2)
+		ps.latency[attr] = h
+	}
+	return h
+}
+
+// sweepLatency drops the histograms of the predicates which weren't queried within the last
+// latencyWindow, like the ones dropped or moved to another group, so that they don't pile up.
+// It must be called with the lock held.
+func (ps *predicateStats) sweepLatency() {
+	now := time.Now()
+	if now.Sub(ps.lastLatencySweep) < latencyWindow {
+		return
+	}
+	for attr, h := range ps.latency {
+		if h.RecentCount() == 0 {
+			delete(ps.latency, attr)
+		}
+	}
+	ps.lastLatencySweep = now
+}
+
+// recordLatency records the time taken to process a task for the given predicate.
+func (ps *predicateStats) recordLatency(attr string, d time.Duration) {
+	ps.histogram(attr).RecordValue(int64(d / time.Microsecond))
+}
+
+// latencyPercentiles returns the p50, p95 and p99 latencies in nanoseconds of the queries
+// recently run against the given predicate. All of them are zero if there weren't any.
+func (ps *predicateStats) latencyPercentiles(attr string) (p50, p95, p99 uint64) {
+	ps.RLock()
+	h, ok := ps.latency[attr]
+	ps.RUnlock()
+	if !ok {
+		return 0, 0, 0
+	}
+	toNs := func(us int64) uint64 {
+		return uint64(time.Duration(us) * time.Microsecond)
+	}
+	return toNs(h.Percentile(50)), toNs(h.Percentile(95)), toNs(h.Percentile(99))
+}
+
+// recordProposalError records that a proposal touching the given predicate failed to apply.
+func (ps *predicateStats) recordProposalError(attr string) {
+	now := time.Now()
+	ps.Lock()
+	defer ps.Unlock()
+
+	errs := append(ps.proposalErrors[attr], now)
+	// Forget the errors which are too old or too many to be counted.
+	since := now.Add(-proposalErrorWindow)
+	i := sort.Search(len(errs), func(i int) bool { return errs[i].After(since) })
+	if len(errs)-i > maxProposalErrors {
+		i = len(errs) - maxProposalErrors
+	}
+	ps.proposalErrors[attr] = errs[i:]
+}
+
+// recentProposalErrors returns the number of proposals touching the given predicate which
+// recently failed to apply.
+func (ps *predicateStats) recentProposalErrors(attr string) uint64 {
+	since := time.Now().Add(-proposalErrorWindow)
+	ps.RLock()
+	defer ps.RUnlock()
+
+	errs := ps.proposalErrors[attr]
+	i := sort.Search(len(errs), func(i int) bool { return errs[i].After(since) })
+	return uint64(len(errs) - i)
+}
+
+// recordMissingIndex records that the function couldn't be run on the predicate because it
+// lacks the index the function needs.
+func (ps *predicateStats) recordMissingIndex(attr, fn string) {
+	ps.Lock()
+	defer ps.Unlock()
+	counts, ok := ps.missingIndex[attr]
+	if !ok {
+		counts = make(map[string]uint64)
+		ps.missingIndex[attr] = counts
+	}
+	counts[strings.ToLower(fn)]++
+}
+
+// forgetMissingIndex drops the counts of the queries which failed for lack of an index on the
+// predicate, once its schema changes and the index might have been added.
+func (ps *predicateStats) forgetMissingIndex(attr string) {
+	ps.Lock()
+	defer ps.Unlock()
+	delete(ps.missingIndex, attr)
+}
+
+// missingIndexFor returns the functions which often failed to run on the predicate for lack of
+// an index, in alphabetical order.
+func (ps *predicateStats) missingIndexFor(attr string) []string {
+	ps.RLock()
+	defer ps.RUnlock()
+	var fns []string
+	for fn, count := range ps.missingIndex[attr] {
+		if count >= minMissingIndexQueries {
+			fns = append(fns, fn)
+		}
+	}
+	sort.Strings(fns)
+	return fns
+}
+
+// vlogRefs returns the number of values held in the value log by the keys of the predicate,
+// counting every version still around. This iterates over all the keys of the predicate, so
+// it should only be done on demand.
+func vlogRefs(attr string) uint64 {
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+
+	prefix := x.PredicatePrefix(attr)
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.PrefetchValues = false
+	iterOpt.AllVersions = true
+	itr := txn.NewIterator(iterOpt)
+	defer itr.Close()
+
+	var refs uint64
+	for itr.Seek(prefix); itr.ValidForPrefix(prefix); itr.Next() {
+		item := itr.Item()
+		if item.IsDeletedOrExpired() {
+			continue
+		}
+		// Badger keeps values smaller than the threshold in the LSM tree.
+		if item.ValueSize() >= x.PostingValueThreshold {
+			refs++
+		}
+	}
+	return refs
+}
+
+// hasReverseEdges returns whether the reverse edges of the predicate are stored, as opposed to
+// @reverse just being declared in its schema, e.g. while they are being rebuilt.
+func hasReverseEdges(attr string) bool {
+	pk := x.ParsedKey{Attr: attr}
+	return hasKeyWithPrefix(pk.ReversePrefix())
+}
+
+// hasCountIndex returns whether the count index of the predicate is stored, as opposed to
+// @count just being declared in its schema, e.g. while the index is being rebuilt.
+func hasCountIndex(attr string) bool {
+	pk := x.ParsedKey{Attr: attr}
+	return hasKeyWithPrefix(pk.CountPrefix(false)) || hasKeyWithPrefix(pk.CountPrefix(true))
+}
+
+func hasKeyWithPrefix(prefix []byte) bool {
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.PrefetchValues = false
+	itr := txn.NewIterator(iterOpt)
+	defer itr.Close()
+
+	itr.Seek(prefix)
+	return itr.ValidForPrefix(prefix)
+}
+
+// matchValuePattern returns whether a sample of the values of the predicate has one matching
+// the regular expression. Only the first maxValueSamples values are looked at, so the result
+// is an estimate if there were more values left without any of them matching.
+func matchValuePattern(attr string, typ types.TypeID, re *regexp.Regexp) (
+	matched, estimated bool, rerr error) {
+	if typ == types.UidID {
+		return false, false, nil
+	}
+
+	readTs := posting.Oracle().MaxAssigned()
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	pk := x.ParsedKey{Attr: attr}
+	prefix := pk.DataPrefix()
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.PrefetchValues = false
+	iterOpt.AllVersions = true
+	iterOpt.Prefix = prefix
+	itr := txn.NewIterator(iterOpt)
+	defer itr.Close()
+
+	var prevKey []byte
+	var sampled int
+	for itr.Seek(prefix); itr.ValidForPrefix(prefix); {
+		if sampled >= maxValueSamples {
+			return false, true, nil
+		}
+		item := itr.Item()
+		if bytes.Equal(item.Key(), prevKey) {
+			itr.Next()
+			continue
+		}
+		prevKey = append(prevKey[:0], item.Key()...)
+
+		// We do need to copy over the key for ReadPostingList.
+		pl, err := posting.ReadPostingList(item.KeyCopy(nil), itr)
+		if err != nil {
+			return false, false, err
+		}
+		vals, err := pl.AllValues(readTs)
+		if err != nil {
+			return false, false, err
+		}
+		for _, val := range vals {
+			sampled++
+			str, err := types.Convert(val, types.StringID)
+			if err != nil {
+				continue
+			}
+			if re.MatchString(str.Value.(string)) {
+				return true, false, nil
+			}
+		}
+	}
+	return false, false, nil
+}
+
+// indexBuildMemFactors estimate the bytes of memory taken by rebuilding an index with the
+// tokenizer, per byte of the predicate on disk. Tokenizers emitting more tokens per value take
+// more memory. Custom tokenizers use defaultIndexBuildMemFactor.
+var indexBuildMemFactors = map[string]float64{
+	"bool":     0.5,
+	"int":      0.5,
+	"float":    0.5,
+	"year":     0.5,
+	"month":    0.5,
+	"day":      0.5,
+	"hour":     0.5,
+	"hash":     1,
+	"exact":    1.5,
+	"term":     2,
+	"fulltext": 3,
+	"geo":      4,
+	"trigram":  8,
+}
+
+const defaultIndexBuildMemFactor = 2
+
+// indexBuildMem estimates the peak memory taken by rebuilding the index of the predicate, going
+// by the space the predicate takes on disk and by its tokenizers. Zero is returned if the
+// predicate isn't indexed or its size isn't known yet.
+func indexBuildMem(attr string) uint64 {
+	if !schema.State().IsIndexed(attr) {
+		return 0
+	}
+	g := groups()
+	g.RLock()
+	tablet, ok := g.tablets[attr]
+	g.RUnlock()
+	if !ok || tablet.Space <= 0 {
+		return 0
+	}
+
+	var factor float64
+	for _, name := range schema.State().TokenizerNames(attr) {
+		f, ok := indexBuildMemFactors[name]
+		if !ok {
+			f = defaultIndexBuildMemFactor
+		}
+		factor += f
+	}
+	return uint64(float64(tablet.Space) * factor)
+}
@@ -112,3 +112,52 @@ func tokenizeValue(value types.Val, filter stringFilter) []string {
 	}
 	return tokens
 }
+
+// matchFuzzy returns whether the value is at most max edits away from the query.
+func matchFuzzy(value types.Val, query string, max int) bool {
+	val, ok := value.Value.(string)
+	return ok && len(val) > 0 && levenshteinDistance(val, query, max) <= max
+}
+
+// levenshteinDistance returns the number of runes to insert, delete or substitute to turn s
+// into t. It stops counting, returning max+1, once the distance is known to be above max.
+func levenshteinDistance(s, t string, max int) int {
+	a, b := []rune(s), []rune(t)
+	if d := len(a) - len(b); d > max || -d > max {
+		return max + 1
+	}
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if cur[j] < rowMin {
+				rowMin = cur[j]
+			}
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
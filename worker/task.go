@@ -19,7 +19,6 @@ package worker
 import (
 	"bytes"
 	"errors"
-	"fmt"
 	"sort"
 	"strconv"
 	"strings"
@@ -39,6 +38,8 @@ import (
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
 	otrace "go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	cindex "github.com/google/codesearch/index"
 	cregexp "github.com/google/codesearch/regexp"
@@ -51,47 +52,48 @@ var (
 	emptyValueList = pb.ValueList{Values: []*pb.TaskValue{}}
 )
 
-func invokeNetworkRequest(
-	ctx context.Context, addr string, f func(context.Context, pb.WorkerClient) (interface{}, error)) (interface{}, error) {
-	pl, err := conn.Get().Get(addr)
-	if err != nil {
-		return &emptyResult, x.Wrapf(err, "dispatchTaskOverNetwork: while retrieving connection.")
-	}
-
-	conn := pl.Get()
-	if span := otrace.FromContext(ctx); span != nil {
-		span.Annotatef(nil, "invokeNetworkRequest: Sending request to %v", addr)
-	}
-	c := pb.NewWorkerClient(conn)
-	return f(ctx, c)
+// invokeNetworkRequest sends the request to the servers one after the other, failing over to the
+// next one if a server can't be reached or is unavailable.
+func invokeNetworkRequest(ctx context.Context, pools []*conn.Pool,
+	f func(context.Context, pb.WorkerClient) (interface{}, error)) (interface{}, error) {
+	return conn.WithFailover(ctx, pools,
+		func(ctx context.Context, pl *conn.Pool) (interface{}, error) {
+			if span := otrace.FromContext(ctx); span != nil {
+				span.Annotatef(nil, "invokeNetworkRequest: Sending request to %v", pl.Addr)
+			}
+			return f(ctx, pb.NewWorkerClient(pl.Get()))
+		})
 }
 
 const backupRequestGracePeriod = time.Second
 
 // TODO: Cross-server cancellation as described in Jeff Dean's talk.
+
+// processWithBackupRequest sends the request to a server of the group, and a backup request to
+// another one if there's no reply within the grace period, returning the first reply. Both fail
+// over to the other servers of the group, so that a server going down doesn't fail the request.
 func processWithBackupRequest(
 	ctx context.Context,
 	gid uint32,
 	f func(context.Context, pb.WorkerClient) (interface{}, error)) (interface{}, error) {
-	addrs := groups().AnyTwoServers(gid)
-	if len(addrs) == 0 {
+	pools := groups().Replicas(gid)
+	if len(pools) == 0 {
 		return nil, errors.New("no network connection")
 	}
-	if len(addrs) == 1 {
-		reply, err := invokeNetworkRequest(ctx, addrs[0], f)
-		return reply, err
+	if len(pools) == 1 {
+		return invokeNetworkRequest(ctx, pools, f)
 	}
 	type taskresult struct {
 		reply interface{}
 		err   error
 	}
 
-	chResults := make(chan taskresult, len(addrs))
+	chResults := make(chan taskresult, 2)
 	ctx0, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	go func() {
-		reply, err := invokeNetworkRequest(ctx0, addrs[0], f)
+		reply, err := invokeNetworkRequest(ctx0, pools, f)
 		chResults <- taskresult{reply, err}
 	}()
 
@@ -101,40 +103,40 @@ func processWithBackupRequest(
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
+	case result := <-chResults:
+		// The request already went to the other servers if it could.
+		return result.reply, result.err
 	case <-timer.C:
-		go func() {
-			reply, err := invokeNetworkRequest(ctx0, addrs[1], f)
-			chResults <- taskresult{reply, err}
-		}()
+	}
+	// The backup request starts with the next server, and goes around to the first one last.
+	backup := append(append([]*conn.Pool{}, pools[1:]...), pools[0])
+	go func() {
+		reply, err := invokeNetworkRequest(ctx0, backup, f)
+		chResults <- taskresult{reply, err}
+	}()
+	var err error
+	for i := 0; i < 2; i++ {
 		select {
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		case result := <-chResults:
-			if result.err != nil {
-				select {
-				case <-ctx.Done():
-					return nil, ctx.Err()
-				case result := <-chResults:
-					return result.reply, result.err
-				}
-			} else {
+			if result.err == nil {
 				return result.reply, nil
 			}
+			err = result.err
 		}
-	case result := <-chResults:
-		if result.err != nil {
-			cancel() // Might as well cleanup resources ASAP
-			timer.Stop()
-			return invokeNetworkRequest(ctx, addrs[1], f)
-		}
-		return result.reply, nil
 	}
+	return nil, err
 }
 
 // ProcessTaskOverNetwork is used to process the query and get the result from
 // the instance which stores posting list corresponding to the predicate in the
 // query.
 func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (*pb.Result, error) {
+	// Don't start any work for a query which has already timed out or been cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	attr := q.Attr
 	gid := groups().BelongsTo(attr)
 	if gid == 0 {
@@ -146,9 +148,19 @@ func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (*pb.Result, error
 			attr, gid, q.ReadTs)
 	}
 
+	plan := QueryPlanFrom(ctx)
+	if plan != nil {
+		q.Explain = true
+	}
+	start := time.Now()
+
 	if groups().ServesGroup(gid) {
 		// No need for a network call, as this should be run from within this instance.
-		return processTask(ctx, q, gid)
+		reply, err := processTask(ctx, q, gid)
+		if err == nil && plan != nil {
+			plan.addResult(reply, gid, true, start)
+		}
+		return reply, err
 	}
 
 	result, err := processWithBackupRequest(ctx, gid,
@@ -164,6 +176,9 @@ func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (*pb.Result, error
 		span.Annotatef(nil, "Reply from server. len: %v gid: %v Attr: %v",
 			len(reply.UidMatrix), gid, attr)
 	}
+	if plan != nil {
+		plan.addResult(reply, gid, false, start)
+	}
 	return reply, nil
 }
 
@@ -219,6 +234,10 @@ const (
 	HasFn
 	UidInFn
 	CustomIndexFn
+	MatchFn
+	NearestFn
+	BM25Fn
+	CompareDatePartFn
 	StandardFn = 100
 )
 
@@ -234,6 +253,10 @@ func parseFuncType(srcFunc *pb.SrcFunction) (FuncType, string) {
 		//    counting on attr, then compare the result as scalar with int
 		return CompareScalarFn, fname
 	}
+	if srcFunc.DatePart != "" && ftype == CompareAttrFn {
+		// ge(year(dob), 1990) compares a component of the values, which isn't indexed.
+		return CompareDatePartFn, fname
+	}
 	return ftype, fname
 }
 
@@ -245,12 +268,14 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 	switch f {
 	case "le", "ge", "lt", "gt", "eq":
 		return CompareAttrFn, f
-	case "min", "max", "sum", "avg":
+	case "min", "max", "sum", "avg", "stddev", "variance", "median", "percentile":
 		return AggregatorFn, f
 	case "checkpwd":
 		return PasswordFn, f
 	case "regexp":
 		return RegexFn, f
+	case "match":
+		return MatchFn, f
 	case "alloftext", "anyoftext":
 		return FullTextSearchFn, f
 	case "has":
@@ -259,6 +284,10 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 		return UidInFn, f
 	case "anyof", "allof":
 		return CustomIndexFn, f
+	case "nearest":
+		return NearestFn, f
+	case "bm25":
+		return BM25Fn, f
 	default:
 		if types.IsGeoFunc(f) {
 			return GeoFn, f
@@ -269,7 +298,8 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 
 func needsIndex(fnType FuncType) bool {
 	switch fnType {
-	case CompareAttrFn, GeoFn, RegexFn, FullTextSearchFn, StandardFn:
+	case CompareAttrFn, GeoFn, RegexFn, MatchFn, FullTextSearchFn, StandardFn, NearestFn,
+		BM25Fn:
 		return true
 	default:
 		return false
@@ -291,14 +321,15 @@ type funcArgs struct {
 // The function tells us whether we want to fetch value posting lists or uid posting lists.
 func (srcFn *functionContext) needsValuePostings(typ types.TypeID) (bool, error) {
 	switch srcFn.fnType {
-	case AggregatorFn, PasswordFn:
+	case AggregatorFn, PasswordFn, CompareDatePartFn:
 		return true, nil
 	case CompareAttrFn:
 		if len(srcFn.tokens) > 0 {
 			return false, nil
 		}
 		return true, nil
-	case GeoFn, RegexFn, FullTextSearchFn, StandardFn, HasFn, CustomIndexFn:
+	case GeoFn, RegexFn, MatchFn, FullTextSearchFn, StandardFn, HasFn, CustomIndexFn, NearestFn,
+		BM25Fn:
 		// All of these require index, hence would require fetching uid postings.
 		return false, nil
 	case UidInFn, CompareScalarFn:
@@ -323,7 +354,7 @@ func handleValuePostings(ctx context.Context, args funcArgs) error {
 	}
 
 	switch srcFn.fnType {
-	case NotAFunction, AggregatorFn, PasswordFn, CompareAttrFn:
+	case NotAFunction, AggregatorFn, PasswordFn, CompareAttrFn, CompareDatePartFn:
 	default:
 		return x.Errorf("Unhandled function in handleValuePostings: %s", srcFn.fname)
 	}
@@ -339,6 +370,10 @@ func handleValuePostings(ctx context.Context, args funcArgs) error {
 	if srcFn.n == 0 {
 		return nil
 	}
+	facetsTree, err := preprocessFilter(q.FacetsFilter)
+	if err != nil {
+		return err
+	}
 
 	// This function has small boiletplate as handleUidPostings, around how the code gets
 	// concurrently executed. I didn't see much value in trying to separate it out, because the core
@@ -370,16 +405,25 @@ func handleValuePostings(ctx context.Context, args funcArgs) error {
 				return err
 			}
 			var vals []types.Val
+			var fcs [][]*api.Facet // The facets of each of vals, if they're needed.
 			if q.ExpandAll {
 				vals, err = pl.AllValues(args.q.ReadTs)
 			} else if listType && len(q.Langs) == 0 {
 				vals, err = pl.AllUntaggedValues(args.q.ReadTs)
+				if err == nil && (q.FacetParam != nil || facetsTree != nil) {
+					fcs, err = pl.AllUntaggedFacets(args.q.ReadTs)
+				}
 			} else {
 				var val types.Val
 				val, err = pl.ValueFor(args.q.ReadTs, q.Langs)
 				vals = append(vals, val)
 			}
 
+			var fl []*pb.Facets
+			if err == nil && len(vals) > 0 && (q.FacetParam != nil || facetsTree != nil) {
+				vals, fl, err = filterValueFacets(pl, q, facetsTree, vals, fcs)
+			}
+
 			if err == posting.ErrNoValue || len(vals) == 0 {
 				out.UidMatrix = append(out.UidMatrix, &emptyUIDList)
 				if q.DoCount {
@@ -426,25 +470,24 @@ func handleValuePostings(ctx context.Context, args funcArgs) error {
 						uidList.Uids = append(uidList.Uids, q.UidList.Uids[i])
 						break
 					}
+				} else if srcFn.fnType == CompareDatePartFn {
+					ok, err := matchesDatePart(srcFn, val)
+					if err != nil {
+						return err
+					}
+					if ok {
+						uidList.Uids = append(uidList.Uids, q.UidList.Uids[i])
+						break
+					}
 				} else {
 					vl.Values = append(vl.Values, newValue)
 				}
 			}
 			out.ValueMatrix = append(out.ValueMatrix, &vl)
 
-			if q.FacetsFilter != nil { // else part means isValueEdge
-				// This is Value edge and we are asked to do facet filtering. Not supported.
-				return x.Errorf("Facet filtering is not supported on values.")
-			}
-
 			// add facets to result.
 			if q.FacetParam != nil {
-				fs, err := pl.Facets(args.q.ReadTs, q.FacetParam, q.Langs)
-				if err != nil {
-					fs = []*api.Facet{}
-				}
-				out.FacetMatrix = append(out.FacetMatrix,
-					&pb.FacetsList{FacetsList: []*pb.Facets{{Facets: fs}}})
+				out.FacetMatrix = append(out.FacetMatrix, &pb.FacetsList{FacetsList: fl})
 			}
 
 			switch {
@@ -511,6 +554,49 @@ func handleValuePostings(ctx context.Context, args funcArgs) error {
 	return nil
 }
 
+// allFacets asks for all the facets of a posting.
+var allFacets = &pb.FacetParams{AllKeys: true}
+
+// filterValueFacets drops the values of pl with facets not matching the facets filter of q, and
+// returns the ones left along with the facets asked for by q. The facets of every value are in
+// fcs for the lists of values. Otherwise, the values all share the facets of the value picked by
+// the languages of q, and they're either all kept or all dropped.
+func filterValueFacets(pl *posting.List, q *pb.Query, ftree *facetsTree, vals []types.Val,
+	fcs [][]*api.Facet) ([]types.Val, []*pb.Facets, error) {
+	if len(fcs) == 0 {
+		fs, err := pl.Facets(q.ReadTs, allFacets, q.Langs)
+		if err != nil {
+			// The value picked by the languages might not exist, but then it has no facets.
+			fs = nil
+		}
+		fcs = [][]*api.Facet{fs}
+	}
+	perValue := len(fcs) == len(vals)
+
+	out := vals[:0]
+	var fl []*pb.Facets
+	for i, fs := range fcs {
+		ok, err := applyFacetsTree(fs, ftree)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			continue
+		}
+		if perValue {
+			out = append(out, vals[i])
+		}
+		fl = append(fl, &pb.Facets{Facets: facets.CopyFacets(fs, q.FacetParam)})
+	}
+	if perValue {
+		return out, fl, nil
+	}
+	if len(fl) == 0 {
+		return nil, nil, nil
+	}
+	return vals, fl, nil
+}
+
 // This function handles operations on uid posting lists. Index keys, reverse keys and some data
 // keys store uid posting lists.
 func handleUidPostings(ctx context.Context, args funcArgs, opts posting.ListOptions) error {
@@ -649,11 +735,10 @@ func handleUidPostings(ctx context.Context, args funcArgs, opts posting.ListOpti
 				if i == 0 {
 					span.Annotate(nil, "UidInFn")
 				}
-				reqList := &pb.List{Uids: []uint64{srcFn.uidPresent}}
 				topts := posting.ListOptions{
 					ReadTs:    args.q.ReadTs,
 					AfterUID:  0,
-					Intersect: reqList,
+					Intersect: srcFn.uidsPresent,
 				}
 				plist, err := pl.Uids(topts)
 				if err != nil {
@@ -709,6 +794,12 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 	stop := x.SpanTimer(span, "processTask"+q.Attr)
 	defer stop()
 
+	start := time.Now()
+	defer func() {
+		pstats.recordLatency(q.Attr, time.Since(start))
+	}()
+	x.PredicateQueries.Add(q.Attr, 1)
+
 	span.Annotatef(nil, "Waiting for startTs: %d", q.ReadTs)
 	if err := posting.Oracle().WaitForTs(ctx, q.ReadTs); err != nil {
 		return &emptyResult, err
@@ -730,6 +821,10 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 	if err != nil {
 		return &emptyResult, err
 	}
+	if out.Plan != nil {
+		countResults(out.Plan, out)
+		out.Plan.ProcessingNs = uint64(time.Since(start))
+	}
 	return out, nil
 }
 
@@ -748,8 +843,12 @@ func helpProcessTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result,
 	}
 
 	if needsIndex(srcFn.fnType) && !schema.State().IsIndexed(q.Attr) {
+		pstats.recordMissingIndex(q.Attr, q.SrcFunc.Name)
 		return nil, x.Errorf("Predicate %s is not indexed", q.Attr)
 	}
+	if needsIndex(srcFn.fnType) && indexPending(q.Attr) {
+		return nil, errIndexPending(q.Attr)
+	}
 
 	if len(q.Langs) > 0 && !schema.State().HasLang(attr) {
 		return nil, x.Errorf("Language tags can only be used with predicates of string type"+
@@ -781,6 +880,9 @@ func helpProcessTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result,
 	if err != nil {
 		return nil, err
 	}
+	if q.Explain {
+		out.Plan = planTask(q, srcFn, needsValPostings)
+	}
 	if needsValPostings {
 		span.Annotate(nil, "handleValuePostings")
 		if err = handleValuePostings(ctx, args); err != nil {
@@ -800,7 +902,7 @@ func helpProcessTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result,
 		}
 	}
 
-	if srcFn.fnType == CompareScalarFn && srcFn.isFuncAtRoot {
+	if srcFn.fnType == CompareScalarFn && (srcFn.isFuncAtRoot || srcFn.isCountIndexed) {
 		span.Annotate(nil, "handleCompareScalarFunction")
 		if err := handleCompareScalarFunction(funcArgs{q, gid, srcFn, out}); err != nil {
 			return nil, err
@@ -816,6 +918,30 @@ func helpProcessTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result,
 		}
 	}
 
+	if srcFn.fnType == MatchFn {
+		// The uids sharing a trigram with the value are checked for the edit distance.
+		span.Annotate(nil, "handleMatchFunction")
+		if err := handleMatchFunction(ctx, funcArgs{q, gid, srcFn, out}); err != nil {
+			return nil, err
+		}
+	}
+
+	if srcFn.fnType == NearestFn {
+		// The geometries are looked up around the point, then ranked by their distance to it.
+		span.Annotate(nil, "handleNearestFunction")
+		if err := handleNearestFunction(ctx, funcArgs{q, gid, srcFn, out}); err != nil {
+			return nil, err
+		}
+	}
+
+	if srcFn.fnType == BM25Fn {
+		// The source uids are scored with the frequencies stored in the full-text index.
+		span.Annotate(nil, "handleBM25Function")
+		if err := handleBM25Function(ctx, funcArgs{q, gid, srcFn, out}); err != nil {
+			return nil, err
+		}
+	}
+
 	// We fetch the actual value for the uids, compare them to the value in the
 	// request and filter the uids only if the tokenizer IsLossy.
 	if srcFn.fnType == CompareAttrFn && len(srcFn.tokens) > 0 {
@@ -872,7 +998,19 @@ func handleCompareScalarFunction(arg funcArgs) error {
 		readTs:  arg.q.ReadTs,
 		reverse: arg.q.Reverse,
 	}
-	return cp.evaluate(arg.out)
+	if arg.q.UidList == nil {
+		return cp.evaluate(arg.out)
+	}
+
+	// As a filter, only the source uids with that count are kept.
+	var res pb.Result
+	if err := cp.evaluate(&res); err != nil {
+		return err
+	}
+	uids := algo.MergeSorted(res.UidMatrix)
+	algo.IntersectWith(uids, arg.q.UidList, uids)
+	arg.out.UidMatrix = append(arg.out.UidMatrix, uids)
+	return nil
 }
 
 func handleRegexFunction(ctx context.Context, arg funcArgs) error {
@@ -884,79 +1022,116 @@ func handleRegexFunction(ctx context.Context, arg funcArgs) error {
 	if typ != types.StringID {
 		return x.Errorf("Got non-string type. Regex match is allowed only on string type.")
 	}
-	tokenizers := schema.State().TokenizerNames(attr)
-	var found bool
-	for _, t := range tokenizers {
-		if t == "trigram" { // TODO(tzdybal) - maybe just rename to 'regex' tokenizer?
-			found = true
-		}
-	}
-	if !found {
+	if !hasTrigramIndex(attr) {
+		pstats.recordMissingIndex(attr, arg.q.SrcFunc.Name)
 		return x.Errorf("Attribute %v does not have trigram index for regex matching.", attr)
 	}
 
 	query := cindex.RegexpQuery(arg.srcFn.regex.Syntax)
 	empty := pb.List{}
 	uids, err := uidsForRegex(attr, arg, query, &empty)
-	isList := schema.State().IsList(attr)
-	lang := langForFunc(arg.q.Langs)
-	if uids != nil {
-		arg.out.UidMatrix = append(arg.out.UidMatrix, uids)
+	if uids == nil {
+		return err
+	}
+	return filterStringValues(ctx, arg, uids, func(val types.Val) bool {
+		return matchRegex(val, arg.srcFn.regex)
+	})
+}
 
-		filtered := &pb.List{}
-		for _, uid := range uids.Uids {
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			default:
-			}
-			pl, err := posting.Get(x.DataKey(attr, uid))
-			if err != nil {
-				return err
-			}
+func handleMatchFunction(ctx context.Context, arg funcArgs) error {
+	attr := arg.q.Attr
+	typ, err := schema.State().TypeOf(attr)
+	if err != nil || !typ.IsScalar() {
+		return x.Errorf("Attribute not scalar: %s %v", attr, typ)
+	}
+	if typ != types.StringID {
+		return x.Errorf("Got non-string type. Fuzzy match is allowed only on string type.")
+	}
+	if !hasTrigramIndex(attr) {
+		pstats.recordMissingIndex(attr, arg.q.SrcFunc.Name)
+		return x.Errorf("Attribute %v does not have trigram index for fuzzy matching.", attr)
+	}
 
-			var val types.Val
-			if lang != "" {
-				val, err = pl.ValueForTag(arg.q.ReadTs, lang)
-			} else if isList {
-				vals, err := pl.AllUntaggedValues(arg.q.ReadTs)
-				if err == posting.ErrNoValue {
-					continue
-				} else if err != nil {
-					return err
-				}
-				for _, val := range vals {
-					// convert data from binary to appropriate format
-					strVal, err := types.Convert(val, types.StringID)
-					if err == nil && matchRegex(strVal, arg.srcFn.regex) {
-						filtered.Uids = append(filtered.Uids, uid)
-						break
-					}
-				}
+	uids, err := uidsForMatch(attr, arg)
+	if err != nil {
+		return err
+	}
+	return filterStringValues(ctx, arg, uids, func(val types.Val) bool {
+		return matchFuzzy(val, arg.srcFn.matchValue, arg.srcFn.maxDistance)
+	})
+}
 
-				continue
-			} else {
-				val, err = pl.Value(arg.q.ReadTs)
-			}
+// hasTrigramIndex returns whether the predicate is indexed with the trigram tokenizer, which
+// regexp and match need.
+func hasTrigramIndex(attr string) bool {
+	for _, t := range schema.State().TokenizerNames(attr) {
+		if t == "trigram" { // TODO(tzdybal) - maybe just rename to 'regex' tokenizer?
+			return true
+		}
+	}
+	return false
+}
+
+// filterStringValues adds the candidate uids to the result, keeping only the ones having a
+// value, converted to a string, for which match returns true.
+func filterStringValues(ctx context.Context, arg funcArgs, uids *pb.List,
+	match func(types.Val) bool) error {
+	attr := arg.q.Attr
+	isList := schema.State().IsList(attr)
+	lang := langForFunc(arg.q.Langs)
+	arg.out.UidMatrix = append(arg.out.UidMatrix, uids)
+
+	filtered := &pb.List{}
+	for _, uid := range uids.Uids {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		pl, err := posting.Get(x.DataKey(attr, uid))
+		if err != nil {
+			return err
+		}
 
+		var val types.Val
+		if lang != "" {
+			val, err = pl.ValueForTag(arg.q.ReadTs, lang)
+		} else if isList {
+			vals, err := pl.AllUntaggedValues(arg.q.ReadTs)
 			if err == posting.ErrNoValue {
 				continue
 			} else if err != nil {
 				return err
 			}
-
-			// convert data from binary to appropriate format
-			strVal, err := types.Convert(val, types.StringID)
-			if err == nil && matchRegex(strVal, arg.srcFn.regex) {
-				filtered.Uids = append(filtered.Uids, uid)
+			for _, val := range vals {
+				// convert data from binary to appropriate format
+				strVal, err := types.Convert(val, types.StringID)
+				if err == nil && match(strVal) {
+					filtered.Uids = append(filtered.Uids, uid)
+					break
+				}
 			}
+
+			continue
+		} else {
+			val, err = pl.Value(arg.q.ReadTs)
+		}
+
+		if err == posting.ErrNoValue {
+			continue
+		} else if err != nil {
+			return err
 		}
 
-		for i := 0; i < len(arg.out.UidMatrix); i++ {
-			algo.IntersectWith(arg.out.UidMatrix[i], filtered, arg.out.UidMatrix[i])
+		// convert data from binary to appropriate format
+		strVal, err := types.Convert(val, types.StringID)
+		if err == nil && match(strVal) {
+			filtered.Uids = append(filtered.Uids, uid)
 		}
-	} else {
-		return err
+	}
+
+	for i := 0; i < len(arg.out.UidMatrix); i++ {
+		algo.IntersectWith(arg.out.UidMatrix[i], filtered, arg.out.UidMatrix[i])
 	}
 	return nil
 }
@@ -1219,19 +1394,25 @@ func matchRegex(value types.Val, regex *cregexp.Regexp) bool {
 type functionContext struct {
 	tokens         []string
 	geoQuery       *types.GeoQueryData
+	geoPoint       *types.GeoPoint
+	numNearest     int
 	intersectDest  bool
 	ineqValue      types.Val
 	eqTokens       []types.Val
 	ineqValueToken string
 	n              int
 	threshold      int64
-	uidPresent     uint64
+	uidsPresent    *pb.List
+	matchValue     string
+	maxDistance    int
 	fname          string
 	fnType         FuncType
 	regex          *cregexp.Regexp
 	isFuncAtRoot   bool
+	isCountIndexed bool
 	isStringFn     bool
 	atype          types.TypeID
+	datePart       string
 }
 
 const (
@@ -1246,6 +1427,26 @@ func ensureArgsCount(srcFunc *pb.SrcFunction, expected int) error {
 	return nil
 }
 
+// matchesDatePart returns whether the component of the datetime val compared by srcFn, like the
+// month of le(month(dob), 6), matches any of its arguments.
+func matchesDatePart(srcFn *functionContext, val types.Val) (bool, error) {
+	dt, err := types.Convert(val, types.DateTimeID)
+	if err != nil {
+		// Values which aren't datetimes don't have any component to match.
+		return false, nil
+	}
+	part, err := types.DatePart(srcFn.datePart, dt)
+	if err != nil {
+		return false, err
+	}
+	for _, arg := range srcFn.eqTokens {
+		if types.CompareVals(srcFn.fname, part, arg) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func checkRoot(q *pb.Query, fc *functionContext) {
 	if q.UidList == nil {
 		// Fetch Uids from Store and populate in q.UidList.
@@ -1334,6 +1535,31 @@ func parseSrcFn(q *pb.Query) (*functionContext, error) {
 		} else {
 			fc.n = len(fc.tokens)
 		}
+	case CompareDatePartFn:
+		if !types.IsDatePart(q.SrcFunc.DatePart) {
+			return nil, x.Errorf("Unknown datetime component %s", q.SrcFunc.DatePart)
+		}
+		// The components aren't indexed, so only the values of the uids to filter are compared.
+		if q.UidList == nil {
+			return nil, x.Errorf("Function %s(%s(%s)) can only be used in a filter",
+				fc.fname, q.SrcFunc.DatePart, attr)
+		}
+		args := q.SrcFunc.Args
+		if fc.fname == eq && len(args) < 1 {
+			return nil, x.Errorf("eq expects atleast 1 argument.")
+		} else if fc.fname != eq && len(args) != 1 {
+			return nil, x.Errorf("%+v expects only 1 argument. Got: %+v", fc.fname, args)
+		}
+		for _, arg := range args {
+			n, err := strconv.ParseInt(arg, 0, 64)
+			if err != nil {
+				return nil, x.Wrapf(err, "Function %s(%s(%s)) requires an integer, but got %q",
+					fc.fname, q.SrcFunc.DatePart, attr, arg)
+			}
+			fc.eqTokens = append(fc.eqTokens, types.Val{Tid: types.IntID, Value: n})
+		}
+		fc.datePart = q.SrcFunc.DatePart
+		fc.n = len(q.UidList.Uids)
 	case CompareScalarFn:
 		if err = ensureArgsCount(q.SrcFunc, 1); err != nil {
 			return nil, err
@@ -1343,6 +1569,12 @@ func parseSrcFn(q *pb.Query) (*functionContext, error) {
 				q.SrcFunc.Name, q.SrcFunc.Args[0])
 		}
 		checkRoot(q, fc)
+		if !fc.isFuncAtRoot && fc.fname == eq && fc.threshold > 0 && schema.State().HasCount(attr) {
+			// As a filter, the uids with exactly that count are read off the count index instead
+			// of counting the postings of each of the source uids.
+			fc.n = 0
+			fc.isCountIndexed = true
+		}
 	case GeoFn:
 		// For geo functions, we get extra information used for filtering.
 		fc.tokens, fc.geoQuery, err = types.GetGeoTokens(q.SrcFunc)
@@ -1351,6 +1583,42 @@ func parseSrcFn(q *pb.Query) (*functionContext, error) {
 			return nil, err
 		}
 		fc.n = len(fc.tokens)
+	case NearestFn:
+		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
+			return nil, err
+		}
+		if fc.geoPoint, err = types.ParseGeoPoint(q.SrcFunc.Args[0]); err != nil {
+			return nil, err
+		}
+		if fc.numNearest, err = strconv.Atoi(q.SrcFunc.Args[1]); err != nil {
+			return nil, x.Wrapf(err, "Function nearest requires the number of nodes, but got %q",
+				q.SrcFunc.Args[1])
+		}
+		if fc.numNearest <= 0 {
+			return nil, x.Errorf("Function nearest requires a positive number of nodes, but got %d",
+				fc.numNearest)
+		}
+		// No postings are read per token or uid, handleNearestFunction does the lookup.
+		fc.n = 0
+		fc.isFuncAtRoot = q.UidList == nil
+	case BM25Fn:
+		if err = ensureArgsCount(q.SrcFunc, 1); err != nil {
+			return nil, err
+		}
+		if q.UidList == nil {
+			return nil, x.Errorf("Function bm25 can only be used on the predicates of a block")
+		}
+		required, found := verifyStringIndex(attr, FullTextSearchFn)
+		if !found {
+			pstats.recordMissingIndex(attr, q.SrcFunc.Name)
+			return nil, x.Errorf("Attribute %s is not indexed with type %s", attr, required)
+		}
+		if fc.tokens, err = getStringTokens(q.SrcFunc.Args, langForFunc(q.Langs),
+			FullTextSearchFn); err != nil {
+			return nil, err
+		}
+		// No postings are read per token or uid, handleBM25Function does the lookup.
+		fc.n = 0
 	case PasswordFn:
 		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
 			return nil, err
@@ -1364,6 +1632,7 @@ func parseSrcFn(q *pb.Query) (*functionContext, error) {
 		}
 		required, found := verifyStringIndex(attr, fnType)
 		if !found {
+			pstats.recordMissingIndex(attr, q.SrcFunc.Name)
 			return nil, x.Errorf("Attribute %s is not indexed with type %s", attr, required)
 		}
 		if fc.tokens, err = getStringTokens(q.SrcFunc.Args, langForFunc(q.Langs), fnType); err != nil {
@@ -1378,6 +1647,7 @@ func parseSrcFn(q *pb.Query) (*functionContext, error) {
 		}
 		tokerName := q.SrcFunc.Args[0]
 		if !verifyCustomIndex(q.Attr, tokerName) {
+			pstats.recordMissingIndex(q.Attr, q.SrcFunc.Name)
 			return nil, x.Errorf("Attribute %s is not indexed with custom tokenizer %s",
 				q.Attr, tokerName)
 		}
@@ -1416,18 +1686,33 @@ func parseSrcFn(q *pb.Query) (*functionContext, error) {
 			return nil, err
 		}
 		fc.n = 0
+	case MatchFn:
+		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
+			return nil, err
+		}
+		fc.matchValue = q.SrcFunc.Args[0]
+		if fc.maxDistance, err = strconv.Atoi(q.SrcFunc.Args[1]); err != nil || fc.maxDistance < 0 {
+			return nil, x.Errorf("Invalid maximum distance for match: %s", q.SrcFunc.Args[1])
+		}
+		fc.n = 0
 	case HasFn:
 		if err = ensureArgsCount(q.SrcFunc, 0); err != nil {
 			return nil, err
 		}
 		checkRoot(q, fc)
 	case UidInFn:
-		if err = ensureArgsCount(q.SrcFunc, 1); err != nil {
-			return nil, err
-		}
-		if fc.uidPresent, err = strconv.ParseUint(q.SrcFunc.Args[0], 0, 64); err != nil {
-			return nil, err
+		// The uids of a variable given as uid_in(friend, uid(a)) could be none at all, then
+		// nothing matches.
+		fc.uidsPresent = &pb.List{}
+		for _, arg := range q.SrcFunc.Args {
+			uid, err := strconv.ParseUint(arg, 0, 64)
+			if err != nil {
+				return nil, err
+			}
+			fc.uidsPresent.Uids = append(fc.uidsPresent.Uids, uid)
 		}
+		uids := fc.uidsPresent.Uids
+		sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
 		checkRoot(q, fc)
 		if fc.isFuncAtRoot {
 			return nil, x.Errorf("uid_in function not allowed at root")
@@ -1455,8 +1740,9 @@ func (w *grpcWorker) ServeTask(ctx context.Context, q *pb.Query) (*pb.Result, er
 	span.Annotatef(nil, "Attribute: %q NumUids: %v groupId: %v ServeTask", q.Attr, numUids, gid)
 
 	if !groups().ServesGroup(gid) {
-		return nil, fmt.Errorf("Temporary error, attr: %q groupId: %v Request sent to wrong server",
-			q.Attr, gid)
+		// Unavailable, so that the request goes to another server of the group.
+		return nil, status.Errorf(codes.Unavailable,
+			"Temporary error, attr: %q groupId: %v Request sent to wrong server", q.Attr, gid)
 	}
 
 	type reply struct {
@@ -1753,6 +2039,10 @@ func (cp *countParams) evaluate(out *pb.Result) error {
 	return nil
 }
 
+// hasCheckInterval is the number of keys iterated by handleHasFunction between the checks for
+// the cancellation of the query.
+const hasCheckInterval = 10000
+
 func handleHasFunction(ctx context.Context, q *pb.Query, out *pb.Result) error {
 	span := otrace.FromContext(ctx)
 	stop := x.SpanTimer(span, "handleHasFunction")
@@ -1788,6 +2078,7 @@ func handleHasFunction(ctx context.Context, q *pb.Query, out *pb.Result) error {
 	// This function could be switched to the stream.Lists framework, but after the change to use
 	// BitCompletePosting, the speed here is already pretty fast. The slowdown for @lang predicates
 	// occurs in filterStringFunction (like has(name) queries).
+	var numKeys int
 	for it.Seek(startKey); it.Valid(); {
 		item := it.Item()
 		if bytes.Equal(item.Key(), prevKey) {
@@ -1796,6 +2087,15 @@ func handleHasFunction(ctx context.Context, q *pb.Query, out *pb.Result) error {
 		}
 		prevKey = append(prevKey[:0], item.Key()...)
 
+		// Check for cancellation every so many keys, whether they end up in the result or not,
+		// so that a has() over a huge predicate can be stopped.
+		numKeys++
+		if numKeys%hasCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+
 		// Parse the key upfront, otherwise ReadPostingList would advance the
 		// iterator.
 		pk := x.Parse(item.Key())
@@ -1818,14 +2118,6 @@ func handleHasFunction(ctx context.Context, q *pb.Query, out *pb.Result) error {
 		} else if !empty {
 			result.Uids = append(result.Uids, pk.Uid)
 		}
-
-		if len(result.Uids)%100000 == 0 {
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			default:
-			}
-		}
 	}
 	if span != nil {
 		span.Annotatef(nil, "handleHasFunction found %d uids", len(result.Uids))
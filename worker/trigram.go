@@ -107,3 +107,18 @@ func uidsForRegex(attr string, arg funcArgs,
 	}
 	return results, nil
 }
+
+// uidsForMatch returns the uids having a value which shares a trigram with the value given to
+// match, the candidates to be within the maximum distance of it. A value of less than three
+// characters has no trigram, so there's no candidate.
+func uidsForMatch(attr string, arg funcArgs) (*pb.List, error) {
+	trigrams, err := tok.TrigramTokenizer{}.Tokens(arg.srcFn.matchValue)
+	if err != nil {
+		return nil, err
+	}
+	if len(trigrams) == 0 {
+		return &pb.List{}, nil
+	}
+	query := &cindex.Query{Op: cindex.QOr, Trigram: trigrams}
+	return uidsForRegex(attr, arg, query, &pb.List{})
+}
@@ -24,6 +24,7 @@ import (
 	"math"
 	"net"
 	"sync"
+	"time"
 
 	"github.com/dgraph-io/badger"
 	"github.com/dgraph-io/dgraph/conn"
@@ -31,6 +32,8 @@ import (
 	"github.com/dgraph-io/dgraph/x"
 
 	"github.com/golang/glog"
+	"go.opencensus.io/plugin/ocgrpc"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
 
@@ -53,10 +56,17 @@ func Init(ps *badger.DB) {
 	pstore = ps
 	// needs to be initialized after group config
 	pendingProposals = make(chan struct{}, Config.NumPendingProposals)
-	workerServer = grpc.NewServer(
+	tlsOpts, err := conn.SetupInternalTLS(Config.InternalTLSDir)
+	x.Checkf(err, "While setting up internal TLS with the certificates in %s",
+		Config.InternalTLSDir)
+	opts := append([]grpc.ServerOption{
 		grpc.MaxRecvMsgSize(x.GrpcMaxSize),
 		grpc.MaxSendMsgSize(x.GrpcMaxSize),
-		grpc.MaxConcurrentStreams(math.MaxInt32))
+		grpc.MaxConcurrentStreams(math.MaxInt32),
+		// Continues the traces of the queries made to other alphas through the WorkerClient.
+		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
+	}, tlsOpts...)
+	workerServer = grpc.NewServer(opts...)
 }
 
 // grpcWorker struct implements the gRPC server interface.
@@ -88,6 +98,39 @@ func StoreStats() string {
 	return "Currently no stats for badger"
 }
 
+// TransferLeadership hands the leadership of the group over to another voting member, if this
+// node is the leader, and waits until it's taken over or ctx is done.
+func TransferLeadership(ctx context.Context) error {
+	g := groups()
+	n := g.Node
+	if !n.AmLeader() {
+		return nil
+	}
+	var peerId uint64
+	for _, m := range g.members(g.groupId()) {
+		if m.Id != n.Id && !m.Learner {
+			peerId = m.Id
+			break
+		}
+	}
+	if peerId == 0 {
+		return x.Errorf("No other member of group %d to transfer the leadership to", g.groupId())
+	}
+	glog.Infof("Transferring the leadership of group %d to %#x", g.groupId(), peerId)
+	n.Raft().TransferLeadership(ctx, n.Id, peerId)
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for n.AmLeader() {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
 // BlockingStop stops all the nodes, server between other workers and syncs all marks.
 func BlockingStop() {
 	glog.Infof("Stopping group...")
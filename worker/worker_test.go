@@ -30,6 +30,7 @@ import (
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -124,6 +125,28 @@ func TestProcessTask(t *testing.T) {
 		}, algo.ToUintsListForTest(r.UidMatrix))
 }
 
+func TestProcessTaskExplain(t *testing.T) {
+	initTest(t, `friend:string @index(term) .`)
+
+	query := newQuery("friend", nil, []string{"anyofterms", "", "hey photon"})
+	query.Explain = true
+	r, err := helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.NotNil(t, r.Plan)
+	require.Equal(t, "anyofterms", r.Plan.Func)
+	require.Equal(t, "index", r.Plan.Strategy)
+	require.Equal(t, []string{"term"}, r.Plan.Tokenizers)
+	require.Equal(t, "merge", r.Plan.Combine)
+	require.False(t, r.Plan.Intersect)
+
+	query = newQuery("friend", []uint64{10, 12}, nil)
+	query.Explain = true
+	r, err = helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.Equal(t, "values", r.Plan.Strategy)
+	require.EqualValues(t, 2, r.Plan.NumSrcUids)
+}
+
 // newQuery creates a Query task and returns it.
 func newQuery(attr string, uids []uint64, srcFunc []string) *pb.Query {
 	x.AssertTrue(uids == nil || srcFunc == nil)
@@ -624,3 +647,18 @@ func TestMain(m *testing.M) {
 	Init(ps)
 	os.Exit(m.Run())
 }
+
+func TestLevenshteinDistance(t *testing.T) {
+	require.Equal(t, 0, levenshteinDistance("Stephen", "Stephen", 2))
+	require.Equal(t, 2, levenshteinDistance("Steven", "Stephen", 2))
+	require.Equal(t, 1, levenshteinDistance("Stephan", "Stephen", 2))
+	require.Equal(t, 3, levenshteinDistance("kitten", "sitting", 5))
+	require.Equal(t, 1, levenshteinDistance("naïve", "naive", 1))
+	// Counting stops once the distance is above the maximum.
+	require.Equal(t, 2, levenshteinDistance("Stephen", "Bob", 1))
+	require.Equal(t, 2, levenshteinDistance("abcdef", "uvwxyz", 1))
+
+	require.True(t, matchFuzzy(types.Val{Tid: types.StringID, Value: "Steven"}, "Stephen", 2))
+	require.False(t, matchFuzzy(types.Val{Tid: types.StringID, Value: "Steven"}, "Stephen", 1))
+	require.False(t, matchFuzzy(types.Val{Tid: types.StringID, Value: ""}, "ab", 2))
+}
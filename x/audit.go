@@ -0,0 +1,217 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package x
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// The audit log records the operations changing the data, the schema or the state of the
+// cluster, one JSON entry per line, in a file that is only ever appended to. Each entry carries
+// the hash of the one before it, and its own hash covers all of its fields, so that an entry
+// can't be changed, removed or inserted without breaking the chain of hashes that follows.
+
+// AuditEvent is an entry of the audit log.
+type AuditEvent struct {
+	Seq        uint64    `json:"seq"`
+	Time       time.Time `json:"time"`
+	Server     string    `json:"server"`
+	Operation  string    `json:"operation"`
+	User       string    `json:"user,omitempty"`
+	Namespace  uint64    `json:"namespace,omitempty"`
+	Address    string    `json:"address,omitempty"`
+	Endpoint   string    `json:"endpoint,omitempty"`
+	Predicates []string  `json:"predicates,omitempty"`
+	StartTs    uint64    `json:"start_ts,omitempty"`
+	CommitTs   uint64    `json:"commit_ts,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	PrevHash   string    `json:"prev_hash"`
+	Hash       string    `json:"hash"`
+}
+
+// hash returns the hash of the entry, taken over its JSON encoding without the hash itself.
+func (ev AuditEvent) hash() (string, error) {
+	ev.Hash = ""
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+type auditLog struct {
+	sync.Mutex
+	server   string
+	f        *os.File
+	seq      uint64
+	lastHash string
+}
+
+var audit *auditLog
+
+// OpenAuditLog opens the audit log of the server, named alpha or zero, at path, creating it if
+// needed. The entries are appended to the existing ones, continuing their chain of hashes.
+// Nothing is audited if path is empty.
+func OpenAuditLog(path, server string) error {
+	if path == "" {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return Wrapf(err, "while opening the audit log %s", path)
+	}
+	al := &auditLog{server: server, f: f}
+	last, err := lastAuditEvent(f)
+	if err != nil {
+		f.Close()
+		return Wrapf(err, "while reading the audit log %s", path)
+	}
+	if last != nil {
+		al.seq, al.lastHash = last.Seq, last.Hash
+	}
+	audit = al
+	return nil
+}
+
+// lastAuditEvent returns the last entry of the audit log, or nil if it's empty.
+func lastAuditEvent(r io.Reader) (*AuditEvent, error) {
+	var last []byte
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			last = line
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	if last == nil {
+		return nil, nil
+	}
+	var ev AuditEvent
+	if err := json.Unmarshal(last, &ev); err != nil {
+		return nil, Errorf("Unable to parse the last entry: %v", err)
+	}
+	return &ev, nil
+}
+
+// AuditEnabled returns whether the operations are recorded in an audit log.
+func AuditEnabled() bool {
+	return audit != nil
+}
+
+// Audit appends the event to the audit log, if there's one. The sequence number, the time and
+// the hashes of the event are set here. The entry is synced to disk before returning.
+func Audit(ev AuditEvent) {
+	if audit == nil {
+		return
+	}
+	if err := audit.write(ev); err != nil {
+		glog.Errorf("Unable to write to the audit log: %v. Event: %+v", err, ev)
+	}
+}
+
+func (al *auditLog) write(ev AuditEvent) error {
+	al.Lock()
+	defer al.Unlock()
+
+	ev.Seq = al.seq + 1
+	ev.Time = time.Now().UTC()
+	ev.Server = al.server
+	ev.PrevHash = al.lastHash
+	hash, err := ev.hash()
+	if err != nil {
+		return err
+	}
+	ev.Hash = hash
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	if _, err := al.f.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	if err := al.f.Sync(); err != nil {
+		return err
+	}
+	al.seq, al.lastHash = ev.Seq, ev.Hash
+	return nil
+}
+
+// VerifyAuditLog checks the chain of hashes of the audit log read from r, and returns the
+// number of entries in it. The error points to the first entry found to be altered.
+func VerifyAuditLog(r io.Reader) (int, error) {
+	var prev AuditEvent
+	n := 0
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return n, err
+		}
+		if len(bytes.TrimSpace(line)) > 0 {
+			n++
+			var ev AuditEvent
+			if err := json.Unmarshal(line, &ev); err != nil {
+				return n, Errorf("Unable to parse entry at line %d: %v", n, err)
+			}
+			hash, herr := ev.hash()
+			switch {
+			case herr != nil:
+				return n, herr
+			case hash != ev.Hash:
+				return n, Errorf("Hash of entry %d at line %d doesn't match its content",
+					ev.Seq, n)
+			case ev.PrevHash != prev.Hash || ev.Seq != prev.Seq+1:
+				return n, Errorf("Entry %d at line %d doesn't follow entry %d", ev.Seq, n,
+					prev.Seq)
+			}
+			prev = ev
+		}
+		if err == io.EOF {
+			return n, nil
+		}
+	}
+}
+
+// AuditHandler records the calls to the admin endpoint served by h in the audit log, with the
+// address of the client, before serving them.
+func AuditHandler(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		Audit(AuditEvent{
+			Operation: "admin",
+			Address:   r.RemoteAddr,
+			Endpoint:  r.Method + " " + r.URL.RequestURI(),
+		})
+		h(w, r)
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package x
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	defer func() { audit = nil }()
+
+	path := filepath.Join(dir, "audit.log")
+	require.NoError(t, OpenAuditLog(path, "alpha"))
+	Audit(AuditEvent{Operation: "alter", Predicates: []string{"name"}})
+	Audit(AuditEvent{Operation: "mutate", User: "alice", StartTs: 5, CommitTs: 6})
+	require.NoError(t, audit.f.Close())
+
+	// The chain goes on once the log is opened again.
+	require.NoError(t, OpenAuditLog(path, "alpha"))
+	Audit(AuditEvent{Operation: "login", User: "alice", Address: "127.0.0.1:1234"})
+	require.NoError(t, audit.f.Close())
+
+	b, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	n, err := VerifyAuditLog(bytes.NewReader(b))
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	lines := strings.SplitAfter(strings.TrimSpace(string(b)), "\n")
+	require.Len(t, lines, 3)
+	var ev AuditEvent
+	require.NoError(t, json.Unmarshal([]byte(lines[2]), &ev))
+	require.Equal(t, uint64(3), ev.Seq)
+	require.Equal(t, "alpha", ev.Server)
+	require.Equal(t, "alice", ev.User)
+
+	// An entry changed, even with its own hash fixed up, breaks the chain.
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &ev))
+	ev.User = "bob"
+	ev.Hash, err = ev.hash()
+	require.NoError(t, err)
+	changed, err := json.Marshal(ev)
+	require.NoError(t, err)
+	_, err = VerifyAuditLog(strings.NewReader(lines[0] + string(changed) + "\n" + lines[2]))
+	require.Error(t, err)
+
+	// So does a removed entry.
+	_, err = VerifyAuditLog(strings.NewReader(lines[0] + lines[2]))
+	require.Error(t, err)
+	_, err = VerifyAuditLog(strings.NewReader(lines[1] + lines[2]))
+	require.Error(t, err)
+}
@@ -25,6 +25,10 @@ import (
 	"github.com/golang/glog"
 )
 
+// PostingValueThreshold is the size from which values written to the postings store are kept
+// in the value log, instead of alongside their keys in the LSM tree.
+const PostingValueThreshold = 1 << 10 // 1KB
+
 type TxnWriter struct {
 	db  *badger.DB
 	wg  sync.WaitGroup
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package x
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// FormatVersion is the version of the on-disk format of the postings and WAL directories
+	// written by this version of Dgraph. It's bumped with every change to the encoding of the keys
+	// or the values, and dgraph upgrade rewrites the directories written in an older format.
+	FormatVersion = 1
+	// MinFormatVersion is the oldest format this version of Dgraph reads without an upgrade.
+	MinFormatVersion = 0
+
+	// formatFile holds the version of the format of the directory it's in. The directories
+	// written before it was introduced have none, and are of version 0.
+	formatFile = "FORMAT"
+)
+
+// ReadFormatVersion returns the version of the format the directory is written in.
+func ReadFormatVersion(dir string) (int, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, formatFile))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	version, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil {
+		return 0, Wrapf(err, "while reading the format version of %s", dir)
+	}
+	return version, nil
+}
+
+// WriteFormatVersion records the version of the format the directory is written in.
+func WriteFormatVersion(dir string, version int) error {
+	return WriteFileSync(filepath.Join(dir, formatFile), []byte(strconv.Itoa(version)+"\n"),
+		0600)
+}
+
+// CheckFormatVersion returns an error if the directory is written in a format this version of
+// Dgraph can't read. An empty directory gets the current format, which is recorded in it.
+func CheckFormatVersion(dir string) error {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return WriteFormatVersion(dir, FormatVersion)
+	}
+	version, err := ReadFormatVersion(dir)
+	if err != nil {
+		return err
+	}
+	switch {
+	case version > FormatVersion:
+		return Errorf("Directory %s is written in format %d, newer than the format %d of this"+
+			" version of Dgraph", dir, version, FormatVersion)
+	case version < MinFormatVersion:
+		return Errorf("Directory %s is written in format %d, older than the format %d of this"+
+			" version of Dgraph. Run dgraph upgrade to rewrite it.", dir, version, FormatVersion)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package x
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckFormatVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "format")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// An empty directory gets the current format.
+	require.NoError(t, CheckFormatVersion(dir))
+	version, err := ReadFormatVersion(dir)
+	require.NoError(t, err)
+	require.Equal(t, FormatVersion, version)
+
+	// A directory without a format is of version 0.
+	require.NoError(t, os.Remove(filepath.Join(dir, formatFile)))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "MANIFEST"), nil, 0600))
+	version, err = ReadFormatVersion(dir)
+	require.NoError(t, err)
+	require.Equal(t, 0, version)
+	require.NoError(t, CheckFormatVersion(dir))
+
+	require.NoError(t, WriteFormatVersion(dir, FormatVersion+1))
+	require.Error(t, CheckFormatVersion(dir))
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, formatFile), []byte("x"), 0600))
+	_, err = ReadFormatVersion(dir)
+	require.Error(t, err)
+}
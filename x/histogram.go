@@ -59,19 +59,27 @@ func (h *slidingHistogram) nextTick() time.Time {
 	return h.nextT
 }
 
-func (h *slidingHistogram) Current() *hdrhistogram.Histogram {
+// rotate drops the buckets which fell out of the window since the last call.
+func (h *slidingHistogram) rotate() {
 	for h.nextTick().Before(time.Now()) {
 		h.tick()
 	}
+}
+
+func (h *slidingHistogram) Current() *hdrhistogram.Histogram {
+	h.rotate()
 	return h.windowed.Merge()
 }
 
+// RecordValue rotates the window before recording the value, so that it isn't recorded in
+// buckets which are already out of the window.
 func (h *slidingHistogram) RecordValue(v int64) error {
+	h.rotate()
 	return h.windowed.Current.RecordValue(v)
 }
 
 // A Histogram collects observed values by keeping bucketed counts. For
-// convenience, pb.y two sets of buckets are kept: A cumulative set (i.e.
+// convenience, two sets of buckets are kept: A cumulative set (i.e.
 // data is never evicted) and a windowed set (which keeps only recently
 // collected samples).
 //
@@ -115,3 +123,20 @@ func (h *Histogram) RecordValue(v int64) {
 func (h *Histogram) Stats() {
 
 }
+
+// Percentile returns the value at the given percentile (between 0 and 100) computed over
+// the windowed buckets, so only recently collected samples are taken into account.
+func (h *Histogram) Percentile(p float64) int64 {
+	h.Lock()
+	defer h.Unlock()
+
+	return h.sliding.Current().ValueAtQuantile(p)
+}
+
+// RecentCount returns the number of values recorded in the windowed buckets.
+func (h *Histogram) RecentCount() int64 {
+	h.Lock()
+	defer h.Unlock()
+
+	return h.sliding.Current().TotalCount()
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package x
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogramPercentile(t *testing.T) {
+	h := NewHistogram(time.Minute, 1000, 2)
+	require.Zero(t, h.RecentCount())
+	for v := int64(1); v <= 100; v++ {
+		h.RecordValue(v)
+	}
+	require.EqualValues(t, 100, h.RecentCount())
+	require.EqualValues(t, 50, h.Percentile(50))
+	require.EqualValues(t, 99, h.Percentile(99))
+
+	// The values above the maximum are recorded as the maximum, up to the precision kept.
+	h.RecordValue(5000)
+	require.InDelta(t, 1000, h.Percentile(100), 10)
+}
+
+func TestHistogramWindow(t *testing.T) {
+	const window = 20 * time.Millisecond
+	h := NewHistogram(window, 1000, 2)
+	h.RecordValue(500)
+	require.EqualValues(t, 1, h.RecentCount())
+
+	// The value recorded once the window has passed is kept, while the older one is dropped.
+	time.Sleep(2 * window)
+	h.RecordValue(10)
+	require.EqualValues(t, 1, h.RecentCount())
+	require.EqualValues(t, 10, h.Percentile(99))
+
+	time.Sleep(2 * window)
+	require.Zero(t, h.RecentCount())
+	require.Zero(t, h.Percentile(99))
+}
@@ -34,6 +34,7 @@ const (
 	// keys of same attributes are located together
 	defaultPrefix = byte(0x00)
 	byteSchema    = byte(0x01)
+	byteTypeDef   = byte(0x02)
 )
 
 func writeAttr(buf []byte, attr string) []byte {
@@ -58,6 +59,17 @@ func SchemaKey(attr string) []byte {
 	return buf
 }
 
+// TypeDefKey returns the key under which the definition of the object type is stored. Type
+// definitions get their own prefix, so they can be iterated over like the schema.
+func TypeDefKey(typeName string) []byte {
+	buf := make([]byte, 1+2+len(typeName))
+	buf[0] = byteTypeDef
+	rest := buf[1:]
+
+	writeAttr(rest, typeName)
+	return buf
+}
+
 func DataKey(attr string, uid uint64) []byte {
 	buf := make([]byte, 2+len(attr)+2+8)
 	buf[0] = defaultPrefix
@@ -144,6 +156,12 @@ func (p ParsedKey) IsSchema() bool {
 	return p.bytePrefix == byteSchema
 }
 
+// IsTypeDef returns whether the key holds the definition of an object type, in which case Attr
+// is the name of the type.
+func (p ParsedKey) IsTypeDef() bool {
+	return p.bytePrefix == byteTypeDef
+}
+
 func (p ParsedKey) IsType(typ byte) bool {
 	switch typ {
 	case ByteCount, ByteCountRev:
@@ -240,6 +258,13 @@ func SchemaPrefix() []byte {
 	return buf[:]
 }
 
+// TypeDefPrefix returns the prefix for the keys of type definitions.
+func TypeDefPrefix() []byte {
+	var buf [1]byte
+	buf[0] = byteTypeDef
+	return buf[:]
+}
+
 // PredicatePrefix returns the prefix for all keys belonging
 // to this predicate except schema key.
 func PredicatePrefix(predicate string) []byte {
@@ -250,6 +275,12 @@ func PredicatePrefix(predicate string) []byte {
 	return buf
 }
 
+// ParseAttr returns the attribute of the key, without parsing the rest of it.
+func ParseAttr(key []byte) string {
+	sz := int(binary.BigEndian.Uint16(key[1:3]))
+	return string(key[3 : 3+sz])
+}
+
 // Parse would parse the key. ParsedKey does not reuse the key slice, so the key slice can change
 // without affecting the contents of ParsedKey.
 func Parse(key []byte) *ParsedKey {
@@ -263,7 +294,7 @@ func Parse(key []byte) *ParsedKey {
 	k = k[sz:]
 
 	switch p.bytePrefix {
-	case byteSchema:
+	case byteSchema, byteTypeDef:
 		return p
 	default:
 	}
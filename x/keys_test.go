@@ -17,6 +17,7 @@
 package x
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 	"testing"
@@ -65,6 +66,19 @@ func TestIndexKey(t *testing.T) {
 	}
 }
 
+func TestParseAttr(t *testing.T) {
+	attr := NamespaceAttr(2, "name")
+	for _, key := range [][]byte{
+		DataKey(attr, 1),
+		ReverseKey(attr, 1),
+		IndexKey(attr, "term"),
+		CountKey(attr, 3, false),
+		SchemaKey(attr),
+	} {
+		require.Equal(t, attr, ParseAttr(key))
+	}
+}
+
 func TestReverseKey(t *testing.T) {
 	var uid uint64
 	for uid = 0; uid < 1001; uid++ {
@@ -91,3 +105,13 @@ func TestSchemaKey(t *testing.T) {
 		require.Equal(t, sattr, pk.Attr)
 	}
 }
+
+func TestTypeDefKey(t *testing.T) {
+	key := TypeDefKey("Person")
+	pk := Parse(key)
+
+	require.True(t, pk.IsTypeDef())
+	require.False(t, pk.IsSchema())
+	require.Equal(t, "Person", pk.Attr)
+	require.True(t, bytes.HasPrefix(key, TypeDefPrefix()))
+}
@@ -35,6 +35,10 @@ var (
 	LcacheMiss    *expvar.Int
 	LcacheRace    *expvar.Int
 	LcacheEvicts  *expvar.Int
+	LcacheRejects *expvar.Int
+	// Results of the read-only queries served from the query cache, or not found there.
+	QueryCacheHit  *expvar.Int
+	QueryCacheMiss *expvar.Int
 
 	// value at particular point of time
 	PendingQueries   *expvar.Int
@@ -42,6 +46,7 @@ var (
 	LcacheSize       *expvar.Int
 	LcacheLen        *expvar.Int
 	LcacheCapacity   *expvar.Int
+	QueryCacheSize   *expvar.Int
 	DirtyMapSize     *expvar.Int
 	NumGoRoutines    *expvar.Int
 	MemoryInUse      *expvar.Int
@@ -55,6 +60,29 @@ var (
 	PredicateStats *expvar.Map
 	Conf           *expvar.Map
 
+	// Schema reads, by whether they were served locally or forwarded to another group.
+	SchemaReads       *expvar.Map
+	SchemaReadLatency *expvar.Map
+
+	// Per predicate activity, to find the tablets causing hot spots. The LRU hits and misses
+	// give the cache hit ratio of the predicate, and the evictions how much it's thrashing.
+	PredicateQueries       *expvar.Map
+	PredicateMutationEdges *expvar.Map
+	PredicateLcacheHit     *expvar.Map
+	PredicateLcacheMiss    *expvar.Map
+	PredicateLcacheEvicts  *expvar.Map
+	PredicateIndexTokens   *expvar.Map
+	// On-disk size of the tablets served, by group and predicate. Only the group leaders
+	// compute it.
+	TabletSize *expvar.Map
+	// Transactions pending a commit or abort, by group.
+	PendingTxns *expvar.Map
+	// Rollups of the posting lists, by trigger, the keys rolled up on their own because of
+	// their deltas, and the keys with deltas not rolled up yet.
+	Rollups           *expvar.Map
+	RollupKeys        *expvar.Int
+	RollupPendingKeys *expvar.Int
+
 	MaxPlSz int64
 	// TODO: Request statistics, latencies, 500, timeouts
 
@@ -77,13 +105,33 @@ func init() {
 	ActiveMutations = expvar.NewInt("dgraph_active_mutations_total")
 	PredicateStats = expvar.NewMap("dgraph_predicate_stats")
 	Conf = expvar.NewMap("dgraph_config")
+	SchemaReads = expvar.NewMap("dgraph_schema_reads_total")
+	SchemaReadLatency = expvar.NewMap("dgraph_schema_read_latency_ms")
+	for _, source := range []string{"local", "forwarded"} {
+		SchemaReadLatency.Set(source, new(expvar.Map).Init())
+	}
+	PredicateQueries = expvar.NewMap("dgraph_predicate_queries_total")
+	PredicateMutationEdges = expvar.NewMap("dgraph_predicate_mutation_edges_total")
+	PredicateLcacheHit = expvar.NewMap("dgraph_predicate_lru_hits_total")
+	PredicateLcacheMiss = expvar.NewMap("dgraph_predicate_lru_miss_total")
+	PredicateLcacheEvicts = expvar.NewMap("dgraph_predicate_lru_evicted_total")
+	PredicateIndexTokens = expvar.NewMap("dgraph_predicate_index_tokens_total")
+	TabletSize = expvar.NewMap("dgraph_tablet_size_bytes")
+	PendingTxns = expvar.NewMap("dgraph_pending_txns_total")
+	Rollups = expvar.NewMap("dgraph_rollups_total")
+	RollupKeys = expvar.NewInt("dgraph_rollup_keys_total")
+	RollupPendingKeys = expvar.NewInt("dgraph_rollup_pending_keys")
 	LcacheHit = expvar.NewInt("dgraph_lru_hits_total")
 	LcacheMiss = expvar.NewInt("dgraph_lru_miss_total")
 	LcacheRace = expvar.NewInt("dgraph_lru_race_total")
 	LcacheEvicts = expvar.NewInt("dgraph_lru_evicted_total")
+	LcacheRejects = expvar.NewInt("dgraph_lru_rejected_total")
 	LcacheSize = expvar.NewInt("dgraph_lru_size_bytes")
 	LcacheLen = expvar.NewInt("dgraph_lru_keys_total")
 	LcacheCapacity = expvar.NewInt("dgraph_lru_capacity_bytes")
+	QueryCacheHit = expvar.NewInt("dgraph_query_cache_hits_total")
+	QueryCacheMiss = expvar.NewInt("dgraph_query_cache_miss_total")
+	QueryCacheSize = expvar.NewInt("dgraph_query_cache_size_bytes")
 	MaxPlSize = expvar.NewInt("dgraph_max_list_bytes")
 	MaxPlLength = expvar.NewInt("dgraph_max_list_length")
 
@@ -125,6 +173,11 @@ func init() {
 			"dgraph_lru_evicted_total",
 			nil, nil,
 		),
+		"dgraph_lru_rejected_total": prometheus.NewDesc(
+			"dgraph_lru_rejected_total",
+			"dgraph_lru_rejected_total",
+			nil, nil,
+		),
 		"dgraph_lru_size_bytes": prometheus.NewDesc(
 			"dgraph_lru_size_bytes",
 			"dgraph_lru_size_bytes",
@@ -225,6 +278,86 @@ func init() {
 			"dgraph_predicate_stats",
 			[]string{"name"}, nil,
 		),
+		"dgraph_schema_reads_total": prometheus.NewDesc(
+			"dgraph_schema_reads_total",
+			"dgraph_schema_reads_total",
+			[]string{"source"}, nil,
+		),
+		"dgraph_schema_read_latency_ms": prometheus.NewDesc(
+			"dgraph_schema_read_latency_ms",
+			"dgraph_schema_read_latency_ms",
+			[]string{"source", "le"}, nil,
+		),
+		"dgraph_predicate_queries_total": prometheus.NewDesc(
+			"dgraph_predicate_queries_total",
+			"dgraph_predicate_queries_total",
+			[]string{"predicate"}, nil,
+		),
+		"dgraph_predicate_mutation_edges_total": prometheus.NewDesc(
+			"dgraph_predicate_mutation_edges_total",
+			"dgraph_predicate_mutation_edges_total",
+			[]string{"predicate"}, nil,
+		),
+		"dgraph_predicate_lru_hits_total": prometheus.NewDesc(
+			"dgraph_predicate_lru_hits_total",
+			"dgraph_predicate_lru_hits_total",
+			[]string{"predicate"}, nil,
+		),
+		"dgraph_predicate_lru_miss_total": prometheus.NewDesc(
+			"dgraph_predicate_lru_miss_total",
+			"dgraph_predicate_lru_miss_total",
+			[]string{"predicate"}, nil,
+		),
+		"dgraph_predicate_lru_evicted_total": prometheus.NewDesc(
+			"dgraph_predicate_lru_evicted_total",
+			"dgraph_predicate_lru_evicted_total",
+			[]string{"predicate"}, nil,
+		),
+		"dgraph_predicate_index_tokens_total": prometheus.NewDesc(
+			"dgraph_predicate_index_tokens_total",
+			"dgraph_predicate_index_tokens_total",
+			[]string{"predicate"}, nil,
+		),
+		"dgraph_tablet_size_bytes": prometheus.NewDesc(
+			"dgraph_tablet_size_bytes",
+			"dgraph_tablet_size_bytes",
+			[]string{"group", "predicate"}, nil,
+		),
+		"dgraph_pending_txns_total": prometheus.NewDesc(
+			"dgraph_pending_txns_total",
+			"dgraph_pending_txns_total",
+			[]string{"group"}, nil,
+		),
+		"dgraph_rollups_total": prometheus.NewDesc(
+			"dgraph_rollups_total",
+			"dgraph_rollups_total",
+			[]string{"trigger"}, nil,
+		),
+		"dgraph_rollup_keys_total": prometheus.NewDesc(
+			"dgraph_rollup_keys_total",
+			"dgraph_rollup_keys_total",
+			nil, nil,
+		),
+		"dgraph_rollup_pending_keys": prometheus.NewDesc(
+			"dgraph_rollup_pending_keys",
+			"dgraph_rollup_pending_keys",
+			nil, nil,
+		),
+		"dgraph_query_cache_hits_total": prometheus.NewDesc(
+			"dgraph_query_cache_hits_total",
+			"dgraph_query_cache_hits_total",
+			nil, nil,
+		),
+		"dgraph_query_cache_miss_total": prometheus.NewDesc(
+			"dgraph_query_cache_miss_total",
+			"dgraph_query_cache_miss_total",
+			nil, nil,
+		),
+		"dgraph_query_cache_size_bytes": prometheus.NewDesc(
+			"dgraph_query_cache_size_bytes",
+			"dgraph_query_cache_size_bytes",
+			nil, nil,
+		),
 		"badger_disk_reads_total": prometheus.NewDesc(
 			"badger_disk_reads_total",
 			"badger_disk_reads_total",
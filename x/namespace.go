@@ -0,0 +1,75 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package x
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// DefaultNamespace is the namespace of the requests which don't ask for one. Its predicates are
+// stored under their own name, so the data written before namespaces existed belongs to it.
+const DefaultNamespace uint64 = 0
+
+// NamespaceSeparator separates the namespace from the name of the predicate in the attributes
+// stored. It's not allowed in the names of the predicates.
+const NamespaceSeparator = "|"
+
+// NamespaceAttr returns the attribute the predicate of the namespace is stored under, e.g.
+// "3|name" for the name predicate of the namespace 3. The reverse marker stays in front.
+func NamespaceAttr(ns uint64, attr string) string {
+	if ns == DefaultNamespace {
+		return attr
+	}
+	if strings.HasPrefix(attr, "~") {
+		return "~" + NamespaceAttr(ns, attr[1:])
+	}
+	return strconv.FormatUint(ns, 10) + NamespaceSeparator + attr
+}
+
+// ParseNamespaceAttr returns the namespace of the attribute and the name of its predicate in the
+// namespace. It's the inverse of NamespaceAttr.
+func ParseNamespaceAttr(attr string) (uint64, string) {
+	if strings.HasPrefix(attr, "~") {
+		ns, pred := ParseNamespaceAttr(attr[1:])
+		return ns, "~" + pred
+	}
+	idx := strings.Index(attr, NamespaceSeparator)
+	if idx < 0 {
+		return DefaultNamespace, attr
+	}
+	ns, err := strconv.ParseUint(attr[:idx], 10, 64)
+	if err != nil {
+		return DefaultNamespace, attr
+	}
+	return ns, attr[idx+1:]
+}
+
+type namespaceKey struct{}
+
+// AttachNamespace returns a context carrying the namespace the request runs in.
+func AttachNamespace(ctx context.Context, ns uint64) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, ns)
+}
+
+// ExtractNamespace returns the namespace the request runs in, the default one if the context
+// doesn't carry any.
+func ExtractNamespace(ctx context.Context) uint64 {
+	ns, _ := ctx.Value(namespaceKey{}).(uint64)
+	return ns
+}
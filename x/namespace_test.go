@@ -0,0 +1,55 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package x
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceAttr(t *testing.T) {
+	tests := []struct {
+		ns   uint64
+		pred string
+		attr string
+	}{
+		{0, "name", "name"},
+		{0, "~friend", "~friend"},
+		{3, "name", "3|name"},
+		{3, "~friend", "~3|friend"},
+		{3, "_predicate_", "3|_predicate_"},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.attr, NamespaceAttr(tc.ns, tc.pred))
+		ns, pred := ParseNamespaceAttr(tc.attr)
+		require.Equal(t, tc.ns, ns)
+		require.Equal(t, tc.pred, pred)
+	}
+
+	// attributes not prefixed by a number belong to the default namespace
+	ns, pred := ParseNamespaceAttr("a|b")
+	require.Equal(t, DefaultNamespace, ns)
+	require.Equal(t, "a|b", pred)
+}
+
+func TestAttachNamespace(t *testing.T) {
+	require.Equal(t, DefaultNamespace, ExtractNamespace(context.Background()))
+	ctx := AttachNamespace(context.Background(), 7)
+	require.Equal(t, uint64(7), ExtractNamespace(ctx))
+}
@@ -39,6 +39,10 @@ const (
 
 const (
 	tlsRootCert = "ca.crt"
+
+	// The certificate and key of a node used for the traffic between the nodes.
+	tlsInternalCert = "node.crt"
+	tlsInternalKey  = "node.key"
 )
 
 // TLSHelperConfig define params used to create a tls.Config
@@ -59,6 +63,14 @@ func RegisterTLSFlags(flag *pflag.FlagSet) {
 	flag.Bool("tls_use_system_ca", true, "Include System CA into CA Certs.")
 }
 
+// RegisterInternalTLSFlags registers the flags setting up mutual TLS for the traffic between the
+// Dgraph nodes.
+func RegisterInternalTLSFlags(flag *pflag.FlagSet) {
+	flag.String("tls_internal_dir", "", "Path to directory with the CA certificate ca.crt, and "+
+		"the certificate node.crt and key node.key of this node, to use mutual TLS between "+
+		"the Dgraph nodes. The files are reloaded on SIGHUP.")
+}
+
 func LoadTLSConfig(conf *TLSHelperConfig, v *viper.Viper, tlsCertFile string, tlsKeyFile string) {
 	conf.CertDir = v.GetString("tls_dir")
 	if conf.CertDir != "" {
@@ -193,6 +205,58 @@ func GenerateTLSConfig(config TLSHelperConfig) (tlsCfg *tls.Config, reloadConfig
 	return tlsCfg, wrapper.reloadConfig, nil
 }
 
+// GenerateInternalTLSConfig creates the server and client *tls.Config of mutual TLS between the
+// Dgraph nodes, from the CA certificate and the certificate and key of the node in certDir. The
+// node presents the same certificate as a server and as a client, and the certificates of its
+// peers have to be signed by the CA. The returned function reloads the files.
+func GenerateInternalTLSConfig(certDir string) (serverCfg, clientCfg *tls.Config,
+	reloadConfig func(), err error) {
+	config := TLSHelperConfig{
+		ConfigType:   TLSServerConfig,
+		CertDir:      certDir,
+		CertRequired: true,
+		Cert:         path.Join(certDir, tlsInternalCert),
+		Key:          path.Join(certDir, tlsInternalKey),
+		RootCACert:   path.Join(certDir, tlsRootCert),
+	}
+	cert, err := parseCertificate(true, config.Cert, config.Key)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	pool, err := generateCertPool(config.RootCACert, false)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	wrapper := &wrapperTLSConfig{
+		cert:         &wrapperCert{cert: cert},
+		clientCert:   &wrapperCert{cert: cert},
+		clientCAPool: &wrapperCAPool{pool: pool},
+		clientAuth:   tls.RequireAndVerifyClientCert,
+		helperConfig: &config,
+	}
+	// As in GenerateTLSConfig, the certificates are verified by the wrapper against the CAs it
+	// holds, so that they can be reloaded.
+	serverCfg = &tls.Config{
+		GetCertificate:        wrapper.getCertificate,
+		ClientAuth:            tls.RequireAnyClientCert,
+		VerifyPeerCertificate: wrapper.verifyPeerCertificate,
+		MinVersion:            tls.VersionTLS12,
+		MaxVersion:            tls.VersionTLS12,
+	}
+	// The nodes are dialed at the addresses they advertise rather than at the names in their
+	// certificates, so only the chain of the certificate of the server is verified.
+	clientCfg = &tls.Config{
+		GetClientCertificate:  wrapper.getClientCertificate,
+		InsecureSkipVerify:    true,
+		VerifyPeerCertificate: wrapper.verifyServerCertificate,
+		MinVersion:            tls.VersionTLS12,
+		MaxVersion:            tls.VersionTLS12,
+	}
+	wrapper.config = serverCfg
+	return serverCfg, clientCfg, wrapper.reloadConfig, nil
+}
+
 type wrapperCert struct {
 	sync.RWMutex
 	cert *tls.Certificate
@@ -229,38 +293,48 @@ func (c *wrapperTLSConfig) getClientCertificate(_ *tls.CertificateRequestInfo) (
 
 func (c *wrapperTLSConfig) verifyPeerCertificate(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
 	if c.clientAuth >= tls.VerifyClientCertIfGiven && len(rawCerts) > 0 {
-		if len(rawCerts) > 0 {
-			pool := x509.NewCertPool()
-			for _, raw := range rawCerts[1:] {
-				if cert, err := x509.ParseCertificate(raw); err == nil {
-					pool.AddCert(cert)
-				} else {
-					return Errorf("Invalid certificate")
-				}
-			}
-
-			c.clientCAPool.RLock()
-			clientCAs := c.clientCAPool.pool
-			c.clientCAPool.RUnlock()
-			opts := x509.VerifyOptions{
-				Intermediates: pool,
-				Roots:         clientCAs,
-				CurrentTime:   time.Now(),
-				KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
-			}
-
-			cert, err := x509.ParseCertificate(rawCerts[0])
-			if err != nil {
-				return err
-			}
-			_, err = cert.Verify(opts)
-			if err != nil {
-				return Errorf("Failed to verify certificate")
-			}
+		return c.verifyChain(rawCerts, x509.ExtKeyUsageClientAuth)
+	}
+	return nil
+}
+
+func (c *wrapperTLSConfig) verifyServerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	return c.verifyChain(rawCerts, x509.ExtKeyUsageServerAuth)
+}
+
+// verifyChain verifies the certificate first in rawCerts, with the intermediate ones following it,
+// against the CAs of the wrapper.
+func (c *wrapperTLSConfig) verifyChain(rawCerts [][]byte, usage x509.ExtKeyUsage) error {
+	if len(rawCerts) == 0 {
+		return Errorf("Invalid certificate")
+	}
+	pool := x509.NewCertPool()
+	for _, raw := range rawCerts[1:] {
+		if cert, err := x509.ParseCertificate(raw); err == nil {
+			pool.AddCert(cert)
 		} else {
 			return Errorf("Invalid certificate")
 		}
 	}
+
+	c.clientCAPool.RLock()
+	clientCAs := c.clientCAPool.pool
+	c.clientCAPool.RUnlock()
+	opts := x509.VerifyOptions{
+		Intermediates: pool,
+		Roots:         clientCAs,
+		CurrentTime:   time.Now(),
+		KeyUsages:     []x509.ExtKeyUsage{usage},
+	}
+
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return err
+	}
+	_, err = cert.Verify(opts)
+	if err != nil {
+		return Errorf("Failed to verify certificate")
+	}
 	return nil
 }
 
@@ -278,6 +352,11 @@ func (c *wrapperTLSConfig) reloadConfig() {
 			c.cert.cert = cert
 			c.cert.Unlock()
 		}
+		if c.clientCert != nil {
+			c.clientCert.Lock()
+			c.clientCert.cert = cert
+			c.clientCert.Unlock()
+		}
 	}
 
 	// Configure Client CAs
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package x
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+// newTestCert creates a certificate for both servers and clients, signed by the parent, or
+// self-signed as a CA if it's nil.
+func newTestCert(t *testing.T, serial int64, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "node"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth,
+			x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+func (c *testCert) write(t *testing.T, certFile, keyFile string) {
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.der})
+	require.NoError(t, ioutil.WriteFile(certFile, certPEM, 0600))
+	if keyFile == "" {
+		return
+	}
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	require.NoError(t, ioutil.WriteFile(keyFile, keyPEM, 0600))
+}
+
+func (c *testCert) tlsCert() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.der}, PrivateKey: c.key}
+}
+
+// handshake runs a TLS handshake between the configurations, and returns the certificate
+// presented by the server along with the error of the server side.
+func handshake(serverCfg, clientCfg *tls.Config) (*x509.Certificate, error) {
+	sc, cc := net.Pipe()
+	defer sc.Close()
+	defer cc.Close()
+
+	client := tls.Client(cc, clientCfg)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- client.Handshake()
+		// Unblocks the server if the client gave up.
+		cc.Close()
+	}()
+	serverErr := tls.Server(sc, serverCfg).Handshake()
+	sc.Close()
+	clientErr := <-errCh
+	if serverErr != nil {
+		return nil, serverErr
+	}
+	if clientErr != nil {
+		return nil, clientErr
+	}
+	return client.ConnectionState().PeerCertificates[0], nil
+}
+
+func TestInternalTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := newTestCert(t, 1, nil)
+	ca.write(t, path.Join(dir, tlsRootCert), "")
+	node := newTestCert(t, 2, ca)
+	node.write(t, path.Join(dir, tlsInternalCert), path.Join(dir, tlsInternalKey))
+
+	serverCfg, clientCfg, reload, err := GenerateInternalTLSConfig(dir)
+	require.NoError(t, err)
+	peer, err := handshake(serverCfg, clientCfg)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), peer.SerialNumber.Int64())
+
+	// A client with a certificate signed by another CA is refused.
+	other := newTestCert(t, 3, newTestCert(t, 4, nil))
+	_, err = handshake(serverCfg, &tls.Config{
+		Certificates:       []tls.Certificate{other.tlsCert()},
+		InsecureSkipVerify: true,
+	})
+	require.Error(t, err)
+	// So is a client without any certificate.
+	_, err = handshake(serverCfg, &tls.Config{InsecureSkipVerify: true})
+	require.Error(t, err)
+	// And a server with a certificate signed by another CA.
+	_, err = handshake(&tls.Config{Certificates: []tls.Certificate{other.tlsCert()}}, clientCfg)
+	require.Error(t, err)
+
+	// The new certificate of the node is used once reloaded.
+	renewed := newTestCert(t, 5, ca)
+	renewed.write(t, path.Join(dir, tlsInternalCert), path.Join(dir, tlsInternalKey))
+	reload()
+	peer, err = handshake(serverCfg, clientCfg)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), peer.SerialNumber.Int64())
+}
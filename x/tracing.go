@@ -0,0 +1,79 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package x
+
+import (
+	"contrib.go.opencensus.io/exporter/ocagent"
+	openzipkin "github.com/openzipkin/zipkin-go"
+	zipkinhttp "github.com/openzipkin/zipkin-go/reporter/http"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"go.opencensus.io/exporter/jaeger"
+	"go.opencensus.io/exporter/zipkin"
+	otrace "go.opencensus.io/trace"
+)
+
+// RegisterTracingFlags registers the flags sampling the traces and configuring where they're
+// exported to.
+func RegisterTracingFlags(flag *pflag.FlagSet) {
+	flag.Float64("trace", 1.0, "The ratio of queries to trace.")
+	flag.String("jaeger.collector", "", "Send opencensus traces to Jaeger.")
+	flag.String("zipkin.collector", "",
+		"Send opencensus traces to the Zipkin collector at this URL, "+
+			"e.g. http://localhost:9411/api/v2/spans.")
+	flag.String("ocagent.collector", "",
+		"Send opencensus traces to the OpenCensus agent, or to the OpenCensus receiver of an "+
+			"OpenTelemetry collector, at this address, e.g. localhost:55678.")
+}
+
+// SetupTracing registers the exporters configured by the tracing flags, reporting the spans as
+// the given service, and samples the traces with the ratio set by --trace.
+func SetupTracing(v *viper.Viper, service string) error {
+	// Port details: https://www.jaegertracing.io/docs/getting-started/
+	// Default collectorEndpointURI := "http://localhost:14268"
+	if collector := v.GetString("jaeger.collector"); len(collector) > 0 {
+		je, err := jaeger.NewExporter(jaeger.Options{
+			Endpoint:    collector,
+			ServiceName: service,
+		})
+		if err != nil {
+			return Wrapf(err, "While creating the Jaeger exporter")
+		}
+		otrace.RegisterExporter(je)
+	}
+	if collector := v.GetString("zipkin.collector"); len(collector) > 0 {
+		endpoint, err := openzipkin.NewEndpoint(service, "")
+		if err != nil {
+			return Wrapf(err, "While creating the Zipkin endpoint")
+		}
+		otrace.RegisterExporter(zipkin.NewExporter(zipkinhttp.NewReporter(collector), endpoint))
+	}
+	if collector := v.GetString("ocagent.collector"); len(collector) > 0 {
+		oce, err := ocagent.NewExporter(
+			ocagent.WithInsecure(),
+			ocagent.WithAddress(collector),
+			ocagent.WithServiceName(service))
+		if err != nil {
+			return Wrapf(err, "While creating the OpenCensus agent exporter")
+		}
+		otrace.RegisterExporter(oce)
+	}
+
+	otrace.ApplyConfig(otrace.Config{
+		DefaultSampler: otrace.ProbabilitySampler(v.GetFloat64("trace"))})
+	return nil
+}
@@ -59,6 +59,8 @@ const (
 
 	// The attr used to store list of predicates for a node.
 	PredicateListAttr = "_predicate_"
+	// The attr used to store the names of the types of a node.
+	TypeAttr = "dgraph.type"
 
 	PortZeroGrpc = 5080
 	PortZeroHTTP = 6080
@@ -78,6 +80,7 @@ var (
 	regExpHostName = regexp.MustCompile(ValidHostnameRegex)
 	InitialPreds   = map[string]struct{}{
 		PredicateListAttr:   {},
+		TypeAttr:            {},
 		"dgraph.xid":        {},
 		"dgraph.password":   {},
 		"dgraph.user.group": {},
@@ -99,7 +102,8 @@ func ShouldCrash(err error) bool {
 	errStr := grpc.ErrorDesc(err)
 	return strings.Contains(errStr, "REUSE_RAFTID") ||
 		strings.Contains(errStr, "REUSE_ADDR") ||
-		strings.Contains(errStr, "NO_ADDR")
+		strings.Contains(errStr, "NO_ADDR") ||
+		strings.Contains(errStr, "LEARNER_NO_GROUP")
 }
 
 // WhiteSpace Replacer removes spaces and tabs from a string.
@@ -137,9 +141,9 @@ func AddCorsHeaders(w http.ResponseWriter) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
 	w.Header().Set("Access-Control-Allow-Headers",
-		"Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, X-Auth-Token, "+
-			"Cache-Control, X-Requested-With, X-Dgraph-CommitNow, X-Dgraph-Vars, "+
-			"X-Dgraph-MutationType, X-Dgraph-IgnoreIndexConflict")
+		"Content-Type, Content-Length, Content-Encoding, Accept-Encoding, X-CSRF-Token, "+
+			"X-Auth-Token, Cache-Control, X-Requested-With, X-Dgraph-CommitNow, "+
+			"X-Dgraph-Vars, X-Dgraph-MutationType, X-Dgraph-IgnoreIndexConflict")
 	w.Header().Set("Access-Control-Allow-Credentials", "true")
 	w.Header().Set("Connection", "close")
 }
@@ -41,6 +41,9 @@ type Options struct {
 	// between all shards, so with 4 shards and an LRUSize of 100, each shard
 	// receives 25 LRU slots.
 	LRUSize int
+	// Lookup, if set, is called for the xids found neither in the cache nor on disk, to find
+	// the uid of an existing node before assigning a new one. It returns 0 if there's none.
+	Lookup func(xid string) uint64
 }
 
 // XidMap allocates and tracks mappings between Xids and Uids in a threadsafe
@@ -103,17 +106,32 @@ func New(kv *badger.DB, zero *grpc.ClientConn, opt Options) *XidMap {
 		xm.shards[i].queue = list.New()
 		xm.shards[i].xm = xm
 	}
+	// The uids of a map kept from a previous run mustn't be handed out again, e.g. when the
+	// map is reused with a new cluster.
+	maxUid := xm.maxUid()
 	go func() {
 		zc := pb.NewZeroClient(zero)
 		const initBackoff = 10 * time.Millisecond
 		const maxBackoff = 5 * time.Second
 		backoff := initBackoff
+		num := uint64(10000)
 		for {
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-			assigned, err := zc.AssignUids(ctx, &pb.Num{Val: 10000})
+			assigned, err := zc.AssignUids(ctx, &pb.Num{Val: num})
 			cancel()
 			if err == nil {
 				backoff = initBackoff
+				num = 10000
+				if assigned.EndId <= maxUid {
+					// Skip past the uids of the map in one lease.
+					if maxUid-assigned.EndId > num {
+						num = maxUid - assigned.EndId
+					}
+					continue
+				}
+				if assigned.StartId <= maxUid {
+					assigned.StartId = maxUid + 1
+				}
 				xm.newRanges <- assigned
 				continue
 			}
@@ -129,6 +147,28 @@ func New(kv *badger.DB, zero *grpc.ClientConn, opt Options) *XidMap {
 	return xm
 }
 
+// maxUid returns the largest uid mapped on disk.
+func (m *XidMap) maxUid() uint64 {
+	var max uint64
+	x.Check(m.kv.View(func(txn *badger.Txn) error {
+		itr := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer itr.Close()
+		for itr.Rewind(); itr.Valid(); itr.Next() {
+			err := itr.Item().Value(func(uidBuf []byte) error {
+				if uid, _ := binary.Uvarint(uidBuf); uid > max {
+					max = uid
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	return max
+}
+
 // AssignUid creates new or looks up existing XID to UID mappings.
 func (m *XidMap) AssignUid(xid string) (uid uint64, isNew bool) {
 	fp := farm.Fingerprint64([]byte(xid))
@@ -163,6 +203,12 @@ func (m *XidMap) AssignUid(xid string) (uid uint64, isNew bool) {
 		sh.add(xid, uid, true)
 		return uid, false
 	}
+	if m.opt.Lookup != nil {
+		if uid = m.opt.Lookup(xid); uid != 0 {
+			sh.add(xid, uid, false)
+			return uid, false
+		}
+	}
 
 	uid = sh.assign(m.newRanges)
 	sh.add(xid, uid, false)